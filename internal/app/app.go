@@ -22,7 +22,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"log/slog"
 	"net"
+	"net/http"
 	"os"
 
 	"github.com/joho/godotenv"
@@ -30,11 +32,15 @@ import (
 	"github.com/mikhail5545/product-service-go/internal/database"
 	courserepo "github.com/mikhail5545/product-service-go/internal/database/course"
 	cprepo "github.com/mikhail5545/product-service-go/internal/database/course_part"
+	featuredrepo "github.com/mikhail5545/product-service-go/internal/database/featured"
 	imagerepo "github.com/mikhail5545/product-service-go/internal/database/image"
 	physicalgoodrepo "github.com/mikhail5545/product-service-go/internal/database/physical_good"
 	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
 	seminarrepo "github.com/mikhail5545/product-service-go/internal/database/seminar"
 	tsrepo "github.com/mikhail5545/product-service-go/internal/database/training_session"
+	"github.com/mikhail5545/product-service-go/internal/events"
+	"github.com/mikhail5545/product-service-go/internal/featureflags"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	"github.com/mikhail5545/product-service-go/internal/routers"
 	courseserver "github.com/mikhail5545/product-service-go/internal/server/course"
 	cpserver "github.com/mikhail5545/product-service-go/internal/server/course_part"
@@ -45,12 +51,16 @@ import (
 	tsserver "github.com/mikhail5545/product-service-go/internal/server/training_session"
 	courseservice "github.com/mikhail5545/product-service-go/internal/services/course"
 	cpservice "github.com/mikhail5545/product-service-go/internal/services/course_part"
+	featuredservice "github.com/mikhail5545/product-service-go/internal/services/featured"
 	imageservice "github.com/mikhail5545/product-service-go/internal/services/image"
 	imagemanager "github.com/mikhail5545/product-service-go/internal/services/image_manager"
 	physicalgoodservice "github.com/mikhail5545/product-service-go/internal/services/physical_good"
 	productservice "github.com/mikhail5545/product-service-go/internal/services/product"
 	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
+	storefrontservice "github.com/mikhail5545/product-service-go/internal/services/storefront"
 	tsservice "github.com/mikhail5545/product-service-go/internal/services/training_session"
+	"github.com/mikhail5545/product-service-go/internal/util/logger"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -66,6 +76,16 @@ func Run(ctx context.Context) {
 		log.Fatal("Error loading .env file")
 	}
 
+	// Route the standard log package through the structured logger as well.
+	appLogger := logger.New(os.Stderr, logger.ConfigFromEnv())
+	slog.SetDefault(appLogger)
+
+	shutdownTracing, err := tracing.Setup(tracing.ConfigFromEnv(), appLogger)
+	if err != nil {
+		log.Fatalf("Failed to set up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	DBHost := os.Getenv("POSTGRES_HOST")
 	DBPort := os.Getenv("POSTGRES_PORT")
 	DBUser := os.Getenv("POSTGRES_USER")
@@ -81,6 +101,52 @@ func Run(ctx context.Context) {
 
 	log.Println("Database connection established.")
 
+	if err := database.SetupTracing(db); err != nil {
+		log.Fatalf("Failed to set up query tracing: %v", err)
+	}
+	if err := database.SetupExplain(db, database.ExplainConfigFromEnv()); err != nil {
+		log.Fatalf("Failed to set up query EXPLAIN mode: %v", err)
+	}
+	if err := database.SetGuardIsolationFromEnv(); err != nil {
+		log.Fatalf("Failed to set guard transaction isolation level: %v", err)
+	}
+	if err := database.SetDefaultSortFromEnv(); err != nil {
+		log.Fatalf("Failed to set default list sort order: %v", err)
+	}
+	if err := database.SetCascadeDependentsFromEnv(); err != nil {
+		log.Fatalf("Failed to set permanent delete dependents policy: %v", err)
+	}
+	if err := common.SetMinPriceFromEnv(); err != nil {
+		log.Fatalf("Failed to set minimum product price: %v", err)
+	}
+	if err := common.SetMaxPriceFromEnv(); err != nil {
+		log.Fatalf("Failed to set maximum product price: %v", err)
+	}
+	if err := common.SetMaxShortDescriptionLengthFromEnv(); err != nil {
+		log.Fatalf("Failed to set maximum short description length: %v", err)
+	}
+	if err := common.SetMaxLongDescriptionLengthFromEnv(); err != nil {
+		log.Fatalf("Failed to set maximum long description length: %v", err)
+	}
+	if err := featureflags.SetFromEnv(); err != nil {
+		log.Fatalf("Failed to load feature flags: %v", err)
+	}
+	if err := common.SetSlugPolicyFromEnv(); err != nil {
+		log.Fatalf("Failed to set slug policy: %v", err)
+	}
+	if err := common.SetDefaultTimezoneFromEnv(); err != nil {
+		log.Fatalf("Failed to set default timezone: %v", err)
+	}
+	if err := common.SetDefaultCurrencyFromEnv(); err != nil {
+		log.Fatalf("Failed to set default currency: %v", err)
+	}
+
+	eventPublisher, err := events.NewPublisher(ctx, events.ConfigFromEnv(), appLogger)
+	if err != nil {
+		log.Fatalf("Failed to connect to events broker: %v", err)
+	}
+	defer eventPublisher.Close()
+
 	// Create an instance of required repositories
 	productRepo := productrepo.New(db)
 	trainingSessionRepo := tsrepo.New(db)
@@ -89,16 +155,27 @@ func Run(ctx context.Context) {
 	coursePartRepo := cprepo.New(db)
 	physicalGoodRepo := physicalgoodrepo.New(db)
 	imageRepo := imagerepo.New(db)
+	featuredRepo := featuredrepo.New(db)
 
 	// Create an instance of required services
-	imageManager := imagemanager.New(imageRepo)
-	productService := productservice.New(productRepo)
+	imageManager := imagemanager.New(imagemanager.ConfigFromEnv(), imageRepo)
+	productService := productservice.New(productRepo, courseRepo, seminarRepo, trainingSessionRepo, physicalGoodRepo, eventPublisher)
 	imageService := imageservice.New(imageManager, courseRepo, seminarRepo, trainingSessionRepo, physicalGoodRepo)
-	trainingSessionService := tsservice.New(trainingSessionRepo, productRepo)
-	courseService := courseservice.New(courseRepo, productRepo, coursePartRepo)
-	seminarService := seminarservice.New(seminarRepo, productRepo)
-	coursePartService := cpservice.New(coursePartRepo, courseRepo)
-	physicalGoodService := physicalgoodservice.New(physicalGoodRepo, productRepo)
+	trainingSessionService := tsservice.New(trainingSessionRepo, productRepo, featuredRepo)
+	courseService := courseservice.New(courseRepo, productRepo, coursePartRepo, featuredRepo, appLogger)
+	seminarService := seminarservice.New(seminarRepo, productRepo, featuredRepo, appLogger)
+	coursePartService := cpservice.New(coursePartRepo, courseRepo, productRepo, appLogger)
+	physicalGoodService := physicalgoodservice.New(physicalGoodRepo, productRepo, featuredRepo)
+	storefrontService := storefrontservice.New(storefrontservice.ConfigFromEnv(), courseService, seminarService, trainingSessionService, physicalGoodService)
+	featuredService := featuredservice.New(featuredRepo, productRepo, productService)
+
+	// --- Start seminar price worker ---
+	priceWorkerConfig, err := seminarservice.PriceWorkerConfigFromEnv()
+	if err != nil {
+		log.Fatalf("Failed to configure seminar price worker: %v", err)
+	}
+	priceWorker := seminarservice.NewPriceWorker(seminarRepo, seminarservice.EventInvalidator{Publisher: eventPublisher}, priceWorkerConfig, appLogger)
+	go priceWorker.Run(ctx)
 
 	// --- Start gRPC server ---
 	go func() {
@@ -125,11 +202,21 @@ func Run(ctx context.Context) {
 		}
 	}()
 
+	// --- Start profiling server ---
+	if pprofConfig := routers.PprofConfigFromEnv(); pprofConfig.Enabled {
+		go func() {
+			log.Printf("pprof server listening on %s", pprofConfig.Addr)
+			if err := http.ListenAndServe(pprofConfig.Addr, routers.Pprof(pprofConfig)); err != nil {
+				log.Printf("Failed to serve pprof server: %v", err)
+			}
+		}()
+	}
+
 	// --- Start HTTP server ---
 	e := echo.New()
 
 	// Register HTTP handlers
-	routers.Setup(e, productService, coursePartService, trainingSessionService, courseService, seminarService, physicalGoodService)
+	routers.Setup(e, productService, coursePartService, trainingSessionService, courseService, seminarService, physicalGoodService, storefrontService, featuredService, routers.CompressionConfigFromEnv(), routers.TimeoutConfigFromEnv())
 	httpListenAddr := fmt.Sprintf(":%d", httpPort)
 	if err := e.Start(httpListenAddr); err != nil {
 		log.Fatalf("Failed to start HTTP server: %v", err)
@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	muxpb "github.com/mikhail5545/proto-go/proto/media_service/mux/asset/v0"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -35,7 +37,15 @@ type Client struct {
 
 // NewClient creates a new media service client.
 func NewClient(ctx context.Context, addr string) (*Client, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	return newClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor()),
+	)
+}
+
+// newClient creates a media service client with the given dial options.
+func newClient(addr string, opts ...grpc.DialOption) (*Client, error) {
+	conn, err := grpc.NewClient(addr, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to gRPC server: %w", err)
 	}
@@ -56,3 +66,20 @@ func (c *Client) Close() error {
 	}
 	return nil
 }
+
+// State returns the current connectivity state of the gRPC connection to the media service
+// without issuing an RPC. It is intended for readiness probes (e.g. /readyz) that report
+// READY, CONNECTING or TRANSIENT_FAILURE.
+//
+// A freshly created connection stays IDLE until it is used, so an IDLE connection is asked to
+// start connecting and IDLE is returned for this call. A closed or nil client reports SHUTDOWN.
+func (c *Client) State() connectivity.State {
+	if c == nil || c.conn == nil {
+		return connectivity.Shutdown
+	}
+	state := c.conn.GetState()
+	if state == connectivity.Idle {
+		c.conn.Connect()
+	}
+	return state
+}
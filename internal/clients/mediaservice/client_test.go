@@ -0,0 +1,109 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package mediaservice
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// waitForState polls c.State() until it satisfies done or the context expires.
+func waitForState(ctx context.Context, c *Client, done func(connectivity.State) bool) connectivity.State {
+	for {
+		state := c.State()
+		if done(state) {
+			return state
+		}
+		if !c.conn.WaitForStateChange(ctx, state) {
+			return c.conn.GetState()
+		}
+	}
+}
+
+func TestClient_State(t *testing.T) {
+	t.Run("dead address", func(t *testing.T) {
+		// Arrange
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := lis.Addr().String()
+		require.NoError(t, lis.Close())
+
+		c, err := newClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer c.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Act
+		state := waitForState(ctx, c, func(s connectivity.State) bool {
+			return s == connectivity.TransientFailure
+		})
+
+		// Assert
+		assert.Equal(t, connectivity.TransientFailure, state)
+		assert.NotEqual(t, connectivity.Ready, c.State())
+	})
+
+	t.Run("live bufconn server", func(t *testing.T) {
+		// Arrange
+		lis := bufconn.Listen(1024 * 1024)
+		srv := grpc.NewServer()
+		go func() { _ = srv.Serve(lis) }()
+		defer srv.Stop()
+
+		c, err := newClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		require.NoError(t, err)
+		defer c.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Act
+		state := waitForState(ctx, c, func(s connectivity.State) bool {
+			return s == connectivity.Ready
+		})
+
+		// Assert
+		assert.Equal(t, connectivity.Ready, state)
+	})
+
+	t.Run("closed client", func(t *testing.T) {
+		// Arrange
+		c, err := newClient("passthrough:///unused", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		require.NoError(t, c.Close())
+
+		// Act & Assert
+		assert.Equal(t, connectivity.Shutdown, c.State())
+	})
+}
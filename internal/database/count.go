@@ -0,0 +1,50 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import "gorm.io/gorm"
+
+// CountByOwner counts the rows of query per owner for every id in ids with a single grouped query.
+// query must already select the counted table and any conditions, e.g. db.Model(&Model{}), so soft-deleted
+// rows are excluded the same way as in the other repository methods. ownerColumn holds the owner's ID.
+//
+// Every id in ids is present in the result: owners without rows are mapped to 0, not left out.
+func CountByOwner(query *gorm.DB, ownerColumn string, ids []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(ids))
+	if len(ids) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		OwnerID string
+		Count   int64
+	}
+	err := query.Select(ownerColumn+" AS owner_id, COUNT(*) AS count").
+		Where(ownerColumn+" IN ?", ids).
+		Group(ownerColumn).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		counts[id] = 0
+	}
+	for _, row := range rows {
+		counts[row.OwnerID] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,81 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestCountByOwner(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Exec(`CREATE TABLE parts (id TEXT PRIMARY KEY, owner_id TEXT, hidden BOOLEAN)`).Error)
+	seed := []struct {
+		id, owner string
+		hidden    bool
+	}{
+		{"p1", "a", false}, {"p2", "a", false}, {"p3", "a", false},
+		{"p4", "b", false}, {"p5", "b", true},
+		{"p6", "c", true},
+		{"p7", "other", false},
+	}
+	for _, row := range seed {
+		require.NoError(t, db.Exec(`INSERT INTO parts (id, owner_id, hidden) VALUES (?, ?, ?)`, row.id, row.owner, row.hidden).Error)
+	}
+
+	t.Run("counts per owner", func(t *testing.T) {
+		// Act
+		counts, err := CountByOwner(db.Table("parts"), "owner_id", []string{"a", "b", "c"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"a": 3, "b": 2, "c": 1}, counts)
+	})
+
+	t.Run("query conditions apply", func(t *testing.T) {
+		// Act
+		counts, err := CountByOwner(db.Table("parts").Where("hidden = ?", false), "owner_id", []string{"a", "b", "c"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"a": 3, "b": 1, "c": 0}, counts)
+	})
+
+	t.Run("owners without rows are mapped to zero", func(t *testing.T) {
+		// Act
+		counts, err := CountByOwner(db.Table("parts"), "owner_id", []string{"a", "missing"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int64{"a": 3, "missing": 0}, counts)
+	})
+
+	t.Run("no ids", func(t *testing.T) {
+		// Act
+		counts, err := CountByOwner(db.Table("parts"), "owner_id", nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, counts)
+	})
+}
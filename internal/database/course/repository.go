@@ -22,7 +22,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/database"
 	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
 	imagemodel "github.com/mikhail5545/product-service-go/internal/models/image"
 	"gorm.io/gorm"
@@ -74,7 +76,8 @@ type Repository interface {
 	// Create creates a new Course record in the database.
 	Create(ctx context.Context, course *coursemodel.Course) error
 	// SetInStock sets new value for course's InStock field.
-	SetInStock(ctx context.Context, id string, inStock bool) (int64, error)
+	// A record that already has the value is reported as AlreadyInState, not as missing.
+	SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error)
 	// Update performs partial update of Course record in the database using updates.
 	Update(ctx context.Context, course *coursemodel.Course, updates any) (int64, error)
 	// BatchUpdate performs partial update for a batch of Course records in the database.
@@ -93,16 +96,34 @@ type Repository interface {
 	DeleteImage(ctx context.Context, course *coursemodel.Course, mediaSvcID string) error
 	// FindOwnerIDsByImageID finds all course IDs associated with a given image media service ID.
 	FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error)
-	// DecrementImageCount decrements the uploaded_image_amount for the given course IDs.
+	// CountImagesByDetailsIDs counts images of every course in courseIDs with a single query.
+	// Courses without images are mapped to 0.
+	CountImagesByDetailsIDs(ctx context.Context, courseIDs []string) (map[string]int64, error)
+	// DecrementImageCount decrements the uploaded_image_amount for the given course IDs,
+	// soft-deleted ones included.
 	DecrementImageCount(ctx context.Context, courseIDs []string) (int64, error)
+	// ListOwnerIDsByImageID returns IDs of all course records associated with a given image media service ID.
+	ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error)
+	// DeleteImageAssociations removes the image from every course record it is associated with.
+	// Note: This only removes the associations. The caller is responsible for updating any related counters
+	// within the same transaction to ensure data consistency.
+	DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error)
 	// DeleteImageBatch deletes an image (single) from many course records in the database.
 	// Note: This only removes the association. The caller is responsible for updating any related counters
 	// within the same transaction to ensure data consistency.
 	DeleteImageBatch(ctx context.Context, courses []coursemodel.Course, image *imagemodel.Image) error
-	// Delete performs soft-delete of Course record.
-	Delete(ctx context.Context, id string) (int64, error)
+	// Delete performs soft-delete of Course record and stores reason in deleted_reason.
+	// Its products are soft-deleted too by the model's AfterDelete hook,
+	// unless ctx was returned by common.WithoutCascade.
+	Delete(ctx context.Context, id, reason string) (int64, error)
 	// DeletePermanent performs permanent delete of course record.
 	DeletePermanent(ctx context.Context, id string) (int64, error)
+	// ListDeletedIDsBefore returns IDs of course records soft-deleted before the given time.
+	ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error)
+	// CountDeletedBefore counts course records soft-deleted before the given time.
+	CountDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeletePermanentByIDs performs permanent delete of course records by ids.
+	DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error)
 	// Restore restores soft-deleted course record.
 	Restore(ctx context.Context, id string) (int64, error)
 	// DB returns the underlying gorm.DB instance.
@@ -157,7 +178,7 @@ func (r *gormRepository) GetReduced(ctx context.Context, id string) (*coursemode
 // List retrieves all course records from the database without any course parts.
 func (r *gormRepository) List(ctx context.Context, limit, offset int) ([]coursemodel.Course, error) {
 	var courses []coursemodel.Course
-	err := r.db.WithContext(ctx).Limit(limit).Offset(offset).Order("created_at desc").Find(&courses).Error
+	err := r.db.WithContext(ctx).Limit(limit).Offset(offset).Scopes(database.SortDefault).Find(&courses).Error
 	return courses, err
 }
 
@@ -187,7 +208,7 @@ func (r *gormRepository) GetReducedWithDeleted(ctx context.Context, id string) (
 // ListDeleted retrieves all soft-deleted course records from database without any course parts.
 func (r *gormRepository) ListDeleted(ctx context.Context, limit, offset int) ([]coursemodel.Course, error) {
 	var courses []coursemodel.Course
-	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Preload("Images").Limit(limit).Offset(offset).Order("created_at desc").Find(&courses).Error
+	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Preload("Images").Limit(limit).Offset(offset).Scopes(database.SortDefault).Find(&courses).Error
 	return courses, err
 }
 
@@ -220,7 +241,7 @@ func (r *gormRepository) ListUnpublished(ctx context.Context, limit, offset int)
 	err := r.db.WithContext(ctx).
 		Model(&coursemodel.Course{}).
 		Where("in_stock = ?", false).
-		Order("created_at DESC").
+		Scopes(database.SortDefault).
 		Limit(limit).Offset(offset).
 		Find(&courses).Error
 	return courses, err
@@ -248,9 +269,9 @@ func (r *gormRepository) Create(ctx context.Context, course *coursemodel.Course)
 }
 
 // SetInStock sets new value for course's InStock field.
-func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
-	res := r.db.WithContext(ctx).Model(&coursemodel.Course{}).Where("id = ?", id).Update("in_stock", inStock)
-	return res.RowsAffected, res.Error
+// A record that already has the value is reported as AlreadyInState, not as missing.
+func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error) {
+	return database.SetState(r.db.WithContext(ctx).Model(&coursemodel.Course{}).Where("id = ?", id), "in_stock", map[string]any{"in_stock": inStock})
 }
 
 // Update performs partial update of Course record in the database using updates.
@@ -320,15 +341,45 @@ func (r *gormRepository) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID s
 	return affectedCourseIDs, err
 }
 
-// DecrementImageCount decrements the uploaded_image_amount for the given course IDs.
+// CountImagesByDetailsIDs counts images of every course in courseIDs with a single query.
+// Courses without images are mapped to 0.
+func (r *gormRepository) CountImagesByDetailsIDs(ctx context.Context, courseIDs []string) (map[string]int64, error) {
+	joinTable := r.db.WithContext(ctx).Model(&coursemodel.Course{}).Association("Images").Relationship.JoinTable
+	return database.CountByOwner(r.db.WithContext(ctx).Table(joinTable.Table), "course_id", courseIDs)
+}
+
+// DecrementImageCount decrements the uploaded_image_amount for the given course IDs,
+// soft-deleted ones included.
 func (r *gormRepository) DecrementImageCount(ctx context.Context, courseIDs []string) (int64, error) {
 	res := r.db.WithContext(ctx).
+		Unscoped().
 		Model(&coursemodel.Course{}).
 		Where("id IN ?", courseIDs).
 		UpdateColumn("uploaded_image_amount", gorm.Expr("uploaded_image_amount - 1"))
 	return res.RowsAffected, res.Error
 }
 
+// ListOwnerIDsByImageID returns IDs of all course records associated with a given image media service ID.
+func (r *gormRepository) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	var ids []string
+	joinTable := r.db.WithContext(ctx).Model(&coursemodel.Course{}).Association("Images").Relationship.JoinTable
+	err := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Pluck("course_id", &ids).Error
+	return ids, err
+}
+
+// DeleteImageAssociations removes the image from every course record it is associated with.
+// Note: This only removes the associations. The caller is responsible for updating any related counters
+// within the same transaction to ensure data consistency.
+func (r *gormRepository) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	joinTable := r.db.WithContext(ctx).Model(&coursemodel.Course{}).Association("Images").Relationship.JoinTable
+	res := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Delete(nil)
+	return res.RowsAffected, res.Error
+}
+
 // AddImage adds a new image for the Course record in the database.
 func (r *gormRepository) AddImage(ctx context.Context, course *coursemodel.Course, image *imagemodel.Image) error {
 	return r.db.WithContext(ctx).Model(course).Association("Images").Append(image)
@@ -349,10 +400,20 @@ func (r *gormRepository) DeleteImageBatch(ctx context.Context, courses []coursem
 	return r.db.WithContext(ctx).Model(&courses).Association("Images").Delete(image)
 }
 
-// Delete performs soft-delete of Course record.
-func (r *gormRepository) Delete(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Delete(&coursemodel.Course{}, id)
-	return res.RowsAffected, res.Error
+// Delete performs soft-delete of Course record and stores reason in deleted_reason.
+// Its products are soft-deleted too by the model's AfterDelete hook,
+// unless ctx was returned by common.WithoutCascade.
+func (r *gormRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
+	var affected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&coursemodel.Course{}).Where("id = ?", id).UpdateColumn("deleted_reason", reason).Error; err != nil {
+			return err
+		}
+		res := tx.Delete(&coursemodel.Course{ID: id})
+		affected = res.RowsAffected
+		return res.Error
+	})
+	return affected, err
 }
 
 // DeletePermanent performs permanent delete of course record.
@@ -361,8 +422,31 @@ func (r *gormRepository) DeletePermanent(ctx context.Context, id string) (int64,
 	return res.RowsAffected, res.Error
 }
 
+// ListDeletedIDsBefore returns IDs of course records soft-deleted before the given time.
+func (r *gormRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&coursemodel.Course{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountDeletedBefore counts course records soft-deleted before the given time.
+func (r *gormRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&coursemodel.Course{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Count(&count).Error
+	return count, err
+}
+
+// DeletePermanentByIDs performs permanent delete of course records by ids.
+func (r *gormRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Delete(&coursemodel.Course{})
+	return res.RowsAffected, res.Error
+}
+
 // Restore restores soft-deleted course record.
 func (r *gormRepository) Restore(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Unscoped().Model(&coursemodel.Course{}).Where("id = ?", id).Update("deleted_at", nil)
+	res := r.db.WithContext(ctx).Unscoped().Model(&coursemodel.Course{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_reason": ""})
 	return res.RowsAffected, res.Error
 }
@@ -20,7 +20,9 @@ package coursepart
 
 import (
 	"context"
+	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/database"
 	coursepartmodel "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	"gorm.io/gorm"
 )
@@ -37,10 +39,15 @@ type Repository interface {
 	Select(ctx context.Context, id string, fields ...string) (*coursepartmodel.CoursePart, error)
 	// List retrieves a paginated list of all course part records in the database.
 	List(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, error)
+	// ListOrdered retrieves all published course part records of a course ordered by their number.
+	ListOrdered(ctx context.Context, courseID string) ([]coursepartmodel.CoursePart, error)
 	// Count counts the total number of all course part records by courseID in the database.
 	Count(ctx context.Context, courseID string) (int64, error)
 	// CountQuery counts the total number of course part racords in the database by query.
 	CountQuery(ctx context.Context, query any, args ...any) (int64, error)
+	// CountByDetailsIDs counts published course parts of every course in courseIDs with a single query.
+	// Courses without parts are mapped to 0.
+	CountByDetailsIDs(ctx context.Context, courseIDs []string) (map[string]int64, error)
 
 	// --- With soft-deleted, if soft-deleted then also unpublished ---
 
@@ -59,31 +66,52 @@ type Repository interface {
 	ListUnpublished(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, error)
 	// CountUnpublished counts the total number of all unpublished course part records in the database for the specitic course.
 	CountUnpublished(ctx context.Context, courseID string) (int64, error)
+	// MaxNumber returns the highest number among published and unpublished course parts of the course, or 0 if it has none.
+	MaxNumber(ctx context.Context, courseID string) (int, error)
+	// ListNumbered retrieves IDs and numbers of published and unpublished course parts of the course ordered by number.
+	// Parts with the same number are ordered by creation time.
+	ListNumbered(ctx context.Context, courseID string) ([]coursepartmodel.CoursePart, error)
 
 	// --- Common ---
 
 	// Create creates a new CoursePart record in the database.
 	Create(ctx context.Context, coursePart *coursepartmodel.CoursePart) error
+	// CreateBatch creates multiple new CoursePart records in the database.
+	CreateBatch(ctx context.Context, courseParts ...*coursepartmodel.CoursePart) error
+	// SetNumber sets a new value for course part's Number field.
+	SetNumber(ctx context.Context, id string, number int) (int64, error)
 	// SetPublished sets a new value for course part's Published field.
-	SetPublished(ctx context.Context, id string, published bool) (int64, error)
+	// A record that already has the value is reported as AlreadyInState, not as missing.
+	SetPublished(ctx context.Context, id string, published bool) (database.SetResult, error)
 	// SetPublishedByCourseID sets a new value for Published field in all course parts with specified courseID.
 	SetPublishedByCourseID(ctx context.Context, courseID string, published bool) (int64, error)
 	// UpdateVideoID sets new value for course part's `VideoID` field.
 	UpdateVideoID(ctx context.Context, id string, videoID *string) error
 	// Update performs partial update of a course part record using updates.
 	Update(ctx context.Context, coursePart *coursepartmodel.CoursePart, updates any) (int64, error)
-	// Delete performs soft-delete of a course part record.
-	Delete(ctx context.Context, id string) (int64, error)
+	// Delete performs soft-delete of a course part record and stores reason in deleted_reason.
+	Delete(ctx context.Context, id, reason string) (int64, error)
 	// DeleteByCourseID performs soft-delete for all course parts related to a course.
 	DeleteByCourseID(ctx context.Context, courseID string) (int64, error)
 	// DeletePermanent performs permanent delete of a course part record.
 	DeletePermanent(ctx context.Context, id string) (int64, error)
+	// ListDeletedIDsBefore returns IDs of course part records soft-deleted before the given time.
+	ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error)
+	// CountDeletedBefore counts course part records soft-deleted before the given time.
+	CountDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeletePermanentByIDs performs permanent delete of course part records by ids.
+	DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error)
 	// DeletePermanentByCourseID performs permanent delete for all course parts related to a course.
 	DeletePermanentByCourseID(ctx context.Context, courseID string) (int64, error)
+	// DeletePermanentByCourseIDs performs permanent delete for all course parts related to any of the courses.
+	DeletePermanentByCourseIDs(ctx context.Context, courseIDs []string) (int64, error)
 	// Restore restores soft-deleted course part record.
 	Restore(ctx context.Context, id string) (int64, error)
 	// RestoreByCourseID restores all soft-deleted course parts for a given course.
 	RestoreByCourseID(ctx context.Context, courseID string) (int64, error)
+	// RestoreByCourseIDDeletedSince restores soft-deleted course parts for a given course
+	// that were deleted at or after the given time.
+	RestoreByCourseIDDeletedSince(ctx context.Context, courseID string, since time.Time) (int64, error)
 
 	// DB returns the underlying gorm.DB instance.
 	DB() *gorm.DB
@@ -134,7 +162,14 @@ func (r *gormRepository) Select(ctx context.Context, id string, fields ...string
 // List retrieves a paginated list of all course part records in the database.
 func (r *gormRepository) List(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, error) {
 	var courseParts []coursepartmodel.CoursePart
-	err := r.db.WithContext(ctx).Where("published = ?", true).Order("created_at desc").Limit(limit).Offset(offset).Find(&courseParts, "course_id = ?", courseID).Error
+	err := r.db.WithContext(ctx).Where("published = ?", true).Scopes(database.SortDefault).Limit(limit).Offset(offset).Find(&courseParts, "course_id = ?", courseID).Error
+	return courseParts, err
+}
+
+// ListOrdered retrieves all published course part records of a course ordered by their number.
+func (r *gormRepository) ListOrdered(ctx context.Context, courseID string) ([]coursepartmodel.CoursePart, error) {
+	var courseParts []coursepartmodel.CoursePart
+	err := r.db.WithContext(ctx).Where("course_id = ? AND published = ?", courseID, true).Order("number ASC").Find(&courseParts).Error
 	return courseParts, err
 }
 
@@ -152,6 +187,12 @@ func (r *gormRepository) CountQuery(ctx context.Context, query any, args ...any)
 	return count, err
 }
 
+// CountByDetailsIDs counts published course parts of every course in courseIDs with a single query.
+// Courses without parts are mapped to 0.
+func (r *gormRepository) CountByDetailsIDs(ctx context.Context, courseIDs []string) (map[string]int64, error) {
+	return database.CountByOwner(r.db.WithContext(ctx).Model(&coursepartmodel.CoursePart{}).Where("published = ?", true), "course_id", courseIDs)
+}
+
 // --- With soft-deleted, if soft-deleted then also unpublished ---
 
 // GetWithDeleted retrieves single course part record from the database including soft-deleted course parts.
@@ -168,7 +209,7 @@ func (r *gormRepository) ListDeleted(ctx context.Context, courseID string, limit
 		Model(&coursepartmodel.CoursePart{}).
 		Where("course_id = ?", courseID).
 		Where("deleted_at IS NOT NULL").
-		Order("created_at desc").Limit(limit).Offset(offset).
+		Scopes(database.SortDefault).Limit(limit).Offset(offset).
 		Find(&courseParts).Error
 	return courseParts, err
 }
@@ -200,7 +241,7 @@ func (r *gormRepository) ListUnpublished(ctx context.Context, courseID string, l
 		Model(&coursepartmodel.CoursePart{}).
 		Where("published = ?", false).
 		Where("course_id = ?", courseID).
-		Order("created_at DESC").
+		Scopes(database.SortDefault).
 		Limit(limit).Offset(offset).
 		Find(&courseParts).Error
 	return courseParts, err
@@ -218,6 +259,29 @@ func (r *gormRepository) CountUnpublished(ctx context.Context, courseID string)
 	return count, err
 }
 
+// MaxNumber returns the highest number among published and unpublished course parts of the course, or 0 if it has none.
+func (r *gormRepository) MaxNumber(ctx context.Context, courseID string) (int, error) {
+	var number int
+	err := r.db.WithContext(ctx).
+		Model(&coursepartmodel.CoursePart{}).
+		Where("course_id = ?", courseID).
+		Select("COALESCE(MAX(number), 0)").
+		Scan(&number).Error
+	return number, err
+}
+
+// ListNumbered retrieves IDs and numbers of published and unpublished course parts of the course ordered by number.
+// Parts with the same number are ordered by creation time.
+func (r *gormRepository) ListNumbered(ctx context.Context, courseID string) ([]coursepartmodel.CoursePart, error) {
+	var courseParts []coursepartmodel.CoursePart
+	err := r.db.WithContext(ctx).
+		Select("id", "number").
+		Where("course_id = ?", courseID).
+		Order("number ASC, created_at ASC, id ASC").
+		Find(&courseParts).Error
+	return courseParts, err
+}
+
 // --- Common ---
 
 // Create creates a new CoursePart record in the database.
@@ -225,12 +289,23 @@ func (r *gormRepository) Create(ctx context.Context, coursePart *coursepartmodel
 	return r.db.WithContext(ctx).Create(coursePart).Error
 }
 
-// SetPublished sets a new value for course part's Published field.
-func (r *gormRepository) SetPublished(ctx context.Context, id string, published bool) (int64, error) {
-	res := r.db.WithContext(ctx).Model(&coursepartmodel.CoursePart{}).Where("id = ?", id).Update("published", published)
+// CreateBatch creates multiple new CoursePart records in the database.
+func (r *gormRepository) CreateBatch(ctx context.Context, courseParts ...*coursepartmodel.CoursePart) error {
+	return r.db.WithContext(ctx).Create(&courseParts).Error
+}
+
+// SetNumber sets a new value for course part's Number field.
+func (r *gormRepository) SetNumber(ctx context.Context, id string, number int) (int64, error) {
+	res := r.db.WithContext(ctx).Model(&coursepartmodel.CoursePart{}).Where("id = ?", id).Update("number", number)
 	return res.RowsAffected, res.Error
 }
 
+// SetPublished sets a new value for course part's Published field.
+// A record that already has the value is reported as AlreadyInState, not as missing.
+func (r *gormRepository) SetPublished(ctx context.Context, id string, published bool) (database.SetResult, error) {
+	return database.SetState(r.db.WithContext(ctx).Model(&coursepartmodel.CoursePart{}).Where("id = ?", id), "published", map[string]any{"published": published})
+}
+
 // SetPublishedByCourseID sets a new value for Published field in all course parts with specified courseID.
 func (r *gormRepository) SetPublishedByCourseID(ctx context.Context, courseID string, published bool) (int64, error) {
 	res := r.db.WithContext(ctx).Model(&coursepartmodel.CoursePart{}).Where("course_id = ?", courseID).Update("published", published)
@@ -248,9 +323,10 @@ func (r *gormRepository) Update(ctx context.Context, coursePart *coursepartmodel
 	return res.RowsAffected, res.Error
 }
 
-// Delete performs soft-delete of a course part record.
-func (r *gormRepository) Delete(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Delete(&coursepartmodel.CoursePart{}, id)
+// Delete performs soft-delete of a course part record and stores reason in deleted_reason.
+func (r *gormRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
+	res := r.db.WithContext(ctx).Model(&coursepartmodel.CoursePart{}).Where("id = ?", id).
+		UpdateColumns(map[string]any{"deleted_at": time.Now(), "deleted_reason": reason})
 	return res.RowsAffected, res.Error
 }
 
@@ -266,20 +342,59 @@ func (r *gormRepository) DeletePermanent(ctx context.Context, id string) (int64,
 	return res.RowsAffected, res.Error
 }
 
+// ListDeletedIDsBefore returns IDs of course part records soft-deleted before the given time.
+func (r *gormRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&coursepartmodel.CoursePart{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountDeletedBefore counts course part records soft-deleted before the given time.
+func (r *gormRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&coursepartmodel.CoursePart{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Count(&count).Error
+	return count, err
+}
+
+// DeletePermanentByIDs performs permanent delete of course part records by ids.
+func (r *gormRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Delete(&coursepartmodel.CoursePart{})
+	return res.RowsAffected, res.Error
+}
+
 // DeletePermanent performs permanent delete of a course part records by course id.
 func (r *gormRepository) DeletePermanentByCourseID(ctx context.Context, courseID string) (int64, error) {
 	res := r.db.WithContext(ctx).Unscoped().Where("course_id = ?", courseID).Delete(&coursepartmodel.CoursePart{})
 	return res.RowsAffected, res.Error
 }
 
+// DeletePermanentByCourseIDs performs permanent delete of course part records by course ids.
+func (r *gormRepository) DeletePermanentByCourseIDs(ctx context.Context, courseIDs []string) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Where("course_id IN ?", courseIDs).Delete(&coursepartmodel.CoursePart{})
+	return res.RowsAffected, res.Error
+}
+
 // Restore restores soft-deleted course part record.
 func (r *gormRepository) Restore(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Unscoped().Model(&coursepartmodel.CoursePart{}).Where("id = ?", id).Update("deleted_at", nil)
+	res := r.db.WithContext(ctx).Unscoped().Model(&coursepartmodel.CoursePart{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_reason": ""})
 	return res.RowsAffected, res.Error
 }
 
 // Restore restores soft-deleted course part records.
 func (r *gormRepository) RestoreByCourseID(ctx context.Context, courseID string) (int64, error) {
-	res := r.db.WithContext(ctx).Unscoped().Model(&coursepartmodel.CoursePart{}).Where("course_id = ?", courseID).Update("deleted_at", nil)
+	res := r.db.WithContext(ctx).Unscoped().Model(&coursepartmodel.CoursePart{}).Where("course_id = ?", courseID).
+		Updates(map[string]any{"deleted_at": nil, "deleted_reason": ""})
+	return res.RowsAffected, res.Error
+}
+
+// RestoreByCourseIDDeletedSince restores soft-deleted course part records of a course that were
+// deleted at or after since. Parts deleted on their own before that moment stay deleted.
+func (r *gormRepository) RestoreByCourseIDDeletedSince(ctx context.Context, courseID string, since time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Model(&coursepartmodel.CoursePart{}).
+		Where("course_id = ? AND deleted_at IS NOT NULL AND deleted_at >= ?", courseID, since).
+		Updates(map[string]any{"deleted_at": nil, "deleted_reason": ""})
 	return res.RowsAffected, res.Error
 }
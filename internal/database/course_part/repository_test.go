@@ -0,0 +1,185 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package coursepart
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB opens an isolated in-memory SQLite database with a reduced course_parts table.
+// The model itself can't be migrated on SQLite because of its postgres array column.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.Exec(`CREATE TABLE course_parts (id TEXT PRIMARY KEY, course_id TEXT, number INTEGER, published BOOLEAN DEFAULT false, created_at DATETIME, deleted_at DATETIME)`).Error; err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	return db
+}
+
+func TestRepository_DeletedBefore(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	cutoff := time.Now().Add(-30 * 24 * time.Hour)
+	oldDeleted := []string{uuid.New().String(), uuid.New().String()}
+	seed := []struct {
+		id        string
+		deletedAt any
+	}{
+		{oldDeleted[0], cutoff.Add(-48 * time.Hour)},
+		{oldDeleted[1], cutoff.Add(-time.Minute)},
+		{uuid.New().String(), cutoff.Add(time.Minute)},
+		{uuid.New().String(), nil},
+	}
+	for _, r := range seed {
+		if err := db.Exec(`INSERT INTO course_parts (id, course_id, deleted_at) VALUES (?, ?, ?)`, r.id, uuid.New().String(), r.deletedAt).Error; err != nil {
+			t.Fatalf("failed to seed course part: %v", err)
+		}
+	}
+
+	t.Run("CountDeletedBefore counts only records deleted before the cutoff", func(t *testing.T) {
+		count, err := repo.CountDeletedBefore(ctx, cutoff)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		var total int64
+		assert.NoError(t, db.Table("course_parts").Count(&total).Error)
+		assert.Equal(t, int64(len(seed)), total, "counting must not delete anything")
+	})
+
+	t.Run("ListDeletedIDsBefore", func(t *testing.T) {
+		ids, err := repo.ListDeletedIDsBefore(ctx, cutoff)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, oldDeleted, ids)
+	})
+}
+
+func TestRepository_CountByDetailsIDs(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	withThree, withOne, withNone := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	seed := []struct {
+		courseID  string
+		published bool
+		deletedAt any
+	}{
+		{withThree, true, nil}, {withThree, true, nil}, {withThree, true, nil},
+		{withThree, false, nil},
+		{withOne, true, nil},
+		{withOne, true, time.Now()},
+		{withNone, false, nil},
+	}
+	for _, r := range seed {
+		if err := db.Exec(`INSERT INTO course_parts (id, course_id, published, deleted_at) VALUES (?, ?, ?, ?)`, uuid.New().String(), r.courseID, r.published, r.deletedAt).Error; err != nil {
+			t.Fatalf("failed to seed course part: %v", err)
+		}
+	}
+
+	// Act
+	counts, err := repo.CountByDetailsIDs(context.Background(), []string{withThree, withOne, withNone})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{withThree: 3, withOne: 1, withNone: 0}, counts)
+}
+
+func TestRepository_MaxNumber(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	withGaps, empty := uuid.New().String(), uuid.New().String()
+	seed := []struct {
+		courseID  string
+		number    int
+		published bool
+		deletedAt any
+	}{
+		{withGaps, 1, true, nil},
+		{withGaps, 4, false, nil},
+		{withGaps, 9, true, time.Now()},
+		{uuid.New().String(), 12, true, nil},
+	}
+	for _, r := range seed {
+		if err := db.Exec(`INSERT INTO course_parts (id, course_id, number, published, deleted_at) VALUES (?, ?, ?, ?, ?)`, uuid.New().String(), r.courseID, r.number, r.published, r.deletedAt).Error; err != nil {
+			t.Fatalf("failed to seed course part: %v", err)
+		}
+	}
+
+	t.Run("unpublished counted, soft-deleted skipped", func(t *testing.T) {
+		number, err := repo.MaxNumber(context.Background(), withGaps)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, number)
+	})
+
+	t.Run("course without parts", func(t *testing.T) {
+		number, err := repo.MaxNumber(context.Background(), empty)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, number)
+	})
+}
+
+func TestRepository_ListNumbered(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	courseID := uuid.New().String()
+	now := time.Now()
+	seed := []struct {
+		id        string
+		number    int
+		published bool
+		createdAt time.Time
+		deletedAt any
+	}{
+		{"c", 5, false, now, nil},
+		{"a", 2, true, now.Add(-time.Hour), nil},
+		{"b-later", 5, true, now.Add(time.Hour), nil},
+		{"deleted", 3, true, now, now},
+	}
+	for _, r := range seed {
+		if err := db.Exec(`INSERT INTO course_parts (id, course_id, number, published, created_at, deleted_at) VALUES (?, ?, ?, ?, ?, ?)`, r.id, courseID, r.number, r.published, r.createdAt, r.deletedAt).Error; err != nil {
+			t.Fatalf("failed to seed course part: %v", err)
+		}
+	}
+
+	// Act
+	parts, err := repo.ListNumbered(context.Background(), courseID)
+
+	// Assert
+	assert.NoError(t, err)
+	var got []string
+	for _, p := range parts {
+		got = append(got, fmt.Sprintf("%s:%d", p.ID, p.Number))
+	}
+	assert.Equal(t, []string{"a:2", "c:5", "b-later:5"}, got)
+}
@@ -0,0 +1,57 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync/atomic"
+)
+
+var cascadeDependents atomic.Bool
+
+// SetCascadeDependents sets what DeletePermanent does with references to the deleted products
+// (e.g. featured list entries). If cascade is false, a referenced record is not deleted and
+// the service returns ErrHasDependents. If cascade is true, the references are removed in the
+// same transaction as the record.
+func SetCascadeDependents(cascade bool) {
+	cascadeDependents.Store(cascade)
+}
+
+// SetCascadeDependentsFromEnv sets the dependents policy from the DELETE_CASCADE_DEPENDENTS
+// environment variable. Empty means false.
+func SetCascadeDependentsFromEnv() error {
+	value := os.Getenv("DELETE_CASCADE_DEPENDENTS")
+	if value == "" {
+		SetCascadeDependents(false)
+		return nil
+	}
+	cascade, err := strconv.ParseBool(value)
+	if err != nil {
+		return fmt.Errorf("invalid DELETE_CASCADE_DEPENDENTS value %q", value)
+	}
+	SetCascadeDependents(cascade)
+	return nil
+}
+
+// CascadeDependents reports whether references are removed together with the deleted records,
+// false by default.
+func CascadeDependents() bool {
+	return cascadeDependents.Load()
+}
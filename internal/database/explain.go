@@ -0,0 +1,139 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// ExplainReporter receives the SQL of an inspected query, its plan lines and whether
+// the plan contains a full table scan.
+type ExplainReporter func(sql string, plan []string, fullScan bool)
+
+// ExplainConfig configures the diagnostic EXPLAIN mode. It is off by default.
+type ExplainConfig struct {
+	// Enabled turns on EXPLAIN for every SELECT (List/Count) query.
+	Enabled bool
+	// Report is called with every captured plan. Defaults to [LogFullScans].
+	Report ExplainReporter
+}
+
+// ExplainConfigFromEnv reads the DB_EXPLAIN environment variable. Invalid or missing values disable the mode.
+func ExplainConfigFromEnv() ExplainConfig {
+	enabled, _ := strconv.ParseBool(os.Getenv("DB_EXPLAIN"))
+	return ExplainConfig{Enabled: enabled}
+}
+
+// LogFullScans logs plans that contain a full table scan and ignores the rest.
+func LogFullScans(sql string, plan []string, fullScan bool) {
+	if !fullScan {
+		return
+	}
+	log.Printf("EXPLAIN: full table scan detected for query %q:\n%s", sql, strings.Join(plan, "\n"))
+}
+
+// SetupExplain registers a query callback that runs EXPLAIN for every SELECT statement after it
+// is executed and passes the plan to cfg.Report. It does nothing if cfg.Enabled is false.
+// The extra round trip makes it a diagnostic tool only, it should never be enabled in production.
+func SetupExplain(db *gorm.DB, cfg ExplainConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	report := cfg.Report
+	if report == nil {
+		report = LogFullScans
+	}
+	if err := db.Callback().Query().After("gorm:query").Register("explain:query", explainCallback(report)); err != nil {
+		return fmt.Errorf("failed to register explain callback: %w", err)
+	}
+	return nil
+}
+
+// explainCallback returns the gorm callback that explains the statement just executed.
+func explainCallback(report ExplainReporter) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if (db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound)) || db.Statement.SQL.Len() == 0 {
+			return
+		}
+		sql := db.Statement.SQL.String()
+		if !strings.HasPrefix(strings.ToUpper(strings.TrimSpace(sql)), "SELECT") {
+			return
+		}
+		prefix := "EXPLAIN "
+		if db.Dialector.Name() == "sqlite" {
+			prefix = "EXPLAIN QUERY PLAN "
+		}
+		plan, err := queryPlan(db, prefix+sql)
+		if err != nil {
+			log.Printf("EXPLAIN: failed to explain query %q: %v", sql, err)
+			return
+		}
+		report(sql, plan, hasFullScan(plan))
+	}
+}
+
+// queryPlan runs the explain statement and returns the last column of every row,
+// which holds the plan text both for Postgres and SQLite.
+func queryPlan(db *gorm.DB, explainSQL string) ([]string, error) {
+	rows, err := db.Statement.ConnPool.QueryContext(db.Statement.Context, explainSQL, db.Statement.Vars...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var plan []string
+	for rows.Next() {
+		values := make([]any, len(cols))
+		ptrs := make([]any, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, err
+		}
+		switch v := values[len(values)-1].(type) {
+		case []byte:
+			plan = append(plan, string(v))
+		default:
+			plan = append(plan, fmt.Sprint(v))
+		}
+	}
+	return plan, rows.Err()
+}
+
+// hasFullScan reports whether any plan line is a sequential (Postgres) or full (SQLite) table scan.
+func hasFullScan(plan []string) bool {
+	for _, line := range plan {
+		line = strings.TrimSpace(line)
+		if strings.Contains(line, "Seq Scan") || strings.HasPrefix(line, "SCAN ") {
+			return true
+		}
+	}
+	return false
+}
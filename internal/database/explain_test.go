@@ -0,0 +1,130 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type capturedPlan struct {
+	sql      string
+	plan     []string
+	fullScan bool
+}
+
+func newExplainTestDB(t *testing.T, enabled bool, captured *[]capturedPlan) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&productmodel.Product{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	err = SetupExplain(db, ExplainConfig{
+		Enabled: enabled,
+		Report: func(sql string, plan []string, fullScan bool) {
+			*captured = append(*captured, capturedPlan{sql: sql, plan: plan, fullScan: fullScan})
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to set up explain: %v", err)
+	}
+	return db
+}
+
+func TestSetupExplain(t *testing.T) {
+	t.Run("enabled captures plans for list and count", func(t *testing.T) {
+		// Arrange
+		var captured []capturedPlan
+		db := newExplainTestDB(t, true, &captured)
+
+		// Act
+		var products []productmodel.Product
+		assert.NoError(t, db.WithContext(context.Background()).Where("in_stock = ?", true).Limit(10).Find(&products).Error)
+		var count int64
+		assert.NoError(t, db.WithContext(context.Background()).Model(&productmodel.Product{}).Where("in_stock = ?", true).Count(&count).Error)
+
+		// Assert
+		if assert.Len(t, captured, 2) {
+			for _, c := range captured {
+				assert.Contains(t, c.sql, "products")
+				assert.NotEmpty(t, c.plan)
+			}
+		}
+	})
+
+	t.Run("indexed lookup is not a full scan", func(t *testing.T) {
+		// Arrange
+		var captured []capturedPlan
+		db := newExplainTestDB(t, true, &captured)
+
+		// Act
+		var product productmodel.Product
+		err := db.WithContext(context.Background()).Unscoped().Where("details_id = ?", uuid.New().String()).First(&product).Error
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		// Assert
+		if assert.Len(t, captured, 1) {
+			assert.False(t, captured[0].fullScan, "unexpected full scan in plan %v", captured[0].plan)
+		}
+	})
+
+	t.Run("disabled captures nothing", func(t *testing.T) {
+		// Arrange
+		var captured []capturedPlan
+		db := newExplainTestDB(t, false, &captured)
+
+		// Act
+		var products []productmodel.Product
+		assert.NoError(t, db.WithContext(context.Background()).Where("in_stock = ?", true).Limit(10).Find(&products).Error)
+		var count int64
+		assert.NoError(t, db.WithContext(context.Background()).Model(&productmodel.Product{}).Where("in_stock = ?", true).Count(&count).Error)
+
+		// Assert
+		assert.Empty(t, captured)
+	})
+}
+
+func TestHasFullScan(t *testing.T) {
+	tests := []struct {
+		name string
+		plan []string
+		want bool
+	}{
+		{name: "postgres seq scan", plan: []string{"Limit  (cost=0.00..1.10 rows=10 width=100)", "  ->  Seq Scan on products  (cost=0.00..11.00 rows=100 width=100)"}, want: true},
+		{name: "postgres index scan", plan: []string{"Index Scan using idx_products_details_id on products  (cost=0.15..8.17 rows=1 width=100)"}, want: false},
+		{name: "sqlite scan", plan: []string{"SCAN products"}, want: true},
+		{name: "sqlite search", plan: []string{"SEARCH products USING INDEX idx_products_details_id (details_id=?)"}, want: false},
+		{name: "empty plan", plan: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hasFullScan(tt.plan))
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package featured
+
+import (
+	"context"
+
+	featuredmodel "github.com/mikhail5545/product-service-go/internal/models/featured"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=../../test/database/featured_mock/repo_mock.go -package=featured_mock github.com/mikhail5545/product-service-go/internal/database/featured Repository
+
+// Repository defines the interface for featured list data operations.
+type Repository interface {
+	// List retrieves all featured entries ordered by position.
+	List(ctx context.Context) ([]featuredmodel.Featured, error)
+	// MaxPosition returns the highest position in the list, or -1 if the list is empty.
+	MaxPosition(ctx context.Context) (int, error)
+	// Create inserts a new featured entry.
+	Create(ctx context.Context, entry *featuredmodel.Featured) error
+	// Delete removes the entry of a product from the list.
+	Delete(ctx context.Context, productID string) (int64, error)
+	// SetPosition moves the entry of a product to position.
+	SetPosition(ctx context.Context, productID string, position int) (int64, error)
+	// CountByDetailsID counts entries of products owned by the record with given details ID,
+	// soft-deleted products included.
+	CountByDetailsID(ctx context.Context, detailsID string) (int64, error)
+	// DeleteByDetailsID removes entries of products owned by the record with given details ID,
+	// soft-deleted products included.
+	DeleteByDetailsID(ctx context.Context, detailsID string) (int64, error)
+	// CountByDetailsIDs counts entries of products owned by any of the records with given details IDs,
+	// soft-deleted products included.
+	CountByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error)
+	// DeleteByDetailsIDs removes entries of products owned by any of the records with given details IDs,
+	// soft-deleted products included.
+	DeleteByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error)
+
+	// DB returns the underlying gorm.DB instance.
+	DB() *gorm.DB
+	// WithTx returns a new repository instance with the given transaction.
+	WithTx(tx *gorm.DB) Repository
+}
+
+// gormRepository holds *gorm.DB instance.
+type gormRepository struct {
+	db *gorm.DB
+}
+
+// New creates a new GORM-based featured list repository.
+func New(db *gorm.DB) Repository {
+	return &gormRepository{db: db}
+}
+
+// DB returns the underlying gorm.DB instance.
+func (r *gormRepository) DB() *gorm.DB {
+	return r.db
+}
+
+// WithTx returns a new repository instance with the given transaction.
+func (r *gormRepository) WithTx(tx *gorm.DB) Repository {
+	return &gormRepository{db: tx}
+}
+
+// List retrieves all featured entries ordered by position.
+func (r *gormRepository) List(ctx context.Context) ([]featuredmodel.Featured, error) {
+	var entries []featuredmodel.Featured
+	err := r.db.WithContext(ctx).Order("position ASC").Find(&entries).Error
+	return entries, err
+}
+
+// MaxPosition returns the highest position in the list, or -1 if the list is empty.
+func (r *gormRepository) MaxPosition(ctx context.Context) (int, error) {
+	var position int
+	err := r.db.WithContext(ctx).Model(&featuredmodel.Featured{}).Select("COALESCE(MAX(position), -1)").Scan(&position).Error
+	return position, err
+}
+
+// Create inserts a new featured entry.
+func (r *gormRepository) Create(ctx context.Context, entry *featuredmodel.Featured) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
+
+// Delete removes the entry of a product from the list.
+func (r *gormRepository) Delete(ctx context.Context, productID string) (int64, error) {
+	res := r.db.WithContext(ctx).Where("product_id = ?", productID).Delete(&featuredmodel.Featured{})
+	return res.RowsAffected, res.Error
+}
+
+// SetPosition moves the entry of a product to position.
+func (r *gormRepository) SetPosition(ctx context.Context, productID string, position int) (int64, error) {
+	res := r.db.WithContext(ctx).Model(&featuredmodel.Featured{}).Where("product_id = ?", productID).Update("position", position)
+	return res.RowsAffected, res.Error
+}
+
+// productIDsOf is a subquery selecting IDs of all products owned by the records with given details IDs.
+func (r *gormRepository) productIDsOf(ctx context.Context, detailsIDs ...string) *gorm.DB {
+	return r.db.WithContext(ctx).Unscoped().Model(&productmodel.Product{}).Select("id").Where("details_id IN ?", detailsIDs)
+}
+
+// CountByDetailsID counts entries of products owned by the record with given details ID,
+// soft-deleted products included.
+func (r *gormRepository) CountByDetailsID(ctx context.Context, detailsID string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&featuredmodel.Featured{}).Where("product_id IN (?)", r.productIDsOf(ctx, detailsID)).Count(&count).Error
+	return count, err
+}
+
+// DeleteByDetailsID removes entries of products owned by the record with given details ID,
+// soft-deleted products included.
+func (r *gormRepository) DeleteByDetailsID(ctx context.Context, detailsID string) (int64, error) {
+	res := r.db.WithContext(ctx).Where("product_id IN (?)", r.productIDsOf(ctx, detailsID)).Delete(&featuredmodel.Featured{})
+	return res.RowsAffected, res.Error
+}
+
+// CountByDetailsIDs counts entries of products owned by any of the records with given details IDs,
+// soft-deleted products included.
+func (r *gormRepository) CountByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&featuredmodel.Featured{}).Where("product_id IN (?)", r.productIDsOf(ctx, detailsIDs...)).Count(&count).Error
+	return count, err
+}
+
+// DeleteByDetailsIDs removes entries of products owned by any of the records with given details IDs,
+// soft-deleted products included.
+func (r *gormRepository) DeleteByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error) {
+	res := r.db.WithContext(ctx).Where("product_id IN (?)", r.productIDsOf(ctx, detailsIDs...)).Delete(&featuredmodel.Featured{})
+	return res.RowsAffected, res.Error
+}
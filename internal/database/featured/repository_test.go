@@ -0,0 +1,164 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package featured
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	featuredmodel "github.com/mikhail5545/product-service-go/internal/models/featured"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB opens an isolated in-memory SQLite database with the featured and products tables migrated.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&featuredmodel.Featured{}, &productmodel.Product{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
+
+func productIDs(entries []featuredmodel.Featured) []string {
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ProductID
+	}
+	return ids
+}
+
+func TestRepository_List_Order(t *testing.T) {
+	// Arrange
+	repo := New(newTestDB(t))
+	ctx := context.Background()
+	first, second, third := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+	position, err := repo.MaxPosition(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, -1, position)
+
+	// Inserted out of order to make sure List sorts by position, not by insertion.
+	assert.NoError(t, repo.Create(ctx, &featuredmodel.Featured{ProductID: third, Position: 2}))
+	assert.NoError(t, repo.Create(ctx, &featuredmodel.Featured{ProductID: first, Position: 0}))
+	assert.NoError(t, repo.Create(ctx, &featuredmodel.Featured{ProductID: second, Position: 1}))
+
+	// Act
+	entries, err := repo.List(ctx)
+	position, maxErr := repo.MaxPosition(ctx)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{first, second, third}, productIDs(entries))
+	assert.NoError(t, maxErr)
+	assert.Equal(t, 2, position)
+}
+
+func TestRepository_SetPosition(t *testing.T) {
+	// Arrange
+	repo := New(newTestDB(t))
+	ctx := context.Background()
+	first, second := uuid.New().String(), uuid.New().String()
+	assert.NoError(t, repo.Create(ctx, &featuredmodel.Featured{ProductID: first, Position: 0}))
+	assert.NoError(t, repo.Create(ctx, &featuredmodel.Featured{ProductID: second, Position: 1}))
+
+	// Act
+	affectedA, errA := repo.SetPosition(ctx, first, 1)
+	affectedB, errB := repo.SetPosition(ctx, second, 0)
+	affectedMissing, errMissing := repo.SetPosition(ctx, uuid.New().String(), 0)
+
+	// Assert
+	assert.NoError(t, errA)
+	assert.NoError(t, errB)
+	assert.NoError(t, errMissing)
+	assert.Equal(t, int64(1), affectedA)
+	assert.Equal(t, int64(1), affectedB)
+	assert.Equal(t, int64(0), affectedMissing)
+	entries, err := repo.List(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{second, first}, productIDs(entries))
+}
+
+func TestRepository_ByDetailsID(t *testing.T) {
+	// Arrange
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+	detailsID, otherDetailsID := uuid.New().String(), uuid.New().String()
+	live := productmodel.Product{ID: uuid.New().String(), DetailsID: detailsID, DetailsType: "seminar"}
+	deleted := productmodel.Product{ID: uuid.New().String(), DetailsID: detailsID, DetailsType: "seminar"}
+	other := productmodel.Product{ID: uuid.New().String(), DetailsID: otherDetailsID, DetailsType: "course"}
+	for _, p := range []*productmodel.Product{&live, &deleted, &other} {
+		assert.NoError(t, db.Create(p).Error)
+		assert.NoError(t, repo.Create(ctx, &featuredmodel.Featured{ProductID: p.ID}))
+	}
+	assert.NoError(t, db.Delete(&deleted).Error)
+
+	// Act
+	count, countErr := repo.CountByDetailsID(ctx, detailsID)
+	ra, deleteErr := repo.DeleteByDetailsID(ctx, detailsID)
+	entries, listErr := repo.List(ctx)
+
+	// Assert
+	assert.NoError(t, countErr)
+	assert.Equal(t, int64(2), count)
+	assert.NoError(t, deleteErr)
+	assert.Equal(t, int64(2), ra)
+	assert.NoError(t, listErr)
+	assert.Equal(t, []string{other.ID}, productIDs(entries))
+}
+
+func TestRepository_ByDetailsIDs(t *testing.T) {
+	// Arrange
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+	first, second, kept := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	products := []productmodel.Product{
+		{ID: uuid.New().String(), DetailsID: first, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: first, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: second, DetailsType: "course"},
+		{ID: uuid.New().String(), DetailsID: kept, DetailsType: "course"},
+	}
+	for i := range products {
+		assert.NoError(t, db.Create(&products[i]).Error)
+		assert.NoError(t, repo.Create(ctx, &featuredmodel.Featured{ProductID: products[i].ID}))
+	}
+
+	// Act
+	count, countErr := repo.CountByDetailsIDs(ctx, []string{first, second})
+	ra, deleteErr := repo.DeleteByDetailsIDs(ctx, []string{first, second})
+	entries, listErr := repo.List(ctx)
+
+	// Assert
+	assert.NoError(t, countErr)
+	assert.Equal(t, int64(3), count)
+	assert.NoError(t, deleteErr)
+	assert.Equal(t, int64(3), ra)
+	assert.NoError(t, listErr)
+	assert.Equal(t, []string{products[3].ID}, productIDs(entries))
+}
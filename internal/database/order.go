@@ -0,0 +1,120 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultSortOrder is the ordering used by List, ListDeleted and ListUnpublished repository methods
+// unless it is overridden with [SetDefaultSort]. The id column breaks ties between rows created at
+// the same time, so offset pagination never skips or repeats a row.
+const DefaultSortOrder = "created_at DESC, id DESC"
+
+var (
+	defaultSort atomic.Value
+	sortClause  = regexp.MustCompile(`^[a-z_]+( (asc|desc))?$`)
+)
+
+func init() {
+	defaultSort.Store(DefaultSortOrder)
+}
+
+// SetDefaultSort replaces the default list ordering. order is a comma-separated list of
+// "column [ASC|DESC]" clauses. If it does not mention the id column, "id" is appended
+// with the direction of the last clause to keep the ordering stable. An empty order restores
+// [DefaultSortOrder].
+func SetDefaultSort(order string) error {
+	if strings.TrimSpace(order) == "" {
+		defaultSort.Store(DefaultSortOrder)
+		return nil
+	}
+
+	clauses := strings.Split(order, ",")
+	hasID := false
+	direction := "ASC"
+	for i, c := range clauses {
+		c = strings.Join(strings.Fields(c), " ")
+		if !sortClause.MatchString(strings.ToLower(c)) {
+			return fmt.Errorf("invalid sort clause %q", c)
+		}
+		parts := strings.Fields(c)
+		if parts[0] == "id" {
+			hasID = true
+		}
+		direction = "ASC"
+		if len(parts) == 2 {
+			direction = strings.ToUpper(parts[1])
+		}
+		clauses[i] = parts[0] + " " + direction
+	}
+	if !hasID {
+		clauses = append(clauses, "id "+direction)
+	}
+
+	defaultSort.Store(strings.Join(clauses, ", "))
+	return nil
+}
+
+// SetDefaultSortFromEnv sets the default list ordering from the DB_DEFAULT_SORT environment variable.
+func SetDefaultSortFromEnv() error {
+	return SetDefaultSort(os.Getenv("DB_DEFAULT_SORT"))
+}
+
+// DefaultSort returns the current default list ordering.
+func DefaultSort() string {
+	return defaultSort.Load().(string)
+}
+
+// sortKey is the context key set by [WithSort].
+type sortKey struct{}
+
+// WithSort returns a copy of ctx that makes [SortDefault] order statements run with it by order
+// instead of [DefaultSort]. Callers whose results depend on a specific ordering use it, so
+// DB_DEFAULT_SORT doesn't change them. order is not validated and must not come from user input.
+func WithSort(ctx context.Context, order string) context.Context {
+	return context.WithValue(ctx, sortKey{}, order)
+}
+
+// SortDefault is a gorm scope that orders the query by [DefaultSort],
+// or by the order set with [WithSort] on the statement context.
+func SortDefault(db *gorm.DB) *gorm.DB {
+	if ctx := db.Statement.Context; ctx != nil {
+		if order, ok := ctx.Value(sortKey{}).(string); ok {
+			return db.Order(order)
+		}
+	}
+	return db.Order(DefaultSort())
+}
+
+// UpdatedAfter is a gorm scope that keeps only rows whose updated_at is strictly after since.
+// It backs the "updated_since" filter of the product List endpoint used for incremental sync.
+// The lists of the details types don't take the filter, a sync client follows the products instead.
+func UpdatedAfter(since time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("updated_at > ?", since)
+	}
+}
@@ -0,0 +1,87 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestSetDefaultSort(t *testing.T) {
+	t.Cleanup(func() { _ = SetDefaultSort("") })
+
+	tests := []struct {
+		name    string
+		order   string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty restores default", order: "", want: DefaultSortOrder},
+		{name: "appends id tie-breaker", order: "price desc", want: "price DESC, id DESC"},
+		{name: "defaults to ascending", order: "updated_at, price", want: "updated_at ASC, price ASC, id ASC"},
+		{name: "keeps explicit id", order: "id asc, created_at desc", want: "id ASC, created_at DESC"},
+		{name: "rejects expressions", order: "created_at; DROP TABLE products", wantErr: true},
+		{name: "rejects unknown direction", order: "created_at sideways", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			err := SetDefaultSort(tt.order)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, DefaultSort())
+		})
+	}
+}
+
+func TestSortDefault(t *testing.T) {
+	t.Cleanup(func() { _ = SetDefaultSort("") })
+	assert.NoError(t, SetDefaultSort("name asc"))
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{DryRun: true})
+	assert.NoError(t, err)
+	query := func(ctx context.Context) string {
+		var rows []struct{ ID string }
+		return db.WithContext(ctx).Table("products").Scopes(SortDefault).Find(&rows).Statement.SQL.String()
+	}
+
+	t.Run("uses the configured default", func(t *testing.T) {
+		// Act
+		sql := query(context.Background())
+
+		// Assert
+		assert.Contains(t, sql, "ORDER BY name ASC, id ASC")
+	})
+
+	t.Run("context order overrides the default", func(t *testing.T) {
+		// Act
+		sql := query(WithSort(context.Background(), DefaultSortOrder))
+
+		// Assert
+		assert.Contains(t, sql, "ORDER BY "+DefaultSortOrder)
+	})
+}
@@ -22,7 +22,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/database"
 	imagemodel "github.com/mikhail5545/product-service-go/internal/models/image"
 	physicalgoodmodel "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	"gorm.io/gorm"
@@ -56,6 +58,8 @@ type Repository interface {
 
 	// GetWithUnpublished retrieves a single physical good record from the database including unpublished physial goods.
 	GetWithUnpublished(ctx context.Context, id string) (*physicalgoodmodel.PhysicalGood, error)
+	// GetBySKU retrieves a single physical good record from the database by it's SKU including unpublished physical goods.
+	GetBySKU(ctx context.Context, sku string) (*physicalgoodmodel.PhysicalGood, error)
 	// ListUnpublished retrieves a paginated list of all unpublished physical good records in the database.
 	ListUnpublished(ctx context.Context, limit, offset int) ([]physicalgoodmodel.PhysicalGood, error)
 	// ListWithUnpublishedByIDs retrieves physical good records by ids from database including unpublished ones.
@@ -68,7 +72,11 @@ type Repository interface {
 	// Create creates a new physical good record in the database.
 	Create(ctx context.Context, ts *physicalgoodmodel.PhysicalGood) error
 	// SetInStock sets a new value for physical good's InStock field.
-	SetInStock(ctx context.Context, id string, inStock bool) (int64, error)
+	// A record that already has the value is reported as AlreadyInState, not as missing.
+	SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error)
+	// AdjustAmount adds a signed delta to the amount of a physical good record (including unpublished ones).
+	// The record is left untouched and 0 rows are reported if the amount would become negative.
+	AdjustAmount(ctx context.Context, id string, delta int) (int64, error)
 	// Update performs partial update of a physical good record using updates.
 	Update(ctx context.Context, ts *physicalgoodmodel.PhysicalGood, updates any) (int64, error)
 	// BatchUpdate performs partial update for a batch of physical good records in the database.
@@ -87,16 +95,31 @@ type Repository interface {
 	DeleteImage(ctx context.Context, good *physicalgoodmodel.PhysicalGood, mediaSvcID string) error
 	// FindOwnerIDsByImageID finds all physical good IDs associated with a given image media service ID.
 	FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error)
-	// DecrementImageCount decrements the uploaded_image_amount for the given physical good IDs.
+	// DecrementImageCount decrements the uploaded_image_amount for the given physical good IDs,
+	// soft-deleted ones included.
 	DecrementImageCount(ctx context.Context, goodIDs []string) (int64, error)
+	// ListOwnerIDsByImageID returns IDs of all physical good records associated with a given image media service ID.
+	ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error)
+	// DeleteImageAssociations removes the image from every physical good record it is associated with.
+	// Note: This only removes the associations. The caller is responsible for updating any related counters
+	// within the same transaction to ensure data consistency.
+	DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error)
 	// DeleteImageBatch deletes an image (single) from many physical good records in the database.
 	// Note: This only removes the association. The caller is responsible for updating any related counters
 	// within the same transaction to ensure data consistency.
 	DeleteImageBatch(ctx context.Context, goods []physicalgoodmodel.PhysicalGood, image *imagemodel.Image) error
-	// Delete performs soft-delete of a physical good record.
-	Delete(ctx context.Context, id string) (int64, error)
+	// Delete performs soft-delete of a physical good record and stores reason in deleted_reason.
+	// Its products are soft-deleted too by the model's AfterDelete hook,
+	// unless ctx was returned by common.WithoutCascade.
+	Delete(ctx context.Context, id, reason string) (int64, error)
 	// DeletePermanent performs permanent delete of a physical good record.
 	DeletePermanent(ctx context.Context, id string) (int64, error)
+	// ListDeletedIDsBefore returns IDs of physical good records soft-deleted before the given time.
+	ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error)
+	// CountDeletedBefore counts physical good records soft-deleted before the given time.
+	CountDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeletePermanentByIDs performs permanent delete of physical good records by ids.
+	DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error)
 	// Restore restores soft-deleted physical good record.
 	Restore(ctx context.Context, id string) (int64, error)
 	// DB returns the underlying gorm.DB instance.
@@ -148,7 +171,7 @@ func (r *gormRepository) Select(ctx context.Context, id string, fields ...string
 // List retrieves a paginated list of all physical good records int the database.
 func (r *gormRepository) List(ctx context.Context, limit, offset int) ([]physicalgoodmodel.PhysicalGood, error) {
 	var goods []physicalgoodmodel.PhysicalGood
-	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Preload("Images").Limit(limit).Offset(offset).Order("created_at desc").Find(&goods).Error
+	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Preload("Images").Limit(limit).Offset(offset).Scopes(database.SortDefault).Find(&goods).Error
 	return goods, err
 }
 
@@ -171,7 +194,7 @@ func (r *gormRepository) GetWithDeleted(ctx context.Context, id string) (*physic
 // ListDeleted retrieves a paginated list of all soft-deleted physical good records in the database.
 func (r *gormRepository) ListDeleted(ctx context.Context, limit, offset int) ([]physicalgoodmodel.PhysicalGood, error) {
 	var goods []physicalgoodmodel.PhysicalGood
-	err := r.db.WithContext(ctx).Unscoped().Preload("Images").Where("deleted_at IS NOT NULL").Limit(limit).Offset(offset).Order("created_at desc").Find(&goods).Error
+	err := r.db.WithContext(ctx).Unscoped().Preload("Images").Where("deleted_at IS NOT NULL").Limit(limit).Offset(offset).Scopes(database.SortDefault).Find(&goods).Error
 	return goods, err
 }
 
@@ -194,6 +217,13 @@ func (r *gormRepository) GetWithUnpublished(ctx context.Context, id string) (*ph
 	return &good, err
 }
 
+// GetBySKU retrieves a single physical good record from the database by it's SKU including unpublished physical goods.
+func (r *gormRepository) GetBySKU(ctx context.Context, sku string) (*physicalgoodmodel.PhysicalGood, error) {
+	var good physicalgoodmodel.PhysicalGood
+	err := r.db.WithContext(ctx).Preload("Images").Where("sku = ?", sku).First(&good).Error
+	return &good, err
+}
+
 // ListUnpublished retrieves a paginated list of all unpublished physical good records in the database.
 func (r *gormRepository) ListUnpublished(ctx context.Context, limit, offset int) ([]physicalgoodmodel.PhysicalGood, error) {
 	var goods []physicalgoodmodel.PhysicalGood
@@ -201,7 +231,7 @@ func (r *gormRepository) ListUnpublished(ctx context.Context, limit, offset int)
 		Model(&physicalgoodmodel.PhysicalGood{}).
 		Preload("Images").
 		Where("in_stock = ?", false).
-		Order("created_at DESC").
+		Scopes(database.SortDefault).
 		Limit(limit).Offset(offset).
 		Find(&goods).Error
 	return goods, err
@@ -229,8 +259,18 @@ func (r *gormRepository) Create(ctx context.Context, good *physicalgoodmodel.Phy
 }
 
 // SetInStock sets a new value for physical good's InStock field.
-func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
-	res := r.db.WithContext(ctx).Model(&physicalgoodmodel.PhysicalGood{}).Where("id = ?", id).Update("in_stock", inStock)
+// A record that already has the value is reported as AlreadyInState, not as missing.
+func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error) {
+	return database.SetState(r.db.WithContext(ctx).Model(&physicalgoodmodel.PhysicalGood{}).Where("id = ?", id), "in_stock", map[string]any{"in_stock": inStock})
+}
+
+// AdjustAmount adds a signed delta to the amount of a physical good record (including unpublished ones).
+// The record is left untouched and 0 rows are reported if the amount would become negative.
+func (r *gormRepository) AdjustAmount(ctx context.Context, id string, delta int) (int64, error) {
+	res := r.db.WithContext(ctx).
+		Table("physical_goods").
+		Where("id = ? AND deleted_at IS NULL AND amount + ? >= 0", id, delta).
+		Update("amount", gorm.Expr("amount + ?", delta))
 	return res.RowsAffected, res.Error
 }
 
@@ -301,15 +341,38 @@ func (r *gormRepository) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID s
 	return affectedGoodIDs, err
 }
 
-// DecrementImageCount decrements the uploaded_image_amount for the given physical good IDs.
+// DecrementImageCount decrements the uploaded_image_amount for the given physical good IDs,
+// soft-deleted ones included.
 func (r *gormRepository) DecrementImageCount(ctx context.Context, goodIDs []string) (int64, error) {
 	res := r.db.WithContext(ctx).
+		Unscoped().
 		Model(&physicalgoodmodel.PhysicalGood{}).
 		Where("id IN ?", goodIDs).
 		UpdateColumn("uploaded_image_amount", gorm.Expr("uploaded_image_amount - 1"))
 	return res.RowsAffected, res.Error
 }
 
+// ListOwnerIDsByImageID returns IDs of all physical good records associated with a given image media service ID.
+func (r *gormRepository) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	var ids []string
+	joinTable := r.db.WithContext(ctx).Model(&physicalgoodmodel.PhysicalGood{}).Association("Images").Relationship.JoinTable
+	err := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Pluck("physical_good_id", &ids).Error
+	return ids, err
+}
+
+// DeleteImageAssociations removes the image from every physical good record it is associated with.
+// Note: This only removes the associations. The caller is responsible for updating any related counters
+// within the same transaction to ensure data consistency.
+func (r *gormRepository) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	joinTable := r.db.WithContext(ctx).Model(&physicalgoodmodel.PhysicalGood{}).Association("Images").Relationship.JoinTable
+	res := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Delete(nil)
+	return res.RowsAffected, res.Error
+}
+
 // AddImage adds a new image for the physical good record in the database.
 func (r *gormRepository) AddImage(ctx context.Context, good *physicalgoodmodel.PhysicalGood, image *imagemodel.Image) error {
 	return r.db.WithContext(ctx).Model(good).Association("Images").Append(image)
@@ -330,10 +393,20 @@ func (r *gormRepository) DeleteImageBatch(ctx context.Context, goods []physicalg
 	return r.db.WithContext(ctx).Model(&goods).Association("Images").Delete(image)
 }
 
-// Delete performs soft-delete of a physical good record.
-func (r *gormRepository) Delete(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Delete(&physicalgoodmodel.PhysicalGood{}, id)
-	return res.RowsAffected, res.Error
+// Delete performs soft-delete of a physical good record and stores reason in deleted_reason.
+// Its products are soft-deleted too by the model's AfterDelete hook,
+// unless ctx was returned by common.WithoutCascade.
+func (r *gormRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
+	var affected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&physicalgoodmodel.PhysicalGood{}).Where("id = ?", id).UpdateColumn("deleted_reason", reason).Error; err != nil {
+			return err
+		}
+		res := tx.Delete(&physicalgoodmodel.PhysicalGood{ID: id})
+		affected = res.RowsAffected
+		return res.Error
+	})
+	return affected, err
 }
 
 // DeletePermanent performs permanent delete of a physical good record.
@@ -342,8 +415,31 @@ func (r *gormRepository) DeletePermanent(ctx context.Context, id string) (int64,
 	return res.RowsAffected, res.Error
 }
 
+// ListDeletedIDsBefore returns IDs of physical good records soft-deleted before the given time.
+func (r *gormRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&physicalgoodmodel.PhysicalGood{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountDeletedBefore counts physical good records soft-deleted before the given time.
+func (r *gormRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&physicalgoodmodel.PhysicalGood{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Count(&count).Error
+	return count, err
+}
+
+// DeletePermanentByIDs performs permanent delete of physical good records by ids.
+func (r *gormRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Delete(&physicalgoodmodel.PhysicalGood{})
+	return res.RowsAffected, res.Error
+}
+
 // Restore restores soft-deleted physical good record.
 func (r *gormRepository) Restore(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Unscoped().Model(&physicalgoodmodel.PhysicalGood{}).Where("id = ?", id).Update("deleted_at", nil)
+	res := r.db.WithContext(ctx).Unscoped().Model(&physicalgoodmodel.PhysicalGood{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_reason": ""})
 	return res.RowsAffected, res.Error
 }
@@ -22,6 +22,7 @@ import (
 
 	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
 	coursepartmodel "github.com/mikhail5545/product-service-go/internal/models/course_part"
+	featuredmodel "github.com/mikhail5545/product-service-go/internal/models/featured"
 	physicalgoodmodel "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
 	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
@@ -31,7 +32,10 @@ import (
 )
 
 func NewPostgresDB(ctx context.Context, dsn string) (*gorm.DB, error) {
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		// Reports unique constraint violations as gorm.ErrDuplicatedKey.
+		TranslateError: true,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -44,6 +48,7 @@ func NewPostgresDB(ctx context.Context, dsn string) (*gorm.DB, error) {
 		&trainingsessionmodel.TrainingSession{},
 		&seminarmodel.Seminar{},
 		&physicalgoodmodel.PhysicalGood{},
+		&featuredmodel.Featured{},
 	)
 	if err != nil {
 		sqlDB, _ := db.DB()
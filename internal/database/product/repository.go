@@ -19,11 +19,26 @@ package product
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/database"
 	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrSoftDeleted is returned by Upsert and UpsertBatch when a product with the same ID exists but is soft-deleted.
+// Such products are not revived implicitly, they must be restored first.
+var ErrSoftDeleted = errors.New("product is soft-deleted")
+
+// upsertColumns are the mutable product columns overwritten when Upsert hits an existing ID.
+var upsertColumns = []string{"updated_at", "price", "in_stock", "details_id", "details_type", "available_from", "available_until"}
+
 //go:generate mockgen -destination=../../test/database/product_mock/repo_mock.go -package=product_mock github.com/mikhail5545/product-service-go/internal/database/product Repository
 
 // Repository defines the interface for product data operations.
@@ -38,20 +53,25 @@ type Repository interface {
 	Select(ctx context.Context, id string, fields []string) (*productmodel.Product, error)
 	// SelectByDetailsID retrieves only specific fields from Product record in the database by it's DetailsID.
 	SelectByDetailsID(ctx context.Context, detailsID string, fields ...string) (*productmodel.Product, error)
-	// SelectByDetailsID retrieves only specific fields from Product record in the database by it's DetailsID.
+	// SelectByDetailsIDs retrieves only specific fields from published Product records in the database by DetailsIDs.
+	// Owners without a published product are left out, so an empty result is not an error.
 	SelectByDetailsIDs(ctx context.Context, detailsIDs []string, fields ...string) ([]productmodel.Product, error)
-	// List retrieves all Product records from the database.
-	List(ctx context.Context, limit, offset int) ([]productmodel.Product, error)
-	// ListByDetailsType retrieves all Product records from the database that have specific DetailsType.
-	ListByDetailsType(ctx context.Context, detailsType string, limit, offset int) ([]productmodel.Product, error)
+	// SelectByDetailsRefs retrieves only specific fields from published Product records in the database that belong to
+	// any of the given owners. Owners may be of different DetailsType. Owners without a published product are left out,
+	// like in SelectByDetailsIDs.
+	SelectByDetailsRefs(ctx context.Context, refs []productmodel.DetailsRef, fields ...string) ([]productmodel.Product, error)
+	// List retrieves not soft-deleted Product records matching filter, in the default order.
+	// A zero filter matches both in and out of stock records.
+	List(ctx context.Context, filter productmodel.ProductFilter, limit, offset int) ([]productmodel.Product, error)
 	// ListByIDs retrieves all Product records from the database by a slice of IDs.
 	ListByIDs(ctx context.Context, ids []string) ([]productmodel.Product, error)
 	// SelectByDIs retrieves all Product specified fields from the database by a slice of IDs.
 	SelectByIDs(ctx context.Context, ids []string, fields ...string) ([]productmodel.Product, error)
-	// Count returns total amount of the Product records in the database
-	Count(ctx context.Context) (int64, error)
-	// CountByType returns the total amount of the Product records in the database that have specific DetailsType.
-	CountByDetailsType(ctx context.Context, detailsType string) (int64, error)
+	// Count returns total amount of not soft-deleted Product records matching filter.
+	Count(ctx context.Context, filter productmodel.ProductFilter) (int64, error)
+	// ListUpdatedSince retrieves up to limit Product records positioned strictly after the cursor, ordered by UpdatedAt and ID ascending.
+	// Unpublished and soft-deleted records are included with their State filled, so pollers can evict them.
+	ListUpdatedSince(ctx context.Context, after productmodel.ChangeCursor, limit int) ([]productmodel.Product, error)
 
 	// --- With soft-deleted, if soft-deleted then also unpublished ---
 
@@ -65,6 +85,8 @@ type Repository interface {
 	SelectWithDeletedByIDs(ctx context.Context, ids []string, fields ...string) ([]productmodel.Product, error)
 	// GetWithDeletedByDetailsID retrieves single Product record from the database by it's DetailsID including soft-deleted ones.
 	GetWithDeletedByDetailsID(ctx context.Context, detailsID string) (*productmodel.Product, error)
+	// ListWithDeletedByDetailsID retrieves all Product records of a details owner, published, unpublished and soft-deleted.
+	ListWithDeletedByDetailsID(ctx context.Context, detailsID string) ([]productmodel.Product, error)
 	// ListDeleted retrieves all soft-deleted Product records from the database.
 	ListDeleted(ctx context.Context, limit, offset int) ([]productmodel.Product, error)
 	// CountDeleted returns total amount of soft-deleted Product records in the database
@@ -82,10 +104,6 @@ type Repository interface {
 	SelectWithUnpublishedByIDs(ctx context.Context, ids []string, fields ...string) ([]productmodel.Product, error)
 	// SelectWithUnpublishedByDetailsIDs retrieves only specific fields from unpublished Product record in the database by it's DetailsID.
 	SelectWithUnpublishedByDetailsIDs(ctx context.Context, detailsIDs []string, fields ...string) ([]productmodel.Product, error)
-	// CountUnpublished retrieves all unpublished Product records from the database.
-	ListUnpublished(ctx context.Context, limit, offset int) ([]productmodel.Product, error)
-	// CountUnpublished returns total amount of unpublished Product records in the database
-	CountUnpublished(ctx context.Context) (int64, error)
 
 	// -- Common --
 
@@ -93,24 +111,62 @@ type Repository interface {
 	Create(ctx context.Context, product *productmodel.Product) error
 	// CreateBatch creates multiple new Product records in the database.
 	CreateBatch(ctx context.Context, products ...*productmodel.Product) error
-	// SetInStock sets new value for product's InStock field.
-	SetInStock(ctx context.Context, id string, inStock bool) (int64, error)
-	// SetInStockByDetailsID sets new value for product's InStock field by it's detailsID.
-	SetInStockByDetailsID(ctx context.Context, detailsID string, inStock bool) (int64, error)
+	// Upsert creates a Product record or, if the ID already exists, updates its mutable fields.
+	// Returns ErrSoftDeleted if the existing record is soft-deleted, it is left untouched.
+	Upsert(ctx context.Context, product *productmodel.Product) error
+	// UpsertBatch upserts multiple Product records in a single statement, see Upsert.
+	// The batch runs in a transaction and is written all or nothing: if any existing record is soft-deleted,
+	// nothing is written and ErrSoftDeleted is returned with the IDs of the soft-deleted records.
+	UpsertBatch(ctx context.Context, products ...*productmodel.Product) error
+	// SetInStock sets new value for product's InStock field. Publishing also stamps LastPublishedAt
+	// with at and FirstPublishedAt too, unless the product has been published before.
+	SetInStock(ctx context.Context, id string, inStock bool, at time.Time) (int64, error)
+	// SetInStockByDetailsID sets new value for product's InStock field by it's detailsID. Publishing also stamps
+	// LastPublishedAt with at and FirstPublishedAt too, unless the product has been published before.
+	// Products that already have the value are left untouched and reported as AlreadyInState.
+	SetInStockByDetailsID(ctx context.Context, detailsID string, inStock bool, at time.Time) (database.SetResult, error)
+	// PublishByDetailsID publishes products by their detailsID at the given time. It stamps
+	// LastPublishedAt with at and FirstPublishedAt too, unless the product has been published before.
+	PublishByDetailsID(ctx context.Context, detailsID string, at time.Time) (int64, error)
+	// SetAvailability sets product's sale window. Nil values clear the corresponding bound.
+	SetAvailability(ctx context.Context, id string, from, until *time.Time) (int64, error)
 	// Update partually updates Product record using updates.
 	Update(ctx context.Context, product *productmodel.Product, updates any) (int64, error)
 	// Delete performs a soft-delete.
+	// UpdatedAt is bumped along with DeletedAt, so the change feed picks the record up.
 	Delete(ctx context.Context, id string) (int64, error)
 	// DeleteByDetailsID performs a soft-delete of product records by details id.
+	// UpdatedAt is bumped along with DeletedAt, so the change feed picks the records up.
 	DeleteByDetailsID(ctx context.Context, detailsID string) (int64, error)
 	// DeletePermanent removes product from the database completely.
 	DeletePermanent(ctx context.Context, id string) (int64, error)
 	// DeletePermanent removes products from the database completely by details id.
 	DeletePermanentByDetailsID(ctx context.Context, detailsID string) (int64, error)
+	// DeletePermanentByDetailsIDs removes products of all given details owners from the database completely.
+	DeletePermanentByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error)
 	// Restore restores soft-deleted product.
 	Restore(ctx context.Context, id string) (int64, error)
-	// Restore restores soft-deleted products by details id.
+	// RestoreByDetailsID restores soft-deleted products by details id.
 	RestoreByDetailsID(ctx context.Context, detailsID string) (int64, error)
+	// CountDetailsByTag returns total amount of product details records (including soft-deleted) that have tag in their tags.
+	// It counts details records, not products: a seminar is counted once, however many products it has.
+	CountDetailsByTag(ctx context.Context, tag string) (int64, error)
+	// RemoveTag strips tag from the tags of every product details record (including soft-deleted).
+	RemoveTag(ctx context.Context, tag string) (int64, error)
+	// RenameTag replaces from with to in the tags of every product details record (including soft-deleted).
+	// Records that already have to just lose from, so no tag appears twice.
+	RenameTag(ctx context.Context, from, to string) (int64, error)
+	// SetTags replaces the tags of the details records of products with given ids (including unpublished) with tags.
+	// Details records shared by several products are updated once.
+	SetTags(ctx context.Context, ids []string, tags []string) (int64, error)
+	// AddTags merges tags into the tags of the details records of products with given ids (including unpublished).
+	// Existing tags keep their order, new ones are appended once. Records that already have every tag are left intact.
+	AddTags(ctx context.Context, ids []string, tags []string) (int64, error)
+	// ListRelated retrieves up to limit published Product records whose details share at least one tag
+	// with the details of the product with given id, ranked by the number of shared tags.
+	// Every details record is represented once: seminars by the product setting their price at now.
+	// Products of the same details record (the product itself and, for seminars, its other price tiers) are excluded.
+	ListRelated(ctx context.Context, id string, limit int, now time.Time) ([]productmodel.Product, error)
 
 	// DB returns the underlying gorm.DB instance.
 	DB() *gorm.DB
@@ -168,24 +224,99 @@ func (r *gormRepository) SelectByDetailsID(ctx context.Context, detailsID string
 	return &product, err
 }
 
-// SelectByDetailsID retrieves only specific fields from Product records in the database by DetailsIDs.
+// SelectByDetailsIDs retrieves only specific fields from published Product records in the database by DetailsIDs.
+// Owners without a published product are left out, so an empty result is not an error.
 func (r *gormRepository) SelectByDetailsIDs(ctx context.Context, detailsIDs []string, fields ...string) ([]productmodel.Product, error) {
 	var products []productmodel.Product
-	err := r.db.WithContext(ctx).Select(fields).Where("in_stock = ?", true).Where("details_id IN ?", detailsIDs).First(&products).Error
+	err := r.db.WithContext(ctx).Select(fields).Where("in_stock = ?", true).Where("details_id IN ?", detailsIDs).Find(&products).Error
 	return products, err
 }
 
-// List retrieves all Product records from the database.
-func (r *gormRepository) List(ctx context.Context, limit, offset int) ([]productmodel.Product, error) {
+// SelectByDetailsRefs retrieves only specific fields from published Product records in the database that belong to
+// any of the given owners. Owners may be of different DetailsType. Owners without a published product are left out,
+// like in SelectByDetailsIDs.
+func (r *gormRepository) SelectByDetailsRefs(ctx context.Context, refs []productmodel.DetailsRef, fields ...string) ([]productmodel.Product, error) {
+	if len(refs) == 0 {
+		return nil, nil
+	}
+	// Group owners by type so the query stays a handful of indexed IN lookups.
+	idsByType := make(map[string][]string)
+	var types []string
+	for _, ref := range refs {
+		if _, ok := idsByType[ref.DetailsType]; !ok {
+			types = append(types, ref.DetailsType)
+		}
+		idsByType[ref.DetailsType] = append(idsByType[ref.DetailsType], ref.DetailsID)
+	}
+	cond := r.db.WithContext(ctx)
+	for i, t := range types {
+		if i == 0 {
+			cond = cond.Where("details_type = ? AND details_id IN ?", t, idsByType[t])
+		} else {
+			cond = cond.Or("details_type = ? AND details_id IN ?", t, idsByType[t])
+		}
+	}
 	var products []productmodel.Product
-	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Limit(limit).Offset(offset).Order("created_at desc").Find(&products).Error
+	err := r.db.WithContext(ctx).Select(fields).Where("in_stock = ?", true).Where(cond).Find(&products).Error
 	return products, err
 }
 
-// ListByDetailsType retrieves all Product records from the database that have specific DetailsType.
-func (r *gormRepository) ListByDetailsType(ctx context.Context, detailsType string, limit, offset int) ([]productmodel.Product, error) {
+// filterScope is the single place turning [productmodel.ProductFilter] into SQL. It applies every
+// set field of filter and nothing for unset ones. Tags and Format live on details tables, so they
+// are matched with subqueries against the table of the product's own details type.
+func filterScope(filter productmodel.ProductFilter) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		fresh := db.Session(&gorm.Session{NewDB: true})
+		if filter.DetailsType != "" {
+			db = db.Where("details_type = ?", filter.DetailsType)
+		}
+		if filter.Format != "" {
+			formatted := fresh.Table(detailsTypeTables["training_session"]).Select("id").Where("format = ?", filter.Format)
+			db = db.Where("details_type = ? AND details_id IN (?)", "training_session", formatted)
+		}
+		if len(filter.Tags) > 0 {
+			var tagged *gorm.DB
+			for _, detailsType := range productmodel.DetailsTypes {
+				if filter.DetailsType != "" && detailsType != filter.DetailsType {
+					continue
+				}
+				ids := fresh.Table(detailsTypeTables[detailsType]).Select("id").Where("tags @> ?", tagsArray(filter.Tags))
+				if tagged == nil {
+					tagged = fresh.Where("details_type = ? AND details_id IN (?)", detailsType, ids)
+				} else {
+					tagged = tagged.Or("details_type = ? AND details_id IN (?)", detailsType, ids)
+				}
+			}
+			db = db.Where(tagged)
+		}
+		if filter.MinPrice != nil {
+			db = db.Where("price >= ?", *filter.MinPrice)
+		}
+		if filter.MaxPrice != nil {
+			db = db.Where("price <= ?", *filter.MaxPrice)
+		}
+		if filter.InStock != nil {
+			db = db.Where("in_stock = ?", *filter.InStock)
+		}
+		if filter.AvailableAt != nil {
+			db = db.Where("available_from IS NULL OR available_from <= ?", *filter.AvailableAt).
+				Where("available_until IS NULL OR available_until > ?", *filter.AvailableAt)
+		}
+		if filter.UpdatedAfter != nil {
+			db = database.UpdatedAfter(*filter.UpdatedAfter)(db)
+		}
+		if filter.NeverPublished {
+			db = db.Where("in_stock = ? AND first_published_at IS NULL", false)
+		}
+		return db
+	}
+}
+
+// List retrieves not soft-deleted Product records matching filter, in the default order.
+// A zero filter matches both in and out of stock records.
+func (r *gormRepository) List(ctx context.Context, filter productmodel.ProductFilter, limit, offset int) ([]productmodel.Product, error) {
 	var products []productmodel.Product
-	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Where("details_type = ?", detailsType).Limit(limit).Offset(offset).Order("created_at desc").Find(&products).Error
+	err := r.db.WithContext(ctx).Scopes(filterScope(filter), database.SortDefault).Limit(limit).Offset(offset).Find(&products).Error
 	return products, err
 }
 
@@ -203,18 +334,26 @@ func (r *gormRepository) SelectByIDs(ctx context.Context, ids []string, fields .
 	return products, err
 }
 
-// Count returns total amount of the Product records in the database
-func (r *gormRepository) Count(ctx context.Context) (int64, error) {
+// Count returns total amount of not soft-deleted Product records matching filter.
+func (r *gormRepository) Count(ctx context.Context, filter productmodel.ProductFilter) (int64, error) {
 	var count int64
-	err := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("in_stock = ?", true).Count(&count).Error
+	err := r.db.WithContext(ctx).Model(&productmodel.Product{}).Scopes(filterScope(filter)).Count(&count).Error
 	return count, err
 }
 
-// CountByType returns the total amount of the Product records in the database that have specific DetailsType.
-func (r *gormRepository) CountByDetailsType(ctx context.Context, detailsType string) (int64, error) {
-	var count int64
-	err := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("in_stock = ?", true).Where("details_type = ?", detailsType).Count(&count).Error
-	return count, err
+// ListUpdatedSince retrieves up to limit Product records positioned strictly after the cursor, ordered by UpdatedAt and ID ascending.
+// Unpublished and soft-deleted records are included with their State filled, so pollers can evict them.
+func (r *gormRepository) ListUpdatedSince(ctx context.Context, after productmodel.ChangeCursor, limit int) ([]productmodel.Product, error) {
+	var products []productmodel.Product
+	err := r.db.WithContext(ctx).Unscoped().
+		Where("(updated_at, id) > (?, ?)", after.UpdatedAt, after.ID).
+		Order("updated_at asc").Order("id asc").
+		Limit(limit).
+		Find(&products).Error
+	for i := range products {
+		products[i].State = products[i].CurrentState()
+	}
+	return products, err
 }
 
 // --- With soft-deleted, if soft-deleted then also unpublished ---
@@ -233,6 +372,13 @@ func (r *gormRepository) GetWithDeletedByDetailsID(ctx context.Context, detailsI
 	return &product, err
 }
 
+// ListWithDeletedByDetailsID retrieves all Product records of a details owner, published, unpublished and soft-deleted.
+func (r *gormRepository) ListWithDeletedByDetailsID(ctx context.Context, detailsID string) ([]productmodel.Product, error) {
+	var products []productmodel.Product
+	err := r.db.WithContext(ctx).Unscoped().Where("details_id = ?", detailsID).Scopes(database.SortDefault).Find(&products).Error
+	return products, err
+}
+
 // SelectByDetailsID retrieves only specific fields from soft-deleted Product record in the database by it's DetailsID.
 func (r *gormRepository) SelectWithDeletedByDetailsID(ctx context.Context, detailsID string, fields ...string) (*productmodel.Product, error) {
 	var product productmodel.Product
@@ -257,7 +403,7 @@ func (r *gormRepository) SelectWithDeletedByIDs(ctx context.Context, ids []strin
 // ListDeleted retrieves all soft-deleted Product records from the database.
 func (r *gormRepository) ListDeleted(ctx context.Context, limit, offset int) ([]productmodel.Product, error) {
 	var products []productmodel.Product
-	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Limit(limit).Offset(offset).Order("deleted_at desc").Find(&products).Error
+	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Limit(limit).Offset(offset).Order("deleted_at desc").Order("id desc").Find(&products).Error
 	return products, err
 }
 
@@ -305,49 +451,130 @@ func (r *gormRepository) SelectWithUnpublishedByDetailsIDs(ctx context.Context,
 	return products, err
 }
 
-// CountUnpublished retrieves all unpublished Product records from the database.
-func (r *gormRepository) ListUnpublished(ctx context.Context, limit, offset int) ([]productmodel.Product, error) {
-	var products []productmodel.Product
-	err := r.db.WithContext(ctx).
-		Model(&productmodel.Product{}).
-		Where("in_stock = ?", false).
-		Order("created_at DESC").
-		Limit(limit).Offset(offset).
-		Find(&products).Error
-	return products, err
-}
-
-// CountUnpublished returns total amount of unpublished Product records in the database
-func (r *gormRepository) CountUnpublished(ctx context.Context) (int64, error) {
-	var count int64
-	err := r.db.WithContext(ctx).
-		Model(&productmodel.Product{}).
-		Where("in_stock = ?", false).
-		Count(&count).Error
-	return count, err
-}
-
 // --- Common ---
 
 // Create creates new Product record in the database.
 func (r *gormRepository) Create(ctx context.Context, product *productmodel.Product) error {
+	stampFirstPublished(product)
 	return r.db.WithContext(ctx).Create(product).Error
 }
 
 // CreateBatch creates multiple new Product records in the database.
 func (r *gormRepository) CreateBatch(ctx context.Context, products ...*productmodel.Product) error {
+	stampFirstPublished(products...)
 	return r.db.WithContext(ctx).Create(&products).Error
 }
 
-// SetInStock sets new value for product's InStock field.
-func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
-	res := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("id = ?", id).Update("in_stock", inStock)
+// Upsert creates a Product record or, if the ID already exists, updates its mutable fields.
+// Returns ErrSoftDeleted if the existing record is soft-deleted, it is left untouched.
+func (r *gormRepository) Upsert(ctx context.Context, product *productmodel.Product) error {
+	return r.UpsertBatch(ctx, product)
+}
+
+// UpsertBatch upserts multiple Product records in a single statement, see Upsert.
+// The batch runs in a transaction and is written all or nothing: if any existing record is soft-deleted,
+// nothing is written and ErrSoftDeleted is returned with the IDs of the soft-deleted records.
+func (r *gormRepository) UpsertBatch(ctx context.Context, products ...*productmodel.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID)
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var deleted []string
+		if err := tx.Unscoped().Model(&productmodel.Product{}).Where("id IN ? AND deleted_at IS NOT NULL", ids).Pluck("id", &deleted).Error; err != nil {
+			return err
+		}
+		if len(deleted) > 0 {
+			return fmt.Errorf("%w: %s", ErrSoftDeleted, strings.Join(deleted, ", "))
+		}
+		// first_published_at is not among upsertColumns, so an existing stamp is kept.
+		stampFirstPublished(products...)
+		res := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns(upsertColumns),
+			// Rows soft-deleted after the check above are skipped and detected by the affected rows count below,
+			// returning the error rolls the rest of the batch back.
+			Where: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "products.deleted_at IS NULL"}}},
+		}).Create(&products)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected < int64(len(products)) {
+			return ErrSoftDeleted
+		}
+		return nil
+	})
+}
+
+// SetInStock sets new value for product's InStock field. Publishing also stamps LastPublishedAt
+// with at and FirstPublishedAt too, unless the product has been published before.
+func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool, at time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("id = ?", id).Updates(inStockUpdates(inStock, at))
+	return res.RowsAffected, res.Error
+}
+
+// SetInStockByDetailsID sets new value for product's InStock field by it's detailsID. Publishing also stamps
+// LastPublishedAt with at and FirstPublishedAt too, unless the product has been published before.
+// Products that already have the value are left untouched and reported as AlreadyInState.
+func (r *gormRepository) SetInStockByDetailsID(ctx context.Context, detailsID string, inStock bool, at time.Time) (database.SetResult, error) {
+	return database.SetState(r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("details_id = ?", detailsID), "in_stock", inStockUpdates(inStock, at))
+}
+
+// PublishByDetailsID publishes products by their detailsID at the given time. It stamps
+// LastPublishedAt with at and FirstPublishedAt too, unless the product has been published before.
+func (r *gormRepository) PublishByDetailsID(ctx context.Context, detailsID string, at time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("details_id = ?", detailsID).Updates(publishUpdates(at))
 	return res.RowsAffected, res.Error
 }
 
-// SetInStockByDetailsID sets new value for product's InStock field by it's detailsID.
-func (r *gormRepository) SetInStockByDetailsID(ctx context.Context, detailsID string, inStock bool) (int64, error) {
-	res := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("details_id = ?", detailsID).Update("in_stock", inStock)
+// inStockUpdates returns the columns SetInStock and SetInStockByDetailsID write for inStock,
+// publishing at the given time.
+func inStockUpdates(inStock bool, at time.Time) map[string]any {
+	if inStock {
+		return publishUpdates(at)
+	}
+	return map[string]any{"in_stock": false}
+}
+
+// publishUpdates returns the columns written when products are published at the given time.
+func publishUpdates(at time.Time) map[string]any {
+	return map[string]any{
+		"in_stock":           true,
+		"first_published_at": gorm.Expr("COALESCE(first_published_at, ?)", at),
+		"last_published_at":  at,
+	}
+}
+
+// stampFirstPublished sets FirstPublishedAt and LastPublishedAt of products that are created already published
+// and have no stamps yet. They are published at CreatedAt if the caller set it, at the current time otherwise.
+func stampFirstPublished(products ...*productmodel.Product) {
+	now := time.Now()
+	for _, product := range products {
+		if !product.InStock {
+			continue
+		}
+		publishedAt := product.CreatedAt
+		if publishedAt.IsZero() {
+			publishedAt = now
+		}
+		if product.FirstPublishedAt == nil {
+			first := publishedAt
+			product.FirstPublishedAt = &first
+		}
+		if product.LastPublishedAt == nil {
+			last := publishedAt
+			product.LastPublishedAt = &last
+		}
+	}
+}
+
+// SetAvailability sets product's sale window. Nil values clear the corresponding bound.
+func (r *gormRepository) SetAvailability(ctx context.Context, id string, from, until *time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("id = ?", id).
+		Updates(map[string]any{"available_from": from, "available_until": until})
 	return res.RowsAffected, res.Error
 }
 
@@ -358,14 +585,16 @@ func (r *gormRepository) Update(ctx context.Context, product *productmodel.Produ
 }
 
 // Delete performs a soft-delete.
+// UpdatedAt is bumped along with DeletedAt, so the change feed picks the record up.
 func (r *gormRepository) Delete(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Delete(&productmodel.Product{}, id)
+	res := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("id = ?", id).Update("deleted_at", time.Now())
 	return res.RowsAffected, res.Error
 }
 
 // DeleteByDetailsID performs a soft-delete of product records by details id.
+// UpdatedAt is bumped along with DeletedAt, so the change feed picks the records up.
 func (r *gormRepository) DeleteByDetailsID(ctx context.Context, detailsID string) (int64, error) {
-	res := r.db.WithContext(ctx).Where("details_id = ?", detailsID).Delete(&productmodel.Product{})
+	res := r.db.WithContext(ctx).Model(&productmodel.Product{}).Where("details_id = ?", detailsID).Update("deleted_at", time.Now())
 	return res.RowsAffected, res.Error
 }
 
@@ -381,14 +610,227 @@ func (r *gormRepository) DeletePermanentByDetailsID(ctx context.Context, details
 	return res.RowsAffected, res.Error
 }
 
+// DeletePermanentByDetailsIDs removes products of all given details owners from the database completely.
+func (r *gormRepository) DeletePermanentByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Where("details_id IN ?", detailsIDs).Delete(&productmodel.Product{})
+	return res.RowsAffected, res.Error
+}
+
 // Restore restores soft-deleted product.
 func (r *gormRepository) Restore(ctx context.Context, id string) (int64, error) {
 	res := r.db.WithContext(ctx).Unscoped().Model(&productmodel.Product{}).Where("id = ?", id).Update("deleted_at", nil)
 	return res.RowsAffected, res.Error
 }
 
-// Restore restores soft-deleted products by details id.
+// RestoreByDetailsID restores soft-deleted products by details id.
 func (r *gormRepository) RestoreByDetailsID(ctx context.Context, detailsID string) (int64, error) {
-	res := r.db.WithContext(ctx).Unscoped().Model(&productmodel.Product{}).Where("id = ?", detailsID).Update("deleted_at", nil)
+	res := r.db.WithContext(ctx).Unscoped().Model(&productmodel.Product{}).Where("details_id = ?", detailsID).Update("deleted_at", nil)
 	return res.RowsAffected, res.Error
 }
+
+// taggedDetailsTables lists the tables of details models that products can point to.
+// Tags live on them, not on the product itself.
+var taggedDetailsTables = []string{"courses", "seminars", "training_sessions", "physical_goods"}
+
+// CountDetailsByTag returns total amount of product details records (including soft-deleted) that have tag in their tags.
+// It counts details records, not products: a seminar is counted once, however many products it has.
+func (r *gormRepository) CountDetailsByTag(ctx context.Context, tag string) (int64, error) {
+	var total int64
+	for _, table := range taggedDetailsTables {
+		var count int64
+		if err := r.db.WithContext(ctx).Table(table).Where("? = ANY(tags)", tag).Count(&count).Error; err != nil {
+			return 0, err
+		}
+		total += count
+	}
+	return total, nil
+}
+
+// RemoveTag strips tag from the tags of every product details record (including soft-deleted).
+// It issues one statement per details table, so it should be called within a transaction.
+func (r *gormRepository) RemoveTag(ctx context.Context, tag string) (int64, error) {
+	var total int64
+	for _, table := range taggedDetailsTables {
+		res := r.db.WithContext(ctx).Table(table).Where("? = ANY(tags)", tag).
+			Updates(map[string]any{"tags": gorm.Expr("array_remove(tags, ?)", tag), "updated_at": time.Now()})
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		total += res.RowsAffected
+	}
+	return total, nil
+}
+
+// RenameTag replaces from with to in the tags of every product details record (including soft-deleted).
+// Records that already have to just lose from, so no tag appears twice.
+// It issues two statements per details table, so it should be called within a transaction.
+func (r *gormRepository) RenameTag(ctx context.Context, from, to string) (int64, error) {
+	var total int64
+	for _, table := range taggedDetailsTables {
+		res := r.db.WithContext(ctx).Table(table).Where("? = ANY(tags) AND ? = ANY(tags)", from, to).
+			Updates(map[string]any{"tags": gorm.Expr("array_remove(tags, ?)", from), "updated_at": time.Now()})
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		total += res.RowsAffected
+
+		res = r.db.WithContext(ctx).Table(table).Where("? = ANY(tags)", from).
+			Updates(map[string]any{"tags": gorm.Expr("array_replace(tags, ?, ?)", from, to), "updated_at": time.Now()})
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		total += res.RowsAffected
+	}
+	return total, nil
+}
+
+// detailsTypeTables maps every [productmodel.DetailsTypes] value to the table of its details model.
+var detailsTypeTables = map[string]string{
+	"course":           "courses",
+	"seminar":          "seminars",
+	"training_session": "training_sessions",
+	"physical_good":    "physical_goods",
+}
+
+// tagsArray builds a varchar[] literal out of tags. A plain []string would be expanded
+// into a parenthesized list by gorm instead of being bound as an array.
+func tagsArray(tags []string) clause.Expr {
+	if len(tags) == 0 {
+		return gorm.Expr("'{}'::varchar[]")
+	}
+	vars := make([]any, len(tags))
+	for i, tag := range tags {
+		vars[i] = tag
+	}
+	return gorm.Expr("ARRAY["+strings.TrimSuffix(strings.Repeat("?,", len(tags)), ",")+"]::varchar[]", vars...)
+}
+
+// detailsIDsOf selects details_id of products (including unpublished) with given ids and details type.
+func (r *gormRepository) detailsIDsOf(ctx context.Context, ids []string, detailsType string) *gorm.DB {
+	return r.db.WithContext(ctx).Model(&productmodel.Product{}).Select("details_id").
+		Where("id IN ? AND details_type = ?", ids, detailsType)
+}
+
+// SetTags replaces the tags of the details records of products with given ids (including unpublished) with tags.
+// It issues one statement per details table, so it should be called within a transaction.
+func (r *gormRepository) SetTags(ctx context.Context, ids []string, tags []string) (int64, error) {
+	var total int64
+	for _, detailsType := range productmodel.DetailsTypes {
+		res := r.db.WithContext(ctx).Table(detailsTypeTables[detailsType]).
+			Where("id IN (?)", r.detailsIDsOf(ctx, ids, detailsType)).
+			Updates(map[string]any{"tags": tagsArray(tags), "updated_at": time.Now()})
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		total += res.RowsAffected
+	}
+	return total, nil
+}
+
+// AddTags merges tags into the tags of the details records of products with given ids (including unpublished).
+// Existing tags keep their order, new ones are appended once. Records that already have every tag are left intact.
+// It issues one statement per details table, so it should be called within a transaction.
+func (r *gormRepository) AddTags(ctx context.Context, ids []string, tags []string) (int64, error) {
+	var total int64
+	for _, detailsType := range productmodel.DetailsTypes {
+		res := r.db.WithContext(ctx).Table(detailsTypeTables[detailsType]).
+			Where("id IN (?)", r.detailsIDsOf(ctx, ids, detailsType)).
+			Where("NOT (COALESCE(tags, '{}') @> ?)", tagsArray(tags)).
+			Updates(map[string]any{
+				"tags": gorm.Expr(
+					"ARRAY(SELECT tag FROM unnest(array_cat(COALESCE(tags, '{}'), ?)) WITH ORDINALITY AS merged(tag, pos) GROUP BY tag ORDER BY MIN(pos))",
+					tagsArray(tags),
+				),
+				"updated_at": time.Now(),
+			})
+		if res.Error != nil {
+			return 0, res.Error
+		}
+		total += res.RowsAffected
+	}
+	return total, nil
+}
+
+// relatedQuery lists published products whose details share tags with the details of the source product,
+// with the number of shared tags. Tags live on details tables, so they are unioned first.
+// Seminar products come with the fields picking the seminar's current price product, see [rankRelated].
+const relatedQuery = `
+WITH details AS (
+	SELECT id, 'course' AS details_type, tags FROM courses WHERE deleted_at IS NULL
+	UNION ALL SELECT id, 'seminar', tags FROM seminars WHERE deleted_at IS NULL
+	UNION ALL SELECT id, 'training_session', tags FROM training_sessions WHERE deleted_at IS NULL
+	UNION ALL SELECT id, 'physical_good', tags FROM physical_goods WHERE deleted_at IS NULL
+), source AS (
+	SELECT products.id, products.details_id, details.tags FROM products
+	JOIN details ON details.id = products.details_id AND details.details_type = products.details_type
+	WHERE products.id = ?
+)
+SELECT products.*, shared.overlap,
+	seminars.early_product_id, seminars.late_product_id, seminars.late_payment_date
+FROM products
+JOIN details ON details.id = products.details_id AND details.details_type = products.details_type
+LEFT JOIN seminars ON products.details_type = 'seminar' AND seminars.id = products.details_id
+CROSS JOIN source
+CROSS JOIN LATERAL (SELECT COUNT(*) AS overlap FROM unnest(details.tags) AS tag WHERE tag = ANY(source.tags)) AS shared
+WHERE products.in_stock = true AND products.deleted_at IS NULL
+	AND products.id <> source.id AND products.details_id <> source.details_id
+	AND shared.overlap > 0
+	AND (products.details_type <> 'seminar' OR products.id IN (seminars.early_product_id, seminars.late_product_id))`
+
+// relatedCandidate is a row of relatedQuery.
+type relatedCandidate struct {
+	productmodel.Product
+	Overlap         int
+	EarlyProductID  *string
+	LateProductID   *string
+	LatePaymentDate *time.Time
+}
+
+// rankRelated keeps one candidate per details record, the current price product at now for seminars,
+// and returns up to limit of them ranked by the number of shared tags, newest first on ties.
+func rankRelated(candidates []relatedCandidate, limit int, now time.Time) []productmodel.Product {
+	slices.SortFunc(candidates, func(a, b relatedCandidate) int {
+		if a.Overlap != b.Overlap {
+			return b.Overlap - a.Overlap
+		}
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID, a.ID)
+	})
+	products := make([]productmodel.Product, 0, min(limit, len(candidates)))
+	seen := make(map[productmodel.DetailsRef]bool, len(candidates))
+	for _, c := range candidates {
+		if len(products) == limit {
+			break
+		}
+		if c.DetailsType == "seminar" {
+			seminar := seminarmodel.Seminar{EarlyProductID: c.EarlyProductID, LateProductID: c.LateProductID}
+			if c.LatePaymentDate != nil {
+				seminar.LatePaymentDate = *c.LatePaymentDate
+			}
+			if current := seminar.ProductID(seminar.PriceRoleAt(now)); current == nil || *current != c.ID {
+				continue
+			}
+		}
+		ref := productmodel.DetailsRef{DetailsID: c.DetailsID, DetailsType: c.DetailsType}
+		if seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		products = append(products, c.Product)
+	}
+	return products
+}
+
+// ListRelated retrieves up to limit published Product records whose details share at least one tag
+// with the details of the product with given id, ranked by the number of shared tags.
+// Every details record is represented once: seminars by the product setting their price at now.
+// Products of the same details record (the product itself and, for seminars, its other price tiers) are excluded.
+func (r *gormRepository) ListRelated(ctx context.Context, id string, limit int, now time.Time) ([]productmodel.Product, error) {
+	var candidates []relatedCandidate
+	if err := r.db.WithContext(ctx).Raw(relatedQuery, id).Find(&candidates).Error; err != nil {
+		return nil, err
+	}
+	return rankRelated(candidates, limit, now), nil
+}
@@ -0,0 +1,1250 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// newTestDB opens an isolated in-memory SQLite database with the product table migrated.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&productmodel.Product{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
+
+// newPostgresTestDB opens the Postgres database in PRODUCT_TEST_POSTGRES_DSN for queries relying on
+// postgres-only features and skips the test if it is not set. Every test runs in a fresh schema
+// that is dropped on cleanup, with the product table migrated.
+func newPostgresTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	dsn := os.Getenv("PRODUCT_TEST_POSTGRES_DSN")
+	if dsn == "" {
+		t.Skip("PRODUCT_TEST_POSTGRES_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("failed to get database handle: %v", err)
+	}
+	// search_path is set per connection.
+	sqlDB.SetMaxOpenConns(1)
+	schema := "test_" + strings.ReplaceAll(uuid.New().String(), "-", "")
+	if err := db.Exec("CREATE SCHEMA " + schema).Error; err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Exec("DROP SCHEMA " + schema + " CASCADE")
+		sqlDB.Close()
+	})
+	if err := db.Exec("SET search_path TO " + schema).Error; err != nil {
+		t.Fatalf("failed to set search path: %v", err)
+	}
+	if err := db.AutoMigrate(&productmodel.Product{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	return db
+}
+
+// updateStatements filters out the subqueries recorded while building UPDATE statements.
+func updateStatements(statements []string) []string {
+	var updates []string
+	for _, stmt := range statements {
+		if strings.HasPrefix(stmt, "UPDATE ") {
+			updates = append(updates, stmt)
+		}
+	}
+	return updates
+}
+
+// newDryRunDB returns a postgres gorm instance that only builds statements and records them in
+// statements. It is used for queries relying on postgres-only features, like array operators.
+func newDryRunDB(t *testing.T, statements *[]string) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to open dry-run database: %v", err)
+	}
+	record := func(db *gorm.DB) {
+		*statements = append(*statements, db.Statement.SQL.String())
+	}
+	if err := db.Callback().Query().After("gorm:query").Register("test:record", record); err != nil {
+		t.Fatalf("failed to register callback: %v", err)
+	}
+	if err := db.Callback().Update().After("gorm:update").Register("test:record", record); err != nil {
+		t.Fatalf("failed to register callback: %v", err)
+	}
+	return db
+}
+
+func TestRepository_ListUpdatedSince(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	cutoff := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), InStock: true, Price: 10, DetailsType: "course", UpdatedAt: cutoff.Add(-time.Hour)},
+		{ID: uuid.New().String(), InStock: true, Price: 11, DetailsType: "course", UpdatedAt: cutoff},
+		{ID: uuid.New().String(), InStock: true, Price: 12, DetailsType: "course", UpdatedAt: cutoff.Add(2 * time.Hour)},
+		{ID: uuid.New().String(), InStock: true, Price: 13, DetailsType: "course", UpdatedAt: cutoff.Add(time.Hour)},
+	}
+	if err := repo.CreateBatch(context.Background(), products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	t.Run("only records after cursor", func(t *testing.T) {
+		// Act
+		got, err := repo.ListUpdatedSince(context.Background(), products[1].Cursor(), 10)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, got, 2) {
+			assert.Equal(t, products[3].ID, got[0].ID)
+			assert.Equal(t, products[2].ID, got[1].ID)
+		}
+	})
+
+	t.Run("empty cursor id includes records at cursor time", func(t *testing.T) {
+		// Act
+		got, err := repo.ListUpdatedSince(context.Background(), productmodel.ChangeCursor{UpdatedAt: cutoff}, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, got, 3) {
+			assert.Equal(t, products[1].ID, got[0].ID)
+		}
+	})
+
+	t.Run("respects limit", func(t *testing.T) {
+		// Act
+		got, err := repo.ListUpdatedSince(context.Background(), products[1].Cursor(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, got, 1) {
+			assert.Equal(t, products[3].ID, got[0].ID)
+		}
+	})
+}
+
+func TestRepository_ListUpdatedSince_SameTimestamp(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	at := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	products := make([]*productmodel.Product, 5)
+	for i := range products {
+		products[i] = &productmodel.Product{ID: uuid.New().String(), InStock: true, Price: 10, DetailsType: "course", UpdatedAt: at}
+	}
+	if err := repo.CreateBatch(ctx, products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	// Act
+	seen := make(map[string]bool)
+	var cursor productmodel.ChangeCursor
+	for range len(products) {
+		page, err := repo.ListUpdatedSince(ctx, cursor, 2)
+		if !assert.NoError(t, err) || len(page) == 0 {
+			break
+		}
+		for _, p := range page {
+			seen[p.ID] = true
+		}
+		cursor = page[len(page)-1].Cursor()
+	}
+
+	// Assert
+	assert.Len(t, seen, len(products))
+}
+
+func TestRepository_ListUpdatedSince_States(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	past := time.Now().Add(-time.Hour)
+	live := &productmodel.Product{ID: uuid.New().String(), InStock: true, Price: 10, DetailsType: "course", UpdatedAt: past}
+	unpublished := &productmodel.Product{ID: uuid.New().String(), InStock: true, Price: 10, DetailsType: "course", UpdatedAt: past}
+	deleted := &productmodel.Product{ID: uuid.New().String(), InStock: true, Price: 10, DetailsType: "course", UpdatedAt: past}
+	if err := repo.CreateBatch(ctx, live, unpublished, deleted); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	cursor := productmodel.ChangeCursor{UpdatedAt: past.Add(time.Minute)}
+	if _, err := repo.SetInStock(ctx, unpublished.ID, false, time.Now()); err != nil {
+		t.Fatalf("failed to unpublish product: %v", err)
+	}
+	if _, err := repo.Delete(ctx, deleted.ID); err != nil {
+		t.Fatalf("failed to delete product: %v", err)
+	}
+
+	// Act
+	got, err := repo.ListUpdatedSince(ctx, cursor, 10)
+
+	// Assert
+	assert.NoError(t, err)
+	states := make(map[string]productmodel.State)
+	for _, p := range got {
+		states[p.ID] = p.State
+	}
+	assert.Equal(t, map[string]productmodel.State{
+		unpublished.ID: productmodel.StateUnpublished,
+		deleted.ID:     productmodel.StateDeleted,
+	}, states)
+}
+
+func TestRepository_List_UpdatedAfter(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	cutoff := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	old := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsType: "course", UpdatedAt: cutoff.Add(-time.Hour)}
+	atCutoff := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsType: "course", UpdatedAt: cutoff}
+	recent := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsType: "course", UpdatedAt: cutoff.Add(time.Hour)}
+	recentOutOfStock := &productmodel.Product{ID: uuid.New().String(), DetailsType: "course", UpdatedAt: cutoff.Add(time.Hour)}
+	if err := repo.CreateBatch(ctx, old, atCutoff, recent, recentOutOfStock); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	inStock := true
+	filter := productmodel.ProductFilter{InStock: &inStock, UpdatedAfter: &cutoff}
+
+	// Act
+	got, err := repo.List(ctx, filter, 10, 0)
+	total, countErr := repo.Count(ctx, filter)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, countErr)
+	if assert.Len(t, got, 1) {
+		assert.Equal(t, recent.ID, got[0].ID)
+	}
+	assert.Equal(t, int64(1), total)
+}
+
+func TestRepository_List_AvailableAt(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	now := time.Date(2025, 5, 15, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-24*time.Hour), now.Add(24*time.Hour)
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), InStock: true, Price: 10, DetailsType: "course"},
+		{ID: uuid.New().String(), InStock: true, Price: 11, DetailsType: "course", AvailableFrom: &past, AvailableUntil: &future},
+		{ID: uuid.New().String(), InStock: true, Price: 12, DetailsType: "course", AvailableFrom: &future},
+		{ID: uuid.New().String(), InStock: true, Price: 13, DetailsType: "course", AvailableUntil: &past},
+		{ID: uuid.New().String(), InStock: false, Price: 14, DetailsType: "course"},
+	}
+	if err := repo.CreateBatch(context.Background(), products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	inStock := true
+	filter := productmodel.ProductFilter{InStock: &inStock, AvailableAt: &now}
+
+	// Act
+	got, err := repo.List(context.Background(), filter, 10, 0)
+	assert.NoError(t, err)
+	count, err := repo.Count(context.Background(), filter)
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, int64(2), count)
+	ids := make([]string, 0, len(got))
+	for _, p := range got {
+		ids = append(ids, p.ID)
+	}
+	assert.ElementsMatch(t, []string{products[0].ID, products[1].ID}, ids)
+}
+
+func TestRepository_SelectByDetailsRefs(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	seminarID, sessionID := uuid.New().String(), uuid.New().String()
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), InStock: true, Price: 10, DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), InStock: true, Price: 11, DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), InStock: true, Price: 12, DetailsID: sessionID, DetailsType: "training_session"},
+		// Same details ID as the seminar but a different type must not match.
+		{ID: uuid.New().String(), InStock: true, Price: 13, DetailsID: seminarID, DetailsType: "course"},
+		// Unrelated owner.
+		{ID: uuid.New().String(), InStock: true, Price: 14, DetailsID: uuid.New().String(), DetailsType: "training_session"},
+		// Unpublished product of a requested owner is left out.
+		{ID: uuid.New().String(), Price: 15, DetailsID: seminarID, DetailsType: "seminar"},
+	}
+	if err := repo.CreateBatch(context.Background(), products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	t.Run("mixed owner types", func(t *testing.T) {
+		// Act
+		got, err := repo.SelectByDetailsRefs(context.Background(), []productmodel.DetailsRef{
+			{DetailsID: seminarID, DetailsType: "seminar"},
+			{DetailsID: sessionID, DetailsType: "training_session"},
+		}, "id", "details_id", "details_type")
+
+		// Assert
+		assert.NoError(t, err)
+		owners := make(map[string]productmodel.DetailsRef, len(got))
+		for _, p := range got {
+			owners[p.ID] = productmodel.DetailsRef{DetailsID: p.DetailsID, DetailsType: p.DetailsType}
+		}
+		assert.Equal(t, map[string]productmodel.DetailsRef{
+			products[0].ID: {DetailsID: seminarID, DetailsType: "seminar"},
+			products[1].ID: {DetailsID: seminarID, DetailsType: "seminar"},
+			products[2].ID: {DetailsID: sessionID, DetailsType: "training_session"},
+		}, owners)
+	})
+
+	t.Run("empty refs", func(t *testing.T) {
+		// Act
+		got, err := repo.SelectByDetailsRefs(context.Background(), nil, "id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestRepository_SelectByDetailsIDs(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	partA, partB, partC := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), InStock: true, Price: 10, DetailsID: partA, DetailsType: "course_part"},
+		{ID: uuid.New().String(), InStock: true, Price: 11, DetailsID: partB, DetailsType: "course_part"},
+		// Unpublished products are left out.
+		{ID: uuid.New().String(), Price: 12, DetailsID: partC, DetailsType: "course_part"},
+		// Unrelated owner.
+		{ID: uuid.New().String(), InStock: true, Price: 13, DetailsID: uuid.New().String(), DetailsType: "course_part"},
+	}
+	if err := repo.CreateBatch(context.Background(), products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	t.Run("several owners", func(t *testing.T) {
+		// Act
+		got, err := repo.SelectByDetailsIDs(context.Background(), []string{partA, partB, partC}, "id", "price", "details_id")
+
+		// Assert
+		assert.NoError(t, err)
+		prices := make(map[string]float32, len(got))
+		for _, p := range got {
+			prices[p.DetailsID] = p.Price
+		}
+		assert.Equal(t, map[string]float32{partA: 10, partB: 11}, prices)
+	})
+
+	t.Run("no matching products", func(t *testing.T) {
+		// Act
+		got, err := repo.SelectByDetailsIDs(context.Background(), []string{partC, uuid.New().String()}, "id")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+}
+
+func TestRepository_List_StablePagination(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+
+	// Rows share created_at and price so only the id tie-breaker keeps pages stable.
+	createdAt := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	products := make([]*productmodel.Product, 0, 7)
+	for range 7 {
+		products = append(products, &productmodel.Product{ID: uuid.New().String(), InStock: true, Price: 10, DetailsType: "course", CreatedAt: createdAt})
+	}
+	if err := repo.CreateBatch(context.Background(), products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	want := make([]string, 0, len(products))
+	for _, p := range products {
+		want = append(want, p.ID)
+	}
+
+	paginate := func(t *testing.T) []string {
+		t.Helper()
+		var ids []string
+		for offset := 0; ; offset += 3 {
+			page, err := repo.List(context.Background(), productmodel.ProductFilter{}, 3, offset)
+			if !assert.NoError(t, err) || len(page) == 0 {
+				return ids
+			}
+			for _, p := range page {
+				ids = append(ids, p.ID)
+			}
+		}
+	}
+
+	for _, order := range []string{"", "price asc"} {
+		t.Run(fmt.Sprintf("order %q", order), func(t *testing.T) {
+			// Arrange
+			assert.NoError(t, database.SetDefaultSort(order))
+			t.Cleanup(func() { _ = database.SetDefaultSort("") })
+
+			// Act
+			first := paginate(t)
+			second := paginate(t)
+
+			// Assert
+			assert.Len(t, first, len(want))
+			assert.ElementsMatch(t, want, first)
+			assert.Equal(t, first, second)
+		})
+	}
+}
+
+func TestRepository_Upsert(t *testing.T) {
+	ctx := context.Background()
+
+	countByID := func(t *testing.T, db *gorm.DB, id string) int64 {
+		t.Helper()
+		var count int64
+		if err := db.Unscoped().Model(&productmodel.Product{}).Where("id = ?", id).Count(&count).Error; err != nil {
+			t.Fatalf("failed to count products: %v", err)
+		}
+		return count
+	}
+
+	t.Run("insert then update", func(t *testing.T) {
+		db := newTestDB(t)
+		repo := New(db)
+		id := uuid.New().String()
+
+		assert.NoError(t, repo.Upsert(ctx, &productmodel.Product{ID: id, Price: 10, DetailsType: "course"}))
+		assert.NoError(t, repo.Upsert(ctx, &productmodel.Product{ID: id, Price: 25, InStock: true, DetailsType: "course"}))
+
+		assert.Equal(t, int64(1), countByID(t, db, id))
+		var got productmodel.Product
+		if assert.NoError(t, db.First(&got, "id = ?", id).Error) {
+			assert.Equal(t, float32(25), got.Price)
+			assert.True(t, got.InStock)
+		}
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		db := newTestDB(t)
+		repo := New(db)
+		existing := &productmodel.Product{ID: uuid.New().String(), Price: 10, DetailsType: "course"}
+		assert.NoError(t, repo.Create(ctx, existing))
+
+		fresh := &productmodel.Product{ID: uuid.New().String(), Price: 30, DetailsType: "seminar"}
+		assert.NoError(t, repo.UpsertBatch(ctx, &productmodel.Product{ID: existing.ID, Price: 20, DetailsType: "course"}, fresh))
+
+		assert.Equal(t, int64(1), countByID(t, db, existing.ID))
+		assert.Equal(t, int64(1), countByID(t, db, fresh.ID))
+		products, err := repo.SelectWithUnpublishedByIDs(ctx, []string{existing.ID, fresh.ID}, "id", "price")
+		if assert.NoError(t, err) {
+			prices := make(map[string]float32, len(products))
+			for _, p := range products {
+				prices[p.ID] = p.Price
+			}
+			assert.Equal(t, map[string]float32{existing.ID: 20, fresh.ID: 30}, prices)
+		}
+	})
+
+	t.Run("batch with soft-deleted writes nothing", func(t *testing.T) {
+		db := newTestDB(t)
+		repo := New(db)
+		existing := &productmodel.Product{ID: uuid.New().String(), Price: 10, DetailsType: "course"}
+		deleted := &productmodel.Product{ID: uuid.New().String(), Price: 10, DetailsType: "course"}
+		assert.NoError(t, repo.CreateBatch(ctx, existing, deleted))
+		assert.NoError(t, db.Delete(&productmodel.Product{}, "id = ?", deleted.ID).Error)
+		fresh := &productmodel.Product{ID: uuid.New().String(), Price: 30, DetailsType: "seminar"}
+
+		err := repo.UpsertBatch(ctx,
+			&productmodel.Product{ID: existing.ID, Price: 20, DetailsType: "course"},
+			fresh,
+			&productmodel.Product{ID: deleted.ID, Price: 25, DetailsType: "course"},
+		)
+
+		assert.ErrorIs(t, err, ErrSoftDeleted)
+		assert.ErrorContains(t, err, deleted.ID)
+		assert.NotContains(t, err.Error(), existing.ID)
+		assert.Equal(t, int64(0), countByID(t, db, fresh.ID))
+		var got productmodel.Product
+		if assert.NoError(t, db.First(&got, "id = ?", existing.ID).Error) {
+			assert.Equal(t, float32(10), got.Price)
+		}
+	})
+
+	t.Run("soft-deleted is not revived", func(t *testing.T) {
+		db := newTestDB(t)
+		repo := New(db)
+		id := uuid.New().String()
+		assert.NoError(t, repo.Create(ctx, &productmodel.Product{ID: id, Price: 10, DetailsType: "course"}))
+		assert.NoError(t, db.Delete(&productmodel.Product{}, "id = ?", id).Error)
+
+		err := repo.Upsert(ctx, &productmodel.Product{ID: id, Price: 25, DetailsType: "course"})
+
+		assert.ErrorIs(t, err, ErrSoftDeleted)
+		assert.Equal(t, int64(1), countByID(t, db, id))
+		var got productmodel.Product
+		if assert.NoError(t, db.Unscoped().First(&got, "id = ?", id).Error) {
+			assert.Equal(t, float32(10), got.Price)
+			assert.True(t, got.DeletedAt.Valid)
+		}
+	})
+}
+
+func TestRepository_ListWithDeletedByDetailsID(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	ownerID, otherID := uuid.New().String(), uuid.New().String()
+	live := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsID: ownerID, DetailsType: "seminar"}
+	unpublished := &productmodel.Product{ID: uuid.New().String(), DetailsID: ownerID, DetailsType: "seminar"}
+	deleted := &productmodel.Product{ID: uuid.New().String(), DetailsID: ownerID, DetailsType: "seminar"}
+	other := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsID: otherID, DetailsType: "seminar"}
+	if err := repo.CreateBatch(ctx, live, unpublished, deleted, other); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	if err := db.Delete(&productmodel.Product{}, "id = ?", deleted.ID).Error; err != nil {
+		t.Fatalf("failed to soft-delete product: %v", err)
+	}
+
+	products, err := repo.ListWithDeletedByDetailsID(ctx, ownerID)
+
+	assert.NoError(t, err)
+	ids := make([]string, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.ID)
+	}
+	assert.ElementsMatch(t, []string{live.ID, unpublished.ID, deleted.ID}, ids)
+}
+
+func TestRepository_ListByIDs(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	live := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsType: "course"}
+	unpublished := &productmodel.Product{ID: uuid.New().String(), DetailsType: "course"}
+	deleted := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsType: "course"}
+	if err := repo.CreateBatch(ctx, live, unpublished, deleted); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	if err := db.Delete(&productmodel.Product{}, "id = ?", deleted.ID).Error; err != nil {
+		t.Fatalf("failed to soft-delete product: %v", err)
+	}
+	ids := []string{live.ID, unpublished.ID, deleted.ID}
+
+	t.Run("only published and not deleted", func(t *testing.T) {
+		products, err := repo.ListByIDs(ctx, ids)
+
+		assert.NoError(t, err)
+		if assert.Len(t, products, 1) {
+			assert.Equal(t, live.ID, products[0].ID)
+		}
+	})
+
+	t.Run("with deleted selects every state", func(t *testing.T) {
+		products, err := repo.SelectWithDeletedByIDs(ctx, ids, "id", "in_stock", "deleted_at")
+
+		assert.NoError(t, err)
+		states := make(map[string]productmodel.State, len(products))
+		for _, p := range products {
+			states[p.ID] = p.CurrentState()
+		}
+		assert.Equal(t, map[string]productmodel.State{
+			live.ID:        productmodel.StateLive,
+			unpublished.ID: productmodel.StateUnpublished,
+			deleted.ID:     productmodel.StateDeleted,
+		}, states)
+	})
+}
+
+func TestRepository_List_InStock(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	inStock := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsID: uuid.New().String(), DetailsType: "course"}
+	outOfStock := &productmodel.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "course"}
+	deleted := &productmodel.Product{ID: uuid.New().String(), InStock: true, DetailsID: uuid.New().String(), DetailsType: "course"}
+	if err := repo.CreateBatch(ctx, inStock, outOfStock, deleted); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	if err := db.Delete(&productmodel.Product{}, "id = ?", deleted.ID).Error; err != nil {
+		t.Fatalf("failed to soft-delete product: %v", err)
+	}
+	ids := func(products []productmodel.Product) []string {
+		out := make([]string, 0, len(products))
+		for _, p := range products {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+
+	t.Run("in stock filter excludes out of stock", func(t *testing.T) {
+		published := true
+		products, err := repo.List(ctx, productmodel.ProductFilter{InStock: &published}, 10, 0)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{inStock.ID}, ids(products))
+
+		total, err := repo.Count(ctx, productmodel.ProductFilter{InStock: &published})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("zero filter includes out of stock", func(t *testing.T) {
+		products, err := repo.List(ctx, productmodel.ProductFilter{}, 10, 0)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{inStock.ID, outOfStock.ID}, ids(products))
+
+		total, err := repo.Count(ctx, productmodel.ProductFilter{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+}
+
+func TestRepository_List_NeverPublished(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	newProduct := func(createdAt time.Time) *productmodel.Product {
+		return &productmodel.Product{ID: uuid.New().String(), CreatedAt: createdAt, DetailsID: uuid.New().String(), DetailsType: "course"}
+	}
+	base := time.Now().Add(-time.Hour)
+	drafts := []*productmodel.Product{newProduct(base), newProduct(base.Add(time.Minute)), newProduct(base.Add(2 * time.Minute))}
+	unpublished := newProduct(base)
+	published := newProduct(base)
+	createdPublished := newProduct(base)
+	createdPublished.InStock = true
+	if err := repo.CreateBatch(ctx, append(drafts, unpublished, published, createdPublished)...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	publishedAt := base.Add(30 * time.Minute)
+	for _, id := range []string{unpublished.ID, published.ID} {
+		if _, err := repo.SetInStock(ctx, id, true, publishedAt); err != nil {
+			t.Fatalf("failed to publish product: %v", err)
+		}
+	}
+	if _, err := repo.SetInStock(ctx, unpublished.ID, false, publishedAt); err != nil {
+		t.Fatalf("failed to unpublish product: %v", err)
+	}
+	filter := productmodel.ProductFilter{NeverPublished: true}
+	get := func(t *testing.T, id string) productmodel.Product {
+		t.Helper()
+		var product productmodel.Product
+		if err := db.First(&product, "id = ?", id).Error; err != nil {
+			t.Fatalf("failed to get product: %v", err)
+		}
+		return product
+	}
+	ids := func(products []productmodel.Product) []string {
+		out := make([]string, 0, len(products))
+		for _, p := range products {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+
+	t.Run("keeps only never published products", func(t *testing.T) {
+		products, err := repo.List(ctx, filter, 10, 0)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{drafts[0].ID, drafts[1].ID, drafts[2].ID}, ids(products))
+
+		total, err := repo.Count(ctx, filter)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("paginates", func(t *testing.T) {
+		first, err := repo.List(ctx, filter, 2, 0)
+		assert.NoError(t, err)
+		second, err := repo.List(ctx, filter, 2, 2)
+		assert.NoError(t, err)
+
+		assert.Len(t, first, 2)
+		assert.Len(t, second, 1)
+		assert.ElementsMatch(t, []string{drafts[0].ID, drafts[1].ID, drafts[2].ID}, append(ids(first), ids(second)...))
+	})
+
+	t.Run("republishing keeps the first stamp", func(t *testing.T) {
+		republishedAt := publishedAt.Add(time.Hour)
+
+		_, err := repo.SetInStock(ctx, unpublished.ID, true, republishedAt)
+		assert.NoError(t, err)
+
+		after := get(t, unpublished.ID)
+		if assert.NotNil(t, after.FirstPublishedAt) {
+			assert.True(t, publishedAt.Equal(*after.FirstPublishedAt))
+		}
+		if assert.NotNil(t, after.LastPublishedAt) {
+			assert.True(t, republishedAt.Equal(*after.LastPublishedAt))
+		}
+	})
+
+	t.Run("created published products are stamped with their creation time", func(t *testing.T) {
+		product := get(t, createdPublished.ID)
+		if assert.NotNil(t, product.FirstPublishedAt) {
+			assert.True(t, base.Equal(*product.FirstPublishedAt))
+		}
+		if assert.NotNil(t, product.LastPublishedAt) {
+			assert.True(t, base.Equal(*product.LastPublishedAt))
+		}
+	})
+}
+
+func TestRepository_PublishByDetailsID(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	detailsID := uuid.New().String()
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), DetailsID: detailsID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: detailsID, DetailsType: "seminar"},
+	}
+	if err := repo.CreateBatch(ctx, products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	first := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	second := first.Add(48 * time.Hour)
+
+	// Act
+	affected, err := repo.PublishByDetailsID(ctx, detailsID, first)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), affected)
+	if _, err := repo.SetInStockByDetailsID(ctx, detailsID, false, second); err != nil {
+		t.Fatalf("failed to unpublish products: %v", err)
+	}
+	affected, err = repo.PublishByDetailsID(ctx, detailsID, second)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), affected)
+	for _, p := range products {
+		var product productmodel.Product
+		if err := db.First(&product, "id = ?", p.ID).Error; err != nil {
+			t.Fatalf("failed to get product: %v", err)
+		}
+		assert.True(t, product.InStock)
+		if assert.NotNil(t, product.FirstPublishedAt) {
+			assert.True(t, first.Equal(*product.FirstPublishedAt))
+		}
+		if assert.NotNil(t, product.LastPublishedAt) {
+			assert.True(t, second.Equal(*product.LastPublishedAt))
+		}
+	}
+}
+
+func TestRepository_DeletePermanentByDetailsIDs(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	ownerA, ownerB, kept := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), DetailsID: ownerA, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: ownerA, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: ownerB, DetailsType: "course"},
+		{ID: uuid.New().String(), DetailsID: kept, DetailsType: "course"},
+	}
+	if err := repo.CreateBatch(ctx, products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	// Soft-deleted products are removed as well.
+	if err := db.Delete(&productmodel.Product{}, "id = ?", products[2].ID).Error; err != nil {
+		t.Fatalf("failed to soft-delete product: %v", err)
+	}
+
+	deleted, err := repo.DeletePermanentByDetailsIDs(ctx, []string{ownerA, ownerB})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	var remaining []string
+	assert.NoError(t, db.Unscoped().Model(&productmodel.Product{}).Pluck("id", &remaining).Error)
+	assert.Equal(t, []string{products[3].ID}, remaining)
+}
+
+func TestRepository_DeleteByDetailsID(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	seminarID, otherID := uuid.New().String(), uuid.New().String()
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: otherID, DetailsType: "seminar"},
+	}
+	if err := repo.CreateBatch(ctx, products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	// Already soft-deleted products are left untouched.
+	if _, err := repo.Delete(ctx, products[2].ID); err != nil {
+		t.Fatalf("failed to soft-delete product: %v", err)
+	}
+
+	// Act
+	ra, err := repo.DeleteByDetailsID(ctx, seminarID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), ra)
+	var remaining []string
+	assert.NoError(t, db.Model(&productmodel.Product{}).Pluck("id", &remaining).Error)
+	assert.Equal(t, []string{products[3].ID}, remaining)
+}
+
+func TestRepository_RestoreByDetailsID(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	seminarID, otherID := uuid.New().String(), uuid.New().String()
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: otherID, DetailsType: "seminar"},
+	}
+	if err := repo.CreateBatch(ctx, products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	if err := db.Where("id IS NOT NULL").Delete(&productmodel.Product{}).Error; err != nil {
+		t.Fatalf("failed to soft-delete products: %v", err)
+	}
+
+	// Act
+	ra, err := repo.RestoreByDetailsID(ctx, seminarID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), ra)
+	var restored []productmodel.Product
+	assert.NoError(t, db.Find(&restored).Error)
+	if assert.Len(t, restored, 2) {
+		assert.ElementsMatch(t, []string{products[0].ID, products[1].ID}, []string{restored[0].ID, restored[1].ID})
+	}
+}
+
+func TestRepository_CountDetailsByTag(t *testing.T) {
+	// Arrange
+	db := newPostgresTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+	for _, table := range taggedDetailsTables {
+		if err := db.Exec("CREATE TABLE " + table + " (id varchar(36) PRIMARY KEY, tags varchar(20)[], deleted_at timestamptz)").Error; err != nil {
+			t.Fatalf("failed to create %s: %v", table, err)
+		}
+	}
+	courseID, seminarID, sessionID, goodID := uuid.New().String(), uuid.New().String(), uuid.New().String(), uuid.New().String()
+	seed := []struct {
+		table, id, tags string
+		deleted         bool
+	}{
+		{table: "courses", id: courseID, tags: "{yoga,pilates}"},
+		{table: "seminars", id: seminarID, tags: "{yoga}"},
+		{table: "training_sessions", id: sessionID, tags: "{yoga}", deleted: true},
+		{table: "physical_goods", id: goodID, tags: "{mats}"},
+	}
+	for _, row := range seed {
+		var deletedAt *time.Time
+		if row.deleted {
+			now := time.Now()
+			deletedAt = &now
+		}
+		if err := db.Exec("INSERT INTO "+row.table+" (id, tags, deleted_at) VALUES (?, ?::varchar(20)[], ?)", row.id, row.tags, deletedAt).Error; err != nil {
+			t.Fatalf("failed to seed %s: %v", row.table, err)
+		}
+	}
+	// The seminar has several products, it is still a single details record.
+	products := []*productmodel.Product{
+		{ID: uuid.New().String(), DetailsID: courseID, DetailsType: "course"},
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: seminarID, DetailsType: "seminar"},
+		{ID: uuid.New().String(), DetailsID: sessionID, DetailsType: "training_session"},
+	}
+	if err := repo.CreateBatch(ctx, products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	tests := []struct {
+		tag  string
+		want int64
+	}{
+		{tag: "yoga", want: 3},
+		{tag: "mats", want: 1},
+		{tag: "unused", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			// Act
+			count, err := repo.CountDetailsByTag(ctx, tt.tag)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, count)
+		})
+	}
+}
+
+func TestRepository_Tags(t *testing.T) {
+	tables := []string{`"courses"`, `"seminars"`, `"training_sessions"`, `"physical_goods"`}
+
+	t.Run("RemoveTag removes only the given tag", func(t *testing.T) {
+		// Arrange
+		var statements []string
+		repo := New(newDryRunDB(t, &statements))
+
+		// Act
+		_, err := repo.RemoveTag(context.Background(), "yoga")
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, statements, len(tables)) {
+			for i, stmt := range statements {
+				assert.Contains(t, stmt, "UPDATE "+tables[i])
+				assert.Contains(t, stmt, `"tags"=array_remove(tags, $1)`)
+				assert.Contains(t, stmt, "WHERE $3 = ANY(tags)")
+			}
+		}
+	})
+	t.Run("RenameTag drops duplicates before replacing", func(t *testing.T) {
+		// Arrange
+		var statements []string
+		repo := New(newDryRunDB(t, &statements))
+
+		// Act
+		_, err := repo.RenameTag(context.Background(), "yoga", "pilates")
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, statements, 2*len(tables)) {
+			for i, table := range tables {
+				dedup, replace := statements[2*i], statements[2*i+1]
+				assert.Contains(t, dedup, "UPDATE "+table)
+				assert.Contains(t, dedup, `"tags"=array_remove(tags, $1)`)
+				assert.Contains(t, dedup, "WHERE $3 = ANY(tags) AND $4 = ANY(tags)")
+				assert.Contains(t, replace, "UPDATE "+table)
+				assert.Contains(t, replace, `"tags"=array_replace(tags, $1, $2)`)
+				assert.Contains(t, replace, "WHERE $4 = ANY(tags)")
+			}
+		}
+	})
+	t.Run("SetTags replaces tags of products details", func(t *testing.T) {
+		// Arrange
+		var statements []string
+		repo := New(newDryRunDB(t, &statements))
+		ids := []string{uuid.New().String(), uuid.New().String()}
+
+		// Act
+		_, err := repo.SetTags(context.Background(), ids, []string{"yoga", "summer"})
+
+		// Assert
+		assert.NoError(t, err)
+		updates := updateStatements(statements)
+		if assert.Len(t, updates, len(tables)) {
+			for i, stmt := range updates {
+				assert.Contains(t, stmt, "UPDATE "+tables[i])
+				assert.Contains(t, stmt, `"tags"=ARRAY[$1,$2]::varchar[]`)
+				assert.Contains(t, stmt, `WHERE id IN (SELECT "details_id" FROM "products" WHERE (id IN ($4,$5) AND details_type = $6)`)
+			}
+		}
+	})
+	t.Run("SetTags with no tags clears them", func(t *testing.T) {
+		// Arrange
+		var statements []string
+		repo := New(newDryRunDB(t, &statements))
+
+		// Act
+		_, err := repo.SetTags(context.Background(), []string{uuid.New().String()}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		updates := updateStatements(statements)
+		if assert.Len(t, updates, len(tables)) {
+			assert.Contains(t, updates[0], `"tags"='{}'::varchar[]`)
+		}
+	})
+	t.Run("AddTags appends only missing tags", func(t *testing.T) {
+		// Arrange
+		var statements []string
+		repo := New(newDryRunDB(t, &statements))
+		ids := []string{uuid.New().String()}
+
+		// Act
+		_, err := repo.AddTags(context.Background(), ids, []string{"yoga", "summer"})
+
+		// Assert
+		assert.NoError(t, err)
+		updates := updateStatements(statements)
+		if assert.Len(t, updates, len(tables)) {
+			for i, stmt := range updates {
+				assert.Contains(t, stmt, "UPDATE "+tables[i])
+				assert.Contains(t, stmt, `"tags"=ARRAY(SELECT tag FROM unnest(array_cat(COALESCE(tags, '{}'), ARRAY[$1,$2]::varchar[]))`)
+				assert.Contains(t, stmt, "GROUP BY tag ORDER BY MIN(pos))")
+				assert.Contains(t, stmt, "AND NOT (COALESCE(tags, '{}') @> ARRAY[$6,$7]::varchar[])")
+			}
+		}
+	})
+}
+
+func TestRepository_ListRelated(t *testing.T) {
+	// Arrange
+	var statements []string
+	repo := New(newDryRunDB(t, &statements))
+	id := uuid.New().String()
+
+	// Act
+	_, err := repo.ListRelated(context.Background(), id, 5, time.Now())
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, statements, 1) {
+		stmt := statements[0]
+		for _, table := range []string{"courses", "seminars", "training_sessions", "physical_goods"} {
+			assert.Contains(t, stmt, "FROM "+table+" WHERE deleted_at IS NULL")
+		}
+		assert.Contains(t, stmt, "WHERE products.id = $1")
+		assert.Contains(t, stmt, "WHERE tag = ANY(source.tags)")
+		assert.Contains(t, stmt, "products.in_stock = true AND products.deleted_at IS NULL")
+		assert.Contains(t, stmt, "products.id <> source.id AND products.details_id <> source.details_id")
+		assert.Contains(t, stmt, "shared.overlap > 0")
+		assert.Contains(t, stmt, "LEFT JOIN seminars ON products.details_type = 'seminar' AND seminars.id = products.details_id")
+		assert.Contains(t, stmt, "products.id IN (seminars.early_product_id, seminars.late_product_id)")
+	}
+}
+
+func TestRankRelated(t *testing.T) {
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+	candidate := func(id, detailsID, detailsType string, overlap, minutes int) relatedCandidate {
+		return relatedCandidate{
+			Product: productmodel.Product{ID: id, DetailsID: detailsID, DetailsType: detailsType, CreatedAt: at(minutes)},
+			Overlap: overlap,
+		}
+	}
+	// The seminar shares the most tags. Both of its price tiers are candidates, the late one sets
+	// its price after the late payment date.
+	early, late := "seminar-early", "seminar-late"
+	latePaymentDate := base.AddDate(0, 1, 0)
+	seminarTier := func(id string, minutes int) relatedCandidate {
+		c := candidate(id, "seminar", "seminar", 3, minutes)
+		c.EarlyProductID, c.LateProductID, c.LatePaymentDate = &early, &late, &latePaymentDate
+		return c
+	}
+	candidates := func() []relatedCandidate {
+		return []relatedCandidate{
+			candidate("course-old", "course-old", "course", 2, 1),
+			seminarTier(early, 10),
+			candidate("good", "good", "physical_good", 1, 30),
+			seminarTier(late, 5),
+			candidate("course-new", "course-new", "course", 2, 20),
+		}
+	}
+	ids := func(products []productmodel.Product) []string {
+		out := make([]string, 0, len(products))
+		for _, p := range products {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+
+	t.Run("one product per details record, current seminar tier", func(t *testing.T) {
+		// Act
+		before := rankRelated(candidates(), 10, base)
+		after := rankRelated(candidates(), 10, latePaymentDate.AddDate(0, 0, 1))
+
+		// Assert
+		assert.Equal(t, []string{early, "course-new", "course-old", "good"}, ids(before))
+		assert.Equal(t, []string{late, "course-new", "course-old", "good"}, ids(after))
+	})
+
+	t.Run("limit counts details records", func(t *testing.T) {
+		// Act
+		products := rankRelated(candidates(), 2, base)
+
+		// Assert
+		assert.Equal(t, []string{early, "course-new"}, ids(products))
+	})
+
+	t.Run("seminar without current product is skipped", func(t *testing.T) {
+		// Arrange
+		c := seminarTier(early, 10)
+		c.EarlyProductID = nil
+
+		// Act
+		products := rankRelated([]relatedCandidate{c}, 10, base)
+
+		// Assert
+		assert.Empty(t, products)
+	})
+}
+
+func TestRepository_Count_MatchesList(t *testing.T) {
+	db := newTestDB(t)
+	repo := New(db)
+	ctx := context.Background()
+
+	price := func(v float32) *float32 { return &v }
+	stock := func(v bool) *bool { return &v }
+	seed := []*productmodel.Product{
+		{ID: uuid.New().String(), Price: 10, InStock: true, DetailsID: uuid.New().String(), DetailsType: "course"},
+		{ID: uuid.New().String(), Price: 50, InStock: true, DetailsID: uuid.New().String(), DetailsType: "course"},
+		{ID: uuid.New().String(), Price: 30, DetailsID: uuid.New().String(), DetailsType: "course"},
+		{ID: uuid.New().String(), Price: 30, InStock: true, DetailsID: uuid.New().String(), DetailsType: "physical_good"},
+		{ID: uuid.New().String(), Price: 90, DetailsID: uuid.New().String(), DetailsType: "training_session"},
+		{ID: uuid.New().String(), Price: 20, InStock: true, DetailsID: uuid.New().String(), DetailsType: "course"},
+	}
+	if err := repo.CreateBatch(ctx, seed...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+	if err := db.Delete(&productmodel.Product{}, "id = ?", seed[5].ID).Error; err != nil {
+		t.Fatalf("failed to soft-delete product: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter productmodel.ProductFilter
+		want   int64
+	}{
+		{name: "no filter", filter: productmodel.ProductFilter{}, want: 5},
+		{name: "type", filter: productmodel.ProductFilter{DetailsType: "course"}, want: 3},
+		{name: "in stock", filter: productmodel.ProductFilter{InStock: stock(true)}, want: 3},
+		{name: "out of stock", filter: productmodel.ProductFilter{InStock: stock(false)}, want: 2},
+		{name: "price range", filter: productmodel.ProductFilter{MinPrice: price(20), MaxPrice: price(50)}, want: 3},
+		{name: "min price only", filter: productmodel.ProductFilter{MinPrice: price(50)}, want: 2},
+		{name: "type and in stock", filter: productmodel.ProductFilter{DetailsType: "course", InStock: stock(true)}, want: 2},
+		{
+			name:   "type, price range and in stock",
+			filter: productmodel.ProductFilter{DetailsType: "course", MinPrice: price(30), MaxPrice: price(60), InStock: stock(true)},
+			want:   1,
+		},
+		{name: "nothing matches", filter: productmodel.ProductFilter{DetailsType: "seminar"}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			count, err := repo.Count(ctx, tt.filter)
+			products, listErr := repo.List(ctx, tt.filter, -1, -1)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NoError(t, listErr)
+			assert.Equal(t, tt.want, count)
+			assert.Len(t, products, int(count))
+		})
+	}
+}
+
+func TestFilterScope(t *testing.T) {
+	price := func(v float32) *float32 { return &v }
+	stock := func(v bool) *bool { return &v }
+	at := time.Date(2025, 5, 15, 12, 0, 0, 0, time.UTC)
+	const base = `SELECT count(*) FROM "products" WHERE `
+	const notDeleted = `"products"."deleted_at" IS NULL`
+	tagged := func(table string, first int) string {
+		return fmt.Sprintf(`details_type = $%d AND details_id IN (SELECT id FROM "%s" WHERE tags @> ARRAY[$%d]::varchar[])`, first, table, first+1)
+	}
+
+	tests := []struct {
+		name   string
+		filter productmodel.ProductFilter
+		want   string
+	}{
+		{name: "unset fields add no clause", filter: productmodel.ProductFilter{}, want: base + notDeleted},
+		{name: "details type", filter: productmodel.ProductFilter{DetailsType: "course"}, want: base + `details_type = $1 AND ` + notDeleted},
+		{
+			name:   "format",
+			filter: productmodel.ProductFilter{Format: "online"},
+			want:   base + `(details_type = $1 AND details_id IN (SELECT id FROM "training_sessions" WHERE format = $2)) AND ` + notDeleted,
+		},
+		{
+			name:   "tags check every details table",
+			filter: productmodel.ProductFilter{Tags: []string{"yoga"}},
+			want: base + `((` + tagged("courses", 1) + `) OR (` + tagged("seminars", 3) + `) OR (` +
+				tagged("training_sessions", 5) + `) OR (` + tagged("physical_goods", 7) + `)) AND ` + notDeleted,
+		},
+		{
+			name:   "tags narrowed by details type",
+			filter: productmodel.ProductFilter{DetailsType: "seminar", Tags: []string{"yoga"}},
+			want:   base + `details_type = $1 AND (` + tagged("seminars", 2) + `) AND ` + notDeleted,
+		},
+		{name: "min price", filter: productmodel.ProductFilter{MinPrice: price(10)}, want: base + `price >= $1 AND ` + notDeleted},
+		{name: "max price", filter: productmodel.ProductFilter{MaxPrice: price(10)}, want: base + `price <= $1 AND ` + notDeleted},
+		{name: "in stock", filter: productmodel.ProductFilter{InStock: stock(false)}, want: base + `in_stock = $1 AND ` + notDeleted},
+		{
+			name:   "available at",
+			filter: productmodel.ProductFilter{AvailableAt: &at},
+			want:   base + `(available_from IS NULL OR available_from <= $1) AND (available_until IS NULL OR available_until > $2) AND ` + notDeleted,
+		},
+		{name: "updated after", filter: productmodel.ProductFilter{UpdatedAfter: &at}, want: base + `updated_at > $1 AND ` + notDeleted},
+		{
+			name:   "combination",
+			filter: productmodel.ProductFilter{DetailsType: "course", MinPrice: price(10), MaxPrice: price(20), InStock: stock(true)},
+			want:   base + `details_type = $1 AND price >= $2 AND price <= $3 AND in_stock = $4 AND ` + notDeleted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			var statements []string
+			db := newDryRunDB(t, &statements)
+			var count int64
+
+			// Act
+			err := db.Model(&productmodel.Product{}).Scopes(filterScope(tt.filter)).Count(&count).Error
+
+			// Assert
+			assert.NoError(t, err)
+			// Subqueries are recorded before the statement they are part of.
+			if assert.NotEmpty(t, statements) {
+				assert.Equal(t, tt.want, statements[len(statements)-1])
+			}
+		})
+	}
+}
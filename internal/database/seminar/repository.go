@@ -22,7 +22,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/database"
 	imagemodel "github.com/mikhail5545/product-service-go/internal/models/image"
 	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
 	"gorm.io/gorm"
@@ -36,6 +38,13 @@ type Repository interface {
 
 	// Get retrieves single seminar record from the database.
 	Get(ctx context.Context, id string) (*seminarmodel.Seminar, error)
+	// GetBySlug retrieves single published seminar record by its slug from the database.
+	GetBySlug(ctx context.Context, slug string) (*seminarmodel.Seminar, error)
+	// ListByIDs retrieves published seminar records by ids from the database.
+	ListByIDs(ctx context.Context, ids ...string) ([]seminarmodel.Seminar, error)
+	// ListByLatePaymentDateBetween retrieves only specific fields of published seminar records
+	// whose late payment date is after from and not after to.
+	ListByLatePaymentDateBetween(ctx context.Context, from, to time.Time, fields ...string) ([]seminarmodel.Seminar, error)
 	// Select retrieves specidied seminar fields from the database.
 	Select(ctx context.Context, id string, fields ...string) (*seminarmodel.Seminar, error)
 	// List retrieves a paginated list of all seminar records in the database.
@@ -47,6 +56,8 @@ type Repository interface {
 
 	// GetWithDeleted retrieves single seminar record from the database including soft-deleted ones.
 	GetWithDeleted(ctx context.Context, id string) (*seminarmodel.Seminar, error)
+	// GetWithDeletedBySlug retrieves single seminar record by its slug from the database, including soft-deleted ones.
+	GetWithDeletedBySlug(ctx context.Context, slug string) (*seminarmodel.Seminar, error)
 	// ListDeleted retrieves a paginated list of all soft-deleted seminar records from database.
 	ListDeleted(ctx context.Context, limit, offset int) ([]seminarmodel.Seminar, error)
 	// CountDeleted counts the total number of all soft-deleted seminar records in the database.
@@ -58,6 +69,9 @@ type Repository interface {
 	GetWithUnpublished(ctx context.Context, id string) (*seminarmodel.Seminar, error)
 	// ListWithUnpublished retrieves paginated list of all unpublished seminar records from the database.
 	ListUnpublished(ctx context.Context, limit, offset int) ([]seminarmodel.Seminar, error)
+	// ListWithUnpublished retrieves paginated list of all not soft-deleted seminar records from the database,
+	// both published and unpublished. Images are not loaded.
+	ListWithUnpublished(ctx context.Context, limit, offset int) ([]seminarmodel.Seminar, error)
 	// ListWithUnpublishedByIDs retrieves seminar records by ids from database including unpublished ones.
 	ListWithUnpublishedByIDs(ctx context.Context, ids ...string) ([]seminarmodel.Seminar, error)
 	// CountWithUnpublished counts the total number of all unpublished seminar records in the database.
@@ -65,10 +79,14 @@ type Repository interface {
 
 	// --- Common ---
 
+	// ListSlugsByPrefix retrieves slugs of all seminar records, including unpublished and soft-deleted ones,
+	// that are equal to base or start with base followed by a hyphen.
+	ListSlugsByPrefix(ctx context.Context, base string) ([]string, error)
 	// Create creates a new seminar record in the database.
 	Create(ctx context.Context, seminar *seminarmodel.Seminar) error
 	// SetInStock sets a new value for seminar's InStock field.
-	SetInStock(ctx context.Context, id string, inStock bool) (int64, error)
+	// A record that already has the value is reported as AlreadyInState, not as missing.
+	SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error)
 	// Update performs partial update of a seminar record using updates.
 	Update(ctx context.Context, seminar *seminarmodel.Seminar, updates any) (int64, error)
 	// BatchUpdate performs partial update for a batch of seminar records in the database.
@@ -81,8 +99,15 @@ type Repository interface {
 	BatchUpdate(ctx context.Context, updates []seminarmodel.Seminar, opt uint) (int64, error)
 	// FindOwnerIDsByImageID finds all seminar IDs associated with a given image media service ID within a specific set of owners.
 	FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error)
-	// DecrementImageCount decrements the uploaded_image_amount for the given seminar IDs.
+	// DecrementImageCount decrements the uploaded_image_amount for the given seminar IDs,
+	// soft-deleted ones included.
 	DecrementImageCount(ctx context.Context, seminarIDs []string) (int64, error)
+	// ListOwnerIDsByImageID returns IDs of all seminar records associated with a given image media service ID.
+	ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error)
+	// DeleteImageAssociations removes the image from every seminar record it is associated with.
+	// Note: This only removes the associations. The caller is responsible for updating any related counters
+	// within the same transaction to ensure data consistency.
+	DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error)
 	// AddImage adds a new image for the Seminar record in the database.
 	AddImage(ctx context.Context, seminar *seminarmodel.Seminar, image *imagemodel.Image) error
 	// AddImageBatch adds a new image (single) for the many seminar records in the database.
@@ -93,10 +118,18 @@ type Repository interface {
 	DeleteImageBatch(ctx context.Context, seminars []seminarmodel.Seminar, image *imagemodel.Image) error
 	// DeleteImage deletes an image from the Seminar record.
 	DeleteImage(ctx context.Context, seminar *seminarmodel.Seminar, mediaSvcID string) error
-	// Delete performs soft-delete of a seminar record.
-	Delete(ctx context.Context, id string) (int64, error)
+	// Delete performs soft-delete of a seminar record and stores reason in deleted_reason.
+	// Its products are soft-deleted too by the model's AfterDelete hook,
+	// unless ctx was returned by common.WithoutCascade.
+	Delete(ctx context.Context, id, reason string) (int64, error)
 	// DeletePermanent performs permanent delete of a seminar record.
 	DeletePermanent(ctx context.Context, id string) (int64, error)
+	// ListDeletedIDsBefore returns IDs of seminar records soft-deleted before the given time.
+	ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error)
+	// CountDeletedBefore counts seminar records soft-deleted before the given time.
+	CountDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeletePermanentByIDs performs permanent delete of seminar records by ids.
+	DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error)
 	// Restore restores soft-deleted seminar record.
 	Restore(ctx context.Context, id string) (int64, error)
 	// DB returns the underlying gorm.DB instance.
@@ -134,6 +167,29 @@ func (r *gormRepository) Get(ctx context.Context, id string) (*seminarmodel.Semi
 	return seminar, err
 }
 
+// GetBySlug retrieves single published seminar record by its slug from the database.
+func (r *gormRepository) GetBySlug(ctx context.Context, slug string) (*seminarmodel.Seminar, error) {
+	var seminar *seminarmodel.Seminar
+	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Preload("Images").First(&seminar, "slug = ?", slug).Error
+	return seminar, err
+}
+
+// ListByIDs retrieves published seminar records by ids from the database.
+func (r *gormRepository) ListByIDs(ctx context.Context, ids ...string) ([]seminarmodel.Seminar, error) {
+	var seminars []seminarmodel.Seminar
+	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Where("id IN ?", ids).Preload("Images").Find(&seminars).Error
+	return seminars, err
+}
+
+// ListByLatePaymentDateBetween retrieves only specific fields of published seminar records
+// whose late payment date is after from and not after to.
+func (r *gormRepository) ListByLatePaymentDateBetween(ctx context.Context, from, to time.Time, fields ...string) ([]seminarmodel.Seminar, error) {
+	var seminars []seminarmodel.Seminar
+	err := r.db.WithContext(ctx).Select(fields).Where("in_stock = ?", true).
+		Where("late_payment_date > ? AND late_payment_date <= ?", from, to).Find(&seminars).Error
+	return seminars, err
+}
+
 // Select retrieves specidied seminar fields from the database.
 func (r *gormRepository) Select(ctx context.Context, id string, fields ...string) (*seminarmodel.Seminar, error) {
 	var seminar *seminarmodel.Seminar
@@ -144,7 +200,7 @@ func (r *gormRepository) Select(ctx context.Context, id string, fields ...string
 // List retrieves a paginated list of all seminar records in the database.
 func (r *gormRepository) List(ctx context.Context, limit, offset int) ([]seminarmodel.Seminar, error) {
 	var seminars []seminarmodel.Seminar
-	err := r.db.WithContext(ctx).Model(&seminarmodel.Seminar{}).Preload("Images").Where("in_stock = ?", true).Order("created_at desc").Limit(limit).Offset(offset).Find(&seminars).Error
+	err := r.db.WithContext(ctx).Model(&seminarmodel.Seminar{}).Preload("Images").Where("in_stock = ?", true).Scopes(database.SortDefault).Limit(limit).Offset(offset).Find(&seminars).Error
 	return seminars, err
 }
 
@@ -164,10 +220,17 @@ func (r *gormRepository) GetWithDeleted(ctx context.Context, id string) (*semina
 	return seminar, err
 }
 
+// GetWithDeletedBySlug retrieves single seminar record by its slug from the database, including soft-deleted ones.
+func (r *gormRepository) GetWithDeletedBySlug(ctx context.Context, slug string) (*seminarmodel.Seminar, error) {
+	var seminar *seminarmodel.Seminar
+	err := r.db.WithContext(ctx).Unscoped().First(&seminar, "slug = ?", slug).Error
+	return seminar, err
+}
+
 // ListDeleted retrieves a paginated list of all soft-deleted seminar records from database.
 func (r *gormRepository) ListDeleted(ctx context.Context, limit, offset int) ([]seminarmodel.Seminar, error) {
 	var seminars []seminarmodel.Seminar
-	err := r.db.WithContext(ctx).Unscoped().Preload("Images").Where("deleted_at IS NOT NULL").Order("created_at desc").Limit(limit).Offset(offset).Find(&seminars).Error
+	err := r.db.WithContext(ctx).Unscoped().Preload("Images").Where("deleted_at IS NOT NULL").Scopes(database.SortDefault).Limit(limit).Offset(offset).Find(&seminars).Error
 	return seminars, err
 }
 
@@ -197,7 +260,19 @@ func (r *gormRepository) ListUnpublished(ctx context.Context, limit, offset int)
 		Model(&seminarmodel.Seminar{}).
 		Preload("Images").
 		Where("in_stock = ?", false).
-		Order("created_at DESC").
+		Scopes(database.SortDefault).
+		Limit(limit).Offset(offset).
+		Find(&seminars).Error
+	return seminars, err
+}
+
+// ListWithUnpublished retrieves paginated list of all not soft-deleted seminar records from the database,
+// both published and unpublished. Images are not loaded.
+func (r *gormRepository) ListWithUnpublished(ctx context.Context, limit, offset int) ([]seminarmodel.Seminar, error) {
+	var seminars []seminarmodel.Seminar
+	err := r.db.WithContext(ctx).
+		Model(&seminarmodel.Seminar{}).
+		Scopes(database.SortDefault).
 		Limit(limit).Offset(offset).
 		Find(&seminars).Error
 	return seminars, err
@@ -219,15 +294,24 @@ func (r *gormRepository) CountUnpublished(ctx context.Context) (int64, error) {
 
 // --- Common ---
 
+// ListSlugsByPrefix retrieves slugs of all seminar records, including unpublished and soft-deleted ones,
+// that are equal to base or start with base followed by a hyphen.
+func (r *gormRepository) ListSlugsByPrefix(ctx context.Context, base string) ([]string, error) {
+	var slugs []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&seminarmodel.Seminar{}).
+		Where("slug = ? OR slug LIKE ?", base, base+"-%").Pluck("slug", &slugs).Error
+	return slugs, err
+}
+
 // Create creates a new SeminarPart record in the database.
 func (r *gormRepository) Create(ctx context.Context, seminar *seminarmodel.Seminar) error {
 	return r.db.WithContext(ctx).Create(seminar).Error
 }
 
 // SetInStock sets a new value for seminar's InStock field.
-func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
-	res := r.db.WithContext(ctx).Model(&seminarmodel.Seminar{}).Where("id = ?", id).Update("in_stock", inStock)
-	return res.RowsAffected, res.Error
+// A record that already has the value is reported as AlreadyInState, not as missing.
+func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error) {
+	return database.SetState(r.db.WithContext(ctx).Model(&seminarmodel.Seminar{}).Where("id = ?", id), "in_stock", map[string]any{"in_stock": inStock})
 }
 
 // Update performs partial update of a seminar record using updates.
@@ -297,15 +381,38 @@ func (r *gormRepository) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID s
 	return affectedSeminarIDs, err
 }
 
-// DecrementImageCount decrements the uploaded_image_amount for the given seminar IDs.
+// DecrementImageCount decrements the uploaded_image_amount for the given seminar IDs,
+// soft-deleted ones included.
 func (r *gormRepository) DecrementImageCount(ctx context.Context, seminarIDs []string) (int64, error) {
 	res := r.db.WithContext(ctx).
+		Unscoped().
 		Model(&seminarmodel.Seminar{}).
 		Where("id IN ?", seminarIDs).
 		UpdateColumn("uploaded_image_amount", gorm.Expr("uploaded_image_amount - 1"))
 	return res.RowsAffected, res.Error
 }
 
+// ListOwnerIDsByImageID returns IDs of all seminar records associated with a given image media service ID.
+func (r *gormRepository) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	var ids []string
+	joinTable := r.db.WithContext(ctx).Model(&seminarmodel.Seminar{}).Association("Images").Relationship.JoinTable
+	err := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Pluck("seminar_id", &ids).Error
+	return ids, err
+}
+
+// DeleteImageAssociations removes the image from every seminar record it is associated with.
+// Note: This only removes the associations. The caller is responsible for updating any related counters
+// within the same transaction to ensure data consistency.
+func (r *gormRepository) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	joinTable := r.db.WithContext(ctx).Model(&seminarmodel.Seminar{}).Association("Images").Relationship.JoinTable
+	res := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Delete(nil)
+	return res.RowsAffected, res.Error
+}
+
 // AddImage adds a new image for the Seminar record in the database.
 func (r *gormRepository) AddImage(ctx context.Context, seminar *seminarmodel.Seminar, image *imagemodel.Image) error {
 	return r.db.WithContext(ctx).Model(seminar).Association("Images").Append(image)
@@ -326,10 +433,20 @@ func (r *gormRepository) DeleteImageBatch(ctx context.Context, seminars []semina
 	return r.db.WithContext(ctx).Model(&seminars).Association("Images").Delete(image)
 }
 
-// Delete performs soft-delete of a seminar record.
-func (r *gormRepository) Delete(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Delete(&seminarmodel.Seminar{}, id)
-	return res.RowsAffected, res.Error
+// Delete performs soft-delete of a seminar record and stores reason in deleted_reason.
+// Its products are soft-deleted too by the model's AfterDelete hook,
+// unless ctx was returned by common.WithoutCascade.
+func (r *gormRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
+	var affected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&seminarmodel.Seminar{}).Where("id = ?", id).UpdateColumn("deleted_reason", reason).Error; err != nil {
+			return err
+		}
+		res := tx.Delete(&seminarmodel.Seminar{ID: id})
+		affected = res.RowsAffected
+		return res.Error
+	})
+	return affected, err
 }
 
 // DeletePermanent performs permanent delete of a seminar record.
@@ -338,8 +455,31 @@ func (r *gormRepository) DeletePermanent(ctx context.Context, id string) (int64,
 	return res.RowsAffected, res.Error
 }
 
+// ListDeletedIDsBefore returns IDs of seminar records soft-deleted before the given time.
+func (r *gormRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&seminarmodel.Seminar{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountDeletedBefore counts seminar records soft-deleted before the given time.
+func (r *gormRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&seminarmodel.Seminar{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Count(&count).Error
+	return count, err
+}
+
+// DeletePermanentByIDs performs permanent delete of seminar records by ids.
+func (r *gormRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Delete(&seminarmodel.Seminar{})
+	return res.RowsAffected, res.Error
+}
+
 // Restore restores soft-deleted seminar record.
 func (r *gormRepository) Restore(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Unscoped().Model(&seminarmodel.Seminar{}).Where("id = ?", id).Update("deleted_at", nil)
+	res := r.db.WithContext(ctx).Unscoped().Model(&seminarmodel.Seminar{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_reason": ""})
 	return res.RowsAffected, res.Error
 }
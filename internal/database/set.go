@@ -0,0 +1,54 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+package database
+
+import "gorm.io/gorm"
+
+// SetResult is the outcome of a setter that moves records into a state, e.g. publishing them.
+// Affected counts the records that changed. If it is 0, AlreadyInState tells apart records that
+// exist but were already in the requested state from records that weren't found at all.
+type SetResult struct {
+	Affected       int64
+	AlreadyInState bool
+}
+
+// NotFound reports whether no record matched the setter.
+func (r SetResult) NotFound() bool {
+	return r.Affected == 0 && !r.AlreadyInState
+}
+
+// SetState writes updates to the records of query whose column differs from updates[column].
+// query must already select the table and the target records, e.g. db.Model(&Model{}).Where("id = ?", id),
+// so soft-deleted rows are excluded the same way as in the other repository methods.
+//
+// Records already holding the value are left untouched, so their other columns in updates (timestamps etc.)
+// are not rewritten. If nothing changed, the records are counted once more to fill in AlreadyInState.
+func SetState(query *gorm.DB, column string, updates map[string]any) (SetResult, error) {
+	query = query.Session(&gorm.Session{})
+	res := query.Where(column+" <> ?", updates[column]).Updates(updates)
+	if res.Error != nil {
+		return SetResult{}, res.Error
+	}
+	if res.RowsAffected > 0 {
+		return SetResult{Affected: res.RowsAffected}, nil
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return SetResult{}, err
+	}
+	return SetResult{AlreadyInState: count > 0}, nil
+}
@@ -0,0 +1,80 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestSetState(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.Exec(`CREATE TABLE items (id TEXT PRIMARY KEY, owner_id TEXT, in_stock BOOLEAN, stamp INTEGER)`).Error)
+	seed := []struct {
+		id, owner string
+		inStock   bool
+	}{
+		{"i1", "a", false}, {"i2", "a", true},
+		{"i3", "b", true},
+	}
+	for _, row := range seed {
+		require.NoError(t, db.Exec(`INSERT INTO items (id, owner_id, in_stock, stamp) VALUES (?, ?, ?, 0)`, row.id, row.owner, row.inStock).Error)
+	}
+	stamp := func(id string) int {
+		var stamp int
+		require.NoError(t, db.Table("items").Where("id = ?", id).Pluck("stamp", &stamp).Error)
+		return stamp
+	}
+
+	t.Run("not found", func(t *testing.T) {
+		// Act
+		res, err := SetState(db.Table("items").Where("owner_id = ?", "missing"), "in_stock", map[string]any{"in_stock": true})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, SetResult{}, res)
+		assert.True(t, res.NotFound())
+	})
+
+	t.Run("already in state", func(t *testing.T) {
+		// Act
+		res, err := SetState(db.Table("items").Where("owner_id = ?", "b"), "in_stock", map[string]any{"in_stock": true, "stamp": 1})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, SetResult{AlreadyInState: true}, res)
+		assert.False(t, res.NotFound())
+		assert.Equal(t, 0, stamp("i3"))
+	})
+
+	t.Run("changed", func(t *testing.T) {
+		// Act
+		res, err := SetState(db.Table("items").Where("owner_id = ?", "a"), "in_stock", map[string]any{"in_stock": true, "stamp": 2})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, SetResult{Affected: 1}, res)
+		assert.False(t, res.NotFound())
+		assert.Equal(t, 2, stamp("i1"))
+		assert.Equal(t, 0, stamp("i2"), "records already in state are left untouched")
+	})
+}
@@ -0,0 +1,93 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	// tracingSpanKey is the instance key the open span is kept under between callbacks.
+	tracingSpanKey = "tracing:span"
+	// dbTableKey and dbOperationKey are the attributes of statement spans.
+	dbTableKey     = attribute.Key("db.table")
+	dbOperationKey = attribute.Key("db.operation")
+)
+
+// SetupTracing registers callbacks that wrap every statement issued through db in a span
+// named "db.<operation> <table>", a child of the span in the statement context. Repositories
+// always pass the request context with WithContext, so their calls nest under the service span.
+// The SQL itself is not recorded, it may contain personal data.
+func SetupTracing(db *gorm.DB) error {
+	cb := db.Callback()
+	processors := []struct {
+		operation string
+		before    func(string, func(*gorm.DB)) error
+		after     func(string, func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+	for _, p := range processors {
+		if err := p.before("tracing:before_"+p.operation, startSpan(p.operation)); err != nil {
+			return fmt.Errorf("failed to register tracing callback: %w", err)
+		}
+		if err := p.after("tracing:after_"+p.operation, endSpan); err != nil {
+			return fmt.Errorf("failed to register tracing callback: %w", err)
+		}
+	}
+	return nil
+}
+
+// startSpan returns the callback opening the statement span.
+func startSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx, span := tracing.Tracer().Start(db.Statement.Context, "db."+operation+" "+db.Statement.Table,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(dbTableKey.String(db.Statement.Table), dbOperationKey.String(operation)),
+		)
+		db.Statement.Context = ctx
+		db.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+// endSpan is the callback closing the statement span. Not found errors are expected
+// results of lookups and are not marked as span errors.
+func endSpan(db *gorm.DB) {
+	v, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span := v.(trace.Span)
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,70 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	tracingtest "github.com/mikhail5545/product-service-go/internal/test/tracing"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestSetupTracing(t *testing.T) {
+	// Arrange
+	recorder := tracingtest.Record(t)
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&productmodel.Product{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	assert.NoError(t, SetupTracing(db))
+	id := uuid.New().String()
+
+	// Act
+	ctx, parent := tracing.Start(context.Background(), "product", "Create")
+	createErr := db.WithContext(ctx).Create(&productmodel.Product{ID: id}).Error
+	notFoundErr := db.WithContext(ctx).First(&productmodel.Product{}, "id = ?", uuid.New().String()).Error
+	parent.End()
+
+	// Assert
+	assert.NoError(t, createErr)
+	assert.ErrorIs(t, notFoundErr, gorm.ErrRecordNotFound)
+	parentSpan := tracingtest.Find(recorder, "product.Create")
+	createSpan := tracingtest.Find(recorder, "db.create products")
+	querySpan := tracingtest.Find(recorder, "db.query products")
+	if assert.NotNil(t, parentSpan) && assert.NotNil(t, createSpan) && assert.NotNil(t, querySpan) {
+		assert.Equal(t, parentSpan.SpanContext().SpanID(), createSpan.Parent().SpanID())
+		assert.Equal(t, parentSpan.SpanContext().SpanID(), querySpan.Parent().SpanID())
+		assert.Contains(t, createSpan.Attributes(), attribute.String("db.table", "products"))
+		assert.Contains(t, createSpan.Attributes(), attribute.String("db.operation", "create"))
+		// A lookup that finds nothing is not a failure.
+		assert.Equal(t, codes.Unset, querySpan.Status().Code)
+	}
+}
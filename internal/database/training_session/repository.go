@@ -22,7 +22,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/database"
 	imagemodel "github.com/mikhail5545/product-service-go/internal/models/image"
 	tsmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
 	"gorm.io/gorm"
@@ -68,7 +70,8 @@ type Repository interface {
 	// Create creates a new training session record in the database.
 	Create(ctx context.Context, ts *tsmodel.TrainingSession) error
 	// SetInStock sets a new value for the training session's InStock field.
-	SetInStock(ctx context.Context, id string, inStock bool) (int64, error)
+	// A record that already has the value is reported as AlreadyInState, not as missing.
+	SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error)
 	// Update performs a partial update of a training session record using the provided updates map.
 	Update(ctx context.Context, ts *tsmodel.TrainingSession, updates any) (int64, error)
 	// BatchUpdate performs partial update for a batch of training session records in the database.
@@ -81,8 +84,15 @@ type Repository interface {
 	BatchUpdate(ctx context.Context, updates []tsmodel.TrainingSession, opt uint) (int64, error)
 	// FindOwnerIDsByImageID finds all training session IDs associated with a given image media service ID within a specific set of owners.
 	FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error)
-	// DecrementImageCount decrements the uploaded_image_amount for the given training session IDs.
+	// DecrementImageCount decrements the uploaded_image_amount for the given training session IDs,
+	// soft-deleted ones included.
 	DecrementImageCount(ctx context.Context, tsIDs []string) (int64, error)
+	// ListOwnerIDsByImageID returns IDs of all training session records associated with a given image media service ID.
+	ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error)
+	// DeleteImageAssociations removes the image from every training session record it is associated with.
+	// Note: This only removes the associations. The caller is responsible for updating any related counters
+	// within the same transaction to ensure data consistency.
+	DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error)
 	// AddImage adds a new image for the training session record in the database.
 	AddImage(ctx context.Context, ts *tsmodel.TrainingSession, image *imagemodel.Image) error
 	// AddImageBatch adds a new image (single) for the many training session records in the database.
@@ -93,10 +103,18 @@ type Repository interface {
 	// Note: This only removes the association. The caller is responsible for updating any related counters
 	// within the same transaction to ensure data consistency.
 	DeleteImageBatch(ctx context.Context, ts []tsmodel.TrainingSession, image *imagemodel.Image) error
-	// Delete performs a soft-delete of a training session record.
-	Delete(ctx context.Context, id string) (int64, error)
+	// Delete performs a soft-delete of a training session record and stores reason in deleted_reason.
+	// Its products are soft-deleted too by the model's AfterDelete hook,
+	// unless ctx was returned by common.WithoutCascade.
+	Delete(ctx context.Context, id, reason string) (int64, error)
 	// DeletePermanent performs a permanent delete of a training session record.
 	DeletePermanent(ctx context.Context, id string) (int64, error)
+	// ListDeletedIDsBefore returns IDs of training session records soft-deleted before the given time.
+	ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error)
+	// CountDeletedBefore counts training session records soft-deleted before the given time.
+	CountDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// DeletePermanentByIDs performs permanent delete of training session records by ids.
+	DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error)
 	// Restore restores a soft-deleted training session record.
 	Restore(ctx context.Context, id string) (int64, error)
 
@@ -149,7 +167,7 @@ func (r *gormRepository) Select(ctx context.Context, id string, fields ...string
 // List retrieves a paginated list of all published and not soft-deleted training session records in the database.
 func (r *gormRepository) List(ctx context.Context, limit, offset int) ([]tsmodel.TrainingSession, error) {
 	var ts []tsmodel.TrainingSession
-	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Preload("Images").Limit(limit).Offset(offset).Order("created_at desc").Find(&ts).Error
+	err := r.db.WithContext(ctx).Where("in_stock = ?", true).Preload("Images").Limit(limit).Offset(offset).Scopes(database.SortDefault).Find(&ts).Error
 	return ts, err
 }
 
@@ -172,7 +190,7 @@ func (r *gormRepository) GetWithDeleted(ctx context.Context, id string) (*tsmode
 // ListDeleted retrieves a paginated list of all soft-deleted training session records in the database.
 func (r *gormRepository) ListDeleted(ctx context.Context, limit, offset int) ([]tsmodel.TrainingSession, error) { // Corrected comment
 	var ts []tsmodel.TrainingSession
-	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Preload("Images").Limit(limit).Offset(offset).Order("created_at desc").Find(&ts).Error
+	err := r.db.WithContext(ctx).Unscoped().Where("deleted_at IS NOT NULL").Preload("Images").Limit(limit).Offset(offset).Scopes(database.SortDefault).Find(&ts).Error
 	return ts, err
 }
 
@@ -202,7 +220,7 @@ func (r *gormRepository) ListUnpublished(ctx context.Context, limit, offset int)
 		Model(&tsmodel.TrainingSession{}).
 		Preload("Images").
 		Where("in_stock = ?", false).
-		Order("created_at DESC").
+		Scopes(database.SortDefault).
 		Limit(limit).Offset(offset).
 		Find(&ts).Error
 	return ts, err
@@ -296,15 +314,38 @@ func (r *gormRepository) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID s
 	return affectedIrainingSessionIDs, err
 }
 
-// DecrementImageCount decrements the uploaded_image_amount for the given training session IDs.
+// DecrementImageCount decrements the uploaded_image_amount for the given training session IDs,
+// soft-deleted ones included.
 func (r *gormRepository) DecrementImageCount(ctx context.Context, tsIDs []string) (int64, error) {
 	res := r.db.WithContext(ctx).
+		Unscoped().
 		Model(&tsmodel.TrainingSession{}).
 		Where("id IN ?", tsIDs).
 		UpdateColumn("uploaded_image_amount", gorm.Expr("uploaded_image_amount - 1"))
 	return res.RowsAffected, res.Error
 }
 
+// ListOwnerIDsByImageID returns IDs of all training session records associated with a given image media service ID.
+func (r *gormRepository) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	var ids []string
+	joinTable := r.db.WithContext(ctx).Model(&tsmodel.TrainingSession{}).Association("Images").Relationship.JoinTable
+	err := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Pluck("training_session_id", &ids).Error
+	return ids, err
+}
+
+// DeleteImageAssociations removes the image from every training session record it is associated with.
+// Note: This only removes the associations. The caller is responsible for updating any related counters
+// within the same transaction to ensure data consistency.
+func (r *gormRepository) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	joinTable := r.db.WithContext(ctx).Model(&tsmodel.TrainingSession{}).Association("Images").Relationship.JoinTable
+	res := r.db.WithContext(ctx).Table(joinTable.Table).
+		Where("image_media_service_id = ?", mediaSvcID).
+		Delete(nil)
+	return res.RowsAffected, res.Error
+}
+
 // AddImage adds a new image for the training session record in the database.
 func (r *gormRepository) AddImage(ctx context.Context, ts *tsmodel.TrainingSession, image *imagemodel.Image) error {
 	return r.db.WithContext(ctx).Model(ts).Association("Images").Append(image)
@@ -326,15 +367,25 @@ func (r *gormRepository) DeleteImageBatch(ctx context.Context, ts []tsmodel.Trai
 }
 
 // SetInStock sets a new value for the training session's InStock field.
-func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
-	res := r.db.WithContext(ctx).Model(&tsmodel.TrainingSession{}).Where("id = ?", id).Update("in_stock", inStock)
-	return res.RowsAffected, res.Error
+// A record that already has the value is reported as AlreadyInState, not as missing.
+func (r *gormRepository) SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error) {
+	return database.SetState(r.db.WithContext(ctx).Model(&tsmodel.TrainingSession{}).Where("id = ?", id), "in_stock", map[string]any{"in_stock": inStock})
 }
 
-// Delete performs soft-delete of a training session record.
-func (r *gormRepository) Delete(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Delete(&tsmodel.TrainingSession{}, id)
-	return res.RowsAffected, res.Error
+// Delete performs soft-delete of a training session record and stores reason in deleted_reason.
+// Its products are soft-deleted too by the model's AfterDelete hook,
+// unless ctx was returned by common.WithoutCascade.
+func (r *gormRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
+	var affected int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&tsmodel.TrainingSession{}).Where("id = ?", id).UpdateColumn("deleted_reason", reason).Error; err != nil {
+			return err
+		}
+		res := tx.Delete(&tsmodel.TrainingSession{ID: id})
+		affected = res.RowsAffected
+		return res.Error
+	})
+	return affected, err
 }
 
 // DeletePermanent performs permanent delete of a training session record.
@@ -343,8 +394,31 @@ func (r *gormRepository) DeletePermanent(ctx context.Context, id string) (int64,
 	return res.RowsAffected, res.Error
 }
 
+// ListDeletedIDsBefore returns IDs of training session records soft-deleted before the given time.
+func (r *gormRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	var ids []string
+	err := r.db.WithContext(ctx).Unscoped().Model(&tsmodel.TrainingSession{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// CountDeletedBefore counts training session records soft-deleted before the given time.
+func (r *gormRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Unscoped().Model(&tsmodel.TrainingSession{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Count(&count).Error
+	return count, err
+}
+
+// DeletePermanentByIDs performs permanent delete of training session records by ids.
+func (r *gormRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	res := r.db.WithContext(ctx).Unscoped().Where("id IN ?", ids).Delete(&tsmodel.TrainingSession{})
+	return res.RowsAffected, res.Error
+}
+
 // Restore restores soft-deleted training session record.
 func (r *gormRepository) Restore(ctx context.Context, id string) (int64, error) {
-	res := r.db.WithContext(ctx).Unscoped().Model(&tsmodel.TrainingSession{}).Where("id = ?", id).Update("deleted_at", nil)
+	res := r.db.WithContext(ctx).Unscoped().Model(&tsmodel.TrainingSession{}).Where("id = ?", id).
+		Updates(map[string]any{"deleted_at": nil, "deleted_reason": ""})
 	return res.RowsAffected, res.Error
 }
@@ -0,0 +1,72 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"gorm.io/gorm"
+)
+
+var guardIsolation atomic.Int64
+
+// isolationLevels maps DB_GUARD_ISOLATION values to isolation levels.
+var isolationLevels = map[string]sql.IsolationLevel{
+	"":                sql.LevelDefault,
+	"default":         sql.LevelDefault,
+	"read_committed":  sql.LevelReadCommitted,
+	"repeatable_read": sql.LevelRepeatableRead,
+	"serializable":    sql.LevelSerializable,
+}
+
+// Transaction runs fn in a transaction on db started with the given isolation level.
+// [sql.LevelDefault] leaves the isolation level to the database.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+func Transaction(ctx context.Context, db *gorm.DB, level sql.IsolationLevel, fn func(tx *gorm.DB) error) error {
+	return db.WithContext(ctx).Transaction(fn, &sql.TxOptions{Isolation: level})
+}
+
+// SetGuardIsolation sets the isolation level of transactions that check the number of affected
+// product rows against the expected one, like seminar publish and delete flows.
+// Under concurrent writes a stricter level (e.g. [sql.LevelSerializable]) makes such checks reliable,
+// at the cost of serialization failures the caller has to retry.
+func SetGuardIsolation(level sql.IsolationLevel) {
+	guardIsolation.Store(int64(level))
+}
+
+// SetGuardIsolationFromEnv sets the guard isolation level from the DB_GUARD_ISOLATION environment variable.
+// Accepted values are "default", "read_committed", "repeatable_read" and "serializable". Empty means "default".
+func SetGuardIsolationFromEnv() error {
+	value := os.Getenv("DB_GUARD_ISOLATION")
+	level, ok := isolationLevels[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return fmt.Errorf("invalid DB_GUARD_ISOLATION value %q", value)
+	}
+	SetGuardIsolation(level)
+	return nil
+}
+
+// GuardIsolation returns the isolation level set with [SetGuardIsolation], [sql.LevelDefault] by default.
+func GuardIsolation() sql.IsolationLevel {
+	return sql.IsolationLevel(guardIsolation.Load())
+}
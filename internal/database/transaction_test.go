@@ -0,0 +1,98 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// recordingPool remembers the options of the last transaction it started.
+type recordingPool struct {
+	*sql.DB
+	opts *sql.TxOptions
+}
+
+func (p *recordingPool) BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error) {
+	p.opts = opts
+	return p.DB.BeginTx(ctx, opts)
+}
+
+func TestTransaction(t *testing.T) {
+	sqlDB, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	pool := &recordingPool{DB: sqlDB}
+	db, err := gorm.Open(sqlite.Dialector{Conn: pool}, &gorm.Config{})
+	require.NoError(t, err)
+
+	for _, level := range []sql.IsolationLevel{sql.LevelDefault, sql.LevelSerializable} {
+		t.Run(level.String(), func(t *testing.T) {
+			// Act
+			err := Transaction(context.Background(), db, level, func(tx *gorm.DB) error {
+				return tx.Exec("SELECT 1").Error
+			})
+
+			// Assert
+			require.NoError(t, err)
+			require.NotNil(t, pool.opts)
+			assert.Equal(t, level, pool.opts.Isolation)
+		})
+	}
+}
+
+func TestSetGuardIsolationFromEnv(t *testing.T) {
+	t.Cleanup(func() { SetGuardIsolation(sql.LevelDefault) })
+
+	tests := []struct {
+		name    string
+		value   string
+		want    sql.IsolationLevel
+		wantErr bool
+	}{
+		{name: "empty uses default", value: "", want: sql.LevelDefault},
+		{name: "serializable", value: "serializable", want: sql.LevelSerializable},
+		{name: "case insensitive", value: "Repeatable_Read", want: sql.LevelRepeatableRead},
+		{name: "rejects unknown level", value: "snapshot", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			SetGuardIsolation(sql.LevelDefault)
+			t.Setenv("DB_GUARD_ISOLATION", tt.value)
+
+			// Act
+			err := SetGuardIsolationFromEnv()
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, sql.LevelDefault, GuardIsolation())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, GuardIsolation())
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package events provides domain events emitted by services and publishers delivering them to the event bus.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SchemaVersion is the version of the [Event] envelope. It is bumped on incompatible changes
+// so consumers can tell payload layouts apart.
+const SchemaVersion = 1
+
+// Event types emitted by services.
+const (
+	ProductCreated = "product.created"
+	// SeminarPriceChanged is emitted when a seminar's current price changes over time,
+	// e.g. when its late payment cutoff passes, so cached seminar details can be dropped.
+	SeminarPriceChanged = "seminar.price_changed"
+)
+
+// Event is a domain event envelope serialized as JSON onto the event bus.
+type Event struct {
+	SchemaVersion int       `json:"schema_version"`
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	AggregateID   string    `json:"aggregate_id"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	Payload       any       `json:"payload"`
+}
+
+// New creates an event of given type about the record with given aggregateID.
+func New(eventType, aggregateID string, payload any) Event {
+	return Event{
+		SchemaVersion: SchemaVersion,
+		ID:            uuid.New().String(),
+		Type:          eventType,
+		AggregateID:   aggregateID,
+		OccurredAt:    time.Now().UTC(),
+		Payload:       payload,
+	}
+}
+
+// Publisher delivers domain events to the event bus.
+type Publisher interface {
+	// Publish sends the event and returns once the broker has accepted it.
+	Publish(ctx context.Context, event Event) error
+	// Close releases the broker connection.
+	Close() error
+}
+
+// Noop is a [Publisher] dropping every event. It is used when no broker is configured.
+type Noop struct{}
+
+// Publish does nothing.
+func (Noop) Publish(context.Context, Event) error { return nil }
+
+// Close does nothing.
+func (Noop) Close() error { return nil }
+
+// Config configures the event bus connection.
+type Config struct {
+	// BrokerURL is the broker address, e.g. nats://localhost:4222. Empty disables publishing.
+	BrokerURL string
+	// Subject is the prefix of the subject events are published to. The event type is appended to it,
+	// e.g. vitianmove.product.product.created.
+	Subject string
+	// Strict makes publish failures fail the calling operation. Otherwise they are logged,
+	// counted in [PublishFailures] and the operation succeeds.
+	Strict bool
+}
+
+// DefaultConfig is used for values missing from the environment.
+var DefaultConfig = Config{Subject: "vitianmove.product"}
+
+// ConfigFromEnv reads the EVENTS_BROKER_URL, EVENTS_SUBJECT and EVENTS_STRICT environment variables.
+// Missing or invalid values fall back to [DefaultConfig].
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig
+	cfg.BrokerURL = os.Getenv("EVENTS_BROKER_URL")
+	if subject := os.Getenv("EVENTS_SUBJECT"); subject != "" {
+		cfg.Subject = subject
+	}
+	if strict, err := strconv.ParseBool(os.Getenv("EVENTS_STRICT")); err == nil {
+		cfg.Strict = strict
+	}
+	return cfg
+}
+
+// NewPublisher creates a publisher for the configured broker, or [Noop] if cfg.BrokerURL is empty.
+// Only NATS (nats://host:port) is supported for now. Unless cfg.Strict is set, the publisher is wrapped
+// with [Tolerant] so a broker outage does not fail the operations emitting events.
+//
+// Returns an error if the broker URL is invalid, its scheme is not supported or the broker is unreachable.
+func NewPublisher(ctx context.Context, cfg Config, logger *slog.Logger) (Publisher, error) {
+	if cfg.BrokerURL == "" {
+		return Noop{}, nil
+	}
+	u, err := url.Parse(cfg.BrokerURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid events broker URL: %w", err)
+	}
+	var publisher Publisher
+	switch u.Scheme {
+	case "nats":
+		publisher, err = DialNATS(ctx, u.Host, cfg.Subject)
+	default:
+		return nil, fmt.Errorf("unsupported events broker scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Strict {
+		return publisher, nil
+	}
+	return Tolerant(publisher, logger), nil
+}
+
+// PublishFailures counts events dropped by [Tolerant] publishers. It is exported through expvar
+// as events_publish_failures, served at /debug/vars of the admin listener.
+var PublishFailures = expvar.NewInt("events_publish_failures")
+
+// tolerantPublisher logs and counts publish failures instead of returning them.
+type tolerantPublisher struct {
+	Publisher
+	logger *slog.Logger
+}
+
+// Tolerant wraps p so that publish failures are logged to logger, counted in [PublishFailures]
+// and never returned. Events published while the broker is down are lost.
+func Tolerant(p Publisher, logger *slog.Logger) Publisher {
+	return &tolerantPublisher{Publisher: p, logger: logger}
+}
+
+// Publish sends the event, swallowing any error.
+func (p *tolerantPublisher) Publish(ctx context.Context, event Event) error {
+	if err := p.Publisher.Publish(ctx, event); err != nil {
+		PublishFailures.Add(1)
+		p.logger.ErrorContext(ctx, "failed to publish event",
+			"event_id", event.ID,
+			"event_type", event.Type,
+			"aggregate_id", event.AggregateID,
+			"error", err,
+		)
+	}
+	return nil
+}
+
+// marshal serializes the event as JSON.
+func marshal(event Event) ([]byte, error) {
+	return json.Marshal(event)
+}
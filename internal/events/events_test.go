@@ -0,0 +1,83 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// failingPublisher fails every publish, like a publisher whose broker is down.
+type failingPublisher struct {
+	Noop
+}
+
+func (failingPublisher) Publish(context.Context, Event) error {
+	return errors.New("broker unavailable")
+}
+
+func TestTolerant(t *testing.T) {
+	// Arrange
+	var logs bytes.Buffer
+	publisher := Tolerant(failingPublisher{}, slog.New(slog.NewTextHandler(&logs, nil)))
+	failures := PublishFailures.Value()
+	event := New(ProductCreated, "c6248da5-a2eb-4abd-be56-a19715104c00", nil)
+
+	// Act
+	err := publisher.Publish(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, failures+1, PublishFailures.Value())
+	assert.Contains(t, logs.String(), "failed to publish event")
+	assert.Contains(t, logs.String(), "event_id="+event.ID)
+	assert.Contains(t, logs.String(), "broker unavailable")
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		// Arrange
+		t.Setenv("EVENTS_BROKER_URL", "")
+		t.Setenv("EVENTS_SUBJECT", "")
+		t.Setenv("EVENTS_STRICT", "")
+
+		// Act
+		cfg := ConfigFromEnv()
+
+		// Assert
+		assert.Equal(t, DefaultConfig, cfg)
+	})
+
+	t.Run("configured", func(t *testing.T) {
+		// Arrange
+		t.Setenv("EVENTS_BROKER_URL", "nats://localhost:4222")
+		t.Setenv("EVENTS_SUBJECT", "custom")
+		t.Setenv("EVENTS_STRICT", "true")
+
+		// Act
+		cfg := ConfigFromEnv()
+
+		// Assert
+		assert.Equal(t, Config{BrokerURL: "nats://localhost:4222", Subject: "custom", Strict: true}, cfg)
+	})
+}
@@ -0,0 +1,175 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// natsTimeout bounds broker round trips when the context carries no deadline.
+const natsTimeout = 5 * time.Second
+
+// NATSPublisher publishes events to a NATS server using the core text protocol.
+// Every publish is followed by a PING, so it returns only after the server processed the message.
+// A broken connection is re-established once per publish.
+type NATSPublisher struct {
+	addr    string
+	subject string
+
+	mu   sync.Mutex
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+// DialNATS connects to the NATS server at addr (host:port). Events are published to
+// subject followed by the event type.
+//
+// Returns an error if the server is unreachable or rejects the connection.
+func DialNATS(ctx context.Context, addr, subject string) (*NATSPublisher, error) {
+	p := &NATSPublisher{addr: addr, subject: subject}
+	if err := p.connect(ctx); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Publish sends the event serialized as JSON to <subject>.<event type>.
+func (p *NATSPublisher) Publish(ctx context.Context, event Event) error {
+	data, err := marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	subject := p.subject + "." + event.Type
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.conn != nil {
+		if err := p.publish(ctx, subject, data); err == nil {
+			return nil
+		}
+		p.closeConn()
+	}
+	if err := p.connect(ctx); err != nil {
+		return err
+	}
+	if err := p.publish(ctx, subject, data); err != nil {
+		p.closeConn()
+		return fmt.Errorf("failed to publish event to NATS: %w", err)
+	}
+	return nil
+}
+
+// Close closes the connection to the server.
+func (p *NATSPublisher) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.closeConn()
+}
+
+// connect dials the server, reads its INFO greeting and sends CONNECT. Callers other than
+// DialNATS must hold p.mu.
+func (p *NATSPublisher) connect(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", p.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to NATS at %s: %w", p.addr, err)
+	}
+	p.conn, p.r = conn, bufio.NewReader(conn)
+	setDeadline(ctx, conn)
+
+	line, err := p.readLine()
+	if err == nil && !strings.HasPrefix(line, "INFO ") {
+		err = fmt.Errorf("unexpected greeting %q", line)
+	}
+	if err == nil {
+		_, err = fmt.Fprint(conn, "CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"product-service\",\"lang\":\"go\"}\r\nPING\r\n")
+	}
+	if err == nil {
+		err = p.awaitPong()
+	}
+	if err != nil {
+		p.closeConn()
+		return fmt.Errorf("failed to connect to NATS at %s: %w", p.addr, err)
+	}
+	return nil
+}
+
+// publish writes a PUB message followed by a PING and waits for the PONG.
+func (p *NATSPublisher) publish(ctx context.Context, subject string, data []byte) error {
+	setDeadline(ctx, p.conn)
+	msg := fmt.Sprintf("PUB %s %d\r\n%s\r\nPING\r\n", subject, len(data), data)
+	if _, err := p.conn.Write([]byte(msg)); err != nil {
+		return err
+	}
+	return p.awaitPong()
+}
+
+// awaitPong reads server messages until PONG, answering server PINGs on the way.
+func (p *NATSPublisher) awaitPong() error {
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := fmt.Fprint(p.conn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New(strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+// readLine reads a single protocol line without the trailing CRLF.
+func (p *NATSPublisher) readLine() (string, error) {
+	line, err := p.r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// closeConn closes and forgets the current connection.
+func (p *NATSPublisher) closeConn() error {
+	if p.conn == nil {
+		return nil
+	}
+	err := p.conn.Close()
+	p.conn, p.r = nil, nil
+	return err
+}
+
+// setDeadline applies the context deadline, or [natsTimeout] if there is none, to conn.
+func setDeadline(ctx context.Context, conn net.Conn) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(natsTimeout)
+	}
+	_ = conn.SetDeadline(deadline)
+}
@@ -0,0 +1,121 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	eventsfake "github.com/mikhail5545/product-service-go/internal/test/events_fake"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPublisher(t *testing.T) {
+	t.Run("no broker URL falls back to noop", func(t *testing.T) {
+		// Act
+		publisher, err := NewPublisher(context.Background(), Config{}, slog.New(slog.DiscardHandler))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, Noop{}, publisher)
+	})
+
+	t.Run("unsupported scheme", func(t *testing.T) {
+		// Act
+		_, err := NewPublisher(context.Background(), Config{BrokerURL: "kafka://localhost:9092"}, slog.New(slog.DiscardHandler))
+
+		// Assert
+		assert.ErrorContains(t, err, `unsupported events broker scheme "kafka"`)
+	})
+
+	t.Run("unreachable broker", func(t *testing.T) {
+		// Arrange
+		broker := eventsfake.NewBroker(t)
+		broker.Stop()
+
+		// Act
+		_, err := NewPublisher(context.Background(), Config{BrokerURL: broker.URL(), Subject: "test"}, slog.New(slog.DiscardHandler))
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestNewPublisher_Strict(t *testing.T) {
+	// Arrange
+	broker := eventsfake.NewBroker(t)
+
+	// Act
+	strict, err := NewPublisher(context.Background(), Config{BrokerURL: broker.URL(), Subject: "test", Strict: true}, slog.New(slog.DiscardHandler))
+	assert.NoError(t, err)
+	tolerant, err := NewPublisher(context.Background(), Config{BrokerURL: broker.URL(), Subject: "test"}, slog.New(slog.DiscardHandler))
+	assert.NoError(t, err)
+
+	// Assert
+	assert.IsType(t, &NATSPublisher{}, strict)
+	assert.IsType(t, &tolerantPublisher{}, tolerant)
+	assert.NoError(t, strict.Close())
+	assert.NoError(t, tolerant.Close())
+}
+
+func TestNATSPublisher_Publish(t *testing.T) {
+	// Arrange
+	broker := eventsfake.NewBroker(t)
+	publisher, err := NewPublisher(context.Background(), Config{BrokerURL: broker.URL(), Subject: "vitianmove.product"}, slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("failed to connect to fake broker: %v", err)
+	}
+	defer publisher.Close()
+	event := New(ProductCreated, "c6248da5-a2eb-4abd-be56-a19715104c00", map[string]any{"price": 10})
+
+	// Act
+	err = publisher.Publish(context.Background(), event)
+
+	// Assert
+	assert.NoError(t, err)
+	messages := broker.Messages()
+	if assert.Len(t, messages, 1) {
+		assert.Equal(t, "vitianmove.product.product.created", messages[0].Subject)
+		var got map[string]any
+		assert.NoError(t, json.Unmarshal(messages[0].Data, &got))
+		assert.Equal(t, float64(SchemaVersion), got["schema_version"])
+		assert.Equal(t, event.ID, got["id"])
+		assert.Equal(t, ProductCreated, got["type"])
+		assert.Equal(t, event.AggregateID, got["aggregate_id"])
+		assert.Equal(t, map[string]any{"price": float64(10)}, got["payload"])
+	}
+}
+
+func TestNATSPublisher_Publish_BrokerDown(t *testing.T) {
+	// Arrange
+	broker := eventsfake.NewBroker(t)
+	publisher, err := DialNATS(context.Background(), broker.Addr(), "test")
+	if err != nil {
+		t.Fatalf("failed to connect to fake broker: %v", err)
+	}
+	defer publisher.Close()
+	broker.Stop()
+
+	// Act
+	err = publisher.Publish(context.Background(), New(ProductCreated, "id", nil))
+
+	// Assert
+	assert.Error(t, err)
+}
@@ -0,0 +1,100 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package featureflags holds process-wide toggles for behaviors that change how existing
+// endpoints respond. Every flag is off by default, so a flag that is not enabled keeps the legacy behavior.
+//
+// Flags are loaded from the FEATURE_FLAGS environment variable on startup with [SetFromEnv]
+// and can be flipped at runtime with [Set]. Code consults them with [Enabled].
+package featureflags
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// Flag names a toggleable behavior.
+type Flag string
+
+const (
+	// StrictPagination rejects list requests with a limit outside 1 to request.MaxStrictLimit
+	// instead of accepting unlimited (-1), empty (0) or arbitrarily large pages.
+	StrictPagination Flag = "strict_pagination"
+	// OffsetOutOfRange answers list requests with an offset beyond the total with
+	// 416 Requested Range Not Satisfiable instead of an empty 200 page.
+	OffsetOutOfRange Flag = "offset_out_of_range"
+)
+
+// All lists every known flag.
+var All = []Flag{StrictPagination, OffsetOutOfRange}
+
+var enabled atomic.Pointer[map[Flag]bool]
+
+func init() {
+	enabled.Store(&map[Flag]bool{})
+}
+
+// Enabled reports whether flag is on.
+func Enabled(flag Flag) bool {
+	return (*enabled.Load())[flag]
+}
+
+// Set turns flag on or off. It is safe to call while requests are being served.
+// Returns an error for a flag not listed in [All].
+func Set(flag Flag, on bool) error {
+	if !slices.Contains(All, flag) {
+		return fmt.Errorf("unknown feature flag %q", flag)
+	}
+	for {
+		cur := enabled.Load()
+		next := maps.Clone(*cur)
+		next[flag] = on
+		if enabled.CompareAndSwap(cur, &next) {
+			return nil
+		}
+	}
+}
+
+// SetFromEnv enables the flags listed in the FEATURE_FLAGS environment variable, a comma-separated
+// list of flag names such as "strict_pagination". A name prefixed with "-" disables the flag.
+// Flags are kept as they are if the variable is unset or empty.
+// Returns an error naming the first unknown flag, no flag is changed then.
+func SetFromEnv() error {
+	v := os.Getenv("FEATURE_FLAGS")
+	if v == "" {
+		return nil
+	}
+	next := maps.Clone(*enabled.Load())
+	for _, name := range strings.Split(v, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		on := !strings.HasPrefix(name, "-")
+		flag := Flag(strings.TrimPrefix(name, "-"))
+		if !slices.Contains(All, flag) {
+			return fmt.Errorf("invalid FEATURE_FLAGS value: unknown feature flag %q", flag)
+		}
+		next[flag] = on
+	}
+	enabled.Store(&next)
+	return nil
+}
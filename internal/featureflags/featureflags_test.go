@@ -0,0 +1,72 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package featureflags
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	t.Cleanup(func() { _ = Set(StrictPagination, false) })
+
+	assert.False(t, Enabled(StrictPagination), "flags are off by default")
+
+	assert.NoError(t, Set(StrictPagination, true))
+	assert.True(t, Enabled(StrictPagination))
+
+	assert.NoError(t, Set(StrictPagination, false))
+	assert.False(t, Enabled(StrictPagination))
+
+	assert.Error(t, Set("no_such_flag", true))
+	assert.False(t, Enabled("no_such_flag"))
+}
+
+func TestSetFromEnv(t *testing.T) {
+	t.Cleanup(func() { _ = Set(StrictPagination, false) })
+
+	t.Run("enable", func(t *testing.T) {
+		t.Setenv("FEATURE_FLAGS", " strict_pagination, ")
+
+		assert.NoError(t, SetFromEnv())
+		assert.True(t, Enabled(StrictPagination))
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		t.Setenv("FEATURE_FLAGS", "-strict_pagination")
+
+		assert.NoError(t, SetFromEnv())
+		assert.False(t, Enabled(StrictPagination))
+	})
+
+	t.Run("unknown flag changes nothing", func(t *testing.T) {
+		t.Setenv("FEATURE_FLAGS", "strict_pagination,no_such_flag")
+
+		assert.ErrorContains(t, SetFromEnv(), "no_such_flag")
+		assert.False(t, Enabled(StrictPagination))
+	})
+
+	t.Run("unset keeps flags", func(t *testing.T) {
+		assert.NoError(t, Set(StrictPagination, true))
+		t.Setenv("FEATURE_FLAGS", "")
+
+		assert.NoError(t, SetFromEnv())
+		assert.True(t, Enabled(StrictPagination))
+	})
+}
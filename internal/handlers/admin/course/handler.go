@@ -24,9 +24,11 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
 	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
 	courseservice "github.com/mikhail5545/product-service-go/internal/services/course"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 // Handler holds the course service to handle HTTP requests.
@@ -43,26 +45,28 @@ func New(s courseservice.Service) *Handler {
 //
 //	h.ServeError(http.StatusBadRequest, "Invalid request payload.")
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 // HandleServiceError handles course service errors and populates
 // error response based on error type.
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, courseservice.ErrNotFound) || errors.Is(err, courseservice.ErrImageNotFoundOnOwner) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, courseservice.ErrInvalidArgument) || errors.Is(err, courseservice.ErrImageLimitExceeded) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, courseservice.ErrHasDependents) || errors.Is(err, courseservice.ErrCannotPublishFree) {
+		return response.Write(c, http.StatusConflict, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 // Get handles the retrieval of a single published course by its ID.
 // @Summary Get a course by ID
 // @Description Retrieves details for a specific course.
-// @Success 200 {object} map[string]any{course_details=course.CourseDetails}
+// @Success 200 {object} map[string]any{course_details=dto.Course}
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -70,15 +74,15 @@ func (h *Handler) Get(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"course_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"course_details": dto.NewCourse(details)})
 }
 
 // GetWithDeleted handles the retrieval of a course by its ID, including soft-deleted ones.
 // @Summary Get a course by ID (including deleted)
 // @Description Retrieves details for a specific course, even if it has been soft-deleted.
-// @Success 200 {object} map[string]any{course_details=course.CourseDetails}
+// @Success 200 {object} map[string]any{course_details=dto.Course}
 func (h *Handler) GetWithDeleted(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -86,15 +90,15 @@ func (h *Handler) GetWithDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"course_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"course_details": dto.NewCourse(details)})
 }
 
 // GetWithUnpublished handles the retrieval of a course by its ID, including unpublished ones.
 // @Summary Get a course by ID (including unpublished)
 // @Description Retrieves details for a specific course, even if it is not published.
-// @Success 200 {object} map[string]any{course_details=course.CourseDetails}
+// @Success 200 {object} map[string]any{course_details=dto.Course}
 func (h *Handler) GetWithUnpublished(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -102,13 +106,13 @@ func (h *Handler) GetWithUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"course_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"course_details": dto.NewCourse(details)})
 }
 
 // List handles the retrieval of a paginated list of published courses.
 // @Summary List published courses
 // @Description Retrieves a paginated list of courses that are currently published.
-// @Success 200 {object} map[string]any{course_details=[]course.CourseDetails, total=int64}
+// @Success 200 {object} map[string]any{course_details=[]dto.Course, total=int64}
 func (h *Handler) List(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -118,16 +122,13 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"course_details": details,
-		"total":          total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"course_details": dto.NewCourses(details)}, total, limit, offset)
 }
 
 // ListDeleted handles the retrieval of a paginated list of soft-deleted courses.
 // @Summary List soft-deleted courses
 // @Description Retrieves a paginated list of courses that have been soft-deleted.
-// @Success 200 {object} map[string]any{course_details=[]course.CourseDetails, total=int64}
+// @Success 200 {object} map[string]any{course_details=[]dto.Course, total=int64}
 func (h *Handler) ListDeleted(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -137,16 +138,13 @@ func (h *Handler) ListDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"course_details": details,
-		"total":          total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"course_details": dto.NewCourses(details)}, total, limit, offset)
 }
 
 // ListUnpublished handles the retrieval of a paginated list of unpublished courses.
 // @Summary List unpublished courses
 // @Description Retrieves a paginated list of courses that are not currently published.
-// @Success 200 {object} map[string]any{course_details=[]course.CourseDetails, total=int64}
+// @Success 200 {object} map[string]any{course_details=[]dto.Course, total=int64}
 func (h *Handler) ListUnpublished(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -156,10 +154,7 @@ func (h *Handler) ListUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"course_details": details,
-		"total":          total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"course_details": dto.NewCourses(details)}, total, limit, offset)
 }
 
 // Create handles the creation of a new course and its associated product.
@@ -175,7 +170,20 @@ func (h *Handler) Create(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusCreated, map[string]any{"response": resp})
+	return response.Write(c, http.StatusCreated, map[string]any{"response": resp})
+}
+
+// Validate checks a course create request the way Create does, without creating anything.
+// @Summary Validate a course create request
+// @Description Runs the create request validation only. Field errors are listed under "errors".
+// @Success 200 {object} map[string]any{valid=bool,errors=[]response.FieldError}
+func (h *Handler) Validate(c echo.Context) error {
+	req := new(coursemodel.CreateRequest)
+	if err := request.BindAndValidateJSON(c, req); err != nil {
+		return err
+	}
+	req.Sanitize()
+	return response.WriteValidation(c, req.Validate())
 }
 
 // Update handles the partial update of an existing course and its product.
@@ -183,7 +191,7 @@ func (h *Handler) Create(c echo.Context) error {
 // @Description Updates a course's details. Only the provided fields will be updated.
 // @Success 202 {object} map[string]any{updates=course.UpdateResponse}
 func (h *Handler) Update(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -197,22 +205,29 @@ func (h *Handler) Update(c echo.Context) error {
 		return h.HandleServiceError(c, err)
 	}
 
-	return c.JSON(http.StatusAccepted, map[string]any{"updates": updates})
+	return response.Write(c, http.StatusAccepted, map[string]any{"updates": updates})
 }
 
 // Delete handles the soft-deletion of a course.
 // @Summary Soft-delete a course
-// @Description Soft-deletes a course and its associated product. The course is also unpublished.
-// @Success 204 "No Content"
+// @Description Soft-deletes a course, its associated product and its course parts. The course is also unpublished.
+// @Description An optional reason can be passed in the request body and is kept on the course record.
+// @Param request body object{reason=string} false "Deletion reason"
+// @Success 200 {object} map[string]any{parts_affected=int}
 func (h *Handler) Delete(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
+	if err != nil {
+		return err
+	}
+	reason, err := request.GetReason(c)
 	if err != nil {
 		return err
 	}
-	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+	partsAffected, err := h.service.Delete(c.Request().Context(), id, reason)
+	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.NoContent(http.StatusNoContent)
+	return response.Write(c, http.StatusOK, map[string]any{"parts_affected": partsAffected})
 }
 
 // DeletePermanent handles the permanent deletion of a course.
@@ -220,11 +235,15 @@ func (h *Handler) Delete(c echo.Context) error {
 // @Description Permanently deletes a course and its associated product from the database. This action is irreversible.
 // @Success 204 "No Content"
 func (h *Handler) DeletePermanent(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
-	if err := h.service.DeletePermanent(c.Request().Context(), id); err != nil {
+	force, err := request.GetBoolQueryParam(c, "force", false)
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeletePermanent(c.Request().Context(), id, force); err != nil {
 		return h.HandleServiceError(c, err)
 	}
 	return c.NoContent(http.StatusNoContent)
@@ -232,17 +251,18 @@ func (h *Handler) DeletePermanent(c echo.Context) error {
 
 // Restore handles the restoration of a soft-deleted course.
 // @Summary Restore a soft-deleted course
-// @Description Restores a soft-deleted course and its associated product. The course will be in an unpublished state after restoration.
-// @Success 202 "Accepted"
+// @Description Restores a soft-deleted course, its associated product and the course parts deleted with it. The course will be in an unpublished state after restoration.
+// @Success 202 {object} map[string]any{parts_affected=int}
 func (h *Handler) Restore(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
-	if err := h.service.Restore(c.Request().Context(), id); err != nil {
+	partsAffected, err := h.service.Restore(c.Request().Context(), id)
+	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.NoContent(http.StatusAccepted)
+	return response.Write(c, http.StatusAccepted, map[string]any{"parts_affected": partsAffected})
 }
 
 // Publish handles the publishing of a course.
@@ -250,7 +270,7 @@ func (h *Handler) Restore(c echo.Context) error {
 // @Description Publishes a course and its associated product, making them available.
 // @Success 202 "Accepted"
 func (h *Handler) Publish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -265,7 +285,7 @@ func (h *Handler) Publish(c echo.Context) error {
 // @Description Unpublishes a course, its product, and all its parts.
 // @Success 202 "Accepted"
 func (h *Handler) Unpublish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course ID")
 	if err != nil {
 		return err
 	}
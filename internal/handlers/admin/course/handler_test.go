@@ -20,16 +20,19 @@ package course
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	course "github.com/mikhail5545/product-service-go/internal/models/course"
 	courseservice "github.com/mikhail5545/product-service-go/internal/services/course"
 	coursemock "github.com/mikhail5545/product-service-go/internal/test/services/course_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -59,7 +62,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Get(gomock.Any(), courseID).Return(mockCourseDetails, nil)
@@ -70,7 +73,7 @@ func TestHandler_Get(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"course_details": mockCourseDetails}
+		expectedResp := map[string]any{"course_details": mockCourseDetails, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -81,7 +84,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Get(gomock.Any(), courseID).Return(nil, courseservice.ErrNotFound)
@@ -100,7 +103,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Get(gomock.Any(), courseID).Return(nil, courseservice.ErrInvalidArgument)
@@ -119,7 +122,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -159,7 +162,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), courseID).Return(mockCourseDetails, nil)
@@ -170,7 +173,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"course_details": mockCourseDetails}
+		expectedResp := map[string]any{"course_details": mockCourseDetails, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -181,7 +184,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), courseID).Return(nil, courseservice.ErrNotFound)
@@ -200,7 +203,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -240,7 +243,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), courseID).Return(mockCourseDetails, nil)
@@ -251,7 +254,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"course_details": mockCourseDetails}
+		expectedResp := map[string]any{"course_details": mockCourseDetails, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -262,7 +265,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), courseID).Return(nil, courseservice.ErrNotFound)
@@ -281,7 +284,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -342,7 +345,7 @@ func TestHandler_List(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"course_details": mockCourseDetails, "total": 2}
+		expectedResp := map[string]any{"course_details": mockCourseDetails, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -427,7 +430,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"course_details": mockCourseDetails, "total": 2}
+		expectedResp := map[string]any{"course_details": mockCourseDetails, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -512,7 +515,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"course_details": mockCourseDetails, "total": 2}
+		expectedResp := map[string]any{"course_details": mockCourseDetails, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -585,7 +588,7 @@ func TestHandler_Create(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusCreated, rec.Code)
-		expectedResp := map[string]any{"response": createResp}
+		expectedResp := map[string]any{"response": createResp, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -649,7 +652,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Publish(gomock.Any(), courseID).Return(nil)
@@ -668,7 +671,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -687,7 +690,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Publish(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
@@ -716,7 +719,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), courseID).Return(nil)
@@ -735,7 +738,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -754,7 +757,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
@@ -791,7 +794,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		updateReq.ID = courseID
@@ -802,7 +805,7 @@ func TestHandler_Update(t *testing.T) {
 		err := handler.Update(c)
 
 		// Assert
-		expectedResp := map[string]any{"updates": updates}
+		expectedResp := map[string]any{"updates": updates, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusAccepted, rec.Code)
@@ -823,7 +826,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -849,7 +852,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
 		updateReq.ID = courseID
@@ -879,17 +882,58 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
-		mockService.EXPECT().Delete(gomock.Any(), courseID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), courseID, "").Return(int64(3), nil)
 
 		// Act
 		err := handler.Delete(c)
 
 		// Assert
 		assert.NoError(t, err)
-		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, fmt.Sprintf(`{"parts_affected":3,"api_version":%q}`, response.APIVersion), rec.Body.String())
+	})
+
+	t.Run("success with reason", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/", strings.NewReader(`{"reason":"duplicate listing"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(courseID)
+
+		mockService.EXPECT().Delete(gomock.Any(), courseID, "duplicate listing").Return(int64(0), nil)
+
+		// Act
+		err := handler.Delete(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("invalid reason payload", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/", strings.NewReader(`{"reason":42}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(courseID)
+
+		// Act
+		err := handler.Delete(c)
+
+		// Assert
+		if assert.Error(t, err) {
+			e.HTTPErrorHandler(err, c)
+		}
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 
 	t.Run("invalid id", func(t *testing.T) {
@@ -898,7 +942,7 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -917,10 +961,10 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
-		mockService.EXPECT().Delete(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), courseID, "").Return(int64(0), courseservice.ErrNotFound)
 
 		// Act
 		err := handler.Delete(c)
@@ -946,10 +990,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID, false).Return(nil)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -965,7 +1009,7 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -984,10 +1028,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID, false).Return(courseservice.ErrNotFound)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -1013,10 +1057,10 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
-		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(nil)
+		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(int64(0), nil)
 
 		// Act
 		err := handler.Restore(c)
@@ -1024,6 +1068,7 @@ func TestHandler_Restore(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusAccepted, rec.Code)
+		assert.JSONEq(t, fmt.Sprintf(`{"parts_affected":0,"api_version":%q}`, response.APIVersion), rec.Body.String())
 	})
 
 	t.Run("invalid id", func(t *testing.T) {
@@ -1032,7 +1077,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1051,10 +1096,10 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(courseID)
 
-		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
+		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(int64(0), courseservice.ErrNotFound)
 
 		// Act
 		err := handler.Restore(c)
@@ -1064,3 +1109,41 @@ func TestHandler_Restore(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+func TestHandler_Get_Timestamps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := coursemock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	id := uuid.New().String()
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 3, 2, 11, 30, 0, 0, time.UTC)
+	mockDetails := &course.CourseDetails{
+		Course: &course.Course{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt},
+	}
+
+	// Arrange
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+
+	mockService.EXPECT().Get(gomock.Any(), id).Return(mockDetails, nil)
+
+	// Act
+	err := handler.Get(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Details map[string]any `json:"course_details"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, createdAt.Format(time.RFC3339Nano), resp.Details["created_at"])
+	assert.Equal(t, updatedAt.Format(time.RFC3339Nano), resp.Details["updated_at"])
+}
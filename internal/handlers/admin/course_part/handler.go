@@ -22,9 +22,11 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
 	coursepartmodel "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	coursepart "github.com/mikhail5545/product-service-go/internal/services/course_part"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 type Handler struct {
@@ -39,18 +41,18 @@ func New(s coursepart.Service) *Handler {
 //
 //	h.ServeError(http.StatusBadRequest, "Invalid request payload.")
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 // HandleServiceError handles course service errors and populates
 // error response based on error type.
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
-	if errors.Is(err, coursepart.ErrNotFound) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	if errors.Is(err, coursepart.ErrNotFound) || errors.Is(err, coursepart.ErrProductNotFound) {
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, coursepart.ErrInvalidArgument) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 // Get handles the retrieval of a single published course_part by its ID.
@@ -58,12 +60,12 @@ func (h *Handler) HandleServiceError(c echo.Context, err error) error {
 // @Description Retrieves details for a specific course_part.
 // @Tags admin-course-parts
 // @Param id path string true "Course Part ID"
-// @Success 200 {object} map[string]any{course_part=coursepartmodel.CoursePart}
+// @Success 200 {object} map[string]any{course_part=dto.CoursePart}
 // @Failure 400 {object} map[string]string{error=string} "Invalid course part ID"
-// @Failure 404 {object} map[string]string{error=string} "Course part not found"
+// @Failure 404 {object} map[string]string{error=string} "Course part or its product not found"
 // @Router /admin/course-parts/{id} [get]
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -71,7 +73,7 @@ func (h *Handler) Get(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"course_part": part})
+	return response.Write(c, http.StatusOK, map[string]any{"course_part": dto.NewCoursePart(part)})
 }
 
 // GetWithDeleted handles the retrieval of a course_part by its ID, including soft-deleted ones.
@@ -79,12 +81,12 @@ func (h *Handler) Get(c echo.Context) error {
 // @Description Retrieves details for a specific course_part, even if it has been soft-deleted.
 // @Tags admin-course-parts
 // @Param id path string true "Course Part ID"
-// @Success 200 {object} map[string]any{course_part=coursepartmodel.CoursePart}
+// @Success 200 {object} map[string]any{course_part=dto.CoursePart}
 // @Failure 400 {object} map[string]string{error=string} "Invalid course part ID"
 // @Failure 404 {object} map[string]string{error=string} "Course part not found"
 // @Router /admin/course-parts/deleted/{id} [get]
 func (h *Handler) GetWithDeleted(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -92,7 +94,7 @@ func (h *Handler) GetWithDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"course_part": part})
+	return response.Write(c, http.StatusOK, map[string]any{"course_part": dto.NewCoursePartFromModel(part)})
 }
 
 // GetWithUnpublished handles the retrieval of a course_part by its ID, including unpublished ones.
@@ -100,12 +102,12 @@ func (h *Handler) GetWithDeleted(c echo.Context) error {
 // @Description Retrieves details for a specific course_part, even if it is not published.
 // @Tags admin-course-parts
 // @Param id path string true "Course Part ID"
-// @Success 200 {object} map[string]any{course_part=coursepartmodel.CoursePart}
+// @Success 200 {object} map[string]any{course_part=dto.CoursePart}
 // @Failure 400 {object} map[string]string{error=string} "Invalid course part ID"
 // @Failure 404 {object} map[string]string{error=string} "Course part not found"
 // @Router /admin/course-parts/unpublished/{id} [get]
 func (h *Handler) GetWithUnpublished(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -113,7 +115,7 @@ func (h *Handler) GetWithUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"course_part": part})
+	return response.Write(c, http.StatusOK, map[string]any{"course_part": dto.NewCoursePartFromModel(part)})
 }
 
 // List handles the retrieval of a paginated list of published course_parts.
@@ -123,11 +125,11 @@ func (h *Handler) GetWithUnpublished(c echo.Context) error {
 // @Param cid path string true "Course ID"
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
-// @Success 200 {object} map[string]any{course_parts=[]coursepartmodel.CoursePart, total=int64}
+// @Success 200 {object} map[string]any{course_parts=[]dto.CoursePart, total=int64}
 // @Failure 400 {object} map[string]string{error=string} "Invalid course ID"
 // @Router /admin/courses/{cid}/parts [get]
 func (h *Handler) List(c echo.Context) error {
-	cid, err := request.GetIDParam(c, ":cid", "Invalid course ID")
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -139,10 +141,7 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"course_parts": parts,
-		"total":        total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"course_parts": dto.NewCourseParts(parts)}, total, limit, offset)
 }
 
 // ListDeleted handles the retrieval of a paginated list of soft-deleted course_parts.
@@ -152,11 +151,11 @@ func (h *Handler) List(c echo.Context) error {
 // @Param cid path string true "Course ID"
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
-// @Success 200 {object} map[string]any{course_parts=[]coursepartmodel.CoursePart, total=int64}
+// @Success 200 {object} map[string]any{course_parts=[]dto.CoursePart, total=int64}
 // @Failure 400 {object} map[string]string{error=string} "Invalid course ID"
 // @Router /admin/courses/{cid}/parts/deleted [get]
 func (h *Handler) ListDeleted(c echo.Context) error {
-	cid, err := request.GetIDParam(c, ":cid", "Invalid course ID")
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -168,10 +167,7 @@ func (h *Handler) ListDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"course_parts": parts,
-		"total":        total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"course_parts": dto.NewCoursePartsFromModels(parts)}, total, limit, offset)
 }
 
 // ListUnpublished handles the retrieval of a paginated list of unpublished course_parts.
@@ -181,11 +177,11 @@ func (h *Handler) ListDeleted(c echo.Context) error {
 // @Param cid path string true "Course ID"
 // @Param limit query int false "Limit"
 // @Param offset query int false "Offset"
-// @Success 200 {object} map[string]any{course_parts=[]coursepartmodel.CoursePart, total=int64}
+// @Success 200 {object} map[string]any{course_parts=[]dto.CoursePart, total=int64}
 // @Failure 400 {object} map[string]string{error=string} "Invalid course ID"
 // @Router /admin/courses/{cid}/parts/unpublished [get]
 func (h *Handler) ListUnpublished(c echo.Context) error {
-	cid, err := request.GetIDParam(c, ":cid", "Invalid course ID")
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -197,10 +193,7 @@ func (h *Handler) ListUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"course_parts": parts,
-		"total":        total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"course_parts": dto.NewCoursePartsFromModels(parts)}, total, limit, offset)
 }
 
 // Create handles the creation of a new course_part and its associated product.
@@ -215,7 +208,7 @@ func (h *Handler) ListUnpublished(c echo.Context) error {
 // @Failure 404 {object} map[string]string{error=string} "Course not found"
 // @Router /admin/courses/{cid}/parts [post]
 func (h *Handler) Create(c echo.Context) error {
-	cid, err := request.GetIDParam(c, ":cid", "Invalid course ID")
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -229,7 +222,101 @@ func (h *Handler) Create(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusCreated, map[string]any{"response": resp})
+	return response.Write(c, http.StatusCreated, map[string]any{"response": resp})
+}
+
+// CreateBatch handles the creation of multiple course_parts of a course in one transaction.
+// @Summary Create multiple course_parts
+// @Description Creates course_parts for a given course, numbered in the given order after its last part. The course_parts are created in an unpublished state. Either all of them are created or none.
+// @Tags admin-course-parts
+// @Accept json
+// @Param cid path string true "Course ID"
+// @Param course_parts body coursepartmodel.CreateBatchRequest true "Course Part Create Batch Request"
+// @Success 201 "Created" {object} map[string]any{responses=[]coursepartmodel.CreateResponse}
+// @Failure 400 {object} map[string]string{error=string} "Invalid request payload or ID"
+// @Failure 404 {object} map[string]string{error=string} "Course not found"
+// @Router /admin/courses/{cid}/parts/batch [post]
+func (h *Handler) CreateBatch(c echo.Context) error {
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
+	if err != nil {
+		return err
+	}
+	var req coursepartmodel.CreateBatchRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	resps, err := h.service.CreateBatch(c.Request().Context(), cid, req.Parts)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusCreated, map[string]any{"responses": resps})
+}
+
+// NextPosition handles the retrieval of the number a new course_part of a course should get.
+// @Summary Get the next course_part number of a course
+// @Description Returns one more than the highest number among published and unpublished course_parts of the course, or 1 if it has none.
+// @Tags admin-course-parts
+// @Param cid path string true "Course ID"
+// @Success 200 {object} map[string]any{next_position=int}
+// @Failure 400 {object} map[string]string{error=string} "Invalid course ID"
+// @Failure 404 {object} map[string]string{error=string} "Course not found"
+// @Router /admin/courses/{cid}/parts/next-position [get]
+func (h *Handler) NextPosition(c echo.Context) error {
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
+	if err != nil {
+		return err
+	}
+	next, err := h.service.NextPosition(c.Request().Context(), cid)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"next_position": next})
+}
+
+// Resequence handles renumbering the course_parts of a course to 1..N in their current order.
+// @Summary Resequence course_parts of a course
+// @Description Maintenance endpoint that closes gaps and resolves duplicates in course_part numbers, keeping their order.
+// @Tags admin-course-parts
+// @Param cid path string true "Course ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string{error=string} "Invalid course ID"
+// @Failure 404 {object} map[string]string{error=string} "Course not found"
+// @Failure 500 {object} map[string]string{error=string} "Internal server error"
+// @Router /admin/courses/{cid}/parts/resequence [post]
+func (h *Handler) Resequence(c echo.Context) error {
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
+	if err != nil {
+		return err
+	}
+	if err := h.service.Resequence(c.Request().Context(), cid); err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Validate checks a course_part create request the way Create does, without creating anything.
+// The parent course is not looked up.
+// @Summary Validate a course_part create request
+// @Description Runs the create request validation only. Field errors are listed under "errors".
+// @Tags admin-course-parts
+// @Accept json
+// @Param cid path string true "Course ID"
+// @Param course_part body coursepartmodel.CreateRequest true "Course Part Create Request"
+// @Success 200 {object} map[string]any{valid=bool,errors=[]response.FieldError}
+// @Failure 400 {object} map[string]string{error=string} "Invalid request payload or ID"
+// @Router /admin/courses/{cid}/parts/validate [post]
+func (h *Handler) Validate(c echo.Context) error {
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
+	if err != nil {
+		return err
+	}
+	req := new(coursepartmodel.CreateRequest)
+	if err := request.BindAndValidateJSON(c, req); err != nil {
+		return err
+	}
+	req.CourseID = cid
+	req.Sanitize()
+	return response.WriteValidation(c, req.Validate())
 }
 
 // Publish handles the publishing of a course_part.
@@ -243,7 +330,7 @@ func (h *Handler) Create(c echo.Context) error {
 // @Failure 500 {object} map[string]string{error=string} "Internal server error"
 // @Router /admin/course-parts/publish/{id} [post]
 func (h *Handler) Publish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -264,7 +351,7 @@ func (h *Handler) Publish(c echo.Context) error {
 // @Failure 404 {object} map[string]string{error=string} "Course part not found"
 // @Router /admin/course-parts/unpublish/{id} [post]
 func (h *Handler) Unpublish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -286,7 +373,7 @@ func (h *Handler) Unpublish(c echo.Context) error {
 // @Failure 400 {object} map[string]string{error=string} "Invalid request payload or ID"
 // @Router /admin/course-parts/{id} [patch]
 func (h *Handler) Update(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -300,7 +387,7 @@ func (h *Handler) Update(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusAccepted, map[string]any{"updates": updates})
+	return response.Write(c, http.StatusAccepted, map[string]any{"updates": updates})
 }
 
 // Delete handles the soft-deletion of a course_part.
@@ -308,16 +395,21 @@ func (h *Handler) Update(c echo.Context) error {
 // @Description Soft-deletes a course_part. The course_part is also unpublished.
 // @Tags admin-course-parts
 // @Param id path string true "Course Part ID"
+// @Param request body object{reason=string} false "Deletion reason"
 // @Success 204 "No Content"
 // @Failure 400 {object} map[string]string{error=string} "Invalid course part ID"
 // @Failure 404 {object} map[string]string{error=string} "Course part not found"
 // @Router /admin/course-parts/{id} [delete]
 func (h *Handler) Delete(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
+	if err != nil {
+		return err
+	}
+	reason, err := request.GetReason(c)
 	if err != nil {
 		return err
 	}
-	err = h.service.Delete(c.Request().Context(), id)
+	err = h.service.Delete(c.Request().Context(), id, reason)
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
@@ -334,7 +426,7 @@ func (h *Handler) Delete(c echo.Context) error {
 // @Failure 404 {object} map[string]string{error=string} "Course part not found"
 // @Router /admin/course-parts/permanent/{id} [delete]
 func (h *Handler) DeletePermanent(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -355,7 +447,7 @@ func (h *Handler) DeletePermanent(c echo.Context) error {
 // @Failure 404 {object} map[string]string{error=string} "Course part not found"
 // @Router /admin/course-parts/restore/{id} [post]
 func (h *Handler) Restore(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
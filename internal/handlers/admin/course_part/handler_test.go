@@ -30,6 +30,7 @@ import (
 	coursepart "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	coursepartservice "github.com/mikhail5545/product-service-go/internal/services/course_part"
 	coursepartmock "github.com/mikhail5545/product-service-go/internal/test/services/course_part_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -49,18 +50,22 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
-		mockPart := &coursepart.CoursePart{
-			ID:   partID,
-			Name: "Test Part",
+		mockPart := &coursepart.CoursePartDetails{
+			CoursePart: &coursepart.CoursePart{
+				ID:   partID,
+				Name: "Test Part",
+			},
 		}
 
-		expectedPart := &coursepart.CoursePart{
-			ID:        partID,
-			Name:      "Test Part",
-			Published: false,
+		expectedPart := &coursepart.CoursePartDetails{
+			CoursePart: &coursepart.CoursePart{
+				ID:        partID,
+				Name:      "Test Part",
+				Published: false,
+			},
 		}
 
 		mockService.EXPECT().Get(gomock.Any(), partID).Return(mockPart, nil)
@@ -72,7 +77,7 @@ func TestHandler_Get(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		expectedResponse := map[string]any{"course_part": expectedPart}
+		expectedResponse := map[string]any{"course_part": expectedPart, "api_version": response.APIVersion}
 
 		expectedJSON, err := json.Marshal(expectedResponse)
 		if err != nil {
@@ -87,7 +92,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Get(gomock.Any(), partID).Return(nil, coursepartservice.ErrNotFound)
@@ -106,7 +111,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -136,7 +141,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockPart := &coursepart.CoursePart{
@@ -159,7 +164,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		expectedResponse := map[string]any{"course_part": expectedPart}
+		expectedResponse := map[string]any{"course_part": expectedPart, "api_version": response.APIVersion}
 
 		expectedJSON, err := json.Marshal(expectedResponse)
 		if err != nil {
@@ -174,7 +179,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), partID).Return(nil, coursepartservice.ErrNotFound)
@@ -193,7 +198,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -223,7 +228,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockPart := &coursepart.CoursePart{
@@ -246,7 +251,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 
-		expectedResponse := map[string]any{"course_part": expectedPart}
+		expectedResponse := map[string]any{"course_part": expectedPart, "api_version": response.APIVersion}
 
 		expectedJSON, err := json.Marshal(expectedResponse)
 		if err != nil {
@@ -261,7 +266,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), partID).Return(nil, coursepartservice.ErrNotFound)
@@ -280,7 +285,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -306,16 +311,23 @@ func TestHandler_List(t *testing.T) {
 	partID_2 := uuid.New().String()
 	courseID := uuid.New().String()
 
-	mockParts := []coursepart.CoursePart{
+	mockParts := []coursepart.CoursePartDetails{
 		{
-			ID:               partID_1,
-			Name:             "Course part name 1",
-			ShortDescription: "Course part name 2",
+			CoursePart: &coursepart.CoursePart{
+				ID:               partID_1,
+				Name:             "Course part name 1",
+				ShortDescription: "Course part name 2",
+			},
 		},
 		{
-			ID:               partID_2,
-			Name:             "Course part name 2",
-			ShortDescription: "Course part name 2",
+			CoursePart: &coursepart.CoursePart{
+				ID:               partID_2,
+				Name:             "Course part name 2",
+				ShortDescription: "Course part name 2",
+				Sellable:         true,
+			},
+			Price:     25,
+			ProductID: uuid.New().String(),
 		},
 	}
 
@@ -325,7 +337,7 @@ func TestHandler_List(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/?limit=2&offset=0", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().List(gomock.Any(), courseID, 2, 0).Return(mockParts, int64(2), nil)
@@ -334,7 +346,7 @@ func TestHandler_List(t *testing.T) {
 		err := handler.List(c)
 
 		// Assert
-		expectedResp := map[string]any{"course_parts": mockParts, "total": 2}
+		expectedResp := map[string]any{"course_parts": mockParts, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 		expectedJSON, err := json.Marshal(expectedResp)
@@ -347,7 +359,7 @@ func TestHandler_List(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/?limit=2&offset=0", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().List(gomock.Any(), courseID, 2, 0).Return(nil, int64(0), coursepartservice.ErrNotFound)
@@ -408,7 +420,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/?limit=2&offset=0", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().ListDeleted(gomock.Any(), courseID, 2, 0).Return(mockParts, int64(2), nil)
@@ -417,7 +429,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		err := handler.ListDeleted(c)
 
 		// Assert
-		expectedResp := map[string]any{"course_parts": mockParts, "total": 2}
+		expectedResp := map[string]any{"course_parts": mockParts, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 		expectedJSON, err := json.Marshal(expectedResp)
@@ -430,7 +442,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/?limit=2&offset=0", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().ListDeleted(gomock.Any(), courseID, 2, 0).Return(nil, int64(0), coursepartservice.ErrNotFound)
@@ -491,7 +503,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/?limit=2&offset=0", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().ListUnpublished(gomock.Any(), courseID, 2, 0).Return(mockParts, int64(2), nil)
@@ -500,7 +512,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		err := handler.ListUnpublished(c)
 
 		// Assert
-		expectedResp := map[string]any{"course_parts": mockParts, "total": 2}
+		expectedResp := map[string]any{"course_parts": mockParts, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
 		expectedJSON, err := json.Marshal(expectedResp)
@@ -513,7 +525,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/?limit=2&offset=0", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().ListUnpublished(gomock.Any(), courseID, 2, 0).Return(nil, int64(0), coursepartservice.ErrNotFound)
@@ -567,7 +579,7 @@ func TestHandler_Create(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		// The handler will modify the request object, so we need to match it.
@@ -587,7 +599,7 @@ func TestHandler_Create(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusCreated, rec.Code)
-		expectedJSON := `{"response":{"id":"p17081f3-4a56-4d00-b63e-f942537a702f","course_id":"c17081f3-4a56-4d00-b63e-f942537a702f"}}`
+		expectedJSON := `{"response":{"id":"p17081f3-4a56-4d00-b63e-f942537a702f","course_id":"c17081f3-4a56-4d00-b63e-f942537a702f"},"api_version":"v0"}`
 		assert.JSONEq(t, expectedJSON, rec.Body.String())
 	})
 
@@ -598,7 +610,7 @@ func TestHandler_Create(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		// Act
@@ -623,7 +635,7 @@ func TestHandler_Create(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, coursepartservice.ErrNotFound)
@@ -649,7 +661,7 @@ func TestHandler_Create(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":cid")
+		c.SetParamNames("cid")
 		c.SetParamValues(courseID)
 
 		mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, coursepartservice.ErrNotFound)
@@ -663,6 +675,166 @@ func TestHandler_Create(t *testing.T) {
 	})
 }
 
+func TestHandler_CreateBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := coursepartmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	courseID := "c17081f3-4a56-4d00-b63e-f942537a702f"
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("cid")
+		c.SetParamValues(courseID)
+		return c, rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"parts":[{"name":"First Part","short_description":"A new part"},{"name":"Second Part","short_description":"A new part"}]}`)
+		expectedParts := []*coursepart.CreateRequest{
+			{Name: "First Part", ShortDescription: "A new part"},
+			{Name: "Second Part", ShortDescription: "A new part"},
+		}
+		mockService.EXPECT().CreateBatch(gomock.Any(), courseID, expectedParts).Return([]*coursepart.CreateResponse{
+			{ID: "p17081f3-4a56-4d00-b63e-f942537a702f", CourseID: courseID},
+			{ID: "p27081f3-4a56-4d00-b63e-f942537a702f", CourseID: courseID},
+		}, nil)
+
+		// Act
+		err := handler.CreateBatch(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		expectedJSON := `{"responses":[{"id":"p17081f3-4a56-4d00-b63e-f942537a702f","course_id":"c17081f3-4a56-4d00-b63e-f942537a702f"},{"id":"p27081f3-4a56-4d00-b63e-f942537a702f","course_id":"c17081f3-4a56-4d00-b63e-f942537a702f"}],"api_version":"v0"}`
+		assert.JSONEq(t, expectedJSON, rec.Body.String())
+	})
+
+	t.Run("bind error", func(t *testing.T) {
+		// Arrange
+		c, _ := newContext(`{"parts": [bad json`)
+
+		// Act
+		err := handler.CreateBatch(c)
+
+		// Assert
+		var httpErr *echo.HTTPError
+		if assert.ErrorAs(t, err, &httpErr) {
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		}
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"parts":[{"name":"First Part","short_description":"A new part"}]}`)
+		mockService.EXPECT().CreateBatch(gomock.Any(), courseID, gomock.Any()).Return(nil, coursepartservice.ErrNotFound)
+
+		// Act
+		err := handler.CreateBatch(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestHandler_NextPosition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := coursepartmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	courseID := "c17081f3-4a56-4d00-b63e-f942537a702f"
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("cid")
+		c.SetParamValues(courseID)
+		return c, rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext()
+		mockService.EXPECT().NextPosition(gomock.Any(), courseID).Return(4, nil)
+
+		// Act
+		err := handler.NextPosition(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"next_position":4,"api_version":"v0"}`, rec.Body.String())
+	})
+
+	t.Run("course not found", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext()
+		mockService.EXPECT().NextPosition(gomock.Any(), courseID).Return(0, coursepartservice.ErrNotFound)
+
+		// Act
+		err := handler.NextPosition(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestHandler_Resequence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := coursepartmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	courseID := "c17081f3-4a56-4d00-b63e-f942537a702f"
+	newContext := func() (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("cid")
+		c.SetParamValues(courseID)
+		return c, rec
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext()
+		mockService.EXPECT().Resequence(gomock.Any(), courseID).Return(nil)
+
+		// Act
+		err := handler.Resequence(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("course not found", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext()
+		mockService.EXPECT().Resequence(gomock.Any(), courseID).Return(coursepartservice.ErrNotFound)
+
+		// Act
+		err := handler.Resequence(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
 func TestHandler_Delete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -678,10 +850,10 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
-		mockService.EXPECT().Delete(gomock.Any(), partID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), partID, "").Return(nil)
 
 		// Act
 		err := handler.Delete(c)
@@ -697,10 +869,10 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
-		mockService.EXPECT().Delete(gomock.Any(), partID).Return(coursepartservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), partID, "").Return(coursepartservice.ErrNotFound)
 
 		// Act
 		err := handler.Delete(c)
@@ -718,7 +890,7 @@ func TestHandler_Delete(t *testing.T) {
 		c := e.NewContext(req, rec)
 		// No param set
 
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 		// Act
 		err := handler.Delete(c)
@@ -747,7 +919,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Publish(gomock.Any(), partID).Return(nil)
@@ -766,7 +938,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Publish(gomock.Any(), partID).Return(coursepartservice.ErrNotFound)
@@ -785,7 +957,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 		// Act
 		err := handler.Publish(c)
@@ -814,7 +986,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), partID).Return(nil)
@@ -833,7 +1005,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), partID).Return(coursepartservice.ErrNotFound)
@@ -852,7 +1024,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 		// Act
 		err := handler.Unpublish(c)
@@ -889,7 +1061,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		updates := map[string]any{"name": newName, "short_description": newShortDescription}
@@ -899,7 +1071,7 @@ func TestHandler_Update(t *testing.T) {
 		err := handler.Update(c)
 
 		// Assert
-		expectedResp := map[string]any{"updates": updates}
+		expectedResp := map[string]any{"updates": updates, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.NoError(t, err)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
@@ -919,7 +1091,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, coursepartservice.ErrNotFound)
@@ -939,7 +1111,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		// Act
@@ -962,7 +1134,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, coursepartservice.ErrNotFound)
@@ -1009,7 +1181,7 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().DeletePermanent(gomock.Any(), partID).Return(nil)
@@ -1028,7 +1200,7 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().DeletePermanent(gomock.Any(), partID).Return(coursepartservice.ErrNotFound)
@@ -1049,7 +1221,7 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		c := e.NewContext(req, rec)
 		// No param set
 
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 		// Act
 		err := handler.DeletePermanent(c)
@@ -1078,7 +1250,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Restore(gomock.Any(), partID).Return(nil)
@@ -1097,7 +1269,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodDelete, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(partID)
 
 		mockService.EXPECT().Restore(gomock.Any(), partID).Return(coursepartservice.ErrNotFound)
@@ -1118,7 +1290,7 @@ func TestHandler_Restore(t *testing.T) {
 		c := e.NewContext(req, rec)
 		// No param set
 
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 		// Act
 		err := handler.Restore(c)
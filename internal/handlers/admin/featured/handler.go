@@ -0,0 +1,99 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package featured
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	featuredmodel "github.com/mikhail5545/product-service-go/internal/models/featured"
+	featuredservice "github.com/mikhail5545/product-service-go/internal/services/featured"
+	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+type Handler struct {
+	service featuredservice.Service
+}
+
+func New(s featuredservice.Service) *Handler {
+	return &Handler{service: s}
+}
+
+func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
+	return response.Write(c, code, map[string]any{"error": msg})
+}
+
+func (h *Handler) HandleServiceError(c echo.Context, err error) error {
+	if errors.Is(err, featuredservice.ErrNotFound) {
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, featuredservice.ErrInvalidArgument) {
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, featuredservice.ErrAlreadyFeatured) {
+		return response.Write(c, http.StatusConflict, map[string]any{"error": err.Error()})
+	}
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+}
+
+// List serves every featured entry with the current state of its product, including
+// entries that are hidden from the public list because their product is no longer live.
+func (h *Handler) List(c echo.Context) error {
+	entries, err := h.service.ListEntries(c.Request().Context())
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"entries": entries})
+}
+
+// Add appends a product to the end of the featured list.
+func (h *Handler) Add(c echo.Context) error {
+	req := new(featuredmodel.AddRequest)
+	if err := request.BindAndValidateJSON(c, req); err != nil {
+		return err
+	}
+	entry, err := h.service.Add(c.Request().Context(), req)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusCreated, map[string]any{"featured": entry})
+}
+
+// Remove removes a product from the featured list.
+func (h *Handler) Remove(c echo.Context) error {
+	id, err := request.GetIDParam(c, "id", "Invalid product ID")
+	if err != nil {
+		return err
+	}
+	if err := h.service.Remove(c.Request().Context(), id); err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Reorder replaces the order of the featured list with the order of 'product_ids'.
+func (h *Handler) Reorder(c echo.Context) error {
+	req := new(featuredmodel.ReorderRequest)
+	if err := request.BindAndValidateJSON(c, req); err != nil {
+		return err
+	}
+	if err := h.service.Reorder(c.Request().Context(), req); err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,79 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package featured
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	featuredmodel "github.com/mikhail5545/product-service-go/internal/models/featured"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/services/featured_mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := featuredmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().ListEntries(gomock.Any()).Return([]featuredmodel.Entry{
+			{Featured: featuredmodel.Featured{ProductID: "live-id"}, State: productmodel.StateLive},
+			{Featured: featuredmodel.Featured{ProductID: "unpublished-id", Position: 1}, State: productmodel.StateUnpublished},
+		}, nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"product_id":"unpublished-id"`)
+		assert.Contains(t, rec.Body.String(), `"state":"unpublished"`)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().ListEntries(gomock.Any()).Return(nil, errors.New("database error"))
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -22,9 +22,12 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	physicalgood "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	physicalgoodservice "github.com/mikhail5545/product-service-go/internal/services/physical_good"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 type Handler struct {
@@ -39,22 +42,25 @@ func New(s physicalgoodservice.Service) *Handler {
 //
 //	h.ServeError(http.StatusBadRequest, "Invalid request payload.")
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 // HandleServiceError handles physical good service errors and populates
 // error response based on error type.
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, physicalgoodservice.ErrNotFound) || errors.Is(err, physicalgoodservice.ErrImageNotFoundOnOwner) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, physicalgoodservice.ErrInvalidArgument) || errors.Is(err, physicalgoodservice.ErrImageLimitExceeded) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, physicalgoodservice.ErrInsufficientStock) || errors.Is(err, physicalgoodservice.ErrDuplicateSKU) ||
+		errors.Is(err, physicalgoodservice.ErrHasDependents) || errors.Is(err, physicalgoodservice.ErrCannotPublishFree) {
+		return response.Write(c, http.StatusConflict, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
@@ -62,11 +68,11 @@ func (h *Handler) Get(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"physical_good_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"physical_good_details": dto.NewPhysicalGood(details)})
 }
 
 func (h *Handler) GetWithDeleted(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
@@ -74,11 +80,11 @@ func (h *Handler) GetWithDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"physical_good_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"physical_good_details": dto.NewPhysicalGood(details)})
 }
 
 func (h *Handler) GetWithUnpublished(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
@@ -86,13 +92,22 @@ func (h *Handler) GetWithUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"physical_good_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"physical_good_details": dto.NewPhysicalGood(details)})
+}
+
+// GetBySKU serves a single not soft-deleted physical good, including unpublished ones, by its external SKU.
+func (h *Handler) GetBySKU(c echo.Context) error {
+	details, err := h.service.GetBySKU(c.Request().Context(), c.Param("sku"))
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"physical_good_details": dto.NewPhysicalGood(details)})
 }
 
 // List handles the retrieval of a paginated list of published physical goods.
 // @Summary List published physical goods
 // @Description Retrieves a paginated list of physical goods that are currently published.
-// @Success 200 {object} map[string]any{physical_good_details=[]physicalgood.PhysicalGoodDetails, total=int64}
+// @Success 200 {object} map[string]any{physical_good_details=[]dto.PhysicalGood, total=int64}
 func (h *Handler) List(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -102,16 +117,13 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"physical_good_details": details,
-		"total":                 total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"physical_good_details": dto.NewPhysicalGoods(details)}, total, limit, offset)
 }
 
 // ListDeleted handles the retrieval of a paginated list of soft-deleted physical goods.
 // @Summary List soft-deleted physical goods
 // @Description Retrieves a paginated list of physical goods that have been soft-deleted.
-// @Success 200 {object} map[string]any{physical_good_details=[]physicalgood.PhysicalGoodDetails, total=int64}
+// @Success 200 {object} map[string]any{physical_good_details=[]dto.PhysicalGood, total=int64}
 func (h *Handler) ListDeleted(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -121,16 +133,13 @@ func (h *Handler) ListDeleted(c echo.Context) error {
 	if err != nil {
 		h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"physical_good_details": details,
-		"total":                 total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"physical_good_details": dto.NewPhysicalGoods(details)}, total, limit, offset)
 }
 
 // ListUnpublished handles the retrieval of a paginated list of unpublished physical goods.
 // @Summary List unpublished physical goods
 // @Description Retrieves a paginated list of physical goods that are not currently published.
-// @Success 200 {object} map[string]any{physical_good_details=[]physicalgood.PhysicalGoodDetails, total=int64}
+// @Success 200 {object} map[string]any{physical_good_details=[]dto.PhysicalGood, total=int64}
 func (h *Handler) ListUnpublished(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -140,10 +149,7 @@ func (h *Handler) ListUnpublished(c echo.Context) error {
 	if err != nil {
 		h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"physical_good_details": details,
-		"total":                 total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"physical_good_details": dto.NewPhysicalGoods(details)}, total, limit, offset)
 }
 
 func (h *Handler) Create(c echo.Context) error {
@@ -155,11 +161,21 @@ func (h *Handler) Create(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusCreated, map[string]any{"response": resp})
+	return response.Write(c, http.StatusCreated, map[string]any{"response": resp})
+}
+
+// Validate checks a physical good create request the way Create does, without creating anything.
+func (h *Handler) Validate(c echo.Context) error {
+	req := new(physicalgood.CreateRequest)
+	if err := request.BindAndValidateJSON(c, req); err != nil {
+		return err
+	}
+	req.Sanitize()
+	return response.WriteValidation(c, req.Validate())
 }
 
 func (h *Handler) Publish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
@@ -171,7 +187,7 @@ func (h *Handler) Publish(c echo.Context) error {
 }
 
 func (h *Handler) Unpublish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
@@ -183,7 +199,7 @@ func (h *Handler) Unpublish(c echo.Context) error {
 }
 
 func (h *Handler) Update(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
@@ -196,27 +212,61 @@ func (h *Handler) Update(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusAccepted, map[string]any{"updates": updates})
+	return response.Write(c, http.StatusAccepted, map[string]any{"updates": updates})
 }
 
 func (h *Handler) Delete(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
-	err = h.service.Delete(c.Request().Context(), id)
+	reason, err := request.GetReason(c)
+	if err != nil {
+		return err
+	}
+	err = h.service.Delete(c.Request().Context(), id, reason)
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
+func (h *Handler) DeleteBatch(c echo.Context) error {
+	var req common.IDsRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	deleted, err := h.service.DeleteBatch(c.Request().Context(), req.IDs)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"deleted": deleted})
+}
+
+// AdjustStock applies signed amount deltas to multiple physical goods at once,
+// all or nothing.
+func (h *Handler) AdjustStock(c echo.Context) error {
+	var req physicalgood.AdjustStockRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	applied, err := h.service.AdjustStockBatch(c.Request().Context(), req.Adjustments)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"applied": applied})
+}
+
 func (h *Handler) DeletePermanent(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
+	if err != nil {
+		return err
+	}
+	force, err := request.GetBoolQueryParam(c, "force", false)
 	if err != nil {
 		return err
 	}
-	err = h.service.DeletePermanent(c.Request().Context(), id)
+	err = h.service.DeletePermanent(c.Request().Context(), id, force)
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
@@ -224,7 +274,7 @@ func (h *Handler) DeletePermanent(c echo.Context) error {
 }
 
 func (h *Handler) Restore(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid physical good ID")
+	id, err := request.GetIDParam(c, "id", "Invalid physical good ID")
 	if err != nil {
 		return err
 	}
@@ -234,3 +284,15 @@ func (h *Handler) Restore(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusAccepted)
 }
+
+func (h *Handler) RestoreBatch(c echo.Context) error {
+	var req common.IDsRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	restored, err := h.service.RestoreBatch(c.Request().Context(), req.IDs)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"restored": restored})
+}
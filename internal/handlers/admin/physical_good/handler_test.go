@@ -24,12 +24,15 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
 	physicalgood "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	physicalgoodservice "github.com/mikhail5545/product-service-go/internal/services/physical_good"
 	physicalgoodmock "github.com/mikhail5545/product-service-go/internal/test/services/physical_good_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -59,7 +62,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Get(gomock.Any(), goodID).Return(mockPhysicalGoodDetails, nil)
@@ -70,7 +73,7 @@ func TestHandler_Get(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"physical_good_details": mockPhysicalGoodDetails}
+		expectedResp := map[string]any{"physical_good_details": dto.NewPhysicalGood(mockPhysicalGoodDetails), "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -81,7 +84,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Get(gomock.Any(), goodID).Return(nil, physicalgoodservice.ErrNotFound)
@@ -100,7 +103,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -140,7 +143,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), goodID).Return(mockPhysicalGoodDetails, nil)
@@ -151,7 +154,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"physical_good_details": mockPhysicalGoodDetails}
+		expectedResp := map[string]any{"physical_good_details": dto.NewPhysicalGood(mockPhysicalGoodDetails), "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -162,7 +165,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), goodID).Return(nil, physicalgoodservice.ErrNotFound)
@@ -181,7 +184,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -221,7 +224,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), goodID).Return(mockPhysicalGoodDetails, nil)
@@ -232,7 +235,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"physical_good_details": mockPhysicalGoodDetails}
+		expectedResp := map[string]any{"physical_good_details": dto.NewPhysicalGood(mockPhysicalGoodDetails), "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -243,7 +246,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), goodID).Return(nil, physicalgoodservice.ErrNotFound)
@@ -262,7 +265,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -323,7 +326,7 @@ func TestHandler_List(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"physical_good_details": mockPhysicalGoodDetails, "total": 2}
+		expectedResp := map[string]any{"physical_good_details": dto.NewPhysicalGoods(mockPhysicalGoodDetails), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -392,7 +395,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"physical_good_details": mockPhysicalGoodDetails, "total": 2}
+		expectedResp := map[string]any{"physical_good_details": dto.NewPhysicalGoods(mockPhysicalGoodDetails), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -461,7 +464,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"physical_good_details": mockPhysicalGoodDetails, "total": 2}
+		expectedResp := map[string]any{"physical_good_details": dto.NewPhysicalGoods(mockPhysicalGoodDetails), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -518,7 +521,7 @@ func TestHandler_Create(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusCreated, rec.Code)
-		expectedResp := map[string]any{"response": createResp}
+		expectedResp := map[string]any{"response": createResp, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -580,7 +583,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Publish(gomock.Any(), goodID).Return(nil)
@@ -599,7 +602,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -618,7 +621,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Publish(gomock.Any(), goodID).Return(physicalgoodservice.ErrNotFound)
@@ -647,7 +650,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), goodID).Return(nil)
@@ -666,7 +669,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -685,7 +688,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), goodID).Return(physicalgoodservice.ErrNotFound)
@@ -722,7 +725,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		updateReq.ID = goodID
@@ -733,7 +736,7 @@ func TestHandler_Update(t *testing.T) {
 		err := handler.Update(c)
 
 		// Assert
-		expectedResp := map[string]any{"updates": updates}
+		expectedResp := map[string]any{"updates": updates, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusAccepted, rec.Code)
@@ -754,7 +757,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -780,7 +783,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		updateReq.ID = goodID
@@ -810,10 +813,10 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
-		mockService.EXPECT().Delete(gomock.Any(), goodID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), goodID, "").Return(nil)
 
 		// Act
 		err := handler.Delete(c)
@@ -829,7 +832,7 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -848,10 +851,10 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
-		mockService.EXPECT().Delete(gomock.Any(), goodID).Return(physicalgoodservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), goodID, "").Return(physicalgoodservice.ErrNotFound)
 
 		// Act
 		err := handler.Delete(c)
@@ -862,6 +865,54 @@ func TestHandler_Delete(t *testing.T) {
 	})
 }
 
+func TestHandler_AdjustStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := physicalgoodmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	goodID := uuid.New().String()
+	body := `{"adjustments":{"` + goodID + `":-3}}`
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().AdjustStockBatch(gomock.Any(), map[string]int{goodID: -3}).Return(int64(1), nil)
+
+		// Act
+		err := handler.AdjustStock(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"applied":1,"api_version":"v0"}`, rec.Body.String())
+	})
+
+	t.Run("insufficient stock", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().AdjustStockBatch(gomock.Any(), gomock.Any()).Return(int64(0), physicalgoodservice.ErrInsufficientStock)
+
+		// Act
+		err := handler.AdjustStock(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
 func TestHandler_DeletePermanent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -877,10 +928,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID, false).Return(nil)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -896,7 +947,7 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -915,10 +966,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID).Return(physicalgoodservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID, false).Return(physicalgoodservice.ErrNotFound)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -944,7 +995,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Restore(gomock.Any(), goodID).Return(nil)
@@ -963,7 +1014,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -982,7 +1033,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(goodID)
 
 		mockService.EXPECT().Restore(gomock.Any(), goodID).Return(physicalgoodservice.ErrNotFound)
@@ -995,3 +1046,125 @@ func TestHandler_Restore(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+func TestHandler_Get_Timestamps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := physicalgoodmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	id := uuid.New().String()
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 3, 2, 11, 30, 0, 0, time.UTC)
+	mockDetails := &physicalgood.PhysicalGoodDetails{
+		PhysicalGood: &physicalgood.PhysicalGood{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt},
+	}
+
+	// Arrange
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+
+	mockService.EXPECT().Get(gomock.Any(), id).Return(mockDetails, nil)
+
+	// Act
+	err := handler.Get(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Details map[string]any `json:"physical_good_details"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, createdAt.Format(time.RFC3339Nano), resp.Details["created_at"])
+	assert.Equal(t, updatedAt.Format(time.RFC3339Nano), resp.Details["updated_at"])
+}
+
+func TestHandler_APIVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := physicalgoodmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	goodID := uuid.New().String()
+	details := &physicalgood.PhysicalGoodDetails{PhysicalGood: &physicalgood.PhysicalGood{ID: goodID}}
+	createReq := &physicalgood.CreateRequest{Name: "Physical good name", ShortDescription: "Physical good short description", Amount: 3, Price: 33.33}
+	createBody, _ := json.Marshal(createReq)
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		body    []byte
+		setup   func()
+		handler func(echo.Context) error
+		code    int
+	}{
+		{
+			name:    "Get",
+			method:  http.MethodGet,
+			target:  "/",
+			setup:   func() { mockService.EXPECT().Get(gomock.Any(), goodID).Return(details, nil) },
+			handler: handler.Get,
+			code:    http.StatusOK,
+		},
+		{
+			name:   "List",
+			method: http.MethodGet,
+			target: "/?limit=1&offset=0",
+			setup: func() {
+				mockService.EXPECT().List(gomock.Any(), 1, 0).Return([]physicalgood.PhysicalGoodDetails{*details}, int64(1), nil)
+			},
+			handler: handler.List,
+			code:    http.StatusOK,
+		},
+		{
+			name:   "Create",
+			method: http.MethodPost,
+			target: "/",
+			body:   createBody,
+			setup: func() {
+				mockService.EXPECT().Create(gomock.Any(), createReq).Return(&physicalgood.CreateResponse{ID: goodID}, nil)
+			},
+			handler: handler.Create,
+			code:    http.StatusCreated,
+		},
+		{
+			name:    "error",
+			method:  http.MethodGet,
+			target:  "/",
+			setup:   func() { mockService.EXPECT().Get(gomock.Any(), goodID).Return(nil, physicalgoodservice.ErrNotFound) },
+			handler: handler.Get,
+			code:    http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			e := echo.New()
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(goodID)
+			tt.setup()
+
+			// Act
+			err := tt.handler(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.code, rec.Code)
+			var resp map[string]any
+			assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, response.APIVersion, resp["api_version"])
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	productservice "github.com/mikhail5545/product-service-go/internal/services/product"
+	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+type Handler struct {
+	service productservice.Service
+}
+
+func New(s productservice.Service) *Handler {
+	return &Handler{service: s}
+}
+
+func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
+	return response.Write(c, code, map[string]any{"error": msg})
+}
+
+func (h *Handler) HandleServiceError(c echo.Context, err error) error {
+	if errors.Is(err, productservice.ErrNotFound) {
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, productservice.ErrInvalidArgument) {
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	}
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+}
+
+func (h *Handler) SetAvailability(c echo.Context) error {
+	id, err := request.GetIDParam(c, "id", "Invalid product ID")
+	if err != nil {
+		return err
+	}
+	var req productmodel.SetAvailabilityRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	req.ID = id
+	if err := h.service.SetAvailability(c.Request().Context(), &req); err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return c.NoContent(http.StatusAccepted)
+}
+
+// List serves paginated not soft-deleted products. Whether unpublished (out of stock) products
+// are included is decided by the stock policy of the route group, see [request.GetIncludeOutOfStock].
+func (h *Handler) List(c echo.Context) error {
+	limit, offset, err := request.GetPaginationParams(c, 10, 0)
+	if err != nil {
+		return err
+	}
+	includeOutOfStock, err := request.GetIncludeOutOfStock(c)
+	if err != nil {
+		return err
+	}
+	list := h.service.List
+	if includeOutOfStock {
+		list = h.service.ListWithUnpublished
+	}
+	products, total, err := list(c.Request().Context(), limit, offset)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.WritePage(c, http.StatusOK, map[string]any{"products": products}, total, limit, offset)
+}
+
+// ListByOwner serves all products of a details owner in every state, each tagged with its state.
+func (h *Handler) ListByOwner(c echo.Context) error {
+	detailsID, err := request.GetIDParam(c, "id", "Invalid owner ID")
+	if err != nil {
+		return err
+	}
+	products, err := h.service.ListByOwnerAllStates(c.Request().Context(), detailsID)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"products": products})
+}
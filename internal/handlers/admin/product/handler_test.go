@@ -0,0 +1,121 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/models/product"
+	productmock "github.com/mikhail5545/product-service-go/internal/test/services/product_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := productmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	inStockID, outOfStockID := uuid.New().String(), uuid.New().String()
+
+	adminPolicy := request.StockPolicy{IncludeOutOfStock: true, AllowOverride: true}
+
+	t.Run("out of stock included by default", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		request.SetStockPolicy(c, adminPolicy)
+
+		mockService.EXPECT().ListWithUnpublished(gomock.Any(), 10, 0).
+			Return([]product.Product{{ID: inStockID, InStock: true}, {ID: outOfStockID}}, int64(2), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), inStockID)
+		assert.Contains(t, rec.Body.String(), outOfStockID)
+		assert.Contains(t, rec.Body.String(), `"total":2`)
+	})
+
+	t.Run("exclude out of stock", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?include_out_of_stock=false", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		request.SetStockPolicy(c, adminPolicy)
+
+		mockService.EXPECT().List(gomock.Any(), 10, 0).
+			Return([]product.Product{{ID: inStockID, InStock: true}}, int64(1), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), inStockID)
+		assert.NotContains(t, rec.Body.String(), outOfStockID)
+	})
+
+	t.Run("in stock only without a policy", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?include_out_of_stock=true", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().List(gomock.Any(), 10, 0).
+			Return([]product.Product{{ID: inStockID, InStock: true}}, int64(1), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), outOfStockID)
+	})
+
+	t.Run("invalid flag", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?include_out_of_stock=maybe", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		request.SetStockPolicy(c, adminPolicy)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
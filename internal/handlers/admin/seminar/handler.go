@@ -22,9 +22,12 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	"github.com/mikhail5545/product-service-go/internal/models/seminar"
 	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 // Handler holds [seminarservice.Service] instance to perform service-layer logic.
@@ -41,34 +44,36 @@ func New(s seminarservice.Service) *Handler {
 //
 //	h.ServeError(http.StatusBadRequest, "Invalid request payload.")
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 // HandleServiceError handles seminar service errors and populates
 // error response based on error type.
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, seminarservice.ErrNotFound) || errors.Is(err, seminarservice.ErrImageNotFoundOnOwner) || errors.Is(err, seminarservice.ErrProductsNotFound) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, seminarservice.ErrInvalidArgument) || errors.Is(err, seminarservice.ErrImageLimitExceeded) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, seminarservice.ErrHasDependents) || errors.Is(err, seminarservice.ErrCannotPublishFree) || errors.Is(err, seminarservice.ErrDuplicateName) {
+		return response.Write(c, http.StatusConflict, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
-	details, err := h.service.Get(c.Request().Context(), id)
+	details, err := h.service.Get(c.Request().Context(), id, request.GetIncludeParam(c, "products", true))
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"seminar_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"seminar_details": dto.NewSeminar(details)})
 }
 
 func (h *Handler) GetWithDeleted(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
@@ -76,11 +81,11 @@ func (h *Handler) GetWithDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"seminar_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"seminar_details": dto.NewSeminar(details)})
 }
 
 func (h *Handler) GetWithUnpublished(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
@@ -88,7 +93,7 @@ func (h *Handler) GetWithUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"seminar_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"seminar_details": dto.NewSeminar(details)})
 }
 
 func (h *Handler) List(c echo.Context) error {
@@ -100,10 +105,7 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"seminar_details": details,
-		"total":           total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"seminar_details": dto.NewSeminars(details)}, total, limit, offset)
 }
 
 func (h *Handler) ListDeleted(c echo.Context) error {
@@ -115,10 +117,7 @@ func (h *Handler) ListDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"seminar_details": details,
-		"total":           total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"seminar_details": dto.NewSeminars(details)}, total, limit, offset)
 }
 
 func (h *Handler) ListUnpublished(c echo.Context) error {
@@ -130,10 +129,7 @@ func (h *Handler) ListUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"seminar_details": details,
-		"total":           total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"seminar_details": dto.NewSeminars(details)}, total, limit, offset)
 }
 
 func (h *Handler) Create(c echo.Context) error {
@@ -145,11 +141,21 @@ func (h *Handler) Create(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusCreated, map[string]any{"response": resp})
+	return response.Write(c, http.StatusCreated, map[string]any{"response": resp})
+}
+
+// Validate checks a seminar create request the way Create does, without creating anything.
+func (h *Handler) Validate(c echo.Context) error {
+	req := new(seminar.CreateRequest)
+	if err := request.BindAndValidateJSON(c, req); err != nil {
+		return err
+	}
+	req.Sanitize()
+	return response.WriteValidation(c, req.Validate())
 }
 
 func (h *Handler) Update(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
@@ -162,11 +168,11 @@ func (h *Handler) Update(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusAccepted, map[string]any{"updates": updates})
+	return response.Write(c, http.StatusAccepted, map[string]any{"updates": updates})
 }
 
 func (h *Handler) Publish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
@@ -177,7 +183,7 @@ func (h *Handler) Publish(c echo.Context) error {
 }
 
 func (h *Handler) Unpublish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
@@ -188,29 +194,49 @@ func (h *Handler) Unpublish(c echo.Context) error {
 }
 
 func (h *Handler) Delete(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
-	if err := h.service.Delete(c.Request().Context(), id); err != nil {
+	reason, err := request.GetReason(c)
+	if err != nil {
+		return err
+	}
+	if err := h.service.Delete(c.Request().Context(), id, reason); err != nil {
 		return h.HandleServiceError(c, err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
+func (h *Handler) DeleteBatch(c echo.Context) error {
+	var req common.IDsRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	deleted, err := h.service.DeleteBatch(c.Request().Context(), req.IDs)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"deleted": deleted})
+}
+
 func (h *Handler) DeletePermanent(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
-	if err := h.service.DeletePermanent(c.Request().Context(), id); err != nil {
+	force, err := request.GetBoolQueryParam(c, "force", false)
+	if err != nil {
+		return err
+	}
+	if err := h.service.DeletePermanent(c.Request().Context(), id, force); err != nil {
 		return h.HandleServiceError(c, err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
 func (h *Handler) Restore(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
@@ -219,3 +245,15 @@ func (h *Handler) Restore(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusAccepted)
 }
+
+func (h *Handler) RestoreBatch(c echo.Context) error {
+	var req common.IDsRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	restored, err := h.service.RestoreBatch(c.Request().Context(), req.IDs)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"restored": restored})
+}
@@ -24,13 +24,16 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
 	"github.com/mikhail5545/product-service-go/internal/models/product"
 	"github.com/mikhail5545/product-service-go/internal/models/seminar"
 	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
 	seminarmock "github.com/mikhail5545/product-service-go/internal/test/services/seminar_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -112,10 +115,10 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
-		mockService.EXPECT().Get(gomock.Any(), seminarID).Return(mockDetails, nil)
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(mockDetails, nil)
 
 		// Act
 		err := handler.Get(c)
@@ -123,7 +126,7 @@ func TestHandler_Get(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": mockDetails}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminar(mockDetails), "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -134,10 +137,10 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
-		mockService.EXPECT().Get(gomock.Any(), seminarID).Return(nil, seminarservice.ErrNotFound)
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(nil, seminarservice.ErrNotFound)
 
 		// Act
 		err := handler.Get(c)
@@ -153,7 +156,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -245,7 +248,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(mockDetails, nil)
@@ -256,7 +259,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": mockDetails}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminar(mockDetails), "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -267,7 +270,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, seminarservice.ErrNotFound)
@@ -286,7 +289,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -378,7 +381,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(mockDetails, nil)
@@ -389,7 +392,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": mockDetails}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminar(mockDetails), "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -400,7 +403,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, seminarservice.ErrNotFound)
@@ -419,7 +422,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -434,6 +437,52 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 	})
 }
 
+func TestHandler_GetWithUnpublished_PublishTimes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	// Arrange
+	seminarID := uuid.New().String()
+	first := time.Date(2025, 1, 10, 9, 0, 0, 0, time.UTC)
+	last := time.Date(2025, 2, 3, 18, 30, 0, 0, time.UTC)
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(seminarID)
+
+	mockService.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(&seminar.SeminarDetails{
+		Seminar:          &seminar.Seminar{ID: seminarID},
+		FirstPublishedAt: &first,
+		LastPublishedAt:  &last,
+	}, nil)
+
+	// Act
+	err := handler.GetWithUnpublished(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		SeminarDetails struct {
+			FirstPublishedAt *time.Time `json:"first_published_at"`
+			LastPublishedAt  *time.Time `json:"last_published_at"`
+		} `json:"seminar_details"`
+	}
+	if assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body)) {
+		if assert.NotNil(t, body.SeminarDetails.FirstPublishedAt) {
+			assert.True(t, first.Equal(*body.SeminarDetails.FirstPublishedAt))
+		}
+		if assert.NotNil(t, body.SeminarDetails.LastPublishedAt) {
+			assert.True(t, last.Equal(*body.SeminarDetails.LastPublishedAt))
+		}
+	}
+}
+
 func TestHandler_List(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -581,7 +630,7 @@ func TestHandler_List(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": []seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}, "total": 2}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminars([]seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -601,7 +650,7 @@ func TestHandler_List(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": []seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}, "total": 2}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminars([]seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -771,7 +820,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": []seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}, "total": 2}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminars([]seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -791,7 +840,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": []seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}, "total": 2}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminars([]seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -961,7 +1010,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": []seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}, "total": 2}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminars([]seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -981,7 +1030,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"seminar_details": []seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}, "total": 2}
+		expectedResp := map[string]any{"seminar_details": dto.NewSeminars([]seminar.SeminarDetails{*mockDetails_1, *mockDetails_2}), "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -1053,11 +1102,81 @@ func TestHandler_Create(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusCreated, rec.Code)
-		expectedResp := map[string]any{"response": createResp}
+		expectedResp := map[string]any{"response": createResp, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
 
+	t.Run("response shape", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		reqJSON, _ := json.Marshal(createReq)
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(reqJSON))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&seminar.CreateResponse{
+			ID:                      seminarID,
+			ReservationProductID:    rproductID,
+			EarlyProductID:          eproductID,
+			LateProductID:           lproductID,
+			EarlySurchargeProductID: esproductID,
+			LateSurchargeProductID:  lsproductID,
+		}, nil)
+
+		// Act
+		err := handler.Create(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{
+			"response": {
+				"id": "`+seminarID+`",
+				"reservation_product_id": "`+rproductID+`",
+				"early_product_id": "`+eproductID+`",
+				"late_product_id": "`+lproductID+`",
+				"early_surcharge_product_id": "`+esproductID+`",
+				"late_surcharge_product_id": "`+lsproductID+`"
+			},
+			"api_version": "`+response.APIVersion+`"
+		}`, rec.Body.String())
+	})
+
+	t.Run("response omits empty product ids", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		reqJSON, _ := json.Marshal(createReq)
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(reqJSON))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Return(&seminar.CreateResponse{
+			ID:                   seminarID,
+			ReservationProductID: rproductID,
+			EarlyProductID:       eproductID,
+			LateProductID:        lproductID,
+		}, nil)
+
+		// Act
+		err := handler.Create(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{
+			"response": {
+				"id": "`+seminarID+`",
+				"reservation_product_id": "`+rproductID+`",
+				"early_product_id": "`+eproductID+`",
+				"late_product_id": "`+lproductID+`"
+			},
+			"api_version": "`+response.APIVersion+`"
+		}`, rec.Body.String())
+	})
+
 	t.Run("service error", func(t *testing.T) {
 		// Arrange
 		e := echo.New()
@@ -1096,6 +1215,94 @@ func TestHandler_Create(t *testing.T) {
 	})
 }
 
+func TestHandler_Validate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	date := time.Now().Add(30 * 24 * time.Hour)
+	validReq := seminar.CreateRequest{
+		Name:                "Seminar name",
+		ShortDescription:    "Seminar short description",
+		ReservationPrice:    11.11,
+		EarlyPrice:          22.22,
+		LatePrice:           33.33,
+		EarlySurchargePrice: 44.44,
+		LateSurchargePrice:  55.55,
+		Date:                date,
+		EndingDate:          date.Add(48 * time.Hour),
+		LatePaymentDate:     date.Add(-7 * 24 * time.Hour),
+		Place:               "Seminar place",
+	}
+
+	validate := func(t *testing.T, body any) (int, map[string]any) {
+		e := echo.New()
+		reqJSON, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(reqJSON))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		assert.NoError(t, handler.Validate(c))
+		var resp map[string]any
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		return rec.Code, resp
+	}
+
+	t.Run("valid request", func(t *testing.T) {
+		// Arrange
+		mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		code, resp := validate(t, validReq)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, true, resp["valid"])
+		assert.Empty(t, resp["errors"])
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		// Arrange
+		mockService.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+		invalidReq := validReq
+		invalidReq.Name = "a"
+		invalidReq.EarlyPrice = 0
+
+		// Act
+		code, resp := validate(t, invalidReq)
+
+		// Assert
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, false, resp["valid"])
+		errs, _ := resp["errors"].([]any)
+		if assert.Len(t, errs, 2) {
+			assert.Equal(t, "early_price", errs[0].(map[string]any)["field"])
+			assert.Equal(t, "name", errs[1].(map[string]any)["field"])
+			assert.NotEmpty(t, errs[1].(map[string]any)["message"])
+		}
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{"))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		c := e.NewContext(req, httptest.NewRecorder())
+
+		// Act
+		err := handler.Validate(c)
+
+		// Assert
+		var httpErr *echo.HTTPError
+		if assert.ErrorAs(t, err, &httpErr) {
+			assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+		}
+	})
+}
+
 func TestHandler_Publish(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1111,7 +1318,7 @@ func TestHandler_Publish(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().Publish(gomock.Any(), seminarID).Return(nil)
@@ -1131,7 +1338,7 @@ func TestHandler_Publish(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().Publish(gomock.Any(), seminarID).Return(seminarservice.ErrNotFound)
@@ -1151,7 +1358,7 @@ func TestHandler_Publish(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1180,7 +1387,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), seminarID).Return(nil)
@@ -1200,7 +1407,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), seminarID).Return(seminarservice.ErrNotFound)
@@ -1220,7 +1427,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1257,7 +1464,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		updates := map[string]any{"name": newName, "short_description": newDescription}
@@ -1267,7 +1474,7 @@ func TestHandler_Update(t *testing.T) {
 		err := handler.Update(c)
 
 		// Assert
-		expectedResp := map[string]any{"updates": updates}
+		expectedResp := map[string]any{"updates": updates, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusAccepted, rec.Code)
@@ -1286,7 +1493,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		updateReq.ID = seminarID
@@ -1307,7 +1514,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		// Act
@@ -1327,7 +1534,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1356,10 +1563,10 @@ func TestHandler_Delete(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
-		mockService.EXPECT().Delete(gomock.Any(), seminarID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), seminarID, "").Return(nil)
 
 		// Act
 		err := handler.Delete(c)
@@ -1376,10 +1583,10 @@ func TestHandler_Delete(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
-		mockService.EXPECT().Delete(gomock.Any(), seminarID).Return(seminarservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), seminarID, "").Return(seminarservice.ErrNotFound)
 
 		// Act
 		err := handler.Delete(c)
@@ -1396,7 +1603,7 @@ func TestHandler_Delete(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1425,10 +1632,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID, false).Return(nil)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -1445,10 +1652,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(seminarservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID, false).Return(seminarservice.ErrNotFound)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -1465,7 +1672,7 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1494,7 +1701,7 @@ func TestHandler_Restore(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().Restore(gomock.Any(), seminarID).Return(nil)
@@ -1514,7 +1721,7 @@ func TestHandler_Restore(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(seminarID)
 
 		mockService.EXPECT().Restore(gomock.Any(), seminarID).Return(seminarservice.ErrNotFound)
@@ -1534,7 +1741,7 @@ func TestHandler_Restore(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1547,3 +1754,43 @@ func TestHandler_Restore(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 }
+
+func TestHandler_Get_Timestamps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	id := uuid.New().String()
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 3, 2, 11, 30, 0, 0, time.UTC)
+	mockDetails := &seminar.SeminarDetails{
+		Seminar:   &seminar.Seminar{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt},
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+
+	// Arrange
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+
+	mockService.EXPECT().Get(gomock.Any(), id, true).Return(mockDetails, nil)
+
+	// Act
+	err := handler.Get(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Details map[string]any `json:"seminar_details"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, createdAt.Format(time.RFC3339Nano), resp.Details["created_at"])
+	assert.Equal(t, updatedAt.Format(time.RFC3339Nano), resp.Details["updated_at"])
+}
@@ -22,9 +22,12 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	trainingsession "github.com/mikhail5545/product-service-go/internal/models/training_session"
 	trainingsessionservice "github.com/mikhail5545/product-service-go/internal/services/training_session"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 // Handler holds [trainingsessionservice.Service] instance to perform service-layer logic.
@@ -41,34 +44,36 @@ func New(tsService trainingsessionservice.Service) *Handler {
 //
 //	h.ServeError(http.StatusBadRequest, "Invalid request payload.")
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 // HandleServiceError handles training session service errors and populates
 // error response based on error type.
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, trainingsessionservice.ErrNotFound) || errors.Is(err, trainingsessionservice.ErrImageNotFoundOnOwner) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, trainingsessionservice.ErrInvalidArgument) || errors.Is(err, trainingsessionservice.ErrImageLimitExceeded) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, trainingsessionservice.ErrHasDependents) || errors.Is(err, trainingsessionservice.ErrCannotPublishFree) {
+		return response.Write(c, http.StatusConflict, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
-	details, err := h.tsService.Get(c.Request().Context(), id)
+	details, err := h.tsService.Get(c.Request().Context(), id, request.GetIncludeParam(c, "products", true))
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"training_session_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"training_session_details": dto.NewTrainingSession(details)})
 }
 
 func (h *Handler) GetWithDeleted(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
@@ -76,11 +81,11 @@ func (h *Handler) GetWithDeleted(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"training_session_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"training_session_details": dto.NewTrainingSession(details)})
 }
 
 func (h *Handler) GetWithUnpublished(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
@@ -88,13 +93,13 @@ func (h *Handler) GetWithUnpublished(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"training_session_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"training_session_details": dto.NewTrainingSession(details)})
 }
 
 // List handles the retrieval of a paginated list of published training sessions.
 // @Summary List published training sessions
 // @Description Retrieves a paginated list of training sessions that are currently published.
-// @Success 200 {object} map[string]any{training_session_details=[]trainingsession.TrainingSessionDetails, total=int64}
+// @Success 200 {object} map[string]any{training_session_details=[]dto.TrainingSession, total=int64}
 func (h *Handler) List(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -104,16 +109,13 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"training_session_details": details,
-		"total":                    total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"training_session_details": dto.NewTrainingSessions(details)}, total, limit, offset)
 }
 
 // ListDeleted handles the retrieval of a paginated list of soft-deleted training sessions.
 // @Summary List soft-deleted training sessions
 // @Description Retrieves a paginated list of training sessions that have been soft-deleted.
-// @Success 200 {object} map[string]any{training_session_details=[]trainingsession.TrainingSessionDetails, total=int64}
+// @Success 200 {object} map[string]any{training_session_details=[]dto.TrainingSession, total=int64}
 func (h *Handler) ListDeleted(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -123,16 +125,13 @@ func (h *Handler) ListDeleted(c echo.Context) error {
 	if err != nil {
 		h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"training_session_details": details,
-		"total":                    total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"training_session_details": dto.NewTrainingSessions(details)}, total, limit, offset)
 }
 
 // ListUnpublished handles the retrieval of a paginated list of unpublished training sessions.
 // @Summary List unpublished training sessions
 // @Description Retrieves a paginated list of training sessions that are not currently published.
-// @Success 200 {object} map[string]any{training_session_details=[]trainingsession.TrainingSessionDetails, total=int64}
+// @Success 200 {object} map[string]any{training_session_details=[]dto.TrainingSession, total=int64}
 func (h *Handler) ListUnpublished(c echo.Context) error {
 	limit, offset, err := request.GetPaginationParams(c, 10, 0)
 	if err != nil {
@@ -142,10 +141,7 @@ func (h *Handler) ListUnpublished(c echo.Context) error {
 	if err != nil {
 		h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"training_session_details": details,
-		"total":                    total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"training_session_details": dto.NewTrainingSessions(details)}, total, limit, offset)
 }
 
 func (h *Handler) Create(c echo.Context) error {
@@ -157,11 +153,21 @@ func (h *Handler) Create(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusCreated, map[string]any{"response": resp})
+	return response.Write(c, http.StatusCreated, map[string]any{"response": resp})
+}
+
+// Validate checks a training session create request the way Create does, without creating anything.
+func (h *Handler) Validate(c echo.Context) error {
+	req := new(trainingsession.CreateRequest)
+	if err := request.BindAndValidateJSON(c, req); err != nil {
+		return err
+	}
+	req.Sanitize()
+	return response.WriteValidation(c, req.Validate())
 }
 
 func (h *Handler) Publish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
@@ -173,7 +179,7 @@ func (h *Handler) Publish(c echo.Context) error {
 }
 
 func (h *Handler) Unpublish(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
@@ -185,7 +191,7 @@ func (h *Handler) Unpublish(c echo.Context) error {
 }
 
 func (h *Handler) Update(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
@@ -198,27 +204,47 @@ func (h *Handler) Update(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusCreated, map[string]any{"updates": updates})
+	return response.Write(c, http.StatusCreated, map[string]any{"updates": updates})
 }
 
 func (h *Handler) Delete(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
+	if err != nil {
+		return err
+	}
+	reason, err := request.GetReason(c)
 	if err != nil {
 		return err
 	}
-	err = h.tsService.Delete(c.Request().Context(), id)
+	err = h.tsService.Delete(c.Request().Context(), id, reason)
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
+func (h *Handler) DeleteBatch(c echo.Context) error {
+	var req common.IDsRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	deleted, err := h.tsService.DeleteBatch(c.Request().Context(), req.IDs)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"deleted": deleted})
+}
+
 func (h *Handler) DeletePermanent(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
+	if err != nil {
+		return err
+	}
+	force, err := request.GetBoolQueryParam(c, "force", false)
 	if err != nil {
 		return err
 	}
-	err = h.tsService.DeletePermanent(c.Request().Context(), id)
+	err = h.tsService.DeletePermanent(c.Request().Context(), id, force)
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
@@ -226,7 +252,7 @@ func (h *Handler) DeletePermanent(c echo.Context) error {
 }
 
 func (h *Handler) Restore(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
@@ -236,3 +262,15 @@ func (h *Handler) Restore(c echo.Context) error {
 	}
 	return c.NoContent(http.StatusAccepted)
 }
+
+func (h *Handler) RestoreBatch(c echo.Context) error {
+	var req common.IDsRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	restored, err := h.tsService.RestoreBatch(c.Request().Context(), req.IDs)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"restored": restored})
+}
@@ -24,12 +24,14 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	trainingsession "github.com/mikhail5545/product-service-go/internal/models/training_session"
 	trainingsessionservice "github.com/mikhail5545/product-service-go/internal/services/training_session"
 	trainingsessinmock "github.com/mikhail5545/product-service-go/internal/test/services/training_session_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -59,10 +61,10 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
-		mockService.EXPECT().Get(gomock.Any(), tsID).Return(mockTsDetails, nil)
+		mockService.EXPECT().Get(gomock.Any(), tsID, true).Return(mockTsDetails, nil)
 
 		// Act
 		err := handler.Get(c)
@@ -70,7 +72,7 @@ func TestHandler_Get(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"training_session_details": mockTsDetails}
+		expectedResp := map[string]any{"training_session_details": mockTsDetails, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -81,10 +83,10 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
-		mockService.EXPECT().Get(gomock.Any(), tsID).Return(nil, trainingsessionservice.ErrNotFound)
+		mockService.EXPECT().Get(gomock.Any(), tsID, true).Return(nil, trainingsessionservice.ErrNotFound)
 
 		// Act
 		err := handler.Get(c)
@@ -100,7 +102,7 @@ func TestHandler_Get(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -140,7 +142,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), tsID).Return(mockTsDetails, nil)
@@ -151,7 +153,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"training_session_details": mockTsDetails}
+		expectedResp := map[string]any{"training_session_details": mockTsDetails, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -162,7 +164,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().GetWithDeleted(gomock.Any(), tsID).Return(nil, trainingsessionservice.ErrNotFound)
@@ -181,7 +183,7 @@ func TestHandler_GetWithDeleted(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -221,7 +223,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), tsID).Return(mockTsDetails, nil)
@@ -232,7 +234,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"training_session_details": mockTsDetails}
+		expectedResp := map[string]any{"training_session_details": mockTsDetails, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -243,7 +245,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().GetWithUnpublished(gomock.Any(), tsID).Return(nil, trainingsessionservice.ErrNotFound)
@@ -262,7 +264,7 @@ func TestHandler_GetWithUnpublished(t *testing.T) {
 		req := httptest.NewRequest(http.MethodGet, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-uuid")
 
 		// Act
@@ -323,7 +325,7 @@ func TestHandler_List(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"training_session_details": mockTsDetails, "total": 2}
+		expectedResp := map[string]any{"training_session_details": mockTsDetails, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -409,7 +411,7 @@ func TestHandler_ListDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"training_session_details": mockTsDetails, "total": 2}
+		expectedResp := map[string]any{"training_session_details": mockTsDetails, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -495,7 +497,7 @@ func TestHandler_ListUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		expectedResp := map[string]any{"training_session_details": mockTsDetails, "total": 2}
+		expectedResp := map[string]any{"training_session_details": mockTsDetails, "total": 2, "has_more": false, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
@@ -570,11 +572,41 @@ func TestHandler_Create(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusCreated, rec.Code)
-		expectedResp := map[string]any{"response": createResp}
+		expectedResp := map[string]any{"response": createResp, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
 	})
 
+	t.Run("response shape", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		createReq := &trainingsession.CreateRequest{
+			Name:             "Training session name",
+			ShortDescription: "Training session description",
+			Price:            33.33,
+			DurationMinutes:  30,
+			Format:           "online",
+		}
+		reqJSON, _ := json.Marshal(createReq)
+		req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(reqJSON))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().Create(gomock.Any(), createReq).Return(&trainingsession.CreateResponse{ID: tsID, ProductID: productID}, nil)
+
+		// Act
+		err := handler.Create(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{
+			"response": {"id": "`+tsID+`", "product_id": "`+productID+`"},
+			"api_version": "`+response.APIVersion+`"
+		}`, rec.Body.String())
+	})
+
 	t.Run("service error", func(t *testing.T) {
 		// Arrange
 		e := echo.New()
@@ -635,7 +667,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().Publish(gomock.Any(), tsID).Return(nil)
@@ -654,7 +686,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -673,7 +705,7 @@ func TestHandler_Publish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().Publish(gomock.Any(), tsID).Return(trainingsessionservice.ErrNotFound)
@@ -702,7 +734,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), tsID).Return(nil)
@@ -721,7 +753,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -740,7 +772,7 @@ func TestHandler_Unpublish(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().Unpublish(gomock.Any(), tsID).Return(trainingsessionservice.ErrNotFound)
@@ -777,7 +809,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		updateReq.ID = tsID
@@ -788,7 +820,7 @@ func TestHandler_Update(t *testing.T) {
 		err := handler.Update(c)
 
 		// Assert
-		expectedResp := map[string]any{"updates": updates}
+		expectedResp := map[string]any{"updates": updates, "api_version": response.APIVersion}
 		expectedJSON, _ := json.Marshal(expectedResp)
 		assert.NoError(t, err)
 		assert.Equal(t, http.StatusCreated, rec.Code)
@@ -809,7 +841,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -836,7 +868,7 @@ func TestHandler_Update(t *testing.T) {
 		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		updateReq.ID = tsID
@@ -866,10 +898,10 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
-		mockService.EXPECT().Delete(gomock.Any(), tsID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), tsID, "").Return(nil)
 
 		// Act
 		err := handler.Delete(c)
@@ -885,7 +917,7 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -904,10 +936,10 @@ func TestHandler_Delete(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
-		mockService.EXPECT().Delete(gomock.Any(), tsID).Return(trainingsessionservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), tsID, "").Return(trainingsessionservice.ErrNotFound)
 
 		// Act
 		err := handler.Delete(c)
@@ -933,10 +965,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID, false).Return(nil)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -952,7 +984,7 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -971,10 +1003,10 @@ func TestHandler_DeletePermanent(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
-		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID).Return(trainingsessionservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID, false).Return(trainingsessionservice.ErrNotFound)
 
 		// Act
 		err := handler.DeletePermanent(c)
@@ -1000,7 +1032,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().Restore(gomock.Any(), tsID).Return(nil)
@@ -1019,7 +1051,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues("invalid-id")
 
 		// Act
@@ -1038,7 +1070,7 @@ func TestHandler_Restore(t *testing.T) {
 		req := httptest.NewRequest(http.MethodPost, "/", nil)
 		rec := httptest.NewRecorder()
 		c := e.NewContext(req, rec)
-		c.SetParamNames(":id")
+		c.SetParamNames("id")
 		c.SetParamValues(tsID)
 
 		mockService.EXPECT().Restore(gomock.Any(), tsID).Return(trainingsessionservice.ErrNotFound)
@@ -1051,3 +1083,41 @@ func TestHandler_Restore(t *testing.T) {
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 	})
 }
+
+func TestHandler_Get_Timestamps(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := trainingsessinmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	id := uuid.New().String()
+	createdAt := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2025, 3, 2, 11, 30, 0, 0, time.UTC)
+	mockDetails := &trainingsession.TrainingSessionDetails{
+		TrainingSession: &trainingsession.TrainingSession{ID: id, CreatedAt: createdAt, UpdatedAt: updatedAt},
+	}
+
+	// Arrange
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues(id)
+
+	mockService.EXPECT().Get(gomock.Any(), id, true).Return(mockDetails, nil)
+
+	// Act
+	err := handler.Get(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var resp struct {
+		Details map[string]any `json:"training_session_details"`
+	}
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, createdAt.Format(time.RFC3339Nano), resp.Details["created_at"])
+	assert.Equal(t, updatedAt.Format(time.RFC3339Nano), resp.Details["updated_at"])
+}
@@ -0,0 +1,162 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package trash provides admin HTTP handlers operating on soft-deleted records of all types.
+package trash
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+	courseservice "github.com/mikhail5545/product-service-go/internal/services/course"
+	coursepartservice "github.com/mikhail5545/product-service-go/internal/services/course_part"
+	physicalgoodservice "github.com/mikhail5545/product-service-go/internal/services/physical_good"
+	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
+	trainingsessionservice "github.com/mikhail5545/product-service-go/internal/services/training_session"
+	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+type Handler struct {
+	courseService  courseservice.Service
+	cpService      coursepartservice.Service
+	phgService     physicalgoodservice.Service
+	seminarService seminarservice.Service
+	tsService      trainingsessionservice.Service
+}
+
+func New(
+	courseService courseservice.Service,
+	cpService coursepartservice.Service,
+	phgService physicalgoodservice.Service,
+	seminarService seminarservice.Service,
+	tsService trainingsessionservice.Service,
+) *Handler {
+	return &Handler{
+		courseService:  courseService,
+		cpService:      cpService,
+		phgService:     phgService,
+		seminarService: seminarService,
+		tsService:      tsService,
+	}
+}
+
+func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
+	return response.Write(c, code, map[string]any{"error": msg})
+}
+
+// PurgePreview reports how many records of each type [Handler.Purge] would remove for the same 'before' date.
+// @Summary Preview a trash purge
+// @Description Counts courses, course parts, physical goods, seminars and training sessions soft-deleted
+// @Description before the 'before' date (YYYY-MM-DD). Nothing is deleted.
+// @Param before query string true "Cutoff date (YYYY-MM-DD)"
+// @Success 200 {object} map[string]any{purgeable=map[string]int}
+// @Failure 400 {object} map[string]string{error=string} "Invalid before date"
+// @Router /admin/trash/purge-preview [get]
+func (h *Handler) PurgePreview(c echo.Context) error {
+	cutoff, err := time.Parse(time.DateOnly, c.QueryParam("before"))
+	if err != nil {
+		return h.ServeError(c, http.StatusBadRequest, "Invalid before date, expected YYYY-MM-DD.")
+	}
+
+	ctx := c.Request().Context()
+	counts := []struct {
+		name  string
+		count func(context.Context, time.Time) (int64, error)
+	}{
+		{"course_parts", h.cpService.CountDeletedBefore},
+		{"courses", h.courseService.CountDeletedBefore},
+		{"physical_goods", h.phgService.CountDeletedBefore},
+		{"seminars", h.seminarService.CountDeletedBefore},
+		{"training_sessions", h.tsService.CountDeletedBefore},
+	}
+	purgeable := make(map[string]int64, len(counts))
+	for _, t := range counts {
+		n, err := t.count(ctx, cutoff)
+		if err != nil {
+			return h.ServeError(c, http.StatusInternalServerError, "Internal server error")
+		}
+		purgeable[t.name] = n
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"purgeable": purgeable})
+}
+
+// Purge handles permanent deletion of every record soft-deleted before a given date.
+// @Summary Purge the trash
+// @Description Permanently deletes all courses, course parts, physical goods, seminars and training sessions
+// @Description soft-deleted before the 'before' date, together with their products. This action is irreversible,
+// @Description so 'confirm' must repeat the 'before' date. Each type is purged in its own transaction.
+// @Param request body common.PurgeRequest true "Purge request"
+// @Success 200 {object} map[string]any{purged=map[string]int}
+// @Failure 400 {object} map[string]string{error=string} "Missing confirmation or invalid date"
+// @Failure 409 {object} map[string]any{error=string,purged=map[string]int} "Products of purged records are featured"
+// @Router /admin/trash/purge [post]
+func (h *Handler) Purge(c echo.Context) error {
+	var req common.PurgeRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	if req.Confirm == "" {
+		return h.ServeError(c, http.StatusBadRequest, "Purge must be confirmed by repeating the before date in confirm.")
+	}
+	before, err := time.Parse(time.DateOnly, req.Before)
+	if err != nil {
+		return h.ServeError(c, http.StatusBadRequest, "Invalid before date, expected YYYY-MM-DD.")
+	}
+	if req.Confirm != req.Before {
+		return h.ServeError(c, http.StatusBadRequest, "Confirmation does not match the before date.")
+	}
+
+	ctx := c.Request().Context()
+	// Course parts go first so that parts trashed with their course are counted as parts too.
+	purges := []struct {
+		name  string
+		purge func(context.Context, time.Time) (int64, error)
+	}{
+		{"course_parts", h.cpService.PurgeDeletedBefore},
+		{"courses", h.courseService.PurgeDeletedBefore},
+		{"physical_goods", h.phgService.PurgeDeletedBefore},
+		{"seminars", h.seminarService.PurgeDeletedBefore},
+		{"training_sessions", h.tsService.PurgeDeletedBefore},
+	}
+	purged := make(map[string]int64, len(purges))
+	for _, p := range purges {
+		n, err := p.purge(ctx, before)
+		if err != nil {
+			// Types purged so far are already committed, report them alongside the error.
+			if isDependentsError(err) {
+				return response.Write(c, http.StatusConflict, map[string]any{"error": err.Error(), "purged": purged})
+			}
+			return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error", "purged": purged})
+		}
+		purged[p.name] = n
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"purged": purged})
+}
+
+// isDependentsError reports whether err is ErrHasDependents of any purged type, that is products of a record
+// are still featured and DELETE_CASCADE_DEPENDENTS is not set.
+func isDependentsError(err error) bool {
+	return errors.Is(err, courseservice.ErrHasDependents) ||
+		errors.Is(err, physicalgoodservice.ErrHasDependents) ||
+		errors.Is(err, seminarservice.ErrHasDependents) ||
+		errors.Is(err, trainingsessionservice.ErrHasDependents)
+}
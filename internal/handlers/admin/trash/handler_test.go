@@ -0,0 +1,224 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package trash
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	courseservice "github.com/mikhail5545/product-service-go/internal/services/course"
+	coursemock "github.com/mikhail5545/product-service-go/internal/test/services/course_mock"
+	coursepartmock "github.com/mikhail5545/product-service-go/internal/test/services/course_part_mock"
+	physicalgoodmock "github.com/mikhail5545/product-service-go/internal/test/services/physical_good_mock"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/services/seminar_mock"
+	trainingsessionmock "github.com/mikhail5545/product-service-go/internal/test/services/training_session_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_Purge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCourseService := coursemock.NewMockService(ctrl)
+	mockCPService := coursepartmock.NewMockService(ctrl)
+	mockPhgService := physicalgoodmock.NewMockService(ctrl)
+	mockSeminarService := seminarmock.NewMockService(ctrl)
+	mockTSService := trainingsessionmock.NewMockService(ctrl)
+	handler := New(mockCourseService, mockCPService, mockPhgService, mockSeminarService, mockTSService)
+
+	before := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	newContext := func(body string) (echo.Context, *httptest.ResponseRecorder) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		return e.NewContext(req, rec), rec
+	}
+
+	t.Run("valid purge returns count summary", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"before":"2025-03-01","confirm":"2025-03-01"}`)
+
+		mockCPService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(5), nil)
+		mockCourseService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(2), nil)
+		mockPhgService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(0), nil)
+		mockSeminarService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(1), nil)
+		mockTSService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(3), nil)
+
+		// Act
+		err := handler.Purge(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, fmt.Sprintf(`{
+			"purged": {"course_parts":5,"courses":2,"physical_goods":0,"seminars":1,"training_sessions":3},
+			"api_version": %q
+		}`, response.APIVersion), rec.Body.String())
+	})
+
+	t.Run("missing confirmation", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"before":"2025-03-01"}`)
+
+		// Act
+		err := handler.Purge(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("mismatched confirmation", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"before":"2025-03-01","confirm":"2025-04-01"}`)
+
+		// Act
+		err := handler.Purge(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid before date", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"before":"01.03.2025","confirm":"01.03.2025"}`)
+
+		// Act
+		err := handler.Purge(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("service error reports partial summary", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"before":"2025-03-01","confirm":"2025-03-01"}`)
+
+		mockCPService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(5), nil)
+		mockCourseService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(0), errors.New("database error"))
+
+		// Act
+		err := handler.Purge(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"purged":{"course_parts":5}`)
+	})
+
+	t.Run("featured products block purge", func(t *testing.T) {
+		// Arrange
+		c, rec := newContext(`{"before":"2025-03-01","confirm":"2025-03-01"}`)
+
+		mockCPService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).Return(int64(5), nil)
+		mockCourseService.EXPECT().PurgeDeletedBefore(gomock.Any(), before).
+			Return(int64(0), fmt.Errorf("%w: 1 featured entries", courseservice.ErrHasDependents))
+
+		// Act
+		err := handler.Purge(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"purged":{"course_parts":5}`)
+	})
+}
+
+func TestHandler_PurgePreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCourseService := coursemock.NewMockService(ctrl)
+	mockCPService := coursepartmock.NewMockService(ctrl)
+	mockPhgService := physicalgoodmock.NewMockService(ctrl)
+	mockSeminarService := seminarmock.NewMockService(ctrl)
+	mockTSService := trainingsessionmock.NewMockService(ctrl)
+	handler := New(mockCourseService, mockCPService, mockPhgService, mockSeminarService, mockTSService)
+
+	cutoff := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?before=2025-03-01", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Only counts are requested, any purge call would fail the test.
+		mockCPService.EXPECT().CountDeletedBefore(gomock.Any(), cutoff).Return(int64(4), nil)
+		mockCourseService.EXPECT().CountDeletedBefore(gomock.Any(), cutoff).Return(int64(1), nil)
+		mockPhgService.EXPECT().CountDeletedBefore(gomock.Any(), cutoff).Return(int64(0), nil)
+		mockSeminarService.EXPECT().CountDeletedBefore(gomock.Any(), cutoff).Return(int64(2), nil)
+		mockTSService.EXPECT().CountDeletedBefore(gomock.Any(), cutoff).Return(int64(6), nil)
+
+		// Act
+		err := handler.PurgePreview(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, fmt.Sprintf(`{
+			"purgeable": {"course_parts":4,"courses":1,"physical_goods":0,"seminars":2,"training_sessions":6},
+			"api_version": %q
+		}`, response.APIVersion), rec.Body.String())
+	})
+
+	t.Run("missing before", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.PurgePreview(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?before=2025-03-01", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockCPService.EXPECT().CountDeletedBefore(gomock.Any(), cutoff).Return(int64(0), errors.New("database error"))
+
+		// Act
+		err := handler.PurgePreview(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
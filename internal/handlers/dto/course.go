@@ -0,0 +1,119 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package dto
+
+import (
+	"time"
+
+	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
+)
+
+// Course is the response payload of a course with the price of its product.
+type Course struct {
+	ID                  string       `json:"id"`
+	CreatedAt           time.Time    `json:"created_at"`
+	UpdatedAt           time.Time    `json:"updated_at"`
+	DeletedAt           *time.Time   `json:"deleted_at"`
+	DeletedReason       string       `json:"deleted_reason,omitempty"`
+	Tags                []string     `json:"tags"`
+	Name                string       `json:"name"`
+	Topic               string       `json:"topic"`
+	ShortDescription    string       `json:"short_description"`
+	LongDescription     string       `json:"long_description"`
+	InStock             bool         `json:"in_stock"`
+	AccessDuration      int          `json:"access_duration"`
+	UploadedImageAmount int          `json:"uploaded_image_amount"`
+	Images              []Image      `json:"images"`
+	CourseParts         []CoursePart `json:"course_parts"`
+
+	Price     float32 `json:"price"`
+	ProductID string  `json:"product_id"`
+	Available bool    `json:"available"`
+	// FirstPublishedAt and LastPublishedAt are the publish times of the product,
+	// they are only set for GetWithDeleted and GetWithUnpublished results.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
+}
+
+// CourseFull is the response payload of a course with the price of its product
+// and its published parts ordered by number.
+type CourseFull struct {
+	Course
+	Parts []CoursePart `json:"parts"`
+}
+
+// NewCourse maps course details returned by the course service to a [Course].
+func NewCourse(d *coursemodel.CourseDetails) Course {
+	out := newCourse(d.Course)
+	out.CreatedAt = d.CreatedAt
+	out.UpdatedAt = d.UpdatedAt
+	out.Price = d.Price
+	out.ProductID = d.ProductID
+	out.Available = d.Available
+	out.FirstPublishedAt = d.FirstPublishedAt
+	out.LastPublishedAt = d.LastPublishedAt
+	return out
+}
+
+// NewCourses maps a list of course details to [Course] DTOs.
+func NewCourses(details []coursemodel.CourseDetails) []Course {
+	return mapSlice(details, NewCourse)
+}
+
+// NewCourseFull maps full course details returned by the course service to a [CourseFull].
+func NewCourseFull(d *coursemodel.CourseFullDetails) CourseFull {
+	out := CourseFull{
+		Course: newCourse(d.Course),
+		Parts:  NewCourseParts(d.Parts),
+	}
+	out.Price = d.Price
+	out.ProductID = d.ProductID
+	out.Available = d.Available
+	return out
+}
+
+func newCourse(c *coursemodel.Course) Course {
+	if c == nil {
+		return Course{}
+	}
+	out := Course{
+		ID:                  c.ID,
+		CreatedAt:           c.CreatedAt,
+		UpdatedAt:           c.UpdatedAt,
+		DeletedAt:           deletedAt(c.DeletedAt),
+		DeletedReason:       c.DeletedReason,
+		Tags:                c.Tags,
+		Name:                c.Name,
+		Topic:               c.Topic,
+		ShortDescription:    c.ShortDescription,
+		LongDescription:     c.LongDescription,
+		InStock:             c.InStock,
+		AccessDuration:      c.AccessDuration,
+		UploadedImageAmount: c.UploadedImageAmount,
+		Images:              NewImages(c.Images),
+	}
+	if c.CourseParts != nil {
+		out.CourseParts = make([]CoursePart, 0, len(c.CourseParts))
+		for _, p := range c.CourseParts {
+			if p != nil {
+				out.CourseParts = append(out.CourseParts, NewCoursePartFromModel(p))
+			}
+		}
+	}
+	return out
+}
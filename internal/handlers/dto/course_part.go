@@ -0,0 +1,91 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package dto
+
+import (
+	"time"
+
+	coursepartmodel "github.com/mikhail5545/product-service-go/internal/models/course_part"
+	videomodel "github.com/mikhail5545/product-service-go/internal/models/video"
+)
+
+// CoursePart is the response payload of a course part. Price and ProductID are set only
+// for sellable parts returned with their product.
+type CoursePart struct {
+	ID               string     `json:"id"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+	DeletedAt        *time.Time `json:"deleted_at"`
+	DeletedReason    string     `json:"deleted_reason,omitempty"`
+	Tags             []string   `json:"tags"`
+	Number           int        `json:"number"`
+	Name             string     `json:"name"`
+	ShortDescription string     `json:"short_description"`
+	LongDescription  string     `json:"long_description"`
+	Published        bool       `json:"published"`
+	CourseID         string     `json:"course_id"`
+	Sellable         bool       `json:"sellable"`
+	VideoID          *string    `json:"video_id,omitempty"`
+	// Video is passed through as returned by media-service-go, it is not a model of this service.
+	Video *videomodel.Video `json:"video,omitempty"`
+
+	Price     float32 `json:"price,omitempty"`
+	ProductID string  `json:"product_id,omitempty"`
+}
+
+// NewCoursePart maps course part details returned by the course part service to a [CoursePart].
+func NewCoursePart(d *coursepartmodel.CoursePartDetails) CoursePart {
+	out := NewCoursePartFromModel(d.CoursePart)
+	out.Price = d.Price
+	out.ProductID = d.ProductID
+	return out
+}
+
+// NewCourseParts maps a list of course part details to [CoursePart] DTOs.
+func NewCourseParts(details []coursepartmodel.CoursePartDetails) []CoursePart {
+	return mapSlice(details, NewCoursePart)
+}
+
+// NewCoursePartFromModel maps a course part record without product data to a [CoursePart].
+func NewCoursePartFromModel(p *coursepartmodel.CoursePart) CoursePart {
+	if p == nil {
+		return CoursePart{}
+	}
+	return CoursePart{
+		ID:               p.ID,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+		DeletedAt:        deletedAt(p.DeletedAt),
+		DeletedReason:    p.DeletedReason,
+		Tags:             p.Tags,
+		Number:           p.Number,
+		Name:             p.Name,
+		ShortDescription: p.ShortDescription,
+		LongDescription:  p.LongDescription,
+		Published:        p.Published,
+		CourseID:         p.CourseID,
+		Sellable:         p.Sellable,
+		VideoID:          p.VideoID,
+		Video:            p.Video,
+	}
+}
+
+// NewCoursePartsFromModels maps a list of course part records to [CoursePart] DTOs.
+func NewCoursePartsFromModels(parts []coursepartmodel.CoursePart) []CoursePart {
+	return mapSlice(parts, NewCoursePartFromModel)
+}
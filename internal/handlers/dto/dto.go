@@ -0,0 +1,76 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package dto provides response payloads of the HTTP API.
+//
+// Handlers never serialize domain models or service detail structs directly. They map them
+// to the types of this package first, so the JSON contract of the API does not change with
+// internal refactors of the models. Every field has an explicit snake_case JSON tag.
+package dto
+
+import (
+	"time"
+
+	imagemodel "github.com/mikhail5545/product-service-go/internal/models/image"
+	"gorm.io/gorm"
+)
+
+// Image is an image attached to a course, seminar, training session or physical good.
+type Image struct {
+	PublicID       string `json:"public_id"`
+	URL            string `json:"url"`
+	SecureURL      string `json:"secure_url"`
+	MediaServiceID string `json:"media_service_id"`
+}
+
+// NewImages maps image models to [Image] DTOs.
+func NewImages(images []imagemodel.Image) []Image {
+	if images == nil {
+		return nil
+	}
+	out := make([]Image, 0, len(images))
+	for _, i := range images {
+		out = append(out, Image{
+			PublicID:       i.PublicID,
+			URL:            i.URL,
+			SecureURL:      i.SecureURL,
+			MediaServiceID: i.MediaServiceID,
+		})
+	}
+	return out
+}
+
+// mapSlice applies fn to every element of in. A nil in is mapped to nil.
+func mapSlice[T, U any](in []T, fn func(*T) U) []U {
+	if in == nil {
+		return nil
+	}
+	out := make([]U, 0, len(in))
+	for i := range in {
+		out = append(out, fn(&in[i]))
+	}
+	return out
+}
+
+// deletedAt returns the deletion time of a soft-deleted record or nil.
+func deletedAt(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	t := d.Time
+	return &t
+}
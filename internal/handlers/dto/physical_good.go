@@ -0,0 +1,101 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package dto
+
+import (
+	"time"
+
+	physicalgoodmodel "github.com/mikhail5545/product-service-go/internal/models/physical_good"
+)
+
+// PhysicalGood is the response payload of a physical good with the price of its product.
+type PhysicalGood struct {
+	ID                  string     `json:"id"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	DeletedAt           *time.Time `json:"deleted_at"`
+	DeletedReason       string     `json:"deleted_reason,omitempty"`
+	Tags                []string   `json:"tags"`
+	Name                string     `json:"name"`
+	ShortDescription    string     `json:"short_description"`
+	LongDescription     string     `json:"long_description"`
+	Amount              int        `json:"amount"`
+	SKU                 string     `json:"sku,omitempty"`
+	InStock             bool       `json:"in_stock"`
+	UploadedImageAmount int        `json:"uploaded_image_amount"`
+	Images              []Image    `json:"images"`
+	ShippingRequired    bool       `json:"shipping_required"`
+	WeightGrams         int        `json:"weight_grams"`
+	Dimensions          Dimensions `json:"dimensions"`
+
+	Price     float32 `json:"price"`
+	ProductID string  `json:"product_id"`
+	Available bool    `json:"available"`
+	// FirstPublishedAt and LastPublishedAt are the publish times of the product,
+	// they are only set for GetWithDeleted and GetWithUnpublished results.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
+}
+
+// Dimensions is the shipping package size of a physical good.
+type Dimensions struct {
+	Length int `json:"length"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// NewPhysicalGood maps physical good details returned by the physical good service to a [PhysicalGood].
+// Price is the price of the product.
+func NewPhysicalGood(d *physicalgoodmodel.PhysicalGoodDetails) PhysicalGood {
+	out := PhysicalGood{
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+		Price:            d.Price,
+		ProductID:        d.ProductID,
+		Available:        d.Available,
+		FirstPublishedAt: d.FirstPublishedAt,
+		LastPublishedAt:  d.LastPublishedAt,
+	}
+	if pg := d.PhysicalGood; pg != nil {
+		out.ID = pg.ID
+		out.DeletedAt = deletedAt(pg.DeletedAt)
+		out.DeletedReason = pg.DeletedReason
+		out.Tags = pg.Tags
+		out.Name = pg.Name
+		out.ShortDescription = pg.ShortDescription
+		out.LongDescription = pg.LongDescription
+		out.Amount = pg.Amount
+		out.SKU = pg.SKU
+		out.InStock = pg.InStock
+		out.UploadedImageAmount = pg.UploadedImageAmount
+		out.Images = NewImages(pg.Images)
+		out.ShippingRequired = pg.ShippingRequired
+		out.WeightGrams = pg.WeightGrams
+		out.Dimensions = Dimensions{
+			Length: pg.Dimensions.Length,
+			Width:  pg.Dimensions.Width,
+			Height: pg.Dimensions.Height,
+		}
+	}
+	return out
+}
+
+// NewPhysicalGoods maps a list of physical good details to [PhysicalGood] DTOs.
+func NewPhysicalGoods(details []physicalgoodmodel.PhysicalGoodDetails) []PhysicalGood {
+	return mapSlice(details, NewPhysicalGood)
+}
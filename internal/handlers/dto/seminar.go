@@ -0,0 +1,121 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package dto
+
+import (
+	"time"
+
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+)
+
+// Seminar is the response payload of a seminar with the prices of its products.
+type Seminar struct {
+	ID                      string     `json:"id"`
+	CreatedAt               time.Time  `json:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at"`
+	DeletedAt               *time.Time `json:"deleted_at"`
+	DeletedReason           string     `json:"deleted_reason,omitempty"`
+	Tags                    []string   `json:"tags"`
+	Name                    string     `json:"name"`
+	Slug                    string     `json:"slug,omitempty"`
+	ShortDescription        string     `json:"short_description"`
+	LongDescription         string     `json:"long_description"`
+	UploadedImageAmount     int        `json:"uploaded_image_amount"`
+	Images                  []Image    `json:"images"`
+	ReservationProductID    *string    `json:"reservation_product_id"`
+	EarlyProductID          *string    `json:"early_product_id"`
+	LateProductID           *string    `json:"late_product_id"`
+	EarlySurchargeProductID *string    `json:"early_surcharge_product_id"`
+	LateSurchargeProductID  *string    `json:"late_surcharge_product_id"`
+	Date                    time.Time  `json:"date"`
+	EndingDate              time.Time  `json:"ending_date"`
+	Place                   string     `json:"place"`
+	LatePaymentDate         time.Time  `json:"late_payment_date"`
+	// Timezone is the zone the dates are displayed in and late prices start in.
+	Timezone string `json:"timezone"`
+	InStock  bool   `json:"in_stock"`
+
+	ReservationPrice               float32 `json:"reservation_price"`
+	EarlyPrice                     float32 `json:"early_price"`
+	LatePrice                      float32 `json:"late_price"`
+	EarlySurchargePrice            float32 `json:"early_surcharge_price"`
+	LateSurchargePrice             float32 `json:"late_surcharge_price"`
+	CurrentPrice                   float32 `json:"current_price"`
+	CurrentPriceProductID          string  `json:"current_price_product_id"`
+	CurrentSurchargePrice          float32 `json:"current_surcharge_price"`
+	CurrentSurchargePriceProductID string  `json:"current_surcharge_price_product_id"`
+	Available                      bool    `json:"available"`
+	// ProductsOmitted is set if products were not requested, all price fields are zero then.
+	ProductsOmitted bool `json:"products_omitted,omitempty"`
+	// FirstPublishedAt is the earliest and LastPublishedAt the latest publish time across the
+	// seminar products, they are only set for GetWithDeleted and GetWithUnpublished results.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
+}
+
+// NewSeminar maps seminar details returned by the seminar service to a [Seminar].
+func NewSeminar(d *seminarmodel.SeminarDetails) Seminar {
+	out := Seminar{
+		CreatedAt:                      d.CreatedAt,
+		UpdatedAt:                      d.UpdatedAt,
+		ReservationPrice:               d.ReservationPrice,
+		EarlyPrice:                     d.EarlyPrice,
+		LatePrice:                      d.LatePrice,
+		EarlySurchargePrice:            d.EarlySurchargePrice,
+		LateSurchargePrice:             d.LateSurchargePrice,
+		CurrentPrice:                   d.CurrentPrice,
+		CurrentPriceProductID:          d.CurrentPriceProductID,
+		CurrentSurchargePrice:          d.CurrentSurchargePrice,
+		CurrentSurchargePriceProductID: d.CurrentSurchargePriceProductID,
+		Available:                      d.Available,
+		ProductsOmitted:                d.ProductsOmitted,
+		FirstPublishedAt:               d.FirstPublishedAt,
+		LastPublishedAt:                d.LastPublishedAt,
+	}
+	if s := d.Seminar; s != nil {
+		out.ID = s.ID
+		out.DeletedAt = deletedAt(s.DeletedAt)
+		out.DeletedReason = s.DeletedReason
+		out.Tags = s.Tags
+		out.Name = s.Name
+		out.Slug = s.Slug
+		out.ShortDescription = s.ShortDescription
+		out.LongDescription = s.LongDescription
+		out.UploadedImageAmount = s.UploadedImageAmount
+		out.Images = NewImages(s.Images)
+		out.ReservationProductID = s.ReservationProductID
+		out.EarlyProductID = s.EarlyProductID
+		out.LateProductID = s.LateProductID
+		out.EarlySurchargeProductID = s.EarlySurchargeProductID
+		out.LateSurchargeProductID = s.LateSurchargeProductID
+		loc := common.DefaultTimezone()
+		out.Date = s.Date.In(loc)
+		out.EndingDate = s.EndingDate.In(loc)
+		out.Place = s.Place
+		out.LatePaymentDate = s.LatePaymentDate.In(loc)
+		out.Timezone = loc.String()
+		out.InStock = s.InStock
+	}
+	return out
+}
+
+// NewSeminars maps a list of seminar details to [Seminar] DTOs.
+func NewSeminars(details []seminarmodel.SeminarDetails) []Seminar {
+	return mapSlice(details, NewSeminar)
+}
@@ -0,0 +1,86 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package dto
+
+import (
+	"time"
+
+	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
+)
+
+// TrainingSession is the response payload of a training session with the price of its product.
+type TrainingSession struct {
+	ID                  string     `json:"id"`
+	CreatedAt           time.Time  `json:"created_at"`
+	UpdatedAt           time.Time  `json:"updated_at"`
+	DeletedAt           *time.Time `json:"deleted_at"`
+	DeletedReason       string     `json:"deleted_reason,omitempty"`
+	Tags                []string   `json:"tags"`
+	UploadedImageAmount int        `json:"uploaded_image_amount"`
+	Images              []Image    `json:"images"`
+	Name                string     `json:"name"`
+	ShortDescription    string     `json:"short_description"`
+	LongDescription     string     `json:"long_description"`
+	InStock             bool       `json:"in_stock"`
+	DurationMinutes     int        `json:"duration_minutes"`
+	Format              string     `json:"format,omitempty"`
+
+	Price     float32 `json:"price"`
+	ProductID string  `json:"product_id"`
+	Available bool    `json:"available"`
+	// ProductsOmitted is set if the product was not requested, Price, ProductID and Available are zero then.
+	ProductsOmitted bool `json:"products_omitted,omitempty"`
+	// FirstPublishedAt and LastPublishedAt are the publish times of the product,
+	// they are only set for GetWithDeleted and GetWithUnpublished results.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
+}
+
+// NewTrainingSession maps training session details returned by the training session service to a [TrainingSession].
+func NewTrainingSession(d *trainingsessionmodel.TrainingSessionDetails) TrainingSession {
+	out := TrainingSession{
+		CreatedAt:        d.CreatedAt,
+		UpdatedAt:        d.UpdatedAt,
+		Price:            d.Price,
+		ProductID:        d.ProductID,
+		Available:        d.Available,
+		ProductsOmitted:  d.ProductsOmitted,
+		FirstPublishedAt: d.FirstPublishedAt,
+		LastPublishedAt:  d.LastPublishedAt,
+	}
+	if ts := d.TrainingSession; ts != nil {
+		out.ID = ts.ID
+		out.DeletedAt = deletedAt(ts.DeletedAt)
+		out.DeletedReason = ts.DeletedReason
+		out.Tags = ts.Tags
+		out.UploadedImageAmount = ts.UploadedImageAmount
+		out.Images = NewImages(ts.Images)
+		out.Name = ts.Name
+		out.ShortDescription = ts.ShortDescription
+		out.LongDescription = ts.LongDescription
+		out.InStock = ts.InStock
+		out.DurationMinutes = ts.DurationMinutes
+		out.Format = ts.Format
+	}
+	return out
+}
+
+// NewTrainingSessions maps a list of training session details to [TrainingSession] DTOs.
+func NewTrainingSessions(details []trainingsessionmodel.TrainingSessionDetails) []TrainingSession {
+	return mapSlice(details, NewTrainingSession)
+}
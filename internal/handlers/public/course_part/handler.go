@@ -22,8 +22,10 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
 	coursepartservice "github.com/mikhail5545/product-service-go/internal/services/course_part"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 type Handler struct {
@@ -35,20 +37,20 @@ func New(s coursepartservice.Service) *Handler {
 }
 
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
-	if errors.Is(err, coursepartservice.ErrNotFound) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	if errors.Is(err, coursepartservice.ErrNotFound) || errors.Is(err, coursepartservice.ErrProductNotFound) {
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, coursepartservice.ErrInvalidArgument) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid course part ID")
+	id, err := request.GetIDParam(c, "id", "Invalid course part ID")
 	if err != nil {
 		return err
 	}
@@ -56,11 +58,11 @@ func (h *Handler) Get(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"course_part_details": details})
+	return response.Write(c, http.StatusOK, map[string]any{"course_part_details": dto.NewCoursePart(details)})
 }
 
 func (h *Handler) List(c echo.Context) error {
-	cid, err := request.GetIDParam(c, ":cid", "Invalid course ID")
+	cid, err := request.GetIDParam(c, "cid", "Invalid course ID")
 	if err != nil {
 		return err
 	}
@@ -72,8 +74,5 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"course_part_details": details,
-		"total":               total,
-	})
+	return response.WritePage(c, http.StatusOK, map[string]any{"course_part_details": dto.NewCourseParts(details)}, total, limit, offset)
 }
@@ -0,0 +1,51 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package featured
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	featuredservice "github.com/mikhail5545/product-service-go/internal/services/featured"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+type Handler struct {
+	service featuredservice.Service
+}
+
+func New(s featuredservice.Service) *Handler {
+	return &Handler{service: s}
+}
+
+func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
+	return response.Write(c, code, map[string]any{"error": msg})
+}
+
+func (h *Handler) HandleServiceError(c echo.Context, err error) error {
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+}
+
+// List serves the featured products in their curated order, each with the record it sells.
+func (h *Handler) List(c echo.Context) error {
+	products, err := h.service.List(c.Request().Context())
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"products": products})
+}
@@ -0,0 +1,57 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package meta serves the enum values accepted by the API, so clients don't have to hard-code them.
+package meta
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+// Meta lists the allowed enum values. Every list is read from the same variable the request
+// validation uses, so it never goes out of sync with what the API accepts.
+type Meta struct {
+	TrainingSessionFormats []string                   `json:"training_session_formats"`
+	DetailsTypes           []string                   `json:"details_types"`
+	SeminarProductRoles    []seminarmodel.ProductRole `json:"seminar_product_roles"`
+	// Currencies lists the currencies prices are in. All prices share the configured default currency.
+	Currencies []string `json:"currencies"`
+}
+
+type Handler struct{}
+
+func New() *Handler {
+	return &Handler{}
+}
+
+// Get serves the allowed enum values.
+func (h *Handler) Get(c echo.Context) error {
+	meta := Meta{
+		TrainingSessionFormats: trainingsessionmodel.Formats,
+		DetailsTypes:           productmodel.DetailsTypes,
+		SeminarProductRoles:    seminarmodel.AllProductRoles,
+		Currencies:             []string{common.DefaultCurrency()},
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"meta": meta})
+}
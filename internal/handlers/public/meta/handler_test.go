@@ -0,0 +1,113 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package meta
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getMeta(t *testing.T) Meta {
+	t.Helper()
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := New().Get(c)
+
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var body struct {
+		Meta Meta `json:"meta"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body.Meta
+}
+
+func TestHandler_Get(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		// Act
+		meta := getMeta(t)
+
+		// Assert
+		assert.Equal(t, trainingsessionmodel.Formats, meta.TrainingSessionFormats)
+		assert.Equal(t, productmodel.DetailsTypes, meta.DetailsTypes)
+		assert.Equal(t, seminarmodel.AllProductRoles, meta.SeminarProductRoles)
+		assert.Equal(t, []string{common.DefaultCurrency()}, meta.Currencies)
+	})
+
+	t.Run("currencies follow the configured default", func(t *testing.T) {
+		// Arrange
+		original := common.DefaultCurrency()
+		require.NoError(t, common.SetDefaultCurrency("EUR"))
+		defer func() { _ = common.SetDefaultCurrency(original) }()
+
+		// Act
+		meta := getMeta(t)
+
+		// Assert
+		assert.Equal(t, []string{"EUR"}, meta.Currencies)
+	})
+
+	t.Run("formats follow validation", func(t *testing.T) {
+		// Arrange
+		original := trainingsessionmodel.Formats
+		trainingsessionmodel.Formats = append(slices.Clone(original), "hybrid")
+		defer func() { trainingsessionmodel.Formats = original }()
+		req := trainingsessionmodel.CreateRequest{
+			Name:             "Training session",
+			ShortDescription: "Training session description",
+			Price:            33.33,
+			DurationMinutes:  30,
+		}
+
+		// Act
+		meta := getMeta(t)
+
+		// Assert
+		assert.Contains(t, meta.TrainingSessionFormats, "hybrid")
+		for _, format := range meta.TrainingSessionFormats {
+			req.Format = format
+			assert.NoError(t, req.Validate(), format)
+		}
+		req.Format = "unknown"
+		assert.Error(t, req.Validate())
+	})
+
+	t.Run("details types follow validation", func(t *testing.T) {
+		// Act
+		meta := getMeta(t)
+
+		// Assert
+		for _, detailsType := range meta.DetailsTypes {
+			assert.True(t, productmodel.IsValidDetailsType(detailsType), detailsType)
+		}
+	})
+}
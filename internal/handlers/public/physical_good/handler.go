@@ -22,8 +22,10 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
 	physicalgoodservice "github.com/mikhail5545/product-service-go/internal/services/physical_good"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 type Handler struct {
@@ -35,20 +37,20 @@ func New(s physicalgoodservice.Service) *Handler {
 }
 
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, physicalgoodservice.ErrNotFound) || errors.Is(err, physicalgoodservice.ErrImageNotFoundOnOwner) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, physicalgoodservice.ErrInvalidArgument) || errors.Is(err, physicalgoodservice.ErrImageLimitExceeded) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
@@ -56,7 +58,11 @@ func (h *Handler) Get(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"physical_good_details": details})
+	projected, err := response.Project(dto.NewPhysicalGood(details), request.GetFieldsParam(c))
+	if err != nil {
+		return err
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"physical_good_details": projected})
 }
 
 func (h *Handler) List(c echo.Context) error {
@@ -68,8 +74,9 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"physical_good_details": details,
-		"total":                 total,
-	})
+	projected, err := response.Project(dto.NewPhysicalGoods(details), request.GetFieldsParam(c))
+	if err != nil {
+		return err
+	}
+	return response.WritePage(c, http.StatusOK, map[string]any{"physical_good_details": projected}, total, limit, offset)
 }
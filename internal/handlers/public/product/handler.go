@@ -0,0 +1,165 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	productservice "github.com/mikhail5545/product-service-go/internal/services/product"
+	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+type Handler struct {
+	service productservice.Service
+}
+
+func New(s productservice.Service) *Handler {
+	return &Handler{service: s}
+}
+
+func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
+	return response.Write(c, code, map[string]any{"error": msg})
+}
+
+func (h *Handler) HandleServiceError(c echo.Context, err error) error {
+	if errors.Is(err, productservice.ErrNotFound) || errors.Is(err, productservice.ErrDetailsNotFound) {
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
+	} else if errors.Is(err, productservice.ErrInvalidArgument) {
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	}
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+}
+
+// List serves paginated published products. Whether unpublished (out of stock) products are included
+// is decided by the stock policy of the route group, see [request.GetIncludeOutOfStock]; the public one
+// never includes them. With 'available=true' only products which sale window contains the current time
+// are returned. With 'type' only products of that details type are returned. With 'updated_since' (RFC3339)
+// only products changed after that moment are returned. The filters can be combined, a product has to
+// match all of them.
+//
+// 'updated_since' is only offered here: products carry the price and publish state of every details type,
+// so incremental sync follows them, the per-type List endpoints don't take the parameter.
+func (h *Handler) List(c echo.Context) error {
+	limit, offset, err := request.GetPaginationParams(c, 10, 0)
+	if err != nil {
+		return err
+	}
+	available, err := request.GetBoolQueryParam(c, "available", false)
+	if err != nil {
+		return err
+	}
+	includeOutOfStock, err := request.GetIncludeOutOfStock(c)
+	if err != nil {
+		return err
+	}
+	filter := productmodel.ProductFilter{DetailsType: c.QueryParam("type")}
+	if !includeOutOfStock {
+		published := true
+		filter.InStock = &published
+	}
+	if available {
+		now := time.Now()
+		filter.AvailableAt = &now
+	}
+	if updatedSince := c.QueryParam("updated_since"); updatedSince != "" {
+		since, err := time.Parse(time.RFC3339Nano, updatedSince)
+		if err != nil {
+			return h.ServeError(c, http.StatusBadRequest, "Invalid updated_since parameter, expected RFC3339 timestamp")
+		}
+		filter.UpdatedAfter = &since
+	}
+	products, total, err := h.service.ListFiltered(c.Request().Context(), filter, limit, offset)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.WritePage(c, http.StatusOK, map[string]any{"products": products}, total, limit, offset)
+}
+
+// ListUpdatedSince serves the change feed, including unpublished and soft-deleted products with their state.
+// The 'since' query param is an RFC3339 timestamp (zero time if omitted) and 'after_id' is the ID of the last
+// product seen at it, 'limit' defaults to 100. The returned 'watermark' and 'watermark_id' should be passed
+// as 'since' and 'after_id' on the next poll.
+func (h *Handler) ListUpdatedSince(c echo.Context) error {
+	after := productmodel.ChangeCursor{ID: c.QueryParam("after_id")}
+	if sinceStr := c.QueryParam("since"); sinceStr != "" {
+		var err error
+		after.UpdatedAt, err = time.Parse(time.RFC3339Nano, sinceStr)
+		if err != nil {
+			return h.ServeError(c, http.StatusBadRequest, "Invalid since parameter, expected RFC3339 timestamp")
+		}
+	}
+	limit := 100
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		var err error
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return h.ServeError(c, http.StatusBadRequest, "Invalid limit parameter")
+		}
+	}
+	products, watermark, err := h.service.ListUpdatedSince(c.Request().Context(), after, limit)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{
+		"products":     products,
+		"watermark":    watermark.UpdatedAt,
+		"watermark_id": watermark.ID,
+	})
+}
+
+// Related serves the "you might also like" products for a product page: published products
+// sharing the most tags with the given one. 'limit' defaults to 5.
+func (h *Handler) Related(c echo.Context) error {
+	id, err := request.GetIDParam(c, "id", "Invalid product ID")
+	if err != nil {
+		return err
+	}
+	limit := 5
+	if limitStr := c.QueryParam("limit"); limitStr != "" {
+		limit, err = strconv.Atoi(limitStr)
+		if err != nil || limit <= 0 {
+			return h.ServeError(c, http.StatusBadRequest, "Invalid limit parameter")
+		}
+	}
+	products, err := h.service.Related(c.Request().Context(), id, limit)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"products": products})
+}
+
+// GetDetails serves a product resolved to the record it sells, in the same shape for every
+// details type, for callers that only hold a product ID such as the cart. Unpublished products
+// are served too, with 'in_stock' false, so a stale cart item can be told apart from a missing one.
+func (h *Handler) GetDetails(c echo.Context) error {
+	id, err := request.GetIDParam(c, "id", "Invalid product ID")
+	if err != nil {
+		return err
+	}
+	owner, err := h.service.GetOwner(c.Request().Context(), id)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"product_details": owner})
+}
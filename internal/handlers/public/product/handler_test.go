@@ -0,0 +1,345 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/models/product"
+	productservice "github.com/mikhail5545/product-service-go/internal/services/product"
+	productmock "github.com/mikhail5545/product-service-go/internal/test/services/product_mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_List_ByType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := productmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	productID := uuid.New().String()
+	published := true
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?type=seminar&limit=1&offset=2", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().ListFiltered(gomock.Any(), product.ProductFilter{DetailsType: "seminar", InStock: &published}, 1, 2).
+			Return([]product.Product{{ID: productID, DetailsType: "seminar", Price: 10}}, int64(3), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), productID)
+		assert.Contains(t, rec.Body.String(), `"total":3`)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?type=video", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().ListFiltered(gomock.Any(), product.ProductFilter{DetailsType: "video", InStock: &published}, 10, 0).
+			Return(nil, int64(0), fmt.Errorf("%w: unknown details type", productservice.ErrInvalidArgument))
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("combined with available and updated_since", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?type=course&available=true&updated_since=2025-01-01T12:00:00Z", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		since := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+		mockService.EXPECT().ListFiltered(gomock.Any(), gomock.Cond(func(f product.ProductFilter) bool {
+			return f.DetailsType == "course" && f.InStock != nil && *f.InStock &&
+				f.AvailableAt != nil && time.Since(*f.AvailableAt) < time.Minute &&
+				f.UpdatedAfter != nil && f.UpdatedAfter.Equal(since)
+		}), 10, 0).Return([]product.Product{{ID: productID, DetailsType: "course"}}, int64(1), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), productID)
+	})
+}
+
+func TestHandler_List_ExcludesOutOfStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := productmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	// Arrange
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/?include_out_of_stock=true", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	published := true
+	inStockID := uuid.New().String()
+	mockService.EXPECT().ListFiltered(gomock.Any(), product.ProductFilter{InStock: &published}, 10, 0).
+		Return([]product.Product{{ID: inStockID, InStock: true}}, int64(1), nil)
+
+	// Act
+	err := handler.List(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), inStockID)
+	assert.NotContains(t, rec.Body.String(), `"in_stock":false`)
+}
+
+func TestHandler_List_UpdatedSince(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := productmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?updated_since=2025-01-01T12:00:00Z", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		published := true
+		since := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+		productID := uuid.New().String()
+		mockService.EXPECT().ListFiltered(gomock.Any(), product.ProductFilter{InStock: &published, UpdatedAfter: &since}, 10, 0).
+			Return([]product.Product{{ID: productID, InStock: true}}, int64(1), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), productID)
+	})
+
+	t.Run("malformed timestamp", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?updated_since=yesterday", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestHandler_ListUpdatedSince(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := productmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	since := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	afterID := uuid.New().String()
+
+	t.Run("passes cursor and returns watermark", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/?since=%s&after_id=%s", since.Format(time.RFC3339), afterID), nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		deleted := product.Product{ID: uuid.New().String(), UpdatedAt: since, State: product.StateDeleted}
+		mockService.EXPECT().
+			ListUpdatedSince(gomock.Any(), product.ChangeCursor{UpdatedAt: since, ID: afterID}, 100).
+			Return([]product.Product{deleted}, deleted.Cursor(), nil)
+
+		// Act
+		err := handler.ListUpdatedSince(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"state":"deleted"`)
+		assert.Contains(t, rec.Body.String(), fmt.Sprintf(`"watermark_id":"%s"`, deleted.ID))
+	})
+
+	t.Run("invalid since", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?since=yesterday", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.ListUpdatedSince(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestHandler_Related(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := productmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	id := uuid.New().String()
+
+	t.Run("default limit", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		mockService.EXPECT().Related(gomock.Any(), id, 5).Return([]product.Product{{ID: "related-id"}}, nil)
+
+		// Act
+		err := handler.Related(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":"related-id"`)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?limit=0", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		// Act
+		err := handler.Related(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		mockService.EXPECT().Related(gomock.Any(), id, 5).Return(nil, productservice.ErrNotFound)
+
+		// Act
+		err := handler.Related(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestHandler_GetDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := productmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	id := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		mockService.EXPECT().GetOwner(gomock.Any(), id).Return(&product.ProductOwner{
+			Type: "training_session", Name: "Session", Price: 50, Currency: "RUB", InStock: true,
+		}, nil)
+
+		// Act
+		err := handler.GetDetails(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"type":"training_session"`)
+		assert.Contains(t, rec.Body.String(), `"currency":"RUB"`)
+	})
+
+	t.Run("orphaned product", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(id)
+
+		mockService.EXPECT().GetOwner(gomock.Any(), id).Return(nil, productservice.ErrDetailsNotFound)
+
+		// Act
+		err := handler.GetDetails(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -22,8 +22,11 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 type Handler struct {
@@ -35,28 +38,84 @@ func New(s seminarservice.Service) *Handler {
 }
 
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
-	if errors.Is(err, seminarservice.ErrNotFound) || errors.Is(err, seminarservice.ErrImageNotFoundOnOwner) || errors.Is(err, seminarservice.ErrProductsNotFound) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	if errors.Is(err, seminarservice.ErrGone) {
+		return response.Write(c, http.StatusGone, map[string]any{"error": seminarservice.ErrGone.Error()})
+	} else if errors.Is(err, seminarservice.ErrNotFound) || errors.Is(err, seminarservice.ErrImageNotFoundOnOwner) || errors.Is(err, seminarservice.ErrProductsNotFound) {
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, seminarservice.ErrInvalidArgument) || errors.Is(err, seminarservice.ErrImageLimitExceeded) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid seminar ID")
+	id, err := request.GetIDParam(c, "id", "Invalid seminar ID")
 	if err != nil {
 		return err
 	}
-	details, err := h.service.Get(c.Request().Context(), id)
+	details, err := h.service.Get(c.Request().Context(), id, request.GetIncludeParam(c, "products", true))
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"seminar_details": details})
+	projected, err := response.Project(dto.NewSeminar(details), request.GetFieldsParam(c))
+	if err != nil {
+		return err
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"seminar_details": projected})
+}
+
+// GetBySlug serves a seminar by its URL-safe slug, the same way [Handler.Get] does by ID.
+func (h *Handler) GetBySlug(c echo.Context) error {
+	details, err := h.service.GetBySlug(c.Request().Context(), c.Param("slug"), request.GetIncludeParam(c, "products", true))
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	projected, err := response.Project(dto.NewSeminar(details), request.GetFieldsParam(c))
+	if err != nil {
+		return err
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"seminar_details": projected})
+}
+
+// GetByIDs serves multiple seminars at once. Seminars that could not be served are listed
+// under "errors" with the reason, keyed by the requested ID.
+func (h *Handler) GetByIDs(c echo.Context) error {
+	var req common.IDsRequest
+	if err := request.BindAndValidateJSON(c, &req); err != nil {
+		return err
+	}
+	if len(req.IDs) == 0 {
+		return h.ServeError(c, http.StatusBadRequest, "No seminar IDs provided")
+	}
+	found, errs := h.service.GetByIDs(c.Request().Context(), req.IDs)
+	seminars := make(map[string]dto.Seminar, len(found))
+	for id, details := range found {
+		seminars[id] = dto.NewSeminar(details)
+	}
+	messages := make(map[string]string, len(errs))
+	for id, err := range errs {
+		messages[id] = errorMessage(err)
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"seminars": seminars, "errors": messages})
+}
+
+// errorMessage returns the message of a known service error and hides internal ones.
+func errorMessage(err error) string {
+	for _, known := range []error{
+		seminarservice.ErrInvalidArgument,
+		seminarservice.ErrNotFound,
+		seminarservice.ErrIncompleteData,
+		seminarservice.ErrProductsNotFound,
+	} {
+		if errors.Is(err, known) {
+			return err.Error()
+		}
+	}
+	return "Internal server error"
 }
 
 func (h *Handler) List(c echo.Context) error {
@@ -68,8 +127,9 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"seminar_details": details,
-		"total":           total,
-	})
+	projected, err := response.Project(dto.NewSeminars(details), request.GetFieldsParam(c))
+	if err != nil {
+		return err
+	}
+	return response.WritePage(c, http.StatusOK, map[string]any{"seminar_details": projected}, total, limit, offset)
 }
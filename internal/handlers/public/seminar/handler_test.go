@@ -0,0 +1,572 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package seminar
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
+	"github.com/mikhail5545/product-service-go/internal/models/image"
+	"github.com/mikhail5545/product-service-go/internal/models/seminar"
+	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/services/seminar_mock"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_Get_Fields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	seminarID := uuid.New().String()
+	mockDetails := &seminar.SeminarDetails{
+		Seminar:          &seminar.Seminar{ID: seminarID, Name: "Seminar name"},
+		ReservationPrice: 34.44,
+		EarlyPrice:       44.44,
+		LatePrice:        366.44,
+		CurrentPrice:     44.44,
+	}
+
+	t.Run("subset of fields", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?fields=current_price,reservation_price,unknown", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(seminarID)
+
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(mockDetails, nil)
+
+		// Act
+		err := handler.Get(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"seminar_details":{"current_price":44.44,"reservation_price":34.44},"api_version":"v0"}`, rec.Body.String())
+	})
+
+	t.Run("no fields param returns full details", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(seminarID)
+
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(mockDetails, nil)
+
+		// Act
+		err := handler.Get(c)
+
+		// Assert
+		assert.NoError(t, err)
+		expectedJSON, _ := json.Marshal(map[string]any{"seminar_details": dto.NewSeminar(mockDetails), "api_version": "v0"})
+		assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+	})
+}
+
+func TestHandler_Get_Include(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	seminarID := uuid.New().String()
+
+	tests := []struct {
+		name            string
+		query           string
+		includeProducts bool
+	}{
+		{name: "include products", query: "/?include=products", includeProducts: true},
+		{name: "empty include omits products", query: "/?include=", includeProducts: false},
+		{name: "other include omits products", query: "/?include=images", includeProducts: false},
+		{name: "no include param defaults to products", query: "/", includeProducts: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, tt.query, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(seminarID)
+
+			details := &seminar.SeminarDetails{
+				Seminar:         &seminar.Seminar{ID: seminarID},
+				ProductsOmitted: !tt.includeProducts,
+			}
+			mockService.EXPECT().Get(gomock.Any(), seminarID, tt.includeProducts).Return(details, nil)
+
+			// Act
+			err := handler.Get(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusOK, rec.Code)
+		})
+	}
+}
+
+func TestHandler_Get_Deleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	seminarID := uuid.New().String()
+
+	tests := []struct {
+		name       string
+		details    *seminar.SeminarDetails
+		err        error
+		wantStatus int
+	}{
+		{
+			name:       "live",
+			details:    &seminar.SeminarDetails{Seminar: &seminar.Seminar{ID: seminarID}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "soft-deleted",
+			err:        fmt.Errorf("%w: %w", seminarservice.ErrNotFound, seminarservice.ErrGone),
+			wantStatus: http.StatusGone,
+		},
+		{
+			name:       "unknown",
+			err:        seminarservice.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(seminarID)
+
+			mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(tt.details, tt.err)
+
+			// Act
+			err := handler.Get(c)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestHandler_GetBySlug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	t.Run("found", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/?fields=id,slug", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("my-great-seminar")
+
+		details := &seminar.SeminarDetails{Seminar: &seminar.Seminar{ID: "seminar-id", Slug: "my-great-seminar"}}
+		mockService.EXPECT().GetBySlug(gomock.Any(), "my-great-seminar", true).Return(details, nil)
+
+		// Act
+		err := handler.GetBySlug(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"seminar_details":{"id":"seminar-id","slug":"my-great-seminar"},"api_version":"v0"}`, rec.Body.String())
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("missing")
+
+		mockService.EXPECT().GetBySlug(gomock.Any(), "missing", true).Return(nil, seminarservice.ErrNotFound)
+
+		// Act
+		err := handler.GetBySlug(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("soft-deleted", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("slug")
+		c.SetParamValues("old-seminar")
+
+		mockService.EXPECT().GetBySlug(gomock.Any(), "old-seminar", true).
+			Return(nil, fmt.Errorf("%w: %w", seminarservice.ErrNotFound, seminarservice.ErrGone))
+
+		// Act
+		err := handler.GetBySlug(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusGone, rec.Code)
+		assert.JSONEq(t, `{"error":"seminar has been deleted","api_version":"v0"}`, rec.Body.String())
+	})
+}
+
+func TestHandler_GetByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	foundID, missingID, brokenID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+
+	t.Run("partial results", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		body := `{"ids":["` + foundID + `","` + missingID + `","` + brokenID + `"]}`
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().GetByIDs(gomock.Any(), []string{foundID, missingID, brokenID}).Return(
+			map[string]*seminar.SeminarDetails{foundID: {Seminar: &seminar.Seminar{ID: foundID}}},
+			map[string]error{missingID: seminarservice.ErrNotFound, brokenID: errors.New("database error")},
+		)
+
+		// Act
+		err := handler.GetByIDs(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var resp struct {
+			Seminars map[string]map[string]any `json:"seminars"`
+			Errors   map[string]string         `json:"errors"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Len(t, resp.Seminars, 1)
+		assert.Equal(t, foundID, resp.Seminars[foundID]["id"])
+		assert.Equal(t, map[string]string{
+			missingID: seminarservice.ErrNotFound.Error(),
+			brokenID:  "Internal server error",
+		}, resp.Errors)
+	})
+
+	t.Run("no ids", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"ids":[]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		// Act
+		err := handler.GetByIDs(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestHandler_List_Fields(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	mockDetails := []seminar.SeminarDetails{
+		{Seminar: &seminar.Seminar{ID: uuid.New().String()}, EarlyPrice: 10, LatePrice: 20},
+		{Seminar: &seminar.Seminar{ID: uuid.New().String()}, EarlyPrice: 30, LatePrice: 40},
+	}
+
+	// Arrange
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/?fields=late_price", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	mockService.EXPECT().List(gomock.Any(), 10, 0).Return(mockDetails, int64(2), nil)
+
+	// Act
+	err := handler.List(c)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"seminar_details":[{"late_price":20},{"late_price":40}],"total":2,"has_more":false,"api_version":"v0"}`, rec.Body.String())
+}
+
+func TestHandler_Msgpack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	seminarID := uuid.New().String()
+	mockDetails := &seminar.SeminarDetails{
+		Seminar:          &seminar.Seminar{ID: seminarID, Name: "Seminar name", Tags: []string{"yoga"}},
+		ReservationPrice: 34.44,
+		EarlyPrice:       44.44,
+		LatePrice:        366.44,
+		CurrentPrice:     44.44,
+	}
+
+	t.Run("Get msgpack", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderAccept, "application/msgpack")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(seminarID)
+
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(mockDetails, nil)
+
+		// Act
+		err := handler.Get(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, response.MIMEApplicationMsgpack, rec.Header().Get(echo.HeaderContentType))
+		var resp struct {
+			Details    dto.Seminar `json:"seminar_details"`
+			APIVersion string      `json:"api_version"`
+		}
+		assert.NoError(t, response.UnmarshalMsgpack(rec.Body.Bytes(), &resp))
+		assert.Equal(t, response.APIVersion, resp.APIVersion)
+		assert.Equal(t, dto.NewSeminar(mockDetails), resp.Details)
+	})
+
+	t.Run("List msgpack", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderAccept, "application/json;q=0.5, application/msgpack")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().List(gomock.Any(), 10, 0).Return([]seminar.SeminarDetails{*mockDetails}, int64(1), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, response.MIMEApplicationMsgpack, rec.Header().Get(echo.HeaderContentType))
+		var resp struct {
+			Details []dto.Seminar `json:"seminar_details"`
+			Total   int64         `json:"total"`
+		}
+		assert.NoError(t, response.UnmarshalMsgpack(rec.Body.Bytes(), &resp))
+		assert.Equal(t, int64(1), resp.Total)
+		if assert.Len(t, resp.Details, 1) {
+			assert.Equal(t, seminarID, resp.Details[0].ID)
+			assert.Equal(t, mockDetails.EarlyPrice, resp.Details[0].EarlyPrice)
+		}
+	})
+
+	t.Run("JSON by default", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderAccept, "*/*")
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(seminarID)
+
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(mockDetails, nil)
+
+		// Act
+		err := handler.Get(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+		var resp struct {
+			Details dto.Seminar `json:"seminar_details"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, seminarID, resp.Details.ID)
+	})
+}
+
+// seminarContractJSON is the documented JSON shape of a seminar in "seminar_details".
+const seminarContractJSON = `{
+	"id": "5b7c6f2e-2a5d-4c1e-9d7a-3f1b2c4d5e6f",
+	"created_at": "2030-01-01T10:00:00Z",
+	"updated_at": "2030-01-02T10:00:00Z",
+	"deleted_at": null,
+	"tags": ["yoga"],
+	"name": "Seminar name",
+	"short_description": "Short",
+	"long_description": "Long",
+	"uploaded_image_amount": 1,
+	"images": [{"public_id": "img", "url": "http://img", "secure_url": "https://img", "media_service_id": "m-1"}],
+	"reservation_product_id": "r-1",
+	"early_product_id": "e-1",
+	"late_product_id": "l-1",
+	"early_surcharge_product_id": null,
+	"late_surcharge_product_id": null,
+	"date": "2030-03-01T10:00:00Z",
+	"ending_date": "2030-03-03T10:00:00Z",
+	"place": "Place",
+	"late_payment_date": "2030-02-01T10:00:00Z",
+	"timezone": "UTC",
+	"in_stock": true,
+	"reservation_price": 10,
+	"early_price": 20,
+	"late_price": 30,
+	"early_surcharge_price": 0,
+	"late_surcharge_price": 0,
+	"current_price": 20,
+	"current_price_product_id": "e-1",
+	"current_surcharge_price": 0,
+	"current_surcharge_price_product_id": "",
+	"available": true
+}`
+
+func contractSeminarDetails() *seminar.SeminarDetails {
+	at := func(s string) time.Time {
+		t, _ := time.Parse(time.RFC3339, s)
+		return t
+	}
+	reservationID, earlyID, lateID := "r-1", "e-1", "l-1"
+	return &seminar.SeminarDetails{
+		Seminar: &seminar.Seminar{
+			ID:                   "5b7c6f2e-2a5d-4c1e-9d7a-3f1b2c4d5e6f",
+			CreatedAt:            at("2030-01-01T10:00:00Z"),
+			UpdatedAt:            at("2030-01-02T10:00:00Z"),
+			Tags:                 []string{"yoga"},
+			Name:                 "Seminar name",
+			ShortDescription:     "Short",
+			LongDescription:      "Long",
+			UploadedImageAmount:  1,
+			Images:               []image.Image{{PublicID: "img", URL: "http://img", SecureURL: "https://img", MediaServiceID: "m-1"}},
+			ReservationProductID: &reservationID,
+			EarlyProductID:       &earlyID,
+			LateProductID:        &lateID,
+			Date:                 at("2030-03-01T10:00:00Z"),
+			EndingDate:           at("2030-03-03T10:00:00Z"),
+			Place:                "Place",
+			LatePaymentDate:      at("2030-02-01T10:00:00Z"),
+			InStock:              true,
+		},
+		ReservationPrice:      10,
+		EarlyPrice:            20,
+		LatePrice:             30,
+		CurrentPrice:          20,
+		CurrentPriceProductID: "e-1",
+		Available:             true,
+		CreatedAt:             at("2030-01-01T10:00:00Z"),
+		UpdatedAt:             at("2030-01-02T10:00:00Z"),
+	}
+}
+
+func TestHandler_Contract(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockService := seminarmock.NewMockService(ctrl)
+	handler := New(mockService)
+
+	details := contractSeminarDetails()
+
+	t.Run("Get", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetParamNames("id")
+		c.SetParamValues(details.ID)
+
+		mockService.EXPECT().Get(gomock.Any(), details.ID, true).Return(details, nil)
+
+		// Act
+		err := handler.Get(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"seminar_details":`+seminarContractJSON+`,"api_version":"v0"}`, rec.Body.String())
+	})
+
+	t.Run("List", func(t *testing.T) {
+		// Arrange
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().List(gomock.Any(), 10, 0).Return([]seminar.SeminarDetails{*details}, int64(1), nil)
+
+		// Act
+		err := handler.List(c)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"seminar_details":[`+seminarContractJSON+`],"total":1,"has_more":false,"api_version":"v0"}`, rec.Body.String())
+	})
+}
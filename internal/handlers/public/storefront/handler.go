@@ -0,0 +1,61 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package storefront
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	storefrontservice "github.com/mikhail5545/product-service-go/internal/services/storefront"
+	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+type Handler struct {
+	service storefrontservice.Service
+}
+
+func New(s storefrontservice.Service) *Handler {
+	return &Handler{service: s}
+}
+
+func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
+	return response.Write(c, code, map[string]any{"error": msg})
+}
+
+func (h *Handler) HandleServiceError(c echo.Context, err error) error {
+	if errors.Is(err, storefrontservice.ErrInvalidArgument) {
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+	}
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+}
+
+// Feed serves a page of published items of every product type, newest first.
+// The order is stable, so paging through with 'limit' and 'offset' returns every item once.
+func (h *Handler) Feed(c echo.Context) error {
+	limit, offset, err := request.GetPaginationParams(c, 10, 0)
+	if err != nil {
+		return err
+	}
+	items, total, err := h.service.Feed(c.Request().Context(), limit, offset)
+	if err != nil {
+		return h.HandleServiceError(c, err)
+	}
+	return response.WritePage(c, http.StatusOK, map[string]any{"items": items}, total, limit, offset)
+}
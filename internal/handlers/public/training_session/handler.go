@@ -22,8 +22,10 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
 	trainingsessionservice "github.com/mikhail5545/product-service-go/internal/services/training_session"
 	"github.com/mikhail5545/product-service-go/internal/util/request"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 )
 
 type Handler struct {
@@ -35,28 +37,32 @@ func New(s trainingsessionservice.Service) *Handler {
 }
 
 func (h *Handler) ServeError(c echo.Context, code int, msg string) error {
-	return c.JSON(code, map[string]string{"error": msg})
+	return response.Write(c, code, map[string]any{"error": msg})
 }
 
 func (h *Handler) HandleServiceError(c echo.Context, err error) error {
 	if errors.Is(err, trainingsessionservice.ErrNotFound) || errors.Is(err, trainingsessionservice.ErrImageNotFoundOnOwner) {
-		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 	} else if errors.Is(err, trainingsessionservice.ErrInvalidArgument) || errors.Is(err, trainingsessionservice.ErrImageLimitExceeded) {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
 	}
-	return c.JSON(http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
+	return response.Write(c, http.StatusInternalServerError, map[string]any{"error": "Internal server error"})
 }
 
 func (h *Handler) Get(c echo.Context) error {
-	id, err := request.GetIDParam(c, ":id", "Invalid training session ID")
+	id, err := request.GetIDParam(c, "id", "Invalid training session ID")
 	if err != nil {
 		return err
 	}
-	details, err := h.service.Get(c.Request().Context(), id)
+	details, err := h.service.Get(c.Request().Context(), id, request.GetIncludeParam(c, "products", true))
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{"training_session_details": details})
+	projected, err := response.Project(dto.NewTrainingSession(details), request.GetFieldsParam(c))
+	if err != nil {
+		return err
+	}
+	return response.Write(c, http.StatusOK, map[string]any{"training_session_details": projected})
 }
 
 func (h *Handler) List(c echo.Context) error {
@@ -68,8 +74,9 @@ func (h *Handler) List(c echo.Context) error {
 	if err != nil {
 		return h.HandleServiceError(c, err)
 	}
-	return c.JSON(http.StatusOK, map[string]any{
-		"training_session_details": details,
-		"total":                    total,
-	})
+	projected, err := response.Project(dto.NewTrainingSessions(details), request.GetFieldsParam(c))
+	if err != nil {
+		return err
+	}
+	return response.WritePage(c, http.StatusOK, map[string]any{"training_session_details": projected}, total, limit, offset)
 }
@@ -0,0 +1,57 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// skipCascadeKey is the context key set by [WithoutCascade].
+type skipCascadeKey struct{}
+
+// WithoutCascade returns a copy of ctx that turns [CascadeProductDelete] off for statements run with it.
+// Callers that soft-delete products themselves, behind their own count guards, use it so the
+// products are not deleted twice.
+func WithoutCascade(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipCascadeKey{}, true)
+}
+
+// CascadeSkipped reports whether ctx was returned by [WithoutCascade].
+func CascadeSkipped(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipCascadeKey{}).(bool)
+	return skip
+}
+
+// CascadeProductDelete soft-deletes products of the details record detailsID, products that are already
+// soft-deleted are left untouched. UpdatedAt is bumped along with DeletedAt, like the product repository
+// soft-delete does. Details models call it from their AfterDelete hooks, so a details record
+// can't be soft-deleted through gorm without its products.
+//
+// It does nothing if detailsID is empty, as for deletes by condition,
+// or if the statement context was returned by [WithoutCascade].
+func CascadeProductDelete(tx *gorm.DB, detailsID string) error {
+	if detailsID == "" || CascadeSkipped(tx.Statement.Context) {
+		return nil
+	}
+	now := time.Now()
+	return tx.Table("products").Where("details_id = ? AND deleted_at IS NULL", detailsID).
+		Updates(map[string]any{"deleted_at": now, "updated_at": now}).Error
+}
@@ -0,0 +1,146 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// testDetails is a minimal details model wired to the product cascade like the real ones.
+type testDetails struct {
+	ID        string `gorm:"primaryKey"`
+	DeletedAt gorm.DeletedAt
+}
+
+func (d *testDetails) AfterDelete(tx *gorm.DB) error {
+	return CascadeProductDelete(tx, d.ID)
+}
+
+// testProduct maps the columns of the products table the cascade works with.
+type testProduct struct {
+	ID        string `gorm:"primaryKey"`
+	DetailsID string
+	UpdatedAt time.Time
+	DeletedAt gorm.DeletedAt
+}
+
+func (testProduct) TableName() string { return "products" }
+
+func TestCascadeProductDelete(t *testing.T) {
+	newTestDB := func(t *testing.T) *gorm.DB {
+		t.Helper()
+		dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())
+		db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+		if err != nil {
+			t.Fatalf("failed to connect database: %v", err)
+		}
+		if err := db.AutoMigrate(&testDetails{}, &testProduct{}); err != nil {
+			t.Fatalf("failed to migrate database: %v", err)
+		}
+		return db
+	}
+	deletedAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	// seed creates a details record with two live products and one product soft-deleted at deletedAt.
+	seed := func(t *testing.T, db *gorm.DB) (string, []string, string) {
+		t.Helper()
+		detailsID := uuid.New().String()
+		live := []string{uuid.New().String(), uuid.New().String()}
+		deleted := uuid.New().String()
+		products := []testProduct{
+			{ID: live[0], DetailsID: detailsID},
+			{ID: live[1], DetailsID: detailsID},
+			{ID: deleted, DetailsID: detailsID, DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true}},
+		}
+		if err := db.Create(&testDetails{ID: detailsID}).Error; err != nil {
+			t.Fatalf("failed to seed details: %v", err)
+		}
+		if err := db.Create(&products).Error; err != nil {
+			t.Fatalf("failed to seed products: %v", err)
+		}
+		return detailsID, live, deleted
+	}
+	get := func(t *testing.T, db *gorm.DB, id string) testProduct {
+		t.Helper()
+		var product testProduct
+		if err := db.Unscoped().First(&product, "id = ?", id).Error; err != nil {
+			t.Fatalf("failed to get product: %v", err)
+		}
+		return product
+	}
+
+	t.Run("details delete cascades to products", func(t *testing.T) {
+		// Arrange
+		db := newTestDB(t)
+		detailsID, live, deleted := seed(t, db)
+		_, otherLive, _ := seed(t, db)
+
+		// Act
+		res := db.Delete(&testDetails{ID: detailsID})
+
+		// Assert
+		assert.NoError(t, res.Error)
+		assert.Equal(t, int64(1), res.RowsAffected)
+		for _, id := range live {
+			product := get(t, db, id)
+			assert.True(t, product.DeletedAt.Valid)
+			assert.True(t, product.UpdatedAt.Equal(product.DeletedAt.Time), "the change feed must pick up deleted products")
+		}
+		assert.True(t, deletedAt.Equal(get(t, db, deleted).DeletedAt.Time), "already deleted products keep their deletion time")
+		for _, id := range otherLive {
+			assert.False(t, get(t, db, id).DeletedAt.Valid, "products of other details must not be deleted")
+		}
+	})
+
+	t.Run("opted out", func(t *testing.T) {
+		// Arrange
+		db := newTestDB(t)
+		detailsID, live, _ := seed(t, db)
+
+		// Act
+		err := db.WithContext(WithoutCascade(context.Background())).Delete(&testDetails{ID: detailsID}).Error
+
+		// Assert
+		assert.NoError(t, err)
+		for _, id := range live {
+			assert.False(t, get(t, db, id).DeletedAt.Valid)
+		}
+	})
+
+	t.Run("delete by condition", func(t *testing.T) {
+		// Arrange
+		db := newTestDB(t)
+		detailsID, live, _ := seed(t, db)
+
+		// Act
+		err := db.Where("id = ?", detailsID).Delete(&testDetails{}).Error
+
+		// Assert
+		assert.NoError(t, err)
+		for _, id := range live {
+			assert.False(t, get(t, db, id).DeletedAt.Valid)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sync/atomic"
+)
+
+var (
+	currencyCodeRegex = regexp.MustCompile(`^[A-Z]{3}$`)
+	defaultCurrency   atomic.Pointer[string]
+)
+
+func init() {
+	code := "RUB"
+	defaultCurrency.Store(&code)
+}
+
+// SetDefaultCurrency sets the currency product prices are in.
+// code is an ISO 4217 alphabetic code such as RUB.
+func SetDefaultCurrency(code string) error {
+	if !currencyCodeRegex.MatchString(code) {
+		return fmt.Errorf("invalid currency %q: expected an ISO 4217 code", code)
+	}
+	defaultCurrency.Store(&code)
+	return nil
+}
+
+// SetDefaultCurrencyFromEnv sets the default currency from the DEFAULT_CURRENCY environment variable.
+// RUB is kept if the variable is unset or empty.
+func SetDefaultCurrencyFromEnv() error {
+	v := os.Getenv("DEFAULT_CURRENCY")
+	if v == "" {
+		return nil
+	}
+	return SetDefaultCurrency(v)
+}
+
+// DefaultCurrency returns the currency set with [SetDefaultCurrency], RUB by default.
+func DefaultCurrency() string {
+	return *defaultCurrency.Load()
+}
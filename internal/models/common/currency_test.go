@@ -0,0 +1,61 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDefaultCurrency(t *testing.T) {
+	t.Cleanup(func() { _ = SetDefaultCurrency("RUB") })
+
+	t.Run("valid code", func(t *testing.T) {
+		// Act
+		err := SetDefaultCurrency("EUR")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "EUR", DefaultCurrency())
+	})
+
+	t.Run("invalid code keeps previous", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, SetDefaultCurrency("RUB"))
+
+		// Act
+		err := SetDefaultCurrency("rubles")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, "RUB", DefaultCurrency())
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		// Arrange
+		t.Setenv("DEFAULT_CURRENCY", "USD")
+
+		// Act
+		err := SetDefaultCurrencyFromEnv()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "USD", DefaultCurrency())
+	})
+}
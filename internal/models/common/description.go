@@ -0,0 +1,189 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+const (
+	// DefaultMaxShortDescriptionLength is the short description limit unless changed with [SetMaxShortDescriptionLength].
+	// It is also the hard ceiling, short descriptions are stored in a varchar(255) column.
+	DefaultMaxShortDescriptionLength = 255
+	// DefaultMaxLongDescriptionLength is the long description limit unless changed with [SetMaxLongDescriptionLength].
+	DefaultMaxLongDescriptionLength = 3000
+	// MinDescriptionLength is the shortest description accepted, for both short and long descriptions.
+	MinDescriptionLength = 3
+)
+
+var (
+	maxShortDescriptionLength atomic.Int64
+	maxLongDescriptionLength  atomic.Int64
+)
+
+func init() {
+	maxShortDescriptionLength.Store(DefaultMaxShortDescriptionLength)
+	maxLongDescriptionLength.Store(DefaultMaxLongDescriptionLength)
+}
+
+// SetMaxShortDescriptionLength sets the longest short description accepted by create and update requests.
+// The limit must be between [MinDescriptionLength] and [DefaultMaxShortDescriptionLength].
+func SetMaxShortDescriptionLength(max int) error {
+	if max < MinDescriptionLength || max > DefaultMaxShortDescriptionLength {
+		return fmt.Errorf("maximum short description length must be between %d and %d, got %d",
+			MinDescriptionLength, DefaultMaxShortDescriptionLength, max)
+	}
+	maxShortDescriptionLength.Store(int64(max))
+	return nil
+}
+
+// SetMaxShortDescriptionLengthFromEnv sets the short description limit from the DESCRIPTION_SHORT_MAX_LENGTH
+// environment variable. The default is kept if the variable is unset or empty.
+func SetMaxShortDescriptionLengthFromEnv() error {
+	v := os.Getenv("DESCRIPTION_SHORT_MAX_LENGTH")
+	if v == "" {
+		return nil
+	}
+	max, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("invalid DESCRIPTION_SHORT_MAX_LENGTH value %q: %w", v, err)
+	}
+	return SetMaxShortDescriptionLength(max)
+}
+
+// MaxShortDescriptionLength returns the longest short description accepted by create and update requests.
+func MaxShortDescriptionLength() int {
+	return int(maxShortDescriptionLength.Load())
+}
+
+// SetMaxLongDescriptionLength sets the longest long description accepted by update requests.
+// The limit must be at least [MinDescriptionLength].
+func SetMaxLongDescriptionLength(max int) error {
+	if max < MinDescriptionLength {
+		return fmt.Errorf("maximum long description length must be at least %d, got %d", MinDescriptionLength, max)
+	}
+	maxLongDescriptionLength.Store(int64(max))
+	return nil
+}
+
+// SetMaxLongDescriptionLengthFromEnv sets the long description limit from the DESCRIPTION_LONG_MAX_LENGTH
+// environment variable. The default is kept if the variable is unset or empty.
+func SetMaxLongDescriptionLengthFromEnv() error {
+	v := os.Getenv("DESCRIPTION_LONG_MAX_LENGTH")
+	if v == "" {
+		return nil
+	}
+	max, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("invalid DESCRIPTION_LONG_MAX_LENGTH value %q: %w", v, err)
+	}
+	return SetMaxLongDescriptionLength(max)
+}
+
+// MaxLongDescriptionLength returns the longest long description accepted by update requests.
+func MaxLongDescriptionLength() int {
+	return int(maxLongDescriptionLength.Load())
+}
+
+// allowedTags are the formatting tags kept by [SanitizeDescription]. Their attributes are always dropped.
+var allowedTags = map[atom.Atom]bool{
+	atom.B:          true,
+	atom.I:          true,
+	atom.Em:         true,
+	atom.Strong:     true,
+	atom.U:          true,
+	atom.P:          true,
+	atom.Br:         true,
+	atom.Ul:         true,
+	atom.Ol:         true,
+	atom.Li:         true,
+	atom.Code:       true,
+	atom.Pre:        true,
+	atom.Blockquote: true,
+}
+
+// droppedTags are removed by [SanitizeDescription] together with their content.
+var droppedTags = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Iframe:   true,
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Noscript: true,
+	atom.Template: true,
+	atom.Textarea: true,
+}
+
+// SanitizeDescription strips unsafe HTML from a description before it is stored.
+// Basic formatting tags (b, i, em, strong, u, p, br, ul, ol, li, code, pre, blockquote) are kept
+// without attributes, script-like elements are removed with their content and any other tag
+// is removed while its text is kept. Descriptions without markup are returned unchanged.
+func SanitizeDescription(s string) string {
+	if !strings.Contains(s, "<") {
+		return s
+	}
+	var b strings.Builder
+	skip := 0
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			return b.String()
+		}
+		tok := z.Token()
+		switch tt {
+		case html.TextToken:
+			if skip == 0 {
+				b.WriteString(html.EscapeString(tok.Data))
+			}
+		case html.StartTagToken:
+			if droppedTags[tok.DataAtom] {
+				skip++
+			} else if skip == 0 && allowedTags[tok.DataAtom] {
+				b.WriteString("<" + tok.Data + ">")
+			}
+		case html.SelfClosingTagToken:
+			if skip == 0 && allowedTags[tok.DataAtom] {
+				b.WriteString("<" + tok.Data + ">")
+			}
+		case html.EndTagToken:
+			if droppedTags[tok.DataAtom] {
+				if skip > 0 {
+					skip--
+				}
+			} else if skip == 0 && allowedTags[tok.DataAtom] && tok.DataAtom != atom.Br {
+				b.WriteString("</" + tok.Data + ">")
+			}
+		}
+	}
+}
+
+// SanitizeDescriptionPtr sanitizes the description s points to in place, see [SanitizeDescription].
+// Nil pointers are left untouched.
+func SanitizeDescriptionPtr(s *string) {
+	if s != nil {
+		*s = SanitizeDescription(*s)
+	}
+}
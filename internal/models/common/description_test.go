@@ -0,0 +1,114 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescriptionLength(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetMaxShortDescriptionLength(DefaultMaxShortDescriptionLength)
+		_ = SetMaxLongDescriptionLength(DefaultMaxLongDescriptionLength)
+	})
+
+	t.Run("over configured limit", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, SetMaxLongDescriptionLength(100))
+		rule := validation.Length(MinDescriptionLength, MaxLongDescriptionLength())
+
+		// Act & Assert
+		assert.NoError(t, rule.Validate(strings.Repeat("a", 100)))
+		assert.Error(t, rule.Validate(strings.Repeat("a", 101)))
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		// Arrange
+		t.Setenv("DESCRIPTION_SHORT_MAX_LENGTH", "120")
+		t.Setenv("DESCRIPTION_LONG_MAX_LENGTH", "5000")
+
+		// Act
+		assert.NoError(t, SetMaxShortDescriptionLengthFromEnv())
+		assert.NoError(t, SetMaxLongDescriptionLengthFromEnv())
+
+		// Assert
+		assert.Equal(t, 120, MaxShortDescriptionLength())
+		assert.Equal(t, 5000, MaxLongDescriptionLength())
+	})
+
+	t.Run("invalid limits", func(t *testing.T) {
+		assert.Error(t, SetMaxShortDescriptionLength(DefaultMaxShortDescriptionLength+1))
+		assert.Error(t, SetMaxShortDescriptionLength(MinDescriptionLength-1))
+		assert.Error(t, SetMaxLongDescriptionLength(0))
+
+		t.Setenv("DESCRIPTION_LONG_MAX_LENGTH", "lots")
+		assert.Error(t, SetMaxLongDescriptionLengthFromEnv())
+	})
+}
+
+func TestSanitizeDescription(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "plain text unchanged",
+			input: "Tom & Jerry's \"best\" seminar",
+			want:  "Tom & Jerry's \"best\" seminar",
+		},
+		{
+			name:  "script removed with content",
+			input: "<p>Hello<script>alert('xss')</script> world</p>",
+			want:  "<p>Hello world</p>",
+		},
+		{
+			name:  "formatting kept without attributes",
+			input: `<p class="x" onclick="steal()"><b>Bold</b>, <em>em</em><br/>next</p>`,
+			want:  "<p><b>Bold</b>, <em>em</em><br>next</p>",
+		},
+		{
+			name:  "unknown tags stripped, text kept",
+			input: `<a href="javascript:alert(1)">click</a> <img src=x onerror=alert(1)>`,
+			want:  "click ",
+		},
+		{
+			name:  "unclosed script drops the rest",
+			input: "safe<script>alert(1)",
+			want:  "safe",
+		},
+		{
+			name:  "text escaped once markup is present",
+			input: "<i>a &lt; b</i> & c",
+			want:  "<i>a &lt; b</i> &amp; c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SanitizeDescription(tt.input))
+		})
+	}
+
+	t.Run("nil pointer", func(t *testing.T) {
+		assert.NotPanics(t, func() { SanitizeDescriptionPtr(nil) })
+	})
+}
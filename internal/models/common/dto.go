@@ -0,0 +1,30 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+// IDsRequest is a generic request payload for batch operations over a list of record IDs.
+type IDsRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// PurgeRequest is the request payload for permanently deleting trashed records.
+// Before is a YYYY-MM-DD date, Confirm must repeat it to confirm the purge.
+type PurgeRequest struct {
+	Before  string `json:"before"`
+	Confirm string `json:"confirm"`
+}
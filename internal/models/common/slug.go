@@ -0,0 +1,151 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"unicode"
+)
+
+// MaxSlugLength is the longest slug produced by [Slugify], leaving room for the suffix added by [UniqueSlug].
+const MaxSlugLength = 200
+
+// SlugPolicy controls what happens to a record's slug when its name changes.
+type SlugPolicy string
+
+const (
+	// SlugKeep keeps the slug, so existing links stay valid. This is the default.
+	SlugKeep SlugPolicy = "keep"
+	// SlugRegenerate generates a new slug from the new name.
+	SlugRegenerate SlugPolicy = "regenerate"
+)
+
+var slugPolicy atomic.Value
+
+func init() {
+	slugPolicy.Store(SlugKeep)
+}
+
+// SetSlugPolicy sets the policy applied to slugs on rename, see [SlugPolicy].
+func SetSlugPolicy(policy SlugPolicy) error {
+	if policy != SlugKeep && policy != SlugRegenerate {
+		return fmt.Errorf("invalid slug policy %q, must be %q or %q", policy, SlugKeep, SlugRegenerate)
+	}
+	slugPolicy.Store(policy)
+	return nil
+}
+
+// SetSlugPolicyFromEnv sets the slug policy from the SLUG_ON_RENAME environment variable.
+// [SlugKeep] is kept if the variable is unset or empty.
+func SetSlugPolicyFromEnv() error {
+	v := os.Getenv("SLUG_ON_RENAME")
+	if v == "" {
+		return nil
+	}
+	return SetSlugPolicy(SlugPolicy(v))
+}
+
+// SlugOnRename returns the policy applied to slugs on rename.
+func SlugOnRename() SlugPolicy {
+	return slugPolicy.Load().(SlugPolicy)
+}
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// cyrillic maps lowercase Cyrillic letters to their Latin transliteration, so Russian names get readable slugs.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "y", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "h", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "sch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu",
+	'я': "ya",
+}
+
+// Slugify converts name to a URL-safe slug: lowercase Latin letters and digits separated by single hyphens.
+// Cyrillic letters are transliterated, other characters act as separators.
+// The slug is cut to [MaxSlugLength]. An empty string is returned if nothing of name is left.
+func Slugify(name string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(name) {
+		var part string
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			part = string(r)
+		case cyrillic[r] != "":
+			part = cyrillic[r]
+		case r == 'ъ' || r == 'ь':
+			continue
+		default:
+			pendingHyphen = b.Len() > 0
+			continue
+		}
+		if pendingHyphen {
+			b.WriteByte('-')
+			pendingHyphen = false
+		}
+		b.WriteString(part)
+	}
+	slug := b.String()
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+	return slug
+}
+
+// UniqueSlug returns base if it is not taken, otherwise base with the smallest free numeric suffix
+// starting from 2, e.g. "my-seminar-2".
+func UniqueSlug(base string, taken []string) string {
+	if !slices.Contains(taken, base) {
+		return base
+	}
+	for n := 2; ; n++ {
+		candidate := base + "-" + strconv.Itoa(n)
+		if !slices.Contains(taken, candidate) {
+			return candidate
+		}
+	}
+}
+
+// ValidateSlug is a validation rule that checks that a string or *string is a URL-safe slug
+// as produced by [Slugify]. Empty values and nil pointers are skipped, presence is left to validation.Required.
+func ValidateSlug(value interface{}) error {
+	var slug string
+	switch v := value.(type) {
+	case string:
+		slug = v
+	case *string:
+		if v != nil {
+			slug = *v
+		}
+	}
+	if slug == "" {
+		return nil
+	}
+	if !slugPattern.MatchString(slug) {
+		return errors.New("must contain only lowercase latin letters and digits separated by single hyphens")
+	}
+	return nil
+}
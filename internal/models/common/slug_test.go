@@ -0,0 +1,82 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "latin", in: "My Great Seminar", want: "my-great-seminar"},
+		{name: "punctuation collapsed", in: "  Yoga: body & mind -- 2025!  ", want: "yoga-body-mind-2025"},
+		{name: "cyrillic transliterated", in: "Семинар по йоге", want: "seminar-po-yoge"},
+		{name: "signs dropped", in: "Объём", want: "obem"},
+		{name: "nothing left", in: "!!! ???", want: ""},
+		{name: "cut to max length", in: strings.Repeat("ab ", 100), want: strings.TrimRight(strings.Repeat("ab-", 67)[:MaxSlugLength], "-")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Slugify(tt.in)
+
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, len(got), MaxSlugLength)
+			assert.NoError(t, ValidateSlug(got))
+		})
+	}
+}
+
+func TestUniqueSlug(t *testing.T) {
+	assert.Equal(t, "yoga", UniqueSlug("yoga", nil))
+	assert.Equal(t, "yoga", UniqueSlug("yoga", []string{"yoga-2", "yoga-retreat"}))
+	assert.Equal(t, "yoga-2", UniqueSlug("yoga", []string{"yoga", "yoga-retreat"}))
+	assert.Equal(t, "yoga-4", UniqueSlug("yoga", []string{"yoga", "yoga-2", "yoga-3"}))
+	assert.Equal(t, "yoga-3", UniqueSlug("yoga", []string{"yoga", "yoga-2", "yoga-4"}))
+}
+
+func TestValidateSlug(t *testing.T) {
+	for _, valid := range []string{"", "a", "my-seminar-2"} {
+		assert.NoError(t, ValidateSlug(valid), valid)
+	}
+	for _, invalid := range []string{"My-Seminar", "my--seminar", "-seminar", "seminar-", "my seminar", "семинар", "../etc"} {
+		assert.Error(t, ValidateSlug(invalid), invalid)
+		assert.Error(t, ValidateSlug(&invalid), invalid)
+	}
+	assert.NoError(t, ValidateSlug((*string)(nil)))
+}
+
+func TestSetSlugPolicy(t *testing.T) {
+	t.Cleanup(func() { _ = SetSlugPolicy(SlugKeep) })
+
+	assert.Equal(t, SlugKeep, SlugOnRename())
+
+	t.Setenv("SLUG_ON_RENAME", "regenerate")
+	assert.NoError(t, SetSlugPolicyFromEnv())
+	assert.Equal(t, SlugRegenerate, SlugOnRename())
+
+	t.Setenv("SLUG_ON_RENAME", "sometimes")
+	assert.Error(t, SetSlugPolicyFromEnv())
+	assert.Equal(t, SlugRegenerate, SlugOnRename())
+}
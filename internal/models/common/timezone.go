@@ -0,0 +1,102 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+	// Embedded zone database, so DEFAULT_TIMEZONE works in images without tzdata.
+	_ "time/tzdata"
+)
+
+// DateLayout is the layout of date-only values, which are taken as midnight in [DefaultTimezone].
+const DateLayout = time.DateOnly
+
+var defaultTimezone atomic.Pointer[time.Location]
+
+func init() {
+	defaultTimezone.Store(time.UTC)
+}
+
+// SetDefaultTimezone sets the zone date-only values are parsed in and dates are displayed in.
+// name is an IANA zone name such as Europe/Moscow.
+func SetDefaultTimezone(name string) error {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return fmt.Errorf("invalid timezone %q: %w", name, err)
+	}
+	defaultTimezone.Store(loc)
+	return nil
+}
+
+// SetDefaultTimezoneFromEnv sets the default timezone from the DEFAULT_TIMEZONE environment variable.
+// UTC is kept if the variable is unset or empty.
+func SetDefaultTimezoneFromEnv() error {
+	v := os.Getenv("DEFAULT_TIMEZONE")
+	if v == "" {
+		return nil
+	}
+	return SetDefaultTimezone(v)
+}
+
+// DefaultTimezone returns the zone set with [SetDefaultTimezone], UTC by default.
+func DefaultTimezone() *time.Location {
+	return defaultTimezone.Load()
+}
+
+// ParseTime parses an RFC 3339 timestamp or a date-only value (YYYY-MM-DD).
+// Date-only values are taken as midnight in [DefaultTimezone].
+func ParseTime(value string) (time.Time, error) {
+	if t, err := time.ParseInLocation(DateLayout, value, DefaultTimezone()); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+// StartOfDay returns midnight of the calendar day t falls on in [DefaultTimezone].
+func StartOfDay(t time.Time) time.Time {
+	y, m, d := t.In(DefaultTimezone()).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, DefaultTimezone())
+}
+
+// Time is a [time.Time] that also accepts date-only JSON values, see [ParseTime].
+// It is meant for request payloads and marshals as a plain time.
+type Time struct {
+	time.Time
+}
+
+// UnmarshalJSON parses a JSON string with [ParseTime]. null leaves the value unchanged.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	parsed, err := ParseTime(value)
+	if err != nil {
+		return fmt.Errorf("invalid time %q: expected RFC 3339 or %s", value, DateLayout)
+	}
+	t.Time = parsed
+	return nil
+}
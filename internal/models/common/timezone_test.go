@@ -0,0 +1,111 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetDefaultTimezone(t *testing.T) {
+	t.Cleanup(func() { _ = SetDefaultTimezone("UTC") })
+
+	t.Run("valid zone", func(t *testing.T) {
+		// Act
+		err := SetDefaultTimezone("Europe/Moscow")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Europe/Moscow", DefaultTimezone().String())
+	})
+
+	t.Run("invalid zone keeps previous", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, SetDefaultTimezone("UTC"))
+
+		// Act
+		err := SetDefaultTimezone("Mars/Olympus")
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, time.UTC, DefaultTimezone())
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		// Arrange
+		t.Setenv("DEFAULT_TIMEZONE", "America/New_York")
+
+		// Act
+		err := SetDefaultTimezoneFromEnv()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "America/New_York", DefaultTimezone().String())
+	})
+}
+
+func TestParseTime(t *testing.T) {
+	t.Cleanup(func() { _ = SetDefaultTimezone("UTC") })
+	assert.NoError(t, SetDefaultTimezone("Europe/Moscow"))
+
+	t.Run("date-only value is midnight in default timezone", func(t *testing.T) {
+		// Act
+		parsed, err := ParseTime("2030-02-01")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2030, 1, 31, 21, 0, 0, 0, time.UTC), parsed.UTC())
+	})
+
+	t.Run("RFC 3339 value keeps its offset", func(t *testing.T) {
+		// Act
+		parsed, err := ParseTime("2030-02-01T10:00:00Z")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, time.Date(2030, 2, 1, 10, 0, 0, 0, time.UTC), parsed.UTC())
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		// Act
+		_, err := ParseTime("01.02.2030")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestTime_UnmarshalJSON(t *testing.T) {
+	// Arrange
+	var payload struct {
+		Date  Time  `json:"date"`
+		Until *Time `json:"until"`
+	}
+
+	// Act
+	err := json.Unmarshal([]byte(`{"date":"2030-02-01","until":null}`), &payload)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC), payload.Date.UTC())
+	assert.Nil(t, payload.Until)
+	assert.Error(t, json.Unmarshal([]byte(`{"date":"tomorrow"}`), &payload))
+}
@@ -19,9 +19,115 @@ package common
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync/atomic"
 	"unicode"
 )
 
+// DefaultMinPrice is the smallest price accepted for any product unless changed with [SetMinPrice].
+const DefaultMinPrice float32 = 1
+
+var (
+	minPrice atomic.Value
+	// maxPrice is the price ceiling, zero disables it.
+	maxPrice atomic.Value
+)
+
+func init() {
+	minPrice.Store(DefaultMinPrice)
+	maxPrice.Store(float32(0))
+}
+
+// SetMinPrice sets the smallest price accepted by [ValidatePrice]. The minimum must be positive.
+func SetMinPrice(min float32) error {
+	if min <= 0 {
+		return fmt.Errorf("minimum price must be positive, got %v", min)
+	}
+	minPrice.Store(min)
+	return nil
+}
+
+// SetMinPriceFromEnv sets the minimum price from the PRICE_MIN environment variable.
+// The default is kept if the variable is unset or empty.
+func SetMinPriceFromEnv() error {
+	v := os.Getenv("PRICE_MIN")
+	if v == "" {
+		return nil
+	}
+	min, err := strconv.ParseFloat(v, 32)
+	if err != nil {
+		return fmt.Errorf("invalid PRICE_MIN value %q: %w", v, err)
+	}
+	return SetMinPrice(float32(min))
+}
+
+// MinPrice returns the smallest price accepted by [ValidatePrice].
+func MinPrice() float32 {
+	return minPrice.Load().(float32)
+}
+
+// SetMaxPrice sets the price ceiling enforced by [ValidatePrice] to catch fat-finger errors.
+// Zero disables the ceiling, which is the default.
+func SetMaxPrice(max float32) error {
+	if max < 0 {
+		return fmt.Errorf("maximum price must not be negative, got %v", max)
+	}
+	if max != 0 && max < MinPrice() {
+		return fmt.Errorf("maximum price %v is less than minimum price %v", max, MinPrice())
+	}
+	maxPrice.Store(max)
+	return nil
+}
+
+// SetMaxPriceFromEnv sets the price ceiling from the PRICE_MAX environment variable.
+// The ceiling stays disabled if the variable is unset or empty.
+func SetMaxPriceFromEnv() error {
+	v := os.Getenv("PRICE_MAX")
+	if v == "" {
+		return nil
+	}
+	max, err := strconv.ParseFloat(v, 32)
+	if err != nil {
+		return fmt.Errorf("invalid PRICE_MAX value %q: %w", v, err)
+	}
+	return SetMaxPrice(float32(max))
+}
+
+// MaxPrice returns the price ceiling enforced by [ValidatePrice], zero if it is disabled.
+func MaxPrice() float32 {
+	return maxPrice.Load().(float32)
+}
+
+// ValidatePrice checks that a float32 or *float32 price is strictly positive, no less than [MinPrice]
+// and, if the ceiling is configured, no greater than [MaxPrice].
+// Nil pointers are skipped, presence is left to validation.Required.
+func ValidatePrice(value interface{}) error {
+	var price float32
+	switch v := value.(type) {
+	case float32:
+		price = v
+	case *float32:
+		if v == nil {
+			return nil
+		}
+		price = *v
+	default:
+		return nil
+	}
+	if price <= 0 {
+		return errors.New("must be greater than 0")
+	}
+	if min := MinPrice(); price < min {
+		return fmt.Errorf("must be no less than %v", min)
+	}
+	if max := MaxPrice(); max > 0 && price > max {
+		return fmt.Errorf("exceeds the maximum price ceiling of %v", max)
+	}
+	return nil
+}
+
 // ValidateName is a validation rule that checks if a string starts with a letter
 // and contains at least one letter. It can handle both `string` and `*string` types.
 func ValidateName(value interface{}) error {
@@ -0,0 +1,80 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePrice(t *testing.T) {
+	t.Cleanup(func() {
+		_ = SetMinPrice(DefaultMinPrice)
+		_ = SetMaxPrice(0)
+	})
+
+	t.Run("ceiling unconfigured", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, SetMaxPrice(0))
+
+		// Act & Assert
+		assert.NoError(t, ValidatePrice(float32(34664.4)))
+		assert.Error(t, ValidatePrice(float32(0)))
+		assert.Error(t, ValidatePrice(float32(-1)))
+		assert.NoError(t, ValidatePrice((*float32)(nil)))
+	})
+
+	t.Run("at ceiling", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, SetMaxPrice(5000))
+		price := float32(5000)
+
+		// Act & Assert
+		assert.NoError(t, ValidatePrice(price))
+		assert.NoError(t, ValidatePrice(&price))
+	})
+
+	t.Run("just above ceiling", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, SetMaxPrice(5000))
+		price := float32(5000.01)
+
+		// Act
+		err := ValidatePrice(&price)
+
+		// Assert
+		assert.EqualError(t, err, "exceeds the maximum price ceiling of 5000")
+	})
+
+	t.Run("below minimum", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, SetMinPrice(10))
+
+		// Act
+		err := ValidatePrice(float32(9.99))
+
+		// Assert
+		assert.EqualError(t, err, "must be no less than 10")
+	})
+
+	t.Run("invalid ceiling", func(t *testing.T) {
+		assert.Error(t, SetMaxPrice(-1))
+		assert.Error(t, SetMaxPrice(MinPrice()/2))
+	})
+}
@@ -18,6 +18,12 @@
 // Package course provides models, DTO models for [course.Service] requests and validation tools.
 package course
 
+import (
+	"time"
+
+	coursepart "github.com/mikhail5545/product-service-go/internal/models/course_part"
+)
+
 // CreateCourseRequest provides essential fields to create new [database.Course] model.
 // Other fields should be added later with update request.
 type CreateRequest struct {
@@ -30,18 +36,30 @@ type CreateRequest struct {
 
 type CreateResponse struct {
 	ID        string `json:"id"`
-	ProductID string `json:"product_id"`
+	ProductID string `json:"product_id,omitempty"`
 }
 
 type UpdateRequest struct {
-	ID               string   `json:"id" validate:"required"`
-	Name             *string  `json:"name"`
-	ShortDescription *string  `json:"short_description"`
-	LongDescription  *string  `json:"long_description"`
-	Topic            *string  `json:"topic"`
-	AccessDuration   *int     `json:"access_duration"`
-	Tags             []string `json:"tags"`
-	Price            *float32 `json:"price"`
+	ID               string  `json:"id" validate:"required"`
+	Name             *string `json:"name"`
+	ShortDescription *string `json:"short_description"`
+	LongDescription  *string `json:"long_description"`
+	Topic            *string `json:"topic"`
+	AccessDuration   *int    `json:"access_duration"`
+	// Tags replaces the tags, an empty list clears them. Omitted (nil) tags are kept.
+	Tags []string `json:"tags"`
+	// ClearTags removes all tags, for clients that cannot send an empty list. It cannot be combined with Tags.
+	ClearTags bool     `json:"clear_tags,omitempty"`
+	Price     *float32 `json:"price"`
+}
+
+// TagsUpdate returns the tags the record should end up with: an empty list if ClearTags is set,
+// otherwise Tags. It returns nil if the tags should be kept.
+func (req *UpdateRequest) TagsUpdate() []string {
+	if req.ClearTags {
+		return []string{}
+	}
+	return req.Tags
 }
 
 // CourseDetails is a DTO that combines the Course model with its associated Product price.
@@ -49,4 +67,21 @@ type CourseDetails struct {
 	*Course
 	Price     float32 `json:"price"`
 	ProductID string  `json:"product_id"`
+	// Available reports whether the product is within its sale window.
+	Available bool `json:"available"`
+	// FirstPublishedAt and LastPublishedAt are the publish times of the product, they are
+	// only populated by GetWithDeleted and GetWithUnpublished.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
+}
+
+// CourseFullDetails is a DTO that combines the Course model with its own Product price
+// and its published parts ordered by number, each with its price if it is sold separately.
+type CourseFullDetails struct {
+	*Course
+	Price     float32 `json:"price"`
+	ProductID string  `json:"product_id"`
+	// Available reports whether the product is within its sale window.
+	Available bool                           `json:"available"`
+	Parts     []coursepart.CoursePartDetails `json:"parts"`
 }
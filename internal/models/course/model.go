@@ -20,19 +20,21 @@ package course
 import (
 	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	coursepart "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	"github.com/mikhail5545/product-service-go/internal/models/image"
 	"gorm.io/gorm"
 )
 
 type Course struct {
-	ID        string         `gorm:"primaryKey;size:36" json:"id"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `json:"deleted_at"`
-	Tags      []string       `gorm:"type:varchar(128)[]" json:"tags"`
-	Name      string         `gorm:"type:varchar(255)" json:"name"`
-	Topic     string         `gorm:"type:varchar(255)" json:"topic"`
+	ID            string         `gorm:"primaryKey;size:36" json:"id"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"deleted_at"`
+	DeletedReason string         `gorm:"type:varchar(255)" json:"deleted_reason,omitempty"`
+	Tags          []string       `gorm:"type:varchar(128)[]" json:"tags"`
+	Name          string         `gorm:"type:varchar(255)" json:"name"`
+	Topic         string         `gorm:"type:varchar(255)" json:"topic"`
 	// For concise, limited text. Brief description
 	ShortDescription string `gorm:"type:varchar(255)" json:"short_description"`
 	// For large text\Markdown content. Detailed description
@@ -55,3 +57,8 @@ func (c Course) GetUploadedImageAmount() int {
 func (c Course) SetUploadedImageAmount(amount int) {
 	c.UploadedImageAmount = amount
 }
+
+// AfterDelete soft-deletes products of the course when it is soft-deleted by ID, see [common.CascadeProductDelete].
+func (c *Course) AfterDelete(tx *gorm.DB) error {
+	return common.CascadeProductDelete(tx, c.ID)
+}
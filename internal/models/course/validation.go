@@ -29,8 +29,8 @@ import (
 // Validation rules:
 //
 //   - Name: required, 3-255 characters, Alpha only.
-//   - ShortDescription: required, 3-255 characters.
-//   - Price: required, >= 1.
+//   - ShortDescription: required, 3-common.MaxShortDescriptionLength() (255 by default) characters.
+//   - Price: required, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
 //   - Topic: required, 3-128 characters, Alpha only.
 //   - AccessDuration: required, >= 1.
 func (req CreateRequest) Validate() error {
@@ -44,7 +44,7 @@ func (req CreateRequest) Validate() error {
 		validation.Field(
 			&req.ShortDescription,
 			validation.Required,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.Topic,
@@ -59,7 +59,7 @@ func (req CreateRequest) Validate() error {
 		validation.Field(
 			&req.Price,
 			validation.Required,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 	)
 }
@@ -70,12 +70,13 @@ func (req CreateRequest) Validate() error {
 //
 //   - ID: required, UUID
 //   - Name: optional, 3-255 characters, Alpha only.
-//   - ShortDescription: optional, 3-255 characters.
-//   - LongDescription: optional, 3-3000 characters.
-//   - Price: optional, >= 1.
+//   - ShortDescription: optional, 3-common.MaxShortDescriptionLength() (255 by default) characters.
+//   - LongDescription: optional, 3-common.MaxLongDescriptionLength() (3000 by default) characters.
+//   - Price: optional, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
 //   - Topic: optional, 3-128 characters, Alpha only.
 //   - AccessDuration: optional, >= 1.
-//   - Tags: optional, 1-10 items, 3-20 characters each.
+//   - Tags: optional, up to 10 items, 3-20 characters each, empty to clear.
+//   - ClearTags: optional, must not be set together with non-empty Tags.
 func (req UpdateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(
@@ -90,11 +91,11 @@ func (req UpdateRequest) Validate() error {
 		),
 		validation.Field(
 			&req.ShortDescription,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.LongDescription,
-			validation.Length(3, 3000),
+			validation.Length(common.MinDescriptionLength, common.MaxLongDescriptionLength()),
 		),
 		validation.Field(
 			&req.Topic,
@@ -106,12 +107,29 @@ func (req UpdateRequest) Validate() error {
 		),
 		validation.Field(
 			&req.Price,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.Tags,
 			validation.Length(1, 10),
 			validation.Each(validation.Length(3, 20), is.Alphanumeric),
 		),
+		validation.Field(
+			&req.ClearTags,
+			validation.When(len(req.Tags) > 0, validation.Empty.Error("must not be set together with tags")),
+		),
 	)
 }
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [course.CreateRequest.Validate] so length limits apply to the stored value.
+func (req *CreateRequest) Sanitize() {
+	req.ShortDescription = common.SanitizeDescription(req.ShortDescription)
+}
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [course.UpdateRequest.Validate] so length limits apply to the stored value.
+func (req *UpdateRequest) Sanitize() {
+	common.SanitizeDescriptionPtr(req.ShortDescription)
+	common.SanitizeDescriptionPtr(req.LongDescription)
+}
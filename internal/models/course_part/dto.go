@@ -27,6 +27,12 @@ type CreateRequest struct {
 	Number           int    `json:"number"`
 }
 
+// CreateBatchRequest holds course parts to create in one call, in the order they are numbered.
+// Number and CourseID of the parts are ignored.
+type CreateBatchRequest struct {
+	Parts []*CreateRequest `json:"parts"`
+}
+
 type CreateResponse struct {
 	ID       string `json:"id"`
 	CourseID string `json:"course_id"`
@@ -35,11 +41,31 @@ type CreateResponse struct {
 // CreateRequest holds neccessary fields to update new Course Part.
 // All fields are optional except ID and CourseID.
 type UpdateRequest struct {
-	ID               string   `json:"id"`
-	CourseID         string   `json:"course_id"`
-	Name             *string  `json:"name"`
-	LongDescription  *string  `json:"long_description"`
-	ShortDescription *string  `json:"short_description"`
-	Number           *int     `json:"number"`
-	Tags             []string `json:"tags"`
+	ID               string  `json:"id"`
+	CourseID         string  `json:"course_id"`
+	Name             *string `json:"name"`
+	LongDescription  *string `json:"long_description"`
+	ShortDescription *string `json:"short_description"`
+	Number           *int    `json:"number"`
+	// Tags replaces the tags, an empty list clears them. Omitted (nil) tags are kept.
+	Tags []string `json:"tags"`
+	// ClearTags removes all tags, for clients that cannot send an empty list. It cannot be combined with Tags.
+	ClearTags bool `json:"clear_tags,omitempty"`
+}
+
+// TagsUpdate returns the tags the record should end up with: an empty list if ClearTags is set,
+// otherwise Tags. It returns nil if the tags should be kept.
+func (req *UpdateRequest) TagsUpdate() []string {
+	if req.ClearTags {
+		return []string{}
+	}
+	return req.Tags
+}
+
+// CoursePartDetails is a DTO that combines the CoursePart model with its associated Product price.
+// Price and ProductID are empty for free (not sellable) parts.
+type CoursePartDetails struct {
+	*CoursePart
+	Price     float32 `json:"price,omitempty"`
+	ProductID string  `json:"product_id,omitempty"`
 }
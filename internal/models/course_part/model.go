@@ -26,11 +26,12 @@ import (
 )
 
 type CoursePart struct {
-	ID        string         `gorm:"primaryKey;size:36" json:"id"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `json:"deleted_at"`
-	Tags      []string       `gorm:"type:varchar(128)[]" json:"tags"`
+	ID            string         `gorm:"primaryKey;size:36" json:"id"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"deleted_at"`
+	DeletedReason string         `gorm:"type:varchar(255)" json:"deleted_reason,omitempty"`
+	Tags          []string       `gorm:"type:varchar(128)[]" json:"tags"`
 	// Order of a part in the course
 	Number int    `json:"number"`
 	Name   string `gorm:"type:varchar(255)" json:"name"`
@@ -44,6 +45,10 @@ type CoursePart struct {
 	// 	- Published = false -> not available for the users, archived
 	Published bool   `json:"published"`
 	CourseID  string `gorm:"size:36;index" json:"course_id"`
+	// Sellable marks a part that can be bought on its own. Such a part has an associated
+	// Product record whose DetailsID is the part ID. Parts that are not sellable are free
+	// and have no product.
+	Sellable bool `gorm:"default:false" json:"sellable"`
 	// Unique identifier for the associated Video instance. May be nil. It represents the association with the [media-service-go] MUX Asset.
 	//
 	// [media-service-go]: https://github.com/mikhail5545/media-service-go
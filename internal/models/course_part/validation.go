@@ -30,7 +30,7 @@ import (
 //
 //   - CourseID: required, UUID
 //   - Name: required, 3-255 characters, Alpha only.
-//   - ShortDescription: required, 3-255 characters.
+//   - ShortDescription: required, 3-common.MaxShortDescriptionLength() (255 by default) characters.
 //   - Number: required, min 1.
 func (req CreateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
@@ -48,7 +48,7 @@ func (req CreateRequest) Validate() error {
 		validation.Field(
 			&req.ShortDescription,
 			validation.Required,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.Number,
@@ -65,10 +65,11 @@ func (req CreateRequest) Validate() error {
 //   - ID: required, UUID
 //   - CourseID: required, UUID
 //   - Name: optional, 3-255 characters, Alpha only.
-//   - ShortDescription: optional, 3-255 characters.
-//   - LongDescription: optional, 3-3000 characters.
+//   - ShortDescription: optional, 3-common.MaxShortDescriptionLength() (255 by default) characters.
+//   - LongDescription: optional, 3-common.MaxLongDescriptionLength() (3000 by default) characters.
 //   - Number: optional, min 1.
-//   - Tags: optional, 1-10 items, 3-20 characters each.
+//   - Tags: optional, up to 10 items, 3-20 characters each, empty to clear.
+//   - ClearTags: optional, must not be set together with non-empty Tags.
 func (req UpdateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(
@@ -88,11 +89,11 @@ func (req UpdateRequest) Validate() error {
 		),
 		validation.Field(
 			&req.ShortDescription,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.LongDescription,
-			validation.Length(3, 3000),
+			validation.Length(common.MinDescriptionLength, common.MaxLongDescriptionLength()),
 		),
 		validation.Field(
 			&req.Number,
@@ -103,5 +104,22 @@ func (req UpdateRequest) Validate() error {
 			validation.Length(1, 10),
 			validation.Each(validation.Length(3, 20), is.Alphanumeric),
 		),
+		validation.Field(
+			&req.ClearTags,
+			validation.When(len(req.Tags) > 0, validation.Empty.Error("must not be set together with tags")),
+		),
 	)
 }
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [coursepart.CreateRequest.Validate] so length limits apply to the stored value.
+func (req *CreateRequest) Sanitize() {
+	req.ShortDescription = common.SanitizeDescription(req.ShortDescription)
+}
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [coursepart.UpdateRequest.Validate] so length limits apply to the stored value.
+func (req *UpdateRequest) Sanitize() {
+	common.SanitizeDescriptionPtr(req.ShortDescription)
+	common.SanitizeDescriptionPtr(req.LongDescription)
+}
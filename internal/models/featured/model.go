@@ -0,0 +1,50 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package featured provides models, DTO models for [featured.Service] requests and validation tools.
+package featured
+
+import (
+	"time"
+
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+)
+
+// Featured is an entry of the hand-picked list of products shown on the homepage.
+type Featured struct {
+	ProductID string `gorm:"primaryKey;size:36" json:"product_id"`
+	// Position orders the list, lower positions come first.
+	Position  int       `gorm:"index" json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName overrides the pluralized table name.
+func (Featured) TableName() string {
+	return "featured"
+}
+
+// StateMissing is the state of an entry whose product was permanently deleted.
+const StateMissing productmodel.State = "missing"
+
+// Entry is a featured entry with the current state of its product, used by the admin view.
+// Only entries in [productmodel.StateLive] are shown on the public list.
+type Entry struct {
+	Featured
+	// Product is nil if the product was permanently deleted.
+	Product *productmodel.Product `json:"product,omitempty"`
+	State   productmodel.State    `json:"state"`
+}
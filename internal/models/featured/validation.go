@@ -0,0 +1,52 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package featured provides models, DTO models for [featured.Service] requests and validation tools.
+package featured
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+// Validate validates fields of [featured.AddRequest].
+// Validation rules:
+//
+//   - ProductID: required, UUID
+func (req *AddRequest) Validate() error {
+	return validation.ValidateStruct(req,
+		validation.Field(
+			&req.ProductID,
+			validation.Required,
+			is.UUID,
+		),
+	)
+}
+
+// Validate validates fields of [featured.ReorderRequest].
+// Validation rules:
+//
+//   - ProductIDs: required, each a UUID
+func (req *ReorderRequest) Validate() error {
+	return validation.ValidateStruct(req,
+		validation.Field(
+			&req.ProductIDs,
+			validation.Required,
+			validation.Each(is.UUID),
+		),
+	)
+}
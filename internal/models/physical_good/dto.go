@@ -18,23 +18,34 @@
 // Package physicalgood provides models, DTO models for [physicalgood.Service] requests and validation tools.
 package physicalgood
 
+import "time"
+
 type PhysicalGoodDetails struct {
 	*PhysicalGood
 	Price     float32
 	ProductID string
+	// Available reports whether the product is within its sale window.
+	Available bool `json:"available"`
+	// FirstPublishedAt and LastPublishedAt are the publish times of the product, they are
+	// only populated by GetWithDeleted and GetWithUnpublished.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
 }
 
 type CreateRequest struct {
-	Name             string  `json:"name"`
-	ShortDescription string  `json:"short_description"`
-	Price            float32 `json:"price"`
-	Amount           int     `json:"amount"`
-	ShippingRequired bool    `json:"shipping_required"`
+	Name             string     `json:"name"`
+	ShortDescription string     `json:"short_description"`
+	Price            float32    `json:"price"`
+	Amount           int        `json:"amount"`
+	ShippingRequired bool       `json:"shipping_required"`
+	SKU              string     `json:"sku,omitempty"`
+	WeightGrams      int        `json:"weight_grams,omitempty"`
+	Dimensions       Dimensions `json:"dimensions"`
 }
 
 type CreateResponse struct {
 	ID        string `json:"id"`
-	ProductID string `json:"product_id"`
+	ProductID string `json:"product_id,omitempty"`
 }
 
 type UpdateRequest struct {
@@ -45,5 +56,26 @@ type UpdateRequest struct {
 	Price            *float32 `json:"price,omitempty"`
 	Amount           *int     `json:"amount,omitempty"`
 	ShippingRequired *bool    `json:"shipping_required,omitempty"`
-	Tags             []string `json:"tags,omitempty"`
+	// SKU replaces the external stock keeping unit, an empty string clears it.
+	SKU         *string     `json:"sku,omitempty"`
+	WeightGrams *int        `json:"weight_grams,omitempty"`
+	Dimensions  *Dimensions `json:"dimensions,omitempty"`
+	// Tags replaces the tags, an empty list clears them. Omitted (nil) tags are kept.
+	Tags []string `json:"tags,omitempty"`
+	// ClearTags removes all tags, for clients that cannot send an empty list. It cannot be combined with Tags.
+	ClearTags bool `json:"clear_tags,omitempty"`
+}
+
+// TagsUpdate returns the tags the record should end up with: an empty list if ClearTags is set,
+// otherwise Tags. It returns nil if the tags should be kept.
+func (req *UpdateRequest) TagsUpdate() []string {
+	if req.ClearTags {
+		return []string{}
+	}
+	return req.Tags
+}
+
+// AdjustStockRequest maps physical good IDs to signed amount deltas.
+type AdjustStockRequest struct {
+	Adjustments map[string]int `json:"adjustments"`
 }
@@ -21,23 +21,27 @@ package physicalgood
 import (
 	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	"github.com/mikhail5545/product-service-go/internal/models/image"
 	"gorm.io/gorm"
 )
 
 type PhysicalGood struct {
-	ID        string         `gorm:"primaryKey;size:36" json:"id"`
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `json:"deleted_at"`
-	Tags      []string       `gorm:"type:varchar(128)[]" json:"tags"`
-	Name      string         `gorm:"type:varchar(255)" json:"name"`
+	ID            string         `gorm:"primaryKey;size:36" json:"id"`
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"deleted_at"`
+	DeletedReason string         `gorm:"type:varchar(255)" json:"deleted_reason,omitempty"`
+	Tags          []string       `gorm:"type:varchar(128)[]" json:"tags"`
+	Name          string         `gorm:"type:varchar(255)" json:"name"`
 	// For concise, limited text. Brief description
 	ShortDescription string `gorm:"type:varchar(255)" json:"short_description"`
 	// For large text\Markdown content. Detailed description
 	LongDescription string  `gorm:"type:text" json:"long_description"`
 	Price           float32 `json:"price"`
 	Amount          int     `json:"amount"`
+	// External stock keeping unit used by the warehouse system. Optional, unique among not soft-deleted goods.
+	SKU string `gorm:"size:64;index:idx_physical_goods_sku_live,unique,where:deleted_at IS NULL AND sku <> ''" json:"sku,omitempty"`
 	// This field flags is the product available in the catalogue or is it archived.
 	//
 	// 	- InStock = true -> available in the catalogue
@@ -46,6 +50,22 @@ type PhysicalGood struct {
 	UploadedImageAmount int           `json:"uploaded_image_amount"`
 	Images              []image.Image `gorm:"polymorphic:Owner;" json:"images"`
 	ShippingRequired    bool          `json:"shipping_required"`
+	// Shipping weight in grams, required if ShippingRequired is true.
+	WeightGrams int `json:"weight_grams"`
+	// Shipping package size, required if ShippingRequired is true.
+	Dimensions Dimensions `gorm:"embedded;embeddedPrefix:dimensions_" json:"dimensions"`
+}
+
+// Dimensions holds the size of a shipping package in millimeters.
+type Dimensions struct {
+	Length int `json:"length"`
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// IsComplete reports whether all dimensions are set.
+func (d Dimensions) IsComplete() bool {
+	return d.Length > 0 && d.Width > 0 && d.Height > 0
 }
 
 func (g PhysicalGood) GetUploadedImageAmount() int {
@@ -55,3 +75,8 @@ func (g PhysicalGood) GetUploadedImageAmount() int {
 func (g PhysicalGood) SetUploadedImageAmount(amount int) {
 	g.UploadedImageAmount = amount
 }
+
+// AfterDelete soft-deletes products of the physical good when it is soft-deleted by ID, see [common.CascadeProductDelete].
+func (g *PhysicalGood) AfterDelete(tx *gorm.DB) error {
+	return common.CascadeProductDelete(tx, g.ID)
+}
@@ -20,21 +20,29 @@ package physicalgood
 
 import (
 	"errors"
+	"regexp"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/mikhail5545/product-service-go/internal/models/common"
 )
 
+// SKUPattern is the accepted format of [PhysicalGood.SKU]: 1-64 letters, digits, dots, dashes or underscores,
+// starting with a letter or digit.
+var SKUPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]{0,63}$`)
+
 // Validate validates fields of [physicalgood.CreateRequest].
 // All request fields are required for creation.
 // Validation rules:
 //
 //   - Name: required, 3-255 characters, Alpha only.
-//   - ShortDescription: required, 3-255 characters.
-//   - Price: required, >= 1.
+//   - ShortDescription: required, 3-common.MaxShortDescriptionLength() (255 by default) characters.
+//   - Price: required, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
 //   - ShippingRequired: required, boolean.
 //   - Amount: required, >= 0, >= 1 if ShippingRequired is true.
+//   - SKU: optional, matches SKUPattern.
+//   - WeightGrams: >= 0, required if ShippingRequired is true.
+//   - Dimensions: each >= 0, all required if ShippingRequired is true.
 func (req CreateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(
@@ -46,12 +54,12 @@ func (req CreateRequest) Validate() error {
 		validation.Field(
 			&req.ShortDescription,
 			validation.Required,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.Price,
 			validation.Required,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.Amount,
@@ -62,6 +70,19 @@ func (req CreateRequest) Validate() error {
 				validation.Min(1),
 			),
 		),
+		validation.Field(
+			&req.SKU,
+			validation.Match(SKUPattern),
+		),
+		validation.Field(
+			&req.WeightGrams,
+			validation.Min(0),
+			validation.When(req.ShippingRequired, validation.Required),
+		),
+		validation.Field(
+			&req.Dimensions,
+			validation.By(requireDimensions(req.ShippingRequired)),
+		),
 	)
 }
 
@@ -71,12 +92,19 @@ func (req CreateRequest) Validate() error {
 //
 //   - ID: required, UUID.
 //   - Name: optional, 3-255 characters, Alpha only.
-//   - ShortDescription: optional, 3-255 characters.
-//   - ShortDescription: optional, 3-3000 characters.
-//   - Price: optional, >= 1.
+//   - ShortDescription: optional, 3-common.MaxShortDescriptionLength() (255 by default) characters.
+//   - LongDescription: optional, 3-common.MaxLongDescriptionLength() (3000 by default) characters.
+//   - Price: optional, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
 //   - ShippingRequired: optional, boolean.
 //   - Amount: optional, >= 0, >= 1 if ShippingRequired is true.
-//   - Tags: optional, 1-10 items, 3-20 characters each.
+//   - SKU: optional, matches SKUPattern, empty to clear.
+//   - WeightGrams: optional, >= 0.
+//   - Dimensions: optional, each >= 0.
+//
+// Whether the updated record has weight and dimensions if it requires shipping is checked
+// by the service, as it depends on the stored values.
+//   - Tags: optional, up to 10 items, 3-20 characters each, empty to clear.
+//   - ClearTags: optional, must not be set together with non-empty Tags.
 func (req UpdateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(
@@ -91,22 +119,22 @@ func (req UpdateRequest) Validate() error {
 		),
 		validation.Field(
 			&req.ShortDescription,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.LongDescription,
-			validation.Length(3, 3000),
+			validation.Length(common.MinDescriptionLength, common.MaxLongDescriptionLength()),
 		),
 		validation.Field(
 			&req.Price,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.Amount,
 			validation.Min(0),
 			validation.By(func(value interface{}) error {
 				if req.ShippingRequired != nil && *req.ShippingRequired {
-					if amount, ok := value.(*int); ok {
+					if amount, ok := value.(*int); ok && amount != nil {
 						if *amount < 1 {
 							return errors.New("must be greater then 0 if shipping is required")
 						}
@@ -115,10 +143,61 @@ func (req UpdateRequest) Validate() error {
 				return nil
 			}),
 		),
+		validation.Field(
+			&req.SKU,
+			validation.Match(SKUPattern),
+		),
+		validation.Field(
+			&req.WeightGrams,
+			validation.Min(0),
+		),
+		validation.Field(
+			&req.Dimensions,
+		),
 		validation.Field(
 			&req.Tags,
 			validation.Length(1, 10),
 			validation.Each(validation.Length(3, 20), is.Alphanumeric),
 		),
+		validation.Field(
+			&req.ClearTags,
+			validation.When(len(req.Tags) > 0, validation.Empty.Error("must not be set together with tags")),
+		),
 	)
 }
+
+// Validate validates fields of [physicalgood.Dimensions].
+// Validation rules:
+//
+//   - Length, Width, Height: >= 0.
+func (d Dimensions) Validate() error {
+	return validation.ValidateStruct(&d,
+		validation.Field(&d.Length, validation.Min(0)),
+		validation.Field(&d.Width, validation.Min(0)),
+		validation.Field(&d.Height, validation.Min(0)),
+	)
+}
+
+// requireDimensions returns a rule that, if shipping is required, rejects [Dimensions]
+// unless all of them are set.
+func requireDimensions(shippingRequired bool) validation.RuleFunc {
+	return func(value interface{}) error {
+		if d, ok := value.(Dimensions); ok && shippingRequired && !d.IsComplete() {
+			return errors.New("length, width and height are required if shipping is required")
+		}
+		return nil
+	}
+}
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [physicalgood.CreateRequest.Validate] so length limits apply to the stored value.
+func (req *CreateRequest) Sanitize() {
+	req.ShortDescription = common.SanitizeDescription(req.ShortDescription)
+}
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [physicalgood.UpdateRequest.Validate] so length limits apply to the stored value.
+func (req *UpdateRequest) Sanitize() {
+	common.SanitizeDescriptionPtr(req.ShortDescription)
+	common.SanitizeDescriptionPtr(req.LongDescription)
+}
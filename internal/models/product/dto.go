@@ -18,8 +18,71 @@
 // Package product provides models, DTO models for [product.Service] requests and validation tools.
 package product
 
+import (
+	"time"
+
+	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
+	physicalgoodmodel "github.com/mikhail5545/product-service-go/internal/models/physical_good"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
+)
+
 type AddRequest struct {
 	Price       float32 `json:"price"`
 	DetailsID   string  `json:"details_id"`
 	DetailsType string  `json:"details_type"`
+	// IsFree creates the product as explicitly free, Price must be omitted then.
+	IsFree bool `json:"is_free,omitempty"`
+}
+
+type SetAvailabilityRequest struct {
+	ID             string     `json:"id"`
+	AvailableFrom  *time.Time `json:"available_from"`
+	AvailableUntil *time.Time `json:"available_until"`
+}
+
+// ProductFilter narrows product lists and counts. It is shared by every listing of not soft-deleted
+// products, so the same field always means the same condition. Unset fields are not applied, a zero
+// ProductFilter matches every not soft-deleted product, published or not.
+type ProductFilter struct {
+	// DetailsType keeps products of that details type.
+	DetailsType string `json:"type,omitempty"`
+	// Format keeps training session products of that format, one of [trainingsessionmodel.Formats].
+	Format string `json:"format,omitempty"`
+	// Tags keeps products whose details have every one of the tags.
+	Tags []string `json:"tags,omitempty"`
+	// MinPrice and MaxPrice keep products priced within the inclusive range.
+	MinPrice *float32 `json:"min_price,omitempty"`
+	MaxPrice *float32 `json:"max_price,omitempty"`
+	// InStock keeps published (true) or unpublished (false) products.
+	InStock *bool `json:"in_stock,omitempty"`
+	// AvailableAt keeps products which sale window contains the time.
+	AvailableAt *time.Time `json:"available_at,omitempty"`
+	// UpdatedAfter keeps products updated strictly after the time.
+	UpdatedAfter *time.Time `json:"updated_after,omitempty"`
+	// NeverPublished keeps unpublished products that have never been published.
+	NeverPublished bool `json:"never_published,omitempty"`
+}
+
+// ProductWithDetails is a product together with the record it sells. At most one of the details
+// fields is set, the one matching Product.DetailsType. None is set for an orphaned product
+// whose details record is missing or soft-deleted.
+type ProductWithDetails struct {
+	Product         *Product                              `json:"product"`
+	Course          *coursemodel.Course                   `json:"course,omitempty"`
+	Seminar         *seminarmodel.Seminar                 `json:"seminar,omitempty"`
+	TrainingSession *trainingsessionmodel.TrainingSession `json:"training_session,omitempty"`
+	PhysicalGood    *physicalgoodmodel.PhysicalGood       `json:"physical_good,omitempty"`
+}
+
+// ProductOwner is a product resolved to the record it sells, in the same shape for every details type.
+// Price is the price to charge for the product now, Details is the details record itself.
+type ProductOwner struct {
+	Type     string  `json:"type"`
+	Name     string  `json:"name"`
+	Price    float32 `json:"price"`
+	Currency string  `json:"currency"`
+	// InStock reports whether both the product and its details record are published.
+	InStock bool `json:"in_stock"`
+	Details any  `json:"details"`
 }
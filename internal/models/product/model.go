@@ -19,6 +19,7 @@
 package product
 
 import (
+	"slices"
 	"time"
 
 	"gorm.io/gorm"
@@ -30,19 +31,109 @@ import (
 type Product struct {
 	ID        string         `gorm:"primaryKey;size:36" json:"id"`
 	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
+	UpdatedAt time.Time      `gorm:"index" json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"deleted_at,omitempty"`
 	Price     float32        `json:"price"`
+	// IsFree marks a product intentionally given away at zero price. Products priced at zero or below
+	// can only be published if it is set, see [Product.IsPublishable].
+	IsFree bool `gorm:"not null;default:false" json:"is_free"`
 	// This field flags is the product available in the catalogue or is it archived.
+	// InStock is the published flag of the product: "published" and "unpublished" in
+	// service and repository method names refer to InStock = true and InStock = false.
+	// It is unrelated to stock amounts of physical goods.
 	//
-	// 	- InStock = true -> available in the catalogue
-	// 	- InStock = false -> not available in the catalogue, archived
+	// 	- InStock = true -> published, available in the catalogue
+	// 	- InStock = false -> unpublished (out of stock), not available in the catalogue, archived
+	//
+	// Public lists only ever return products with InStock = true.
 	InStock bool `json:"in_stock"`
+	// FirstPublishedAt is stamped the first time the product is published and kept afterwards,
+	// nil means the product has never been published.
+	FirstPublishedAt *time.Time `gorm:"index" json:"first_published_at,omitempty"`
+	// LastPublishedAt is stamped every time the product is published, nil means the product
+	// has never been published.
+	LastPublishedAt *time.Time `json:"last_published_at,omitempty"`
 	// ID to the details struct. It can be [models.course.Course], [models.seminar.Seminar], [models.trainingsession.TrainingSession]
 	// [models.physicalgood.PhysicalGood].
 	DetailsID string `gorm:"size:36;index" json:"details_id"`
 	// Type of the details struct. It can be 'course', 'seminar', 'training_session', 'physical_good'.
 	DetailsType string `gorm:"size:50;index" json:"details_type"`
+	// Optional sale window. Nil bound means the window is open on that side.
+	AvailableFrom  *time.Time `json:"available_from,omitempty"`
+	AvailableUntil *time.Time `json:"available_until,omitempty"`
+	// State is not stored, it is filled only by listings that mix products of different states.
+	State State `gorm:"-" json:"state,omitempty"`
+}
+
+// State is the lifecycle state of a product derived from its InStock and DeletedAt fields.
+type State string
+
+const (
+	// StateLive published and not soft-deleted product.
+	StateLive State = "live"
+	// StateUnpublished unpublished, but not soft-deleted product.
+	StateUnpublished State = "unpublished"
+	// StateDeleted soft-deleted product.
+	StateDeleted State = "deleted"
+)
+
+// CurrentState derives the lifecycle state of the product.
+func (p *Product) CurrentState() State {
+	switch {
+	case p.DeletedAt.Valid:
+		return StateDeleted
+	case !p.InStock:
+		return StateUnpublished
+	default:
+		return StateLive
+	}
+}
+
+// ChangeCursor is a position in the change feed ordered by UpdatedAt and ID.
+// Products sharing the same UpdatedAt are told apart by ID, so none of them is skipped between pages.
+type ChangeCursor struct {
+	UpdatedAt time.Time `json:"updated_at"`
+	ID        string    `json:"id"`
+}
+
+// Cursor returns the change feed position of the product.
+func (p *Product) Cursor() ChangeCursor {
+	return ChangeCursor{UpdatedAt: p.UpdatedAt, ID: p.ID}
+}
+
+// IsPublishable reports whether the product can be published: it has a positive price or is explicitly free.
+// A product published at zero price is most likely a mistake.
+func (p *Product) IsPublishable() bool {
+	return p.Price > 0 || p.IsFree
+}
+
+// IsAvailableAt reports whether t falls within product's sale window.
+// AvailableFrom is inclusive and AvailableUntil is exclusive.
+func (p *Product) IsAvailableAt(t time.Time) bool {
+	if p == nil {
+		return false
+	}
+	if p.AvailableFrom != nil && t.Before(*p.AvailableFrom) {
+		return false
+	}
+	if p.AvailableUntil != nil && !t.Before(*p.AvailableUntil) {
+		return false
+	}
+	return true
+}
+
+// DetailsTypes lists all known values of [Product.DetailsType].
+var DetailsTypes = []string{"course", "seminar", "training_session", "physical_good"}
+
+// IsValidDetailsType reports whether detailsType is one of [DetailsTypes].
+func IsValidDetailsType(detailsType string) bool {
+	return slices.Contains(DetailsTypes, detailsType)
+}
+
+// DetailsRef identifies the owner of a product by it's details ID and details type.
+type DetailsRef struct {
+	DetailsID   string
+	DetailsType string
 }
 
 type GetProductsResponse struct {
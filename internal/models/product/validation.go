@@ -19,23 +19,31 @@
 package product
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
 )
 
-// Validate validates fields of [product.CreateRequest].
+// Validate validates fields of [product.AddRequest].
 // All request fields are required for product creation.
 // Validation rules:
 //
 //   - DetailsID: required, UUID
-//   - Price: required, min 1.
-//   - Name: required, "physical_good", "training_session", seminar or "course".
+//   - Price: required, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//     Must be empty if IsFree is set.
+//   - DetailsType: required, one of [DetailsTypes].
 func (req *AddRequest) Validate() error {
-	return validation.ValidateStruct(&req,
+	return validation.ValidateStruct(req,
 		validation.Field(
 			&req.Price,
-			validation.Required,
-			validation.Min(float32(1)),
+			validation.When(!req.IsFree, validation.Required, validation.By(common.ValidatePrice)).
+				Else(validation.Empty.Error("must be empty for a free product")),
 		),
 		validation.Field(
 			&req.DetailsID,
@@ -45,7 +53,103 @@ func (req *AddRequest) Validate() error {
 		validation.Field(
 			&req.DetailsType,
 			validation.Required,
-			validation.In("physical_good", "training_session", "seminar", "course"),
+			validation.By(validateDetailsType),
 		),
 	)
 }
+
+func validateDetailsType(value any) error {
+	detailsType, _ := value.(string)
+	if !IsValidDetailsType(detailsType) {
+		return errors.New("must be one of: course, seminar, training_session, physical_good")
+	}
+	return nil
+}
+
+// validateFormat checks that value is one of [trainingsessionmodel.Formats].
+func validateFormat(value any) error {
+	format, _ := value.(string)
+	if !slices.Contains(trainingsessionmodel.Formats, format) {
+		return fmt.Errorf("must be one of: %s", strings.Join(trainingsessionmodel.Formats, ", "))
+	}
+	return nil
+}
+
+// Validate validates fields of [product.SetAvailabilityRequest].
+// Validation rules:
+//
+//   - ID: required, UUID
+//   - AvailableFrom: optional, must not be after AvailableUntil if both are set.
+//   - AvailableUntil: optional.
+func (req *SetAvailabilityRequest) Validate() error {
+	return validation.ValidateStruct(req,
+		validation.Field(
+			&req.ID,
+			validation.Required,
+			is.UUID,
+		),
+		validation.Field(
+			&req.AvailableFrom,
+			validation.By(func(any) error {
+				if req.AvailableFrom != nil && req.AvailableUntil != nil && req.AvailableFrom.After(*req.AvailableUntil) {
+					return errors.New("must not be after available_until")
+				}
+				return nil
+			}),
+		),
+	)
+}
+
+// Validate validates fields of [product.ProductFilter].
+// All filter fields are optional.
+// Validation rules:
+//
+//   - DetailsType: optional, one of [DetailsTypes].
+//   - Format: optional, one of [trainingsessionmodel.Formats].
+//   - Tags: optional, up to 10 items, 3-20 characters each, alphanumeric.
+//   - MinPrice: optional, >= 0.
+//   - MaxPrice: optional, >= 0, must not be less than MinPrice if both are set.
+func (f *ProductFilter) Validate() error {
+	return validation.ValidateStruct(f,
+		validation.Field(
+			&f.DetailsType,
+			validation.When(f.DetailsType != "", validation.By(validateDetailsType)),
+		),
+		validation.Field(
+			&f.Format,
+			validation.When(f.Format != "", validation.By(validateFormat)),
+		),
+		validation.Field(
+			&f.Tags,
+			validation.Length(0, 10),
+			validation.Each(validation.Length(3, 20), is.Alphanumeric),
+		),
+		validation.Field(
+			&f.MinPrice,
+			validation.Min(float32(0)),
+		),
+		validation.Field(
+			&f.MaxPrice,
+			validation.Min(float32(0)),
+			validation.By(func(any) error {
+				if f.MinPrice != nil && f.MaxPrice != nil && *f.MaxPrice < *f.MinPrice {
+					return errors.New("must not be less than min_price")
+				}
+				return nil
+			}),
+		),
+	)
+}
+
+// ValidateTags validates a tag set applied to the details records of products.
+// It follows the rules of the details update requests.
+// Validation rules:
+//
+//   - tags: required, 1-10 items, 3-20 characters each, alphanumeric.
+func ValidateTags(tags []string) error {
+	return validation.Validate(tags,
+		validation.Required,
+		validation.Length(1, 10),
+		validation.Each(validation.Length(3, 20), is.Alphanumeric),
+	)
+}
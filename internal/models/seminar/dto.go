@@ -17,7 +17,12 @@
 
 package seminar
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+)
 
 type CreateRequest struct {
 	Name                string    `json:"name"`
@@ -31,15 +36,62 @@ type CreateRequest struct {
 	EndingDate          time.Time `json:"ending_date"`
 	Place               string    `json:"place"`
 	LatePaymentDate     time.Time `json:"late_payment_date"`
+	// Roles lists products to create for the seminar. If empty, products for all of
+	// [AllProductRoles] are created. Prices of roles that are not listed must be omitted.
+	Roles []ProductRole `json:"roles,omitempty"`
+}
+
+// UnmarshalJSON decodes the request, accepting date-only values (YYYY-MM-DD) for the date fields.
+// They are taken as midnight in [common.DefaultTimezone].
+func (req *CreateRequest) UnmarshalJSON(data []byte) error {
+	type plain CreateRequest
+	aux := struct {
+		*plain
+		Date            common.Time `json:"date"`
+		EndingDate      common.Time `json:"ending_date"`
+		LatePaymentDate common.Time `json:"late_payment_date"`
+	}{plain: (*plain)(req)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	req.Date, req.EndingDate, req.LatePaymentDate = aux.Date.Time, aux.EndingDate.Time, aux.LatePaymentDate.Time
+	return nil
+}
+
+// ProductRoles returns roles products should be created for.
+func (req *CreateRequest) ProductRoles() []ProductRole {
+	if len(req.Roles) == 0 {
+		return AllProductRoles
+	}
+	return req.Roles
 }
 
+// Price returns the requested price of the product that holds role.
+func (req *CreateRequest) Price(role ProductRole) float32 {
+	switch role {
+	case RoleReservation:
+		return req.ReservationPrice
+	case RoleEarly:
+		return req.EarlyPrice
+	case RoleLate:
+		return req.LatePrice
+	case RoleEarlySurcharge:
+		return req.EarlySurchargePrice
+	case RoleLateSurcharge:
+		return req.LateSurchargePrice
+	}
+	return 0
+}
+
+// CreateResponse holds IDs of the created seminar and its products. Product IDs of roles
+// that were not created are omitted from JSON.
 type CreateResponse struct {
 	ID                      string `json:"id"`
-	ReservationProductID    string `json:"reservation_product_id"`
-	EarlyProductID          string `json:"early_product_id"`
-	LateProductID           string `json:"late_product_id"`
-	EarlySurchargeProductID string `json:"early_surcharge_product_id"`
-	LateSurchargeProductID  string `json:"late_surcharge_product_id"`
+	ReservationProductID    string `json:"reservation_product_id,omitempty"`
+	EarlyProductID          string `json:"early_product_id,omitempty"`
+	LateProductID           string `json:"late_product_id,omitempty"`
+	EarlySurchargeProductID string `json:"early_surcharge_product_id,omitempty"`
+	LateSurchargeProductID  string `json:"late_surcharge_product_id,omitempty"`
 }
 
 type UpdateRequest struct {
@@ -55,8 +107,62 @@ type UpdateRequest struct {
 	Date                *time.Time `json:"date,omitempty"`
 	EndingDate          *time.Time `json:"ending_date,omitempty"`
 	Place               *string    `json:"place,omitempty"`
-	Tags                []string   `json:"tags,omitempty"`
-	LatePaymentDate     *time.Time `json:"late_payment_date,omitempty"`
+	// Tags replaces the tags, an empty list clears them. Omitted (nil) tags are kept.
+	Tags []string `json:"tags,omitempty"`
+	// ClearTags removes all tags, for clients that cannot send an empty list. It cannot be combined with Tags.
+	ClearTags       bool       `json:"clear_tags,omitempty"`
+	LatePaymentDate *time.Time `json:"late_payment_date,omitempty"`
+}
+
+// TagsUpdate returns the tags the record should end up with: an empty list if ClearTags is set,
+// otherwise Tags. It returns nil if the tags should be kept.
+func (req *UpdateRequest) TagsUpdate() []string {
+	if req.ClearTags {
+		return []string{}
+	}
+	return req.Tags
+}
+
+// UnmarshalJSON decodes the request, accepting date-only values (YYYY-MM-DD) for the date fields.
+// They are taken as midnight in [common.DefaultTimezone].
+func (req *UpdateRequest) UnmarshalJSON(data []byte) error {
+	type plain UpdateRequest
+	aux := struct {
+		*plain
+		Date            *common.Time `json:"date,omitempty"`
+		EndingDate      *common.Time `json:"ending_date,omitempty"`
+		LatePaymentDate *common.Time `json:"late_payment_date,omitempty"`
+	}{plain: (*plain)(req)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	if aux.Date != nil {
+		req.Date = &aux.Date.Time
+	}
+	if aux.EndingDate != nil {
+		req.EndingDate = &aux.EndingDate.Time
+	}
+	if aux.LatePaymentDate != nil {
+		req.LatePaymentDate = &aux.LatePaymentDate.Time
+	}
+	return nil
+}
+
+// Price returns the requested new price of the product that holds role, or nil if it should not change.
+func (req *UpdateRequest) Price(role ProductRole) *float32 {
+	switch role {
+	case RoleReservation:
+		return req.ReservationPrice
+	case RoleEarly:
+		return req.EarlyPrice
+	case RoleLate:
+		return req.LatePrice
+	case RoleEarlySurcharge:
+		return req.EarlySurchargePrice
+	case RoleLateSurcharge:
+		return req.LateSurchargePrice
+	}
+	return nil
 }
 
 type SeminarDetails struct {
@@ -70,21 +176,32 @@ type SeminarDetails struct {
 	CurrentPriceProductID          string  `json:"current_price_product_id"`
 	CurrentSurchargePrice          float32 `json:"current_surcharge_price"`
 	CurrentSurchargePriceProductID string  `json:"current_surcharge_price_product_id"`
+	// Available reports whether the current price product is within its sale window.
+	Available bool      `json:"available"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+	// ProductsOmitted reports that products were not fetched on request,
+	// all price, product ID and availability fields are zero.
+	ProductsOmitted bool `json:"products_omitted,omitempty"`
+	// FirstPublishedAt is the earliest and LastPublishedAt the latest publish time across the
+	// seminar products, they are only populated by GetWithDeleted and GetWithUnpublished.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
 }
 
 // Current populates the following fields in the [seminar.SeminarDetails] struct
-// depnding on Seminar.LatePaymentDate value:
+// depnding on Seminar.LatePaymentDate value at now:
 //
 //   - CurrentPrice: early or late price
 //   - CurrentPriceID: Seminar.EarlyProductID or Seminar.LateProductID
 //   - CurrentSurchargePrice: early or late surcharge price
 //   - CurrentPriceID: Seminar.EarlySurchargeProductID or Seminar.LateSurchargeProductID
-func (d *SeminarDetails) Current() {
+func (d *SeminarDetails) Current(now time.Time) {
 	if d.Seminar == nil {
 		return
 	}
 
-	if d.LatePaymentDate.After(time.Now()) {
+	if d.PriceRoleAt(now) == RoleEarly {
 		d.CurrentPrice = d.EarlyPrice
 		if d.EarlyProductID != nil {
 			d.CurrentPriceProductID = *d.EarlyProductID
@@ -104,3 +221,25 @@ func (d *SeminarDetails) Current() {
 		}
 	}
 }
+
+// IntegrityProblem describes what is wrong with a product reference of a seminar.
+type IntegrityProblem string
+
+const (
+	// ProblemNilReference a required product role has no product ID.
+	ProblemNilReference IntegrityProblem = "nil_reference"
+	// ProblemMissingProduct the referenced product does not exist or is soft-deleted.
+	ProblemMissingProduct IntegrityProblem = "missing_product"
+	// ProblemWrongType the referenced product has a details type other than "seminar".
+	ProblemWrongType IntegrityProblem = "wrong_type"
+	// ProblemWrongOwner the referenced product belongs to another seminar.
+	ProblemWrongOwner IntegrityProblem = "wrong_owner"
+)
+
+// IntegrityIssue is a single broken product reference of a seminar found by integrity verification.
+type IntegrityIssue struct {
+	SeminarID string           `json:"seminar_id"`
+	Role      ProductRole      `json:"role"`
+	ProductID *string          `json:"product_id"`
+	Problem   IntegrityProblem `json:"problem"`
+}
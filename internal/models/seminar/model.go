@@ -18,8 +18,10 @@
 package seminar
 
 import (
+	"slices"
 	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	"github.com/mikhail5545/product-service-go/internal/models/image"
 	"gorm.io/gorm"
 )
@@ -29,10 +31,12 @@ type Seminar struct {
 	CreatedAt               time.Time      `json:"created_at"`
 	UpdatedAt               time.Time      `json:"updated_at"`
 	DeletedAt               gorm.DeletedAt `json:"deleted_at"`
+	DeletedReason           string         `gorm:"type:varchar(255)" json:"deleted_reason,omitempty"`
 	Tags                    []string       `gorm:"type:varchar(128)[]" json:"tags"`
 	Name                    string         `gorm:"type:varchar(255)" json:"name"`
-	ShortDescription        string         `gorm:"type:varchar(255)" json:"short_description"` // For concise, limited text. Brief description
-	LongDescription         string         `gorm:"type:text" json:"long_description"`          // For large text\Markdown content. Detailed description
+	Slug                    string         `gorm:"type:varchar(255);index:idx_seminars_slug_unique,unique,where:slug <> ''" json:"slug"` // URL-safe name, unique among seminars including soft-deleted ones, empty for seminars created before slugs
+	ShortDescription        string         `gorm:"type:varchar(255)" json:"short_description"`                                           // For concise, limited text. Brief description
+	LongDescription         string         `gorm:"type:text" json:"long_description"`                                                    // For large text\Markdown content. Detailed description
 	UploadedImageAmount     int            `json:"uploaded_image_amount"`
 	Images                  []image.Image  `gorm:"polymorphic:Owner;" json:"images"`
 	ReservationProductID    *string        `gorm:"size:36;index" json:"reservation_product_id"`
@@ -58,3 +62,110 @@ func (s Seminar) GetUploadedImageAmount() int {
 func (s Seminar) SetUploadedImageAmount(amount int) {
 	s.UploadedImageAmount = amount
 }
+
+// AfterDelete soft-deletes products of the seminar when it is soft-deleted by ID, see [common.CascadeProductDelete].
+func (s *Seminar) AfterDelete(tx *gorm.DB) error {
+	return common.CascadeProductDelete(tx, s.ID)
+}
+
+// ProductRole identifies which price of a seminar a product represents.
+type ProductRole string
+
+const (
+	RoleReservation    ProductRole = "reservation"
+	RoleEarly          ProductRole = "early"
+	RoleLate           ProductRole = "late"
+	RoleEarlySurcharge ProductRole = "early_surcharge"
+	RoleLateSurcharge  ProductRole = "late_surcharge"
+)
+
+// AllProductRoles lists every role in the order products are created. A seminar created
+// without explicit roles (the classic five-tier seminar) has products for all of them.
+var AllProductRoles = []ProductRole{RoleReservation, RoleEarly, RoleLate, RoleEarlySurcharge, RoleLateSurcharge}
+
+// RequiredProductRoles lists roles every seminar must have products for. Surcharges are optional.
+var RequiredProductRoles = []ProductRole{RoleReservation, RoleEarly, RoleLate}
+
+// IsValid reports whether r is one of [AllProductRoles].
+func (r ProductRole) IsValid() bool {
+	return slices.Contains(AllProductRoles, r)
+}
+
+// productIDField returns pointer to the product ID field of s that holds product for role.
+func (s *Seminar) productIDField(role ProductRole) **string {
+	switch role {
+	case RoleReservation:
+		return &s.ReservationProductID
+	case RoleEarly:
+		return &s.EarlyProductID
+	case RoleLate:
+		return &s.LateProductID
+	case RoleEarlySurcharge:
+		return &s.EarlySurchargeProductID
+	case RoleLateSurcharge:
+		return &s.LateSurchargeProductID
+	}
+	return nil
+}
+
+// ProductID returns the ID of the product that holds role or nil if the seminar has no such product.
+func (s *Seminar) ProductID(role ProductRole) *string {
+	if field := s.productIDField(role); field != nil {
+		return *field
+	}
+	return nil
+}
+
+// SetProductID sets the ID of the product that holds role.
+func (s *Seminar) SetProductID(role ProductRole, id *string) {
+	if field := s.productIDField(role); field != nil {
+		*field = id
+	}
+}
+
+// ProductRoles returns roles the seminar has products for, in [AllProductRoles] order.
+func (s *Seminar) ProductRoles() []ProductRole {
+	roles := make([]ProductRole, 0, len(AllProductRoles))
+	for _, role := range AllProductRoles {
+		if s.ProductID(role) != nil {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+// ProductIDs returns IDs of all products of the seminar, in [AllProductRoles] order.
+func (s *Seminar) ProductIDs() []string {
+	ids := make([]string, 0, len(AllProductRoles))
+	for _, role := range AllProductRoles {
+		if id := s.ProductID(role); id != nil {
+			ids = append(ids, *id)
+		}
+	}
+	return ids
+}
+
+// HasRequiredProducts reports whether the seminar has products for all of [RequiredProductRoles].
+func (s *Seminar) HasRequiredProducts() bool {
+	for _, role := range RequiredProductRoles {
+		if s.ProductID(role) == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// LateCutoff returns the instant late prices start: midnight of the LatePaymentDate day
+// in [common.DefaultTimezone], where the seminar takes place.
+func (s *Seminar) LateCutoff() time.Time {
+	return common.StartOfDay(s.LatePaymentDate)
+}
+
+// PriceRoleAt returns the role of the product that sets the seminar price at the given time:
+// [RoleEarly] before [Seminar.LateCutoff], [RoleLate] from it on.
+func (s *Seminar) PriceRoleAt(at time.Time) ProductRole {
+	if s.LateCutoff().After(at) {
+		return RoleEarly
+	}
+	return RoleLate
+}
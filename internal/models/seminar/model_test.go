@@ -0,0 +1,207 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package seminar
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestSeminar_PriceRoleAt_Timezone(t *testing.T) {
+	t.Cleanup(func() { _ = common.SetDefaultTimezone("UTC") })
+
+	// The same stored late payment date, noon UTC on 2030-02-01.
+	s := &Seminar{LatePaymentDate: time.Date(2030, 2, 1, 12, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		zone   string
+		cutoff time.Time
+	}{
+		{zone: "UTC", cutoff: time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{zone: "Europe/Moscow", cutoff: time.Date(2030, 1, 31, 21, 0, 0, 0, time.UTC)},
+		{zone: "America/New_York", cutoff: time.Date(2030, 2, 1, 5, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.zone, func(t *testing.T) {
+			// Arrange
+			assert.NoError(t, common.SetDefaultTimezone(tt.zone))
+
+			// Act & Assert
+			assert.True(t, s.LateCutoff().Equal(tt.cutoff), "cutoff %v, want %v", s.LateCutoff().UTC(), tt.cutoff)
+			assert.Equal(t, RoleEarly, s.PriceRoleAt(tt.cutoff.Add(-time.Second)))
+			assert.Equal(t, RoleLate, s.PriceRoleAt(tt.cutoff))
+		})
+	}
+}
+
+func TestCreateRequest_UnmarshalJSON_DateOnly(t *testing.T) {
+	t.Cleanup(func() { _ = common.SetDefaultTimezone("UTC") })
+	assert.NoError(t, common.SetDefaultTimezone("Europe/Moscow"))
+
+	// Arrange
+	var req CreateRequest
+
+	// Act
+	err := json.Unmarshal([]byte(`{"name":"Seminar","date":"2030-03-01","ending_date":"2030-03-03T18:00:00Z","late_payment_date":"2030-02-01"}`), &req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "Seminar", req.Name)
+	assert.Equal(t, time.Date(2030, 2, 28, 21, 0, 0, 0, time.UTC), req.Date.UTC())
+	assert.Equal(t, time.Date(2030, 3, 3, 18, 0, 0, 0, time.UTC), req.EndingDate.UTC())
+	assert.Equal(t, time.Date(2030, 1, 31, 21, 0, 0, 0, time.UTC), req.LatePaymentDate.UTC())
+}
+
+func TestUpdateRequest_UnmarshalJSON_DateOnly(t *testing.T) {
+	// Arrange
+	var req UpdateRequest
+
+	// Act
+	err := json.Unmarshal([]byte(`{"id":"id","late_payment_date":"2030-02-01"}`), &req)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "id", req.ID)
+	assert.Nil(t, req.Date)
+	assert.Nil(t, req.EndingDate)
+	if assert.NotNil(t, req.LatePaymentDate) {
+		assert.Equal(t, time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC), req.LatePaymentDate.UTC())
+	}
+}
+
+func TestUpdateRequest_SanitizeValidate(t *testing.T) {
+	t.Cleanup(func() { _ = common.SetMaxLongDescriptionLength(common.DefaultMaxLongDescriptionLength) })
+
+	t.Run("script stripped", func(t *testing.T) {
+		// Arrange
+		short := "<b>Intro</b><script>alert(1)</script>"
+		req := &UpdateRequest{ID: "0198a5a0-0000-7000-8000-000000000001", ShortDescription: &short}
+
+		// Act
+		req.Sanitize()
+
+		// Assert
+		assert.Equal(t, "<b>Intro</b>", *req.ShortDescription)
+		assert.NoError(t, req.Validate())
+	})
+
+	t.Run("over configured limit", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, common.SetMaxLongDescriptionLength(10))
+		long := strings.Repeat("a", 11)
+		req := &UpdateRequest{ID: "0198a5a0-0000-7000-8000-000000000001", LongDescription: &long}
+
+		// Act
+		req.Sanitize()
+		err := req.Validate()
+
+		// Assert
+		assert.ErrorContains(t, err, "long_description")
+	})
+}
+
+func TestUpdateRequest_TagsUpdate(t *testing.T) {
+	id := "0198a5a0-0000-7000-8000-000000000001"
+
+	t.Run("omitted tags are kept", func(t *testing.T) {
+		// Arrange
+		req := &UpdateRequest{ID: id}
+
+		// Act & Assert
+		assert.NoError(t, req.Validate())
+		assert.Nil(t, req.TagsUpdate())
+	})
+
+	t.Run("clear tags empties them", func(t *testing.T) {
+		// Arrange
+		req := &UpdateRequest{ID: id, ClearTags: true}
+
+		// Act & Assert
+		assert.NoError(t, req.Validate())
+		assert.Equal(t, []string{}, req.TagsUpdate())
+	})
+
+	t.Run("tags replace them", func(t *testing.T) {
+		// Arrange
+		req := &UpdateRequest{ID: id, Tags: []string{"yoga", "retreat"}}
+
+		// Act & Assert
+		assert.NoError(t, req.Validate())
+		assert.Equal(t, []string{"yoga", "retreat"}, req.TagsUpdate())
+	})
+
+	t.Run("clear tags together with tags", func(t *testing.T) {
+		// Arrange
+		req := &UpdateRequest{ID: id, Tags: []string{"yoga"}, ClearTags: true}
+
+		// Act
+		err := req.Validate()
+
+		// Assert
+		assert.ErrorContains(t, err, "clear_tags")
+	})
+}
+
+// TestSeminar_SlugIndex migrates the Slug column with its real gorm tag. The whole Seminar model can't be
+// migrated on sqlite, because of its postgres arrays and polymorphic images.
+func TestSeminar_SlugIndex(t *testing.T) {
+	slugField, _ := reflect.TypeOf(Seminar{}).FieldByName("Slug")
+	row := reflect.StructOf([]reflect.StructField{
+		{Name: "ID", Type: reflect.TypeOf(""), Tag: `gorm:"primaryKey"`},
+		{Name: "Slug", Type: slugField.Type, Tag: slugField.Tag},
+	})
+	newRow := func(slug string) any {
+		r := reflect.New(row)
+		r.Elem().Field(0).SetString(uuid.New().String())
+		r.Elem().Field(1).SetString(slug)
+		return r.Interface()
+	}
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	seminars := func() *gorm.DB { return db.Table("seminars") }
+
+	// Seminars created before slugs existed have none, the index must be created over them.
+	if err := db.Exec("CREATE TABLE seminars (id varchar(36) PRIMARY KEY, slug varchar(255))").Error; err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	assert.NoError(t, seminars().Create(newRow("")).Error)
+	assert.NoError(t, seminars().Create(newRow("")).Error)
+
+	// Act
+	err = seminars().AutoMigrate(newRow(""))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, seminars().Create(newRow("")).Error, "empty slugs are not unique")
+	assert.NoError(t, seminars().Create(newRow("yoga")).Error)
+	assert.Error(t, seminars().Create(newRow("yoga")).Error, "slugs are unique")
+}
@@ -2,6 +2,8 @@ package seminar
 
 import (
 	"errors"
+	"fmt"
+	"slices"
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -10,21 +12,23 @@ import (
 )
 
 // Validate validates fields of [seminar.CreateRequest].
-// All request fields are required for creation.
+// All request fields except Roles and prices of optional roles are required for creation.
 // Validation rules:
 //
 //   - Name: required, 3-255 characters, Alpha only.
-//   - ShortDescription: required, 3-255 characters.
-//   - ReservationPrice: required, >= 1.
-//   - EarlyPrice: required, >= 1.
-//   - LatePrice: required, >= 1.
-//   - EarlySurchargePrice: required, >= 1.
-//   - LateSurchargePrice: required, >= 1.
+//   - ShortDescription: required, 3-common.MaxShortDescriptionLength() (255 by default) characters.
+//   - ReservationPrice: required, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - EarlyPrice: required, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - LatePrice: required, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - EarlySurchargePrice: required if the role is present, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured. Must be empty otherwise.
+//   - LateSurchargePrice: required if the role is present, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured. Must be empty otherwise.
 //   - Date: required, at least 48 hours from now.
 //   - EndingDate: required, at least 1 hour after Date.
 //   - LatePaymentDate: required, at least 24 hours from now, max 24 hours before Date.
 //   - Place: required, 3-255 characters.
+//   - Roles: optional, known roles without duplicates, must include all of [RequiredProductRoles].
 func (req CreateRequest) Validate() error {
+	roles := req.ProductRoles()
 	return validation.ValidateStruct(&req,
 		validation.Field(
 			&req.Name,
@@ -35,32 +39,36 @@ func (req CreateRequest) Validate() error {
 		validation.Field(
 			&req.ShortDescription,
 			validation.Required,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.ReservationPrice,
 			validation.Required,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.EarlyPrice,
 			validation.Required,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.LatePrice,
 			validation.Required,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.EarlySurchargePrice,
-			validation.Required,
-			validation.Min(float32(1)),
+			validation.When(slices.Contains(roles, RoleEarlySurcharge),
+				validation.Required,
+				validation.By(common.ValidatePrice),
+			).Else(validation.Empty),
 		),
 		validation.Field(
 			&req.LateSurchargePrice,
-			validation.Required,
-			validation.Min(float32(1)),
+			validation.When(slices.Contains(roles, RoleLateSurcharge),
+				validation.Required,
+				validation.By(common.ValidatePrice),
+			).Else(validation.Empty),
 		),
 		validation.Field(
 			&req.Date,
@@ -97,27 +105,57 @@ func (req CreateRequest) Validate() error {
 			validation.Required,
 			validation.Length(3, 255),
 		),
+		validation.Field(
+			&req.Roles,
+			validation.By(validateRoles),
+		),
 	)
 }
 
+// validateRoles checks that value is a set of known roles that includes all of [RequiredProductRoles].
+// Empty value is valid, it stands for [AllProductRoles].
+func validateRoles(value any) error {
+	roles, _ := value.([]ProductRole)
+	if len(roles) == 0 {
+		return nil
+	}
+	seen := make(map[ProductRole]struct{}, len(roles))
+	for _, role := range roles {
+		if !role.IsValid() {
+			return fmt.Errorf("unknown role %q", role)
+		}
+		if _, ok := seen[role]; ok {
+			return fmt.Errorf("duplicate role %q", role)
+		}
+		seen[role] = struct{}{}
+	}
+	for _, role := range RequiredProductRoles {
+		if _, ok := seen[role]; !ok {
+			return fmt.Errorf("missing required role %q", role)
+		}
+	}
+	return nil
+}
+
 // Validate validates fields of [seminar.UpdateRequest].
 // All request fields except ID are optional.
 // Validation rules:
 //
 //   - ID: required, UUID
 //   - Name: optional, 3-255 characters, Alpha only.
-//   - ShortDescription: optional, 3-255 characters.
-//   - LongDescription: optional, 3-3000 characters.
-//   - ReservationPrice: optional, >= 1.
-//   - EarlyPrice: optional, >= 1.
-//   - LatePrice: optional, >= 1.
-//   - EarlySurchargePrice: optional, >= 1.
-//   - LateSurchargePrice: optional, >= 1.
-//   - Date: optional, at least 48 hours from now.
-//   - EndingDate: optional, at least 1 hour after Date.
-//   - LatePaymentDate: optional, at least 24 hours from now, max 24 hours before Date.
+//   - ShortDescription: optional, 3-common.MaxShortDescriptionLength() (255 by default) characters.
+//   - LongDescription: optional, 3-common.MaxLongDescriptionLength() (3000 by default) characters.
+//   - ReservationPrice: optional, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - EarlyPrice: optional, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - LatePrice: optional, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - EarlySurchargePrice: optional, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - LateSurchargePrice: optional, >= common.MinPrice() (1 by default), <= common.MaxPrice() if configured.
+//   - Date: optional, not zero, at least 48 hours from now.
+//   - EndingDate: optional, not zero, at least 1 hour after Date.
+//   - LatePaymentDate: optional, not zero, at least 24 hours from now, max 24 hours before Date.
 //   - Place: optional, 3-255 characters.
-//   - Tags: optional, 1-10 items, 3-20 characters each.
+//   - Tags: optional, up to 10 items, 3-20 characters each, empty to clear.
+//   - ClearTags: optional, must not be set together with non-empty Tags.
 func (req UpdateRequest) Validate() error {
 	return validation.ValidateStruct(&req,
 		validation.Field(
@@ -132,38 +170,40 @@ func (req UpdateRequest) Validate() error {
 		),
 		validation.Field(
 			&req.ShortDescription,
-			validation.Length(3, 255),
+			validation.Length(common.MinDescriptionLength, common.MaxShortDescriptionLength()),
 		),
 		validation.Field(
 			&req.LongDescription,
-			validation.Length(3, 3000),
+			validation.Length(common.MinDescriptionLength, common.MaxLongDescriptionLength()),
 		),
 		validation.Field(
 			&req.ReservationPrice,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.EarlyPrice,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.LatePrice,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.EarlySurchargePrice,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.LateSurchargePrice,
-			validation.Min(float32(1)),
+			validation.By(common.ValidatePrice),
 		),
 		validation.Field(
 			&req.Date,
+			validation.When(req.Date != nil, validation.Required),
 			validation.Min(time.Now().Add(time.Duration(48)*time.Hour)),
 		),
 		validation.Field(
 			&req.EndingDate,
+			validation.When(req.EndingDate != nil, validation.Required),
 			validation.When(req.Date != nil && req.EndingDate != nil,
 				validation.By(func(value any) error {
 					if endingDate, ok := value.(*time.Time); ok && endingDate != nil {
@@ -178,6 +218,7 @@ func (req UpdateRequest) Validate() error {
 		validation.Field(
 			&req.LatePaymentDate,
 			validation.When(req.LatePaymentDate != nil,
+				validation.Required,
 				validation.Min(time.Now().Add(time.Duration(24)*time.Hour))),
 			validation.When(req.LatePaymentDate != nil && req.Date != nil,
 				validation.By(func(value interface{}) error {
@@ -199,5 +240,22 @@ func (req UpdateRequest) Validate() error {
 			validation.Length(1, 10),
 			validation.Each(validation.Length(3, 20), is.Alphanumeric),
 		),
+		validation.Field(
+			&req.ClearTags,
+			validation.When(len(req.Tags) > 0, validation.Empty.Error("must not be set together with tags")),
+		),
 	)
 }
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [seminar.CreateRequest.Validate] so length limits apply to the stored value.
+func (req *CreateRequest) Sanitize() {
+	req.ShortDescription = common.SanitizeDescription(req.ShortDescription)
+}
+
+// Sanitize strips unsafe HTML from the request descriptions, see [common.SanitizeDescription].
+// It is called before [seminar.UpdateRequest.Validate] so length limits apply to the stored value.
+func (req *UpdateRequest) Sanitize() {
+	common.SanitizeDescriptionPtr(req.ShortDescription)
+	common.SanitizeDescriptionPtr(req.LongDescription)
+}
@@ -0,0 +1,33 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package storefront provides models for the aggregated storefront feed.
+package storefront
+
+import "time"
+
+// Item is a single published catalog entry of any product type, reduced to the fields
+// the storefront shows.
+type Item struct {
+	ID string `json:"id"`
+	// Type is the details type of the item, the same as [product.Product.DetailsType].
+	Type      string    `json:"type"`
+	Name      string    `json:"name"`
+	Price     float32   `json:"price"`
+	ProductID string    `json:"product_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
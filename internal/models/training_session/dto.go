@@ -18,6 +18,8 @@
 // Package trainingsession provides models, DTO models for [trainingsession.Service] requests and validation tools.
 package trainingsession
 
+import "time"
+
 type CreateRequest struct {
 	Name             string  `json:"name"`
 	ShortDescription string  `json:"short_description"`
@@ -28,22 +30,43 @@ type CreateRequest struct {
 
 type CreateResponse struct {
 	ID        string `json:"id"`
-	ProductID string `json:"product_id"`
+	ProductID string `json:"product_id,omitempty"`
 }
 
 type UpdateRequest struct {
-	ID               string   `json:"id"`
-	Name             *string  `json:"name,omitempty"`
-	ShortDescription *string  `json:"short_description,omitempty"`
-	LongDescription  *string  `json:"long_description,omitempty"`
-	DurationMinutes  *int     `json:"duration_minutes,omitempty"`
-	Format           *string  `json:"format,omitempty"`
-	Tags             []string `json:"tags,omitempty"`
-	Price            *float32 `json:"price,omitempty"`
+	ID               string  `json:"id"`
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"short_description,omitempty"`
+	LongDescription  *string `json:"long_description,omitempty"`
+	DurationMinutes  *int    `json:"duration_minutes,omitempty"`
+	Format           *string `json:"format,omitempty"`
+	// Tags replaces the tags, an empty list clears them. Omitted (nil) tags are kept.
+	Tags []string `json:"tags,omitempty"`
+	// ClearTags removes all tags, for clients that cannot send an empty list. It cannot be combined with Tags.
+	ClearTags bool     `json:"clear_tags,omitempty"`
+	Price     *float32 `json:"price,omitempty"`
+}
+
+// TagsUpdate returns the tags the record should end up with: an empty list if ClearTags is set,
+// otherwise Tags. It returns nil if the tags should be kept.
+func (req *UpdateRequest) TagsUpdate() []string {
+	if req.ClearTags {
+		return []string{}
+	}
+	return req.Tags
 }
 
 type TrainingSessionDetails struct {
 	*TrainingSession
 	Price     float32 `json:"price"`
 	ProductID string  `json:"product_id"`
+	// Available reports whether the product is within its sale window.
+	Available bool `json:"available"`
+	// ProductsOmitted reports that the product was not fetched on request,
+	// Price, ProductID and Available are zero.
+	ProductsOmitted bool `json:"products_omitted,omitempty"`
+	// FirstPublishedAt and LastPublishedAt are the publish times of the product, they are
+	// only populated by GetWithDeleted and GetWithUnpublished.
+	FirstPublishedAt *time.Time `json:"first_published_at,omitempty"`
+	LastPublishedAt  *time.Time `json:"last_published_at,omitempty"`
 }
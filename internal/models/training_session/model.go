@@ -21,15 +21,20 @@ package trainingsession
 import (
 	"time"
 
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	"github.com/mikhail5545/product-service-go/internal/models/image"
 	"gorm.io/gorm"
 )
 
+// Formats lists all accepted values of [TrainingSession.Format].
+var Formats = []string{"online", "offline"}
+
 type TrainingSession struct {
 	ID                  string         `gorm:"primaryKey;size:36" json:"id"`
 	CreatedAt           time.Time      `json:"created_at"`
 	UpdatedAt           time.Time      `json:"updated_at"`
 	DeletedAt           gorm.DeletedAt `json:"deleted_at"`
+	DeletedReason       string         `gorm:"type:varchar(255)" json:"deleted_reason,omitempty"`
 	Tags                []string       `gorm:"type:varchar(128)[]" json:"tags"`
 	UploadedImageAmount int            `json:"uploaded_image_amount"`
 	Images              []image.Image  `gorm:"polymorphic:Owner;" json:"images"`
@@ -54,3 +59,8 @@ func (ts TrainingSession) GetUploadedImageAmount() int {
 func (ts TrainingSession) SetUploadedImageAmount(amount int) {
 	ts.UploadedImageAmount = amount
 }
+
+// AfterDelete soft-deletes products of the training session when it is soft-deleted by ID, see [common.CascadeProductDelete].
+func (ts *TrainingSession) AfterDelete(tx *gorm.DB) error {
+	return common.CascadeProductDelete(tx, ts.ID)
+}
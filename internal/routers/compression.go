@@ -0,0 +1,73 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"compress/gzip"
+	"os"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// CompressionConfig configures gzip compression of public read endpoints.
+type CompressionConfig struct {
+	// Enabled turns compression on. Clients that don't send "Accept-Encoding: gzip" always get plain responses.
+	Enabled bool
+	// Level is the gzip compression level, from [gzip.BestSpeed] to [gzip.BestCompression].
+	Level int
+	// MinLength is the response size in bytes below which responses are sent uncompressed.
+	MinLength int
+}
+
+// DefaultCompressionConfig is used for values missing from the environment.
+var DefaultCompressionConfig = CompressionConfig{
+	Enabled:   true,
+	Level:     gzip.DefaultCompression,
+	MinLength: 1024,
+}
+
+// CompressionConfigFromEnv reads HTTP_COMPRESSION, HTTP_GZIP_LEVEL and HTTP_GZIP_MIN_LENGTH environment variables.
+// Missing or invalid values fall back to [DefaultCompressionConfig].
+func CompressionConfigFromEnv() CompressionConfig {
+	cfg := DefaultCompressionConfig
+	if enabled, err := strconv.ParseBool(os.Getenv("HTTP_COMPRESSION")); err == nil {
+		cfg.Enabled = enabled
+	}
+	if level, err := strconv.Atoi(os.Getenv("HTTP_GZIP_LEVEL")); err == nil && level >= gzip.HuffmanOnly && level <= gzip.BestCompression {
+		cfg.Level = level
+	}
+	if minLength, err := strconv.Atoi(os.Getenv("HTTP_GZIP_MIN_LENGTH")); err == nil && minLength >= 0 {
+		cfg.MinLength = minLength
+	}
+	return cfg
+}
+
+// Compression returns gzip middleware configured by cfg. If compression is disabled,
+// the returned middleware passes requests through. It must be registered once per route,
+// otherwise responses would be compressed twice.
+func Compression(cfg CompressionConfig) echo.MiddlewareFunc {
+	if !cfg.Enabled {
+		return func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+	}
+	return middleware.GzipWithConfig(middleware.GzipConfig{
+		Level:     cfg.Level,
+		MinLength: cfg.MinLength,
+	})
+}
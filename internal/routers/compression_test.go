@@ -0,0 +1,123 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/handlers/dto"
+	"github.com/mikhail5545/product-service-go/internal/models/seminar"
+	coursemock "github.com/mikhail5545/product-service-go/internal/test/services/course_mock"
+	coursepartmock "github.com/mikhail5545/product-service-go/internal/test/services/course_part_mock"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/services/featured_mock"
+	physicalgoodmock "github.com/mikhail5545/product-service-go/internal/test/services/physical_good_mock"
+	productmock "github.com/mikhail5545/product-service-go/internal/test/services/product_mock"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/services/seminar_mock"
+	storefrontmock "github.com/mikhail5545/product-service-go/internal/test/services/storefront_mock"
+	trainingsessionmock "github.com/mikhail5545/product-service-go/internal/test/services/training_session_mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetup_Compression(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	seminarService := seminarmock.NewMockService(ctrl)
+	newEcho := func(cfg CompressionConfig) *echo.Echo {
+		e := echo.New()
+		Setup(e,
+			productmock.NewMockService(ctrl),
+			coursepartmock.NewMockService(ctrl),
+			trainingsessionmock.NewMockService(ctrl),
+			coursemock.NewMockService(ctrl),
+			seminarService,
+			physicalgoodmock.NewMockService(ctrl),
+			storefrontmock.NewMockService(ctrl),
+			featuredmock.NewMockService(ctrl),
+			cfg,
+			TimeoutConfig{},
+		)
+		return e
+	}
+
+	// Enough records to exceed the minimum length.
+	details := make([]seminar.SeminarDetails, 0, 20)
+	for i := range 20 {
+		details = append(details, seminar.SeminarDetails{Seminar: &seminar.Seminar{ID: uuid.New().String(), Name: fmt.Sprintf("Seminar %d", i)}})
+	}
+	expected, _ := json.Marshal(dto.NewSeminars(details))
+
+	tests := []struct {
+		name           string
+		cfg            CompressionConfig
+		target         string
+		acceptEncoding string
+		wantGzip       bool
+	}{
+		{name: "gzip requested", cfg: DefaultCompressionConfig, target: "/api/v0/seminars", acceptEncoding: "gzip", wantGzip: true},
+		{name: "gzip not advertised", cfg: DefaultCompressionConfig, target: "/api/v0/seminars", wantGzip: false},
+		{name: "compression disabled", cfg: CompressionConfig{}, target: "/api/v0/seminars", acceptEncoding: "gzip", wantGzip: false},
+		{name: "admin endpoint", cfg: DefaultCompressionConfig, target: "/api/v0/admin/seminars", acceptEncoding: "gzip", wantGzip: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			e := newEcho(tt.cfg)
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.acceptEncoding != "" {
+				req.Header.Set(echo.HeaderAcceptEncoding, tt.acceptEncoding)
+			}
+			rec := httptest.NewRecorder()
+
+			seminarService.EXPECT().List(gomock.Any(), 10, 0).Return(details, int64(len(details)), nil)
+
+			// Act
+			e.ServeHTTP(rec, req)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var body io.Reader = rec.Body
+			if tt.wantGzip {
+				assert.Equal(t, "gzip", rec.Header().Get(echo.HeaderContentEncoding))
+				zr, err := gzip.NewReader(rec.Body)
+				if !assert.NoError(t, err) {
+					return
+				}
+				body = zr
+			} else {
+				assert.Empty(t, rec.Header().Get(echo.HeaderContentEncoding))
+			}
+			raw, err := io.ReadAll(body)
+			assert.NoError(t, err)
+			assert.True(t, strings.HasPrefix(string(raw), "{"), "body is not plain JSON")
+			var resp map[string]json.RawMessage
+			assert.NoError(t, json.Unmarshal(raw, &resp))
+			assert.JSONEq(t, string(expected), string(resp["seminar_details"]))
+		})
+	}
+}
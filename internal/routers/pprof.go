@@ -0,0 +1,71 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"os"
+	"strconv"
+)
+
+// PprofConfig configures the profiling endpoints.
+type PprofConfig struct {
+	// Enabled mounts the net/http/pprof endpoints and the expvar metrics.
+	Enabled bool
+	// Addr is the address of the separate admin listener serving them. It should not be
+	// reachable from the public network.
+	Addr string
+}
+
+// DefaultPprofConfig is used for values missing from the environment.
+var DefaultPprofConfig = PprofConfig{
+	Enabled: false,
+	Addr:    "localhost:6060",
+}
+
+// PprofConfigFromEnv reads ENABLE_PPROF and PPROF_ADDR environment variables.
+// Missing or invalid values fall back to [DefaultPprofConfig].
+func PprofConfigFromEnv() PprofConfig {
+	cfg := DefaultPprofConfig
+	if enabled, err := strconv.ParseBool(os.Getenv("ENABLE_PPROF")); err == nil {
+		cfg.Enabled = enabled
+	}
+	if addr := os.Getenv("PPROF_ADDR"); addr != "" {
+		cfg.Addr = addr
+	}
+	return cfg
+}
+
+// Pprof returns the handler of the admin listener. The pprof endpoints are served under
+// /debug/pprof/ and expvar metrics, like events_publish_failures, under /debug/vars
+// only if cfg.Enabled, otherwise every request gets 404.
+func Pprof(cfg PprofConfig) http.Handler {
+	mux := http.NewServeMux()
+	if !cfg.Enabled {
+		return mux
+	}
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
@@ -0,0 +1,78 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPprof(t *testing.T) {
+	paths := []string{"/debug/pprof/", "/debug/pprof/goroutine?debug=1", "/debug/pprof/heap", "/debug/pprof/cmdline", "/debug/pprof/symbol", "/debug/vars"}
+
+	t.Run("enabled", func(t *testing.T) {
+		handler := Pprof(PprofConfig{Enabled: true})
+
+		for _, path := range paths {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code, path)
+		}
+	})
+
+	t.Run("publish failures metric", func(t *testing.T) {
+		handler := Pprof(PprofConfig{Enabled: true})
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"events_publish_failures"`)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		handler := Pprof(PprofConfig{Enabled: false})
+
+		for _, path := range paths {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+			assert.Equal(t, http.StatusNotFound, rec.Code, path)
+		}
+	})
+}
+
+func TestPprofConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("ENABLE_PPROF", "")
+		t.Setenv("PPROF_ADDR", "")
+
+		assert.Equal(t, DefaultPprofConfig, PprofConfigFromEnv())
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("ENABLE_PPROF", "true")
+		t.Setenv("PPROF_ADDR", "127.0.0.1:7070")
+
+		assert.Equal(t, PprofConfig{Enabled: true, Addr: "127.0.0.1:7070"}, PprofConfigFromEnv())
+	})
+}
@@ -0,0 +1,54 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+// Recover returns middleware that turns a panic in a handler into a 500 response instead of
+// crashing the server. The panic value and stack trace are logged with the request ID, the
+// client only gets the request ID to quote in a bug report. It must be registered after
+// [middleware.RequestID].
+func Recover(logger *slog.Logger) echo.MiddlewareFunc {
+	return middleware.RecoverWithConfig(middleware.RecoverConfig{
+		LogErrorFunc: func(c echo.Context, err error, stack []byte) error {
+			requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+			logger.ErrorContext(c.Request().Context(), "recovered from panic",
+				"request_id", requestID,
+				"method", c.Request().Method,
+				"path", c.Path(),
+				"error", err,
+				"stack", string(stack),
+			)
+			// Nothing can be written if the handler panicked after committing the response.
+			if c.Response().Committed {
+				return nil
+			}
+			return response.Write(c, http.StatusInternalServerError, map[string]any{
+				"error":      "Internal server error",
+				"request_id": requestID,
+			})
+		},
+	})
+}
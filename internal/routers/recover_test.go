@@ -0,0 +1,68 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecover(t *testing.T) {
+	// Arrange
+	var logs bytes.Buffer
+	e := echo.New()
+	e.Use(middleware.RequestID())
+	e.Use(Recover(slog.New(slog.NewTextHandler(&logs, nil))))
+	e.GET("/panic", func(c echo.Context) error {
+		panic("secret connection string")
+	})
+	e.GET("/ok", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	// Act
+	panicRec := httptest.NewRecorder()
+	e.ServeHTTP(panicRec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	okRec := httptest.NewRecorder()
+	e.ServeHTTP(okRec, httptest.NewRequest(http.MethodGet, "/ok", nil))
+
+	// Assert
+	assert.Equal(t, http.StatusInternalServerError, panicRec.Code)
+	assert.NotContains(t, panicRec.Body.String(), "secret connection string")
+	var body map[string]any
+	assert.NoError(t, json.Unmarshal(panicRec.Body.Bytes(), &body))
+	requestID := panicRec.Header().Get(echo.HeaderXRequestID)
+	assert.NotEmpty(t, requestID)
+	assert.Equal(t, requestID, body["request_id"])
+
+	assert.Contains(t, logs.String(), "recovered from panic")
+	assert.Contains(t, logs.String(), "request_id="+requestID)
+	assert.Contains(t, logs.String(), "secret connection string")
+	assert.Contains(t, logs.String(), "goroutine")
+
+	// The server keeps serving requests after the panic.
+	assert.Equal(t, http.StatusOK, okRec.Code)
+}
@@ -18,23 +18,34 @@
 package routers
 
 import (
+	"log/slog"
+
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	admincourse "github.com/mikhail5545/product-service-go/internal/handlers/admin/course"
 	admincp "github.com/mikhail5545/product-service-go/internal/handlers/admin/course_part"
+	adminfeatured "github.com/mikhail5545/product-service-go/internal/handlers/admin/featured"
 	adminphysicalgood "github.com/mikhail5545/product-service-go/internal/handlers/admin/physical_good"
+	adminproduct "github.com/mikhail5545/product-service-go/internal/handlers/admin/product"
 	adminseminar "github.com/mikhail5545/product-service-go/internal/handlers/admin/seminar"
 	admints "github.com/mikhail5545/product-service-go/internal/handlers/admin/training_session"
+	admintrash "github.com/mikhail5545/product-service-go/internal/handlers/admin/trash"
 	publiccourse "github.com/mikhail5545/product-service-go/internal/handlers/public/course"
 	publiccp "github.com/mikhail5545/product-service-go/internal/handlers/public/course_part"
+	publicfeatured "github.com/mikhail5545/product-service-go/internal/handlers/public/featured"
+	publicmeta "github.com/mikhail5545/product-service-go/internal/handlers/public/meta"
 	publicphysicalgood "github.com/mikhail5545/product-service-go/internal/handlers/public/physical_good"
+	publicproduct "github.com/mikhail5545/product-service-go/internal/handlers/public/product"
 	publicseminar "github.com/mikhail5545/product-service-go/internal/handlers/public/seminar"
+	publicstorefront "github.com/mikhail5545/product-service-go/internal/handlers/public/storefront"
 	publicts "github.com/mikhail5545/product-service-go/internal/handlers/public/training_session"
 	"github.com/mikhail5545/product-service-go/internal/services/course"
 	coursepart "github.com/mikhail5545/product-service-go/internal/services/course_part"
+	"github.com/mikhail5545/product-service-go/internal/services/featured"
 	physicalgood "github.com/mikhail5545/product-service-go/internal/services/physical_good"
 	"github.com/mikhail5545/product-service-go/internal/services/product"
 	"github.com/mikhail5545/product-service-go/internal/services/seminar"
+	"github.com/mikhail5545/product-service-go/internal/services/storefront"
 	trainingsession "github.com/mikhail5545/product-service-go/internal/services/training_session"
 	"github.com/mikhail5545/product-service-go/internal/util/errors"
 )
@@ -47,14 +58,23 @@ func Setup(
 	courseService course.Service,
 	seminarService seminar.Service,
 	phgService physicalgood.Service,
+	storefrontService storefront.Service,
+	featuredService featured.Service,
+	compression CompressionConfig,
+	timeout TimeoutConfig,
 ) {
 	e.HTTPErrorHandler = errors.HTTPErrorHandler
 
 	api := e.Group("/api")
 	ver := api.Group("/v0")
 
+	e.Use(middleware.RequestID())
 	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
+	e.Use(Recover(slog.Default()))
+	e.Use(Timeout(timeout))
+
+	// Only public read endpoints serve large catalog lists, admin ones are left uncompressed.
+	compress := Compression(compression)
 
 	// --- Public handlers ---
 	phgHandler := publicphysicalgood.New(phgService)
@@ -62,6 +82,10 @@ func Setup(
 	tsHandler := publicts.New(tsService)
 	courseHandler := publiccourse.New(courseService)
 	seminarHandler := publicseminar.New(seminarService)
+	productHandler := publicproduct.New(productService)
+	storefrontHandler := publicstorefront.New(storefrontService)
+	featuredHandler := publicfeatured.New(featuredService)
+	metaHandler := publicmeta.New()
 
 	// --- Admin handlers ---
 	adminphgHandler := adminphysicalgood.New(phgService)
@@ -69,34 +93,65 @@ func Setup(
 	admintsHandler := admints.New(tsService)
 	adminCourseHandler := admincourse.New(courseService)
 	adminSeminarHandler := adminseminar.New(seminarService)
+	adminProductHandler := adminproduct.New(productService)
+	adminFeaturedHandler := adminfeatured.New(featuredService)
+	adminTrashHandler := admintrash.New(courseService, cpService, phgService, seminarService, tsService)
 
-	trainingSesssions := ver.Group("/training-sessions")
+	trainingSesssions := ver.Group("/training-sessions", compress)
 	{
 		trainingSesssions.GET("", tsHandler.List)
 		trainingSesssions.GET("/:id", tsHandler.Get)
 	}
-	courses := ver.Group("/courses")
+	courses := ver.Group("/courses", compress)
 	{
 		courses.GET("", courseHandler.List)
 		courses.GET("/:id", courseHandler.Get)
+		courses.GET("/:id/full", courseHandler.GetFull)
+		courses.GET("/:cid/parts", cpHandler.List)
 	}
-	course_parts := ver.Group("/course-parts")
+	course_parts := ver.Group("/course-parts", compress)
 	{
-		course_parts.GET("/:cid", cpHandler.List)
 		course_parts.GET("/:id", cpHandler.Get)
 	}
-	seminars := ver.Group("/seminars")
+	seminars := ver.Group("/seminars", compress)
 	{
 		seminars.GET("", seminarHandler.List)
 		seminars.GET("/:id", seminarHandler.Get)
+		seminars.GET("/by-slug/:slug", seminarHandler.GetBySlug)
+		seminars.POST("/batch", seminarHandler.GetByIDs)
 	}
-	physicalGoods := ver.Group("/physical-good")
+	physicalGoods := ver.Group("/physical-good", compress)
 	{
 		physicalGoods.GET("", phgHandler.List)
 		physicalGoods.GET("/:id", phgHandler.Get)
 	}
-	admin := ver.Group("/admin")
+	products := ver.Group("/products", compress, StockDefault(PublicStockPolicy))
+	{
+		products.GET("", productHandler.List)
+		products.GET("/updated", productHandler.ListUpdatedSince)
+		products.GET("/:id/related", productHandler.Related)
+		products.GET("/:id/details", productHandler.GetDetails)
+	}
+	ver.GET("/storefront", storefrontHandler.Feed, compress)
+	ver.GET("/featured", featuredHandler.List, compress)
+	ver.GET("/meta", metaHandler.Get)
+	admin := ver.Group("/admin", StockDefault(AdminStockPolicy))
 	{
+		admin.GET("/trash/purge-preview", adminTrashHandler.PurgePreview)
+		admin.POST("/trash/purge", adminTrashHandler.Purge)
+		adminFeatured := admin.Group("/featured")
+		{
+			adminFeatured.GET("", adminFeaturedHandler.List)
+			adminFeatured.POST("", adminFeaturedHandler.Add)
+			adminFeatured.PUT("/order", adminFeaturedHandler.Reorder)
+			adminFeatured.DELETE("/:id", adminFeaturedHandler.Remove)
+		}
+		adminProducts := admin.Group("/products")
+		{
+			adminProducts.GET("", adminProductHandler.List)
+			adminProducts.PATCH("/:id/availability", adminProductHandler.SetAvailability)
+			adminProducts.GET("/owner/:id", adminProductHandler.ListByOwner)
+		}
 		adminPhysicalGoods := admin.Group("/physical-good")
 		{
 			adminPhysicalGoods.GET("", adminphgHandler.List)
@@ -105,12 +160,17 @@ func Setup(
 			adminPhysicalGoods.GET("/:id", adminphgHandler.Get)
 			adminPhysicalGoods.GET("/deleted/:id", adminphgHandler.GetWithDeleted)
 			adminPhysicalGoods.GET("/unpublished/:id", adminphgHandler.GetWithUnpublished)
+			adminPhysicalGoods.GET("/sku/:sku", adminphgHandler.GetBySKU)
 			adminPhysicalGoods.POST("", adminphgHandler.Create)
+			adminPhysicalGoods.POST("/validate", adminphgHandler.Validate)
 			adminPhysicalGoods.PATCH("/:id", adminphgHandler.Update)
 			adminPhysicalGoods.POST("/publish/:id", adminphgHandler.Publish)
 			adminPhysicalGoods.POST("/unpublish/:id", adminphgHandler.Unpublish)
 			adminPhysicalGoods.POST("/restore/:id", adminphgHandler.Restore)
 			adminPhysicalGoods.DELETE("/:id", adminphgHandler.Delete)
+			adminPhysicalGoods.POST("/delete-batch", adminphgHandler.DeleteBatch)
+			adminPhysicalGoods.POST("/restore-batch", adminphgHandler.RestoreBatch)
+			adminPhysicalGoods.POST("/adjust-stock", adminphgHandler.AdjustStock)
 			adminPhysicalGoods.DELETE("/permanent/:id", adminphgHandler.DeletePermanent)
 		}
 		adminTrainingSessions := admin.Group("/training-sessions")
@@ -122,11 +182,14 @@ func Setup(
 			adminTrainingSessions.GET("/deleted/:id", admintsHandler.GetWithDeleted)
 			adminTrainingSessions.GET("/unpublished/:id", admintsHandler.GetWithUnpublished)
 			adminTrainingSessions.POST("", admintsHandler.Create)
+			adminTrainingSessions.POST("/validate", admintsHandler.Validate)
 			adminTrainingSessions.PATCH("/:id", admintsHandler.Update)
 			adminTrainingSessions.POST("/publish/:id", admintsHandler.Publish)
 			adminTrainingSessions.POST("/unpublish/:id", admintsHandler.Unpublish)
 			adminTrainingSessions.POST("/restore/:id", admintsHandler.Restore)
 			adminTrainingSessions.DELETE("/:id", admintsHandler.Delete)
+			adminTrainingSessions.POST("/delete-batch", admintsHandler.DeleteBatch)
+			adminTrainingSessions.POST("/restore-batch", admintsHandler.RestoreBatch)
 			adminTrainingSessions.DELETE("/permanent/:id", admintsHandler.DeletePermanent)
 		}
 		adminCourses := admin.Group("/courses")
@@ -138,6 +201,7 @@ func Setup(
 			adminCourses.GET("/deleted/:id", adminCourseHandler.GetWithDeleted)
 			adminCourses.GET("/unpublished/:id", adminCourseHandler.GetWithUnpublished)
 			adminCourses.POST("", adminCourseHandler.Create)
+			adminCourses.POST("/validate", adminCourseHandler.Validate)
 			adminCourses.PATCH("/:id", adminCourseHandler.Update)
 			adminCourses.POST("/publish/:id", adminCourseHandler.Publish)
 			adminCourses.POST("/unpublish/:id", adminCourseHandler.Unpublish)
@@ -148,7 +212,11 @@ func Setup(
 			adminCourses.GET("/:cid/parts/", admincpHandler.List)
 			adminCourses.GET("/:cid/parts/deleted", admincpHandler.ListDeleted)
 			adminCourses.GET("/:cid/parts/unpublished", admincpHandler.ListUnpublished)
+			adminCourses.GET("/:cid/parts/next-position", admincpHandler.NextPosition)
 			adminCourses.POST("/:cid/parts", admincpHandler.Create)
+			adminCourses.POST("/:cid/parts/batch", admincpHandler.CreateBatch)
+			adminCourses.POST("/:cid/parts/resequence", admincpHandler.Resequence)
+			adminCourses.POST("/:cid/parts/validate", admincpHandler.Validate)
 		}
 		adminCourseParts := admin.Group("/course-parts")
 		{
@@ -171,11 +239,14 @@ func Setup(
 			adminSeminars.GET("/deleted/:id", adminSeminarHandler.GetWithDeleted)
 			adminSeminars.GET("/unpublished/:id", adminSeminarHandler.GetWithUnpublished)
 			adminSeminars.POST("", adminSeminarHandler.Create)
+			adminSeminars.POST("/validate", adminSeminarHandler.Validate)
 			adminSeminars.PATCH("/:id", adminSeminarHandler.Update)
 			adminSeminars.POST("/publish/:id", adminSeminarHandler.Publish)
 			adminSeminars.POST("/unpublish/:id", adminSeminarHandler.Unpublish)
 			adminSeminars.POST("/restore/:id", adminSeminarHandler.Restore)
 			adminSeminars.DELETE("/:id", adminSeminarHandler.Delete)
+			adminSeminars.POST("/delete-batch", adminSeminarHandler.DeleteBatch)
+			adminSeminars.POST("/restore-batch", adminSeminarHandler.RestoreBatch)
 			adminSeminars.DELETE("/permanent/:id", adminSeminarHandler.DeletePermanent)
 		}
 	}
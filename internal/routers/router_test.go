@@ -0,0 +1,145 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	coursepartmodel "github.com/mikhail5545/product-service-go/internal/models/course_part"
+	"github.com/mikhail5545/product-service-go/internal/models/seminar"
+	coursemock "github.com/mikhail5545/product-service-go/internal/test/services/course_mock"
+	coursepartmock "github.com/mikhail5545/product-service-go/internal/test/services/course_part_mock"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/services/featured_mock"
+	physicalgoodmock "github.com/mikhail5545/product-service-go/internal/test/services/physical_good_mock"
+	productmock "github.com/mikhail5545/product-service-go/internal/test/services/product_mock"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/services/seminar_mock"
+	storefrontmock "github.com/mikhail5545/product-service-go/internal/test/services/storefront_mock"
+	trainingsessionmock "github.com/mikhail5545/product-service-go/internal/test/services/training_session_mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// testServices holds the mocked services routes are registered with in [newTestEcho].
+type testServices struct {
+	product         *productmock.MockService
+	coursePart      *coursepartmock.MockService
+	trainingSession *trainingsessionmock.MockService
+	course          *coursemock.MockService
+	seminar         *seminarmock.MockService
+	physicalGood    *physicalgoodmock.MockService
+	storefront      *storefrontmock.MockService
+	featured        *featuredmock.MockService
+}
+
+// newTestEcho registers every route with [Setup] against mocked services.
+func newTestEcho(ctrl *gomock.Controller) (*echo.Echo, *testServices) {
+	s := &testServices{
+		product:         productmock.NewMockService(ctrl),
+		coursePart:      coursepartmock.NewMockService(ctrl),
+		trainingSession: trainingsessionmock.NewMockService(ctrl),
+		course:          coursemock.NewMockService(ctrl),
+		seminar:         seminarmock.NewMockService(ctrl),
+		physicalGood:    physicalgoodmock.NewMockService(ctrl),
+		storefront:      storefrontmock.NewMockService(ctrl),
+		featured:        featuredmock.NewMockService(ctrl),
+	}
+	e := echo.New()
+	Setup(e, s.product, s.coursePart, s.trainingSession, s.course, s.seminar, s.physicalGood, s.storefront, s.featured, CompressionConfig{}, TimeoutConfig{})
+	return e, s
+}
+
+func TestSetup_PathParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, services := newTestEcho(ctrl)
+	id := uuid.New().String()
+
+	tests := []struct {
+		name   string
+		method string
+		target string
+		expect func()
+		status int
+	}{
+		{
+			name:   "public seminar id",
+			method: http.MethodGet,
+			target: "/api/v0/seminars/" + id,
+			expect: func() {
+				services.seminar.EXPECT().Get(gomock.Any(), id, true).Return(&seminar.SeminarDetails{Seminar: &seminar.Seminar{ID: id}}, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "admin seminar id",
+			method: http.MethodPost,
+			target: "/api/v0/admin/seminars/publish/" + id,
+			expect: func() {
+				services.seminar.EXPECT().Publish(gomock.Any(), id).Return(nil)
+			},
+			status: http.StatusAccepted,
+		},
+		{
+			name:   "public course parts course id",
+			method: http.MethodGet,
+			target: "/api/v0/courses/" + id + "/parts",
+			expect: func() {
+				services.coursePart.EXPECT().List(gomock.Any(), id, gomock.Any(), gomock.Any()).Return([]coursepartmodel.CoursePartDetails{}, int64(0), nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "public course part id",
+			method: http.MethodGet,
+			target: "/api/v0/course-parts/" + id,
+			expect: func() {
+				services.coursePart.EXPECT().Get(gomock.Any(), id).Return(&coursepartmodel.CoursePartDetails{}, nil)
+			},
+			status: http.StatusOK,
+		},
+		{
+			name:   "admin course parts course id",
+			method: http.MethodGet,
+			target: "/api/v0/admin/courses/" + id + "/parts/",
+			expect: func() {
+				services.coursePart.EXPECT().List(gomock.Any(), id, gomock.Any(), gomock.Any()).Return([]coursepartmodel.CoursePartDetails{}, int64(0), nil)
+			},
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.expect()
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			// Act
+			e.ServeHTTP(rec, req)
+
+			// Assert
+			assert.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// TestSetup_AdminSeminarRoutes runs the admin seminar handlers through the routes registered by [Setup].
+func TestSetup_AdminSeminarRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, services := newTestEcho(ctrl)
+	id := uuid.New().String()
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		if body != "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Get", func(t *testing.T) {
+		// Arrange
+		services.seminar.EXPECT().Get(gomock.Any(), id, true).
+			Return(&seminarmodel.SeminarDetails{Seminar: &seminarmodel.Seminar{ID: id, Name: "Seminar name"}}, nil)
+
+		// Act
+		rec := serve(http.MethodGet, "/api/v0/admin/seminars/"+id, "")
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var body struct {
+			SeminarDetails struct {
+				ID   string `json:"id"`
+				Name string `json:"name"`
+			} `json:"seminar_details"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, id, body.SeminarDetails.ID)
+		assert.Equal(t, "Seminar name", body.SeminarDetails.Name)
+	})
+
+	t.Run("Get without products", func(t *testing.T) {
+		// Arrange
+		services.seminar.EXPECT().Get(gomock.Any(), id, false).
+			Return(&seminarmodel.SeminarDetails{Seminar: &seminarmodel.Seminar{ID: id}, ProductsOmitted: true}, nil)
+
+		// Act
+		rec := serve(http.MethodGet, "/api/v0/admin/seminars/"+id+"?include=", "")
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Get invalid id", func(t *testing.T) {
+		// Act
+		rec := serve(http.MethodGet, "/api/v0/admin/seminars/not-a-uuid", "")
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Get not found", func(t *testing.T) {
+		// Arrange
+		services.seminar.EXPECT().Get(gomock.Any(), id, true).Return(nil, seminarservice.ErrNotFound)
+
+		// Act
+		rec := serve(http.MethodGet, "/api/v0/admin/seminars/"+id, "")
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("List", func(t *testing.T) {
+		// Arrange
+		details := []seminarmodel.SeminarDetails{{Seminar: &seminarmodel.Seminar{ID: id}}}
+		services.seminar.EXPECT().List(gomock.Any(), 5, 10).Return(details, int64(11), nil)
+
+		// Act
+		rec := serve(http.MethodGet, "/api/v0/admin/seminars?limit=5&offset=10", "")
+
+		// Assert
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var body struct {
+			SeminarDetails []json.RawMessage `json:"seminar_details"`
+			Total          int64             `json:"total"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Len(t, body.SeminarDetails, 1)
+		assert.Equal(t, int64(11), body.Total)
+	})
+
+	t.Run("List invalid pagination", func(t *testing.T) {
+		// Act
+		rec := serve(http.MethodGet, "/api/v0/admin/seminars?limit=abc", "")
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		// Arrange
+		date := time.Now().Add(30 * 24 * time.Hour).UTC().Truncate(time.Second)
+		body := fmt.Sprintf(`{
+			"name": "Seminar name",
+			"short_description": "Seminar short description",
+			"reservation_price": 10,
+			"early_price": 20,
+			"late_price": 30,
+			"early_surcharge_price": 5,
+			"late_surcharge_price": 6,
+			"date": %q,
+			"ending_date": %q,
+			"place": "Moscow",
+			"late_payment_date": %q
+		}`,
+			date.Format(time.RFC3339),
+			date.Add(4*time.Hour).Format(time.RFC3339),
+			date.Add(-7*24*time.Hour).Format(time.RFC3339),
+		)
+		services.seminar.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, req *seminarmodel.CreateRequest) (*seminarmodel.CreateResponse, error) {
+				assert.Equal(t, "Seminar name", req.Name)
+				assert.True(t, req.Date.Equal(date))
+				return &seminarmodel.CreateResponse{ID: id}, nil
+			},
+		)
+
+		// Act
+		rec := serve(http.MethodPost, "/api/v0/admin/seminars", body)
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+		assert.Contains(t, rec.Body.String(), id)
+	})
+
+	t.Run("Create malformed JSON", func(t *testing.T) {
+		// Act
+		rec := serve(http.MethodPost, "/api/v0/admin/seminars", `{"name": `)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Create rejected by service", func(t *testing.T) {
+		// Arrange
+		services.seminar.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, seminarservice.ErrInvalidArgument)
+
+		// Act
+		rec := serve(http.MethodPost, "/api/v0/admin/seminars", `{"name": "x"}`)
+
+		// Assert
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Publish", func(t *testing.T) {
+		// Arrange
+		services.seminar.EXPECT().Publish(gomock.Any(), id).Return(nil)
+
+		// Act
+		rec := serve(http.MethodPost, "/api/v0/admin/seminars/publish/"+id, "")
+
+		// Assert
+		assert.Equal(t, http.StatusAccepted, rec.Code)
+	})
+
+	t.Run("Publish not found", func(t *testing.T) {
+		// Arrange
+		services.seminar.EXPECT().Publish(gomock.Any(), id).Return(seminarservice.ErrNotFound)
+
+		// Act
+		rec := serve(http.MethodPost, "/api/v0/admin/seminars/publish/"+id, "")
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("unknown route", func(t *testing.T) {
+		// Act
+		rec := serve(http.MethodGet, "/api/v0/admin/unknown", "")
+
+		// Assert
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,41 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/util/request"
+)
+
+var (
+	// PublicStockPolicy hides out-of-stock items from public lists, the query parameter cannot change it.
+	PublicStockPolicy = request.StockPolicy{}
+	// AdminStockPolicy shows everything on admin lists, 'include_out_of_stock=false' hides out-of-stock items.
+	AdminStockPolicy = request.StockPolicy{IncludeOutOfStock: true, AllowOverride: true}
+)
+
+// StockDefault returns middleware storing p as the stock policy of every request of the route group
+// it is registered on. Handlers read it with [request.GetIncludeOutOfStock].
+func StockDefault(p request.StockPolicy) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request.SetStockPolicy(c, p)
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSetup_StockDefault(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	e, services := newTestEcho(ctrl)
+	published := true
+
+	tests := []struct {
+		name   string
+		target string
+		expect func()
+	}{
+		{
+			name:   "public group excludes out of stock by default",
+			target: "/api/v0/products",
+			expect: func() {
+				services.product.EXPECT().ListFiltered(gomock.Any(), productmodel.ProductFilter{InStock: &published}, 10, 0).Return(nil, int64(0), nil)
+			},
+		},
+		{
+			name:   "public group ignores the override",
+			target: "/api/v0/products?include_out_of_stock=true",
+			expect: func() {
+				services.product.EXPECT().ListFiltered(gomock.Any(), productmodel.ProductFilter{InStock: &published}, 10, 0).Return(nil, int64(0), nil)
+			},
+		},
+		{
+			name:   "admin group includes out of stock by default",
+			target: "/api/v0/admin/products",
+			expect: func() {
+				services.product.EXPECT().ListWithUnpublished(gomock.Any(), 10, 0).Return(nil, int64(0), nil)
+			},
+		},
+		{
+			name:   "admin group honours the override",
+			target: "/api/v0/admin/products?include_out_of_stock=false",
+			expect: func() {
+				services.product.EXPECT().List(gomock.Any(), 10, 0).Return(nil, int64(0), nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.expect()
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			rec := httptest.NewRecorder()
+
+			// Act
+			e.ServeHTTP(rec, req)
+
+			// Assert
+			assert.Equal(t, http.StatusOK, rec.Code)
+		})
+	}
+}
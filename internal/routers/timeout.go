@@ -0,0 +1,131 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
+)
+
+// DefaultRequestTimeout bounds a request if HTTP_REQUEST_TIMEOUT is not set.
+const DefaultRequestTimeout = 30 * time.Second
+
+// TimeoutConfig configures the request timeout middleware.
+type TimeoutConfig struct {
+	// Timeout is the time a request may take. Zero disables the middleware.
+	Timeout time.Duration
+}
+
+// TimeoutConfigFromEnv reads the HTTP_REQUEST_TIMEOUT environment variable as a [time.Duration],
+// e.g. "15s". "0" disables the timeout. A missing or invalid value falls back to [DefaultRequestTimeout].
+func TimeoutConfigFromEnv() TimeoutConfig {
+	cfg := TimeoutConfig{Timeout: DefaultRequestTimeout}
+	if timeout, err := time.ParseDuration(os.Getenv("HTTP_REQUEST_TIMEOUT")); err == nil && timeout >= 0 {
+		cfg.Timeout = timeout
+	}
+	return cfg
+}
+
+// Timeout returns middleware that gives every request context a deadline of cfg.Timeout, so services
+// and repositories called by the handler are cancelled once it passes. Deadlines set further down,
+// e.g. per database call, still apply: the earlier of the two wins.
+//
+// The handler's response is held back until it returns. If the deadline has passed by then, it is
+// discarded and the client gets 503 instead, whatever the handler made of the cancelled calls.
+// It must be registered after [middleware.RequestID].
+func Timeout(cfg TimeoutConfig) echo.MiddlewareFunc {
+	if cfg.Timeout <= 0 {
+		return func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx, cancel := context.WithTimeout(c.Request().Context(), cfg.Timeout)
+			defer cancel()
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			res := c.Response()
+			original := res.Writer
+			buffered := &bufferedWriter{ResponseWriter: original}
+			res.Writer = buffered
+			defer func() {
+				// The handler panicked, let the recover middleware respond from scratch.
+				if res.Writer == buffered {
+					res.Writer = original
+					res.Committed, res.Size = false, 0
+				}
+			}()
+			err := next(c)
+			res.Writer = original
+
+			if !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return buffered.flush(err)
+			}
+			res.Committed, res.Size = false, 0
+			header := res.Header()
+			header.Del(echo.HeaderContentEncoding)
+			header.Del(echo.HeaderContentLength)
+			return response.Write(c, http.StatusServiceUnavailable, map[string]any{
+				"error":      "Request timed out",
+				"request_id": header.Get(echo.HeaderXRequestID),
+			})
+		}
+	}
+}
+
+// bufferedWriter holds a response back until [bufferedWriter.flush] is called.
+// Headers are set on the wrapped writer directly, they are not sent before WriteHeader.
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+// Flush is a no-op, the response is sent as a whole once the handler returns.
+func (w *bufferedWriter) Flush() {}
+
+// flush sends the held back response, if any, and passes err through.
+func (w *bufferedWriter) flush(err error) error {
+	if w.status == 0 {
+		return err
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	if _, writeErr := w.ResponseWriter.Write(w.body.Bytes()); writeErr != nil && err == nil {
+		return writeErr
+	}
+	return err
+}
@@ -0,0 +1,155 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package routers
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	newEcho := func(cfg TimeoutConfig) *echo.Echo {
+		e := echo.New()
+		e.Use(middleware.RequestID())
+		e.Use(Recover(slog.New(slog.NewTextHandler(&bytes.Buffer{}, nil))))
+		e.Use(Timeout(cfg))
+		// slow waits for the request context like a database call would, and reports
+		// the cancellation the way handlers report service errors.
+		e.GET("/slow", func(c echo.Context) error {
+			select {
+			case <-c.Request().Context().Done():
+				return c.JSON(http.StatusInternalServerError, map[string]any{"error": c.Request().Context().Err().Error()})
+			case <-time.After(time.Second):
+				return c.JSON(http.StatusOK, map[string]any{"ok": true})
+			}
+		})
+		// sleepy ignores the request context.
+		e.GET("/sleepy", func(c echo.Context) error {
+			time.Sleep(50 * time.Millisecond)
+			return c.JSON(http.StatusOK, map[string]any{"ok": true})
+		})
+		e.GET("/fast", func(c echo.Context) error {
+			_, hasDeadline := c.Request().Context().Deadline()
+			return c.JSON(http.StatusCreated, map[string]any{"deadline": hasDeadline})
+		})
+		e.GET("/panic", func(c echo.Context) error {
+			_ = c.JSON(http.StatusOK, map[string]any{"partial": true})
+			panic("boom")
+		})
+		return e
+	}
+	serve := func(e *echo.Echo, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	t.Run("cancelled handler gets timeout status", func(t *testing.T) {
+		// Arrange
+		e := newEcho(TimeoutConfig{Timeout: 10 * time.Millisecond})
+
+		// Act
+		rec := serve(e, "/slow")
+
+		// Assert
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		var body map[string]any
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "Request timed out", body["error"])
+		assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), body["request_id"])
+		assert.NotContains(t, rec.Body.String(), "deadline exceeded")
+	})
+
+	t.Run("handler sleeping past the timeout", func(t *testing.T) {
+		// Arrange
+		e := newEcho(TimeoutConfig{Timeout: 10 * time.Millisecond})
+
+		// Act
+		rec := serve(e, "/sleepy")
+
+		// Assert
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.NotContains(t, rec.Body.String(), `"ok"`)
+	})
+
+	t.Run("response within the timeout is kept", func(t *testing.T) {
+		// Arrange
+		e := newEcho(TimeoutConfig{Timeout: time.Second})
+
+		// Act
+		rec := serve(e, "/fast")
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"deadline":true}`, rec.Body.String())
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		// Arrange
+		e := newEcho(TimeoutConfig{})
+
+		// Act
+		rec := serve(e, "/fast")
+
+		// Assert
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"deadline":false}`, rec.Body.String())
+	})
+
+	t.Run("panic is still recovered", func(t *testing.T) {
+		// Arrange
+		e := newEcho(TimeoutConfig{Timeout: time.Second})
+
+		// Act
+		rec := serve(e, "/panic")
+
+		// Assert
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "partial")
+	})
+}
+
+func TestTimeoutConfigFromEnv(t *testing.T) {
+	for value, want := range map[string]time.Duration{
+		"":      DefaultRequestTimeout,
+		"15s":   15 * time.Second,
+		"0":     0,
+		"-1s":   DefaultRequestTimeout,
+		"never": DefaultRequestTimeout,
+	} {
+		t.Run(value, func(t *testing.T) {
+			// Arrange
+			t.Setenv("HTTP_REQUEST_TIMEOUT", value)
+
+			// Act
+			cfg := TimeoutConfigFromEnv()
+
+			// Assert
+			assert.Equal(t, want, cfg.Timeout)
+		})
+	}
+}
@@ -248,7 +248,7 @@ func (s *Server) Update(ctx context.Context, req *coursepb.UpdateRequest) (*cour
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Delete(ctx context.Context, req *coursepb.DeleteRequest) (*coursepb.DeleteResponse, error) {
-	if err := s.service.Delete(ctx, req.GetId()); err != nil {
+	if _, err := s.service.Delete(ctx, req.GetId(), ""); err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
 	return &coursepb.DeleteResponse{Id: req.GetId()}, nil
@@ -260,7 +260,7 @@ func (s *Server) Delete(ctx context.Context, req *coursepb.DeleteRequest) (*cour
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) DeletePermanent(ctx context.Context, req *coursepb.DeletePermanentRequest) (*coursepb.DeletePermanentResponse, error) {
-	if err := s.service.DeletePermanent(ctx, req.GetId()); err != nil {
+	if err := s.service.DeletePermanent(ctx, req.GetId(), false); err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
 	return &coursepb.DeletePermanentResponse{Id: req.GetId()}, nil
@@ -272,7 +272,7 @@ func (s *Server) DeletePermanent(ctx context.Context, req *coursepb.DeletePerman
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Restore(ctx context.Context, req *coursepb.RestoreRequest) (*coursepb.RestoreResponse, error) {
-	if err := s.service.Restore(ctx, req.GetId()); err != nil {
+	if _, err := s.service.Restore(ctx, req.GetId()); err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
 	return &coursepb.RestoreResponse{Id: req.GetId()}, nil
@@ -797,7 +797,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), courseID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), courseID, "").Return(int64(0), nil)
 
 		// Act
 		res, err := client.Delete(context.Background(), &coursepb.DeleteRequest{Id: courseID})
@@ -810,7 +810,7 @@ func TestServer_Delete(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Delete(gomock.Any(), invalidID).Return(courseservice.ErrInvalidArgument)
+		mockService.EXPECT().Delete(gomock.Any(), invalidID, "").Return(int64(0), courseservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Delete(context.Background(), &coursepb.DeleteRequest{Id: invalidID})
@@ -826,7 +826,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), courseID, "").Return(int64(0), courseservice.ErrNotFound)
 
 		// Act
 		res, err := client.Delete(context.Background(), &coursepb.DeleteRequest{Id: courseID})
@@ -849,7 +849,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID, false).Return(nil)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &coursepb.DeletePermanentRequest{Id: courseID})
@@ -862,7 +862,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID).Return(courseservice.ErrInvalidArgument)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID, false).Return(courseservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &coursepb.DeletePermanentRequest{Id: invalidID})
@@ -878,7 +878,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), courseID, false).Return(courseservice.ErrNotFound)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &coursepb.DeletePermanentRequest{Id: courseID})
@@ -901,7 +901,7 @@ func TestServer_Restore(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(nil)
+		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(int64(0), nil)
 
 		// Act
 		res, err := client.Restore(context.Background(), &coursepb.RestoreRequest{Id: courseID})
@@ -914,7 +914,7 @@ func TestServer_Restore(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Restore(gomock.Any(), invalidID).Return(courseservice.ErrInvalidArgument)
+		mockService.EXPECT().Restore(gomock.Any(), invalidID).Return(int64(0), courseservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Restore(context.Background(), &coursepb.RestoreRequest{Id: invalidID})
@@ -930,7 +930,7 @@ func TestServer_Restore(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(courseservice.ErrNotFound)
+		mockService.EXPECT().Restore(gomock.Any(), courseID).Return(int64(0), courseservice.ErrNotFound)
 
 		// Act
 		res, err := client.Restore(context.Background(), &coursepb.RestoreRequest{Id: courseID})
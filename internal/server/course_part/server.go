@@ -63,7 +63,7 @@ func (s *Server) Get(ctx context.Context, req *coursepartpb.GetRequest) (*course
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
-	return &coursepartpb.GetResponse{CoursePart: types.CoursePartToProtobuf(part)}, nil
+	return &coursepartpb.GetResponse{CoursePart: types.CoursePartToProtobuf(part.CoursePart)}, nil
 }
 
 // GetWithDeleted retrieves a single course part record, including soft-deleted ones.
@@ -145,7 +145,7 @@ func (s *Server) List(ctx context.Context, req *coursepartpb.ListRequest) (*cour
 	}
 	var pbparts []*coursepartpb.CoursePart
 	for _, p := range parts {
-		pbparts = append(pbparts, types.CoursePartToProtobuf(&p))
+		pbparts = append(pbparts, types.CoursePartToProtobuf(p.CoursePart))
 	}
 	return &coursepartpb.ListResponse{CourseParts: pbparts, Total: total}, nil
 }
@@ -252,7 +252,7 @@ func (s *Server) Update(ctx context.Context, req *coursepartpb.UpdateRequest) (*
 // Returns a `NotFound` gRPC error if course part is not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Delete(ctx context.Context, req *coursepartpb.DeleteRequest) (*coursepartpb.DeleteResponse, error) {
-	if err := s.service.Delete(ctx, req.GetId()); err != nil {
+	if err := s.service.Delete(ctx, req.GetId(), ""); err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
 	return &coursepartpb.DeleteResponse{Id: req.GetId()}, nil
@@ -85,11 +85,13 @@ func TestServer_Get(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		expectedDetails := &coursepartmodel.CoursePart{
-			ID:               partID,
-			Name:             "Course part name",
-			ShortDescription: "Course part short description",
-			Number:           33,
+		expectedDetails := &coursepartmodel.CoursePartDetails{
+			CoursePart: &coursepartmodel.CoursePart{
+				ID:               partID,
+				Name:             "Course part name",
+				ShortDescription: "Course part short description",
+				Number:           33,
+			},
 		}
 
 		mockService.EXPECT().Get(gomock.Any(), partID).Return(expectedDetails, nil).Times(1)
@@ -329,20 +331,24 @@ func TestServer_List(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		expectedParts := []coursepartmodel.CoursePart{
+		expectedParts := []coursepartmodel.CoursePartDetails{
 			{
-				ID:               partID_1,
-				CourseID:         courseID,
-				Name:             "Course part 1 name",
-				ShortDescription: "Course part 1 short description",
-				Number:           22,
+				CoursePart: &coursepartmodel.CoursePart{
+					ID:               partID_1,
+					CourseID:         courseID,
+					Name:             "Course part 1 name",
+					ShortDescription: "Course part 1 short description",
+					Number:           22,
+				},
 			},
 			{
-				ID:               partID_2,
-				CourseID:         courseID,
-				Name:             "Course part 2 name",
-				ShortDescription: "Course part  short description",
-				Number:           33,
+				CoursePart: &coursepartmodel.CoursePart{
+					ID:               partID_2,
+					CourseID:         courseID,
+					Name:             "Course part 2 name",
+					ShortDescription: "Course part  short description",
+					Number:           33,
+				},
 			},
 		}
 
@@ -827,7 +833,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), partID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), partID, "").Return(nil)
 
 		// Act
 		res, err := client.Delete(context.Background(), &coursepartpb.DeleteRequest{Id: partID})
@@ -840,7 +846,7 @@ func TestServer_Delete(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Delete(gomock.Any(), invalidID).Return(coursepartservice.ErrInvalidArgument)
+		mockService.EXPECT().Delete(gomock.Any(), invalidID, "").Return(coursepartservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Delete(context.Background(), &coursepartpb.DeleteRequest{Id: invalidID})
@@ -856,7 +862,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), partID).Return(coursepartservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), partID, "").Return(coursepartservice.ErrNotFound)
 
 		// Act
 		res, err := client.Delete(context.Background(), &coursepartpb.DeleteRequest{Id: partID})
@@ -210,7 +210,7 @@ func (s *Server) Update(ctx context.Context, req *physicalgoodpb.UpdateRequest)
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Delete(ctx context.Context, req *physicalgoodpb.DeleteRequest) (*physicalgoodpb.DeleteResponse, error) {
-	if err := s.service.Delete(ctx, req.GetId()); err != nil {
+	if err := s.service.Delete(ctx, req.GetId(), ""); err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
 	return &physicalgoodpb.DeleteResponse{Id: req.GetId()}, nil
@@ -222,7 +222,7 @@ func (s *Server) Delete(ctx context.Context, req *physicalgoodpb.DeleteRequest)
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) DeletePermanent(ctx context.Context, req *physicalgoodpb.DeletePermanentRequest) (*physicalgoodpb.DeletePermanentResponse, error) {
-	if err := s.service.DeletePermanent(ctx, req.GetId()); err != nil {
+	if err := s.service.DeletePermanent(ctx, req.GetId(), false); err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
 	return &physicalgoodpb.DeletePermanentResponse{Id: req.GetId()}, nil
@@ -794,7 +794,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), goodID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), goodID, "").Return(nil)
 
 		// Act
 		res, err := client.Delete(context.Background(), &physicalgoodpb.DeleteRequest{Id: goodID})
@@ -807,7 +807,7 @@ func TestServer_Delete(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Delete(gomock.Any(), invalidID).Return(physicalgoodservice.ErrInvalidArgument)
+		mockService.EXPECT().Delete(gomock.Any(), invalidID, "").Return(physicalgoodservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Delete(context.Background(), &physicalgoodpb.DeleteRequest{Id: invalidID})
@@ -823,7 +823,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), goodID).Return(physicalgoodservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), goodID, "").Return(physicalgoodservice.ErrNotFound)
 
 		// Act
 		res, err := client.Delete(context.Background(), &physicalgoodpb.DeleteRequest{Id: goodID})
@@ -846,7 +846,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID, false).Return(nil)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &physicalgoodpb.DeletePermanentRequest{Id: goodID})
@@ -859,7 +859,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID).Return(physicalgoodservice.ErrInvalidArgument)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID, false).Return(physicalgoodservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &physicalgoodpb.DeletePermanentRequest{Id: invalidID})
@@ -875,7 +875,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID).Return(physicalgoodservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), goodID, false).Return(physicalgoodservice.ErrNotFound)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &physicalgoodpb.DeletePermanentRequest{Id: goodID})
@@ -84,7 +84,7 @@ func (s *Server) List(ctx context.Context, req *productpb.ListRequest) (*product
 // The response contains a list of products that have specified `type`
 // and the total number of products with that `type` in the system.
 func (s *Server) ListByDetailsType(ctx context.Context, req *productpb.ListByDetailsTypeRequest) (*productpb.ListByDetailsTypeResponse, error) {
-	products, total, err := s.service.ListByDetailsType(ctx, req.GetDetailsType(), int(req.GetLimit()), int(req.GetOffset()))
+	products, total, err := s.service.ListByType(ctx, req.GetDetailsType(), int(req.GetLimit()), int(req.GetOffset()))
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
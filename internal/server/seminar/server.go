@@ -59,7 +59,7 @@ func Register(s *grpc.Server, svc seminarservice.Service) {
 // Returns a `NotFound` gRPC error if the record is not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Get(ctx context.Context, req *seminarpb.GetRequest) (*seminarpb.GetResponse, error) {
-	details, err := s.service.Get(ctx, req.GetId())
+	details, err := s.service.Get(ctx, req.GetId(), true)
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
@@ -231,7 +231,7 @@ func (s *Server) Update(ctx context.Context, req *seminarpb.UpdateRequest) (*sem
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Delete(ctx context.Context, req *seminarpb.DeleteRequest) (*seminarpb.DeleteResponse, error) {
-	err := s.service.Delete(ctx, req.GetId())
+	err := s.service.Delete(ctx, req.GetId(), "")
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
@@ -244,7 +244,7 @@ func (s *Server) Delete(ctx context.Context, req *seminarpb.DeleteRequest) (*sem
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) DeletePermanent(ctx context.Context, req *seminarpb.DeletePermanentRequest) (*seminarpb.DeletePermanentResponse, error) {
-	err := s.service.DeletePermanent(ctx, req.GetId())
+	err := s.service.DeletePermanent(ctx, req.GetId(), false)
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
@@ -111,7 +111,7 @@ func TestServer_Get(t *testing.T) {
 			CurrentSurchargePriceProductID: esproductID,
 		}
 
-		mockService.EXPECT().Get(gomock.Any(), seminarID).Return(expectedDetails, nil).Times(1)
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(expectedDetails, nil).Times(1)
 
 		// Act
 		res, err := client.Get(context.Background(), &seminarpb.GetRequest{Id: seminarID})
@@ -130,7 +130,7 @@ func TestServer_Get(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Get(gomock.Any(), seminarID).Return(nil, seminarservice.ErrNotFound)
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(nil, seminarservice.ErrNotFound)
 
 		// Act
 		res, err := client.Get(context.Background(), &seminarpb.GetRequest{Id: seminarID})
@@ -147,7 +147,7 @@ func TestServer_Get(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Get(gomock.Any(), invalidID).Return(nil, seminarservice.ErrInvalidArgument)
+		mockService.EXPECT().Get(gomock.Any(), invalidID, true).Return(nil, seminarservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Get(context.Background(), &seminarpb.GetRequest{Id: invalidID})
@@ -164,7 +164,7 @@ func TestServer_Get(t *testing.T) {
 	t.Run("internal server error", func(t *testing.T) {
 		// Arrange
 		svcErr := errors.New("unexpected error")
-		mockService.EXPECT().Get(gomock.Any(), seminarID).Return(nil, svcErr)
+		mockService.EXPECT().Get(gomock.Any(), seminarID, true).Return(nil, svcErr)
 
 		// Act
 		res, err := client.Get(context.Background(), &seminarpb.GetRequest{Id: seminarID})
@@ -937,7 +937,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), seminarID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), seminarID, "").Return(nil)
 
 		// Act
 		res, err := client.Delete(context.Background(), &seminarpb.DeleteRequest{Id: seminarID})
@@ -950,7 +950,7 @@ func TestServer_Delete(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Delete(gomock.Any(), invalidID).Return(seminarservice.ErrInvalidArgument)
+		mockService.EXPECT().Delete(gomock.Any(), invalidID, "").Return(seminarservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Delete(context.Background(), &seminarpb.DeleteRequest{Id: invalidID})
@@ -966,7 +966,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), seminarID).Return(seminarservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), seminarID, "").Return(seminarservice.ErrNotFound)
 
 		// Act
 		res, err := client.Delete(context.Background(), &seminarpb.DeleteRequest{Id: seminarID})
@@ -989,7 +989,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID, false).Return(nil)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &seminarpb.DeletePermanentRequest{Id: seminarID})
@@ -1002,7 +1002,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID).Return(seminarservice.ErrInvalidArgument)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID, false).Return(seminarservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &seminarpb.DeletePermanentRequest{Id: invalidID})
@@ -1018,7 +1018,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(seminarservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), seminarID, false).Return(seminarservice.ErrNotFound)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &seminarpb.DeletePermanentRequest{Id: seminarID})
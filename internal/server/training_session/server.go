@@ -59,7 +59,7 @@ func Register(s *grpc.Server, svc trainingsessionservice.Service) {
 // Returns a `NotFound` gRPC error if the record is not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Get(ctx context.Context, req *trainingsessionpb.GetRequest) (*trainingsessionpb.GetResponse, error) {
-	details, err := s.service.Get(ctx, req.GetId())
+	details, err := s.service.Get(ctx, req.GetId(), true)
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
@@ -216,7 +216,7 @@ func (s *Server) Update(ctx context.Context, req *trainingsessionpb.UpdateReques
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) Delete(ctx context.Context, req *trainingsessionpb.DeleteRequest) (*trainingsessionpb.DeleteResponse, error) {
-	err := s.service.Delete(ctx, req.GetId())
+	err := s.service.Delete(ctx, req.GetId(), "")
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
@@ -230,7 +230,7 @@ func (s *Server) Delete(ctx context.Context, req *trainingsessionpb.DeleteReques
 // Returns a `NotFound` gRPC error if any of the records are not found.
 // Returns an `InvalidArgument` gRPC error if the provided ID is not a valid UUID.
 func (s *Server) DeletePermanent(ctx context.Context, req *trainingsessionpb.DeletePermanentRequest) (*trainingsessionpb.DeletePermanentResponse, error) {
-	err := s.service.DeletePermanent(ctx, req.GetId())
+	err := s.service.DeletePermanent(ctx, req.GetId(), false)
 	if err != nil {
 		return nil, errors.HandleServiceError(err)
 	}
@@ -96,7 +96,7 @@ func TestServer_Get(t *testing.T) {
 			ProductID: productID,
 		}
 
-		mockService.EXPECT().Get(gomock.Any(), tsID).Return(expectedDetails, nil).Times(1)
+		mockService.EXPECT().Get(gomock.Any(), tsID, true).Return(expectedDetails, nil).Times(1)
 
 		// Act
 		res, err := client.Get(context.Background(), &trainingsessionpb.GetRequest{Id: tsID})
@@ -111,7 +111,7 @@ func TestServer_Get(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Get(gomock.Any(), tsID).Return(nil, trainingsessionservice.ErrNotFound)
+		mockService.EXPECT().Get(gomock.Any(), tsID, true).Return(nil, trainingsessionservice.ErrNotFound)
 
 		// Act
 		res, err := client.Get(context.Background(), &trainingsessionpb.GetRequest{Id: tsID})
@@ -128,7 +128,7 @@ func TestServer_Get(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Get(gomock.Any(), invalidID).Return(nil, trainingsessionservice.ErrInvalidArgument)
+		mockService.EXPECT().Get(gomock.Any(), invalidID, true).Return(nil, trainingsessionservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Get(context.Background(), &trainingsessionpb.GetRequest{Id: invalidID})
@@ -145,7 +145,7 @@ func TestServer_Get(t *testing.T) {
 	t.Run("internal server error", func(t *testing.T) {
 		// Arrange
 		svcErr := errors.New("unexpected error")
-		mockService.EXPECT().Get(gomock.Any(), tsID).Return(nil, svcErr)
+		mockService.EXPECT().Get(gomock.Any(), tsID, true).Return(nil, svcErr)
 
 		// Act
 		res, err := client.Get(context.Background(), &trainingsessionpb.GetRequest{Id: tsID})
@@ -795,7 +795,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), tsID).Return(nil)
+		mockService.EXPECT().Delete(gomock.Any(), tsID, "").Return(nil)
 
 		// Act
 		res, err := client.Delete(context.Background(), &trainingsessionpb.DeleteRequest{Id: tsID})
@@ -808,7 +808,7 @@ func TestServer_Delete(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().Delete(gomock.Any(), invalidID).Return(trainingsessionservice.ErrInvalidArgument)
+		mockService.EXPECT().Delete(gomock.Any(), invalidID, "").Return(trainingsessionservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.Delete(context.Background(), &trainingsessionpb.DeleteRequest{Id: invalidID})
@@ -824,7 +824,7 @@ func TestServer_Delete(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().Delete(gomock.Any(), tsID).Return(trainingsessionservice.ErrNotFound)
+		mockService.EXPECT().Delete(gomock.Any(), tsID, "").Return(trainingsessionservice.ErrNotFound)
 
 		// Act
 		res, err := client.Delete(context.Background(), &trainingsessionpb.DeleteRequest{Id: tsID})
@@ -847,7 +847,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID).Return(nil)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID, false).Return(nil)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &trainingsessionpb.DeletePermanentRequest{Id: tsID})
@@ -860,7 +860,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 	t.Run("invalid argument", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-uuid"
-		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID).Return(trainingsessionservice.ErrInvalidArgument)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), invalidID, false).Return(trainingsessionservice.ErrInvalidArgument)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &trainingsessionpb.DeletePermanentRequest{Id: invalidID})
@@ -876,7 +876,7 @@ func TestServer_DeletePermanent(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID).Return(trainingsessionservice.ErrNotFound)
+		mockService.EXPECT().DeletePermanent(gomock.Any(), tsID, false).Return(trainingsessionservice.ErrNotFound)
 
 		// Act
 		res, err := client.DeletePermanent(context.Background(), &trainingsessionpb.DeletePermanentRequest{Id: tsID})
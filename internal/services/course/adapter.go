@@ -31,6 +31,7 @@ import (
 //go:generate mockgen -destination=../../test/services/course_mock/adapter_mock.go -package=course_mock . OwnerRepoAdapter
 type OwnerRepoAdapter interface {
 	GetWithUnpublished(ctx context.Context, id string) (imageowner.Owner, error)
+	GetWithDeleted(ctx context.Context, id string) (imageowner.Owner, error)
 	ListWithUnpublishedByIDs(ctx context.Context, ids ...string) ([]imageowner.Owner, error)
 	AddImage(ctx context.Context, owner imageowner.Owner, image *imagemodel.Image) error
 	DeleteImage(ctx context.Context, owner imageowner.Owner, mediaSvcID string) error
@@ -38,6 +39,8 @@ type OwnerRepoAdapter interface {
 	BatchUpdate(ctx context.Context, owners []imageowner.Owner, opt uint) (int64, error)
 	FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error)
 	DecrementImageCount(ctx context.Context, ownerIDs []string) (int64, error)
+	ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error)
+	DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error)
 	DB() *gorm.DB
 	WithTx(tx *gorm.DB) imageowner.OwnerRepo[imageowner.Owner]
 }
@@ -65,6 +68,15 @@ func (a *ownerRepoAdapter) GetWithUnpublished(ctx context.Context, id string) (i
 	return owner, nil
 }
 
+func (a *ownerRepoAdapter) GetWithDeleted(ctx context.Context, id string) (imageowner.Owner, error) {
+	course, err := a.repo.GetWithDeleted(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var owner imageowner.Owner = *course
+	return owner, nil
+}
+
 func (a *ownerRepoAdapter) ListWithUnpublishedByIDs(ctx context.Context, ids ...string) ([]imageowner.Owner, error) {
 	courses, err := a.repo.ListWithUnpublishedByIDs(ctx, ids...)
 	if err != nil {
@@ -133,6 +145,14 @@ func (a *ownerRepoAdapter) DecrementImageCount(ctx context.Context, ownerIDs []s
 	return a.repo.DecrementImageCount(ctx, ownerIDs)
 }
 
+func (a *ownerRepoAdapter) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	return a.repo.ListOwnerIDsByImageID(ctx, mediaSvcID)
+}
+
+func (a *ownerRepoAdapter) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	return a.repo.DeleteImageAssociations(ctx, mediaSvcID)
+}
+
 func (a *ownerRepoAdapter) DB() *gorm.DB {
 	return a.repo.DB()
 }
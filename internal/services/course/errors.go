@@ -28,4 +28,8 @@ var (
 	ErrImageLimitExceeded = errors.New("maximum number of uploaded images is 5 per item")
 	// ErrImageNotFoundOnOwner can't find image on course error
 	ErrImageNotFoundOnOwner = errors.New("image not found on course")
+	// ErrHasDependents course products are referenced by other records (e.g. featured list) error
+	ErrHasDependents = errors.New("course products are referenced by other records")
+	// ErrCannotPublishFree course product priced at zero or below and not marked free error
+	ErrCannotPublishFree = errors.New("cannot publish course with a zero price product that is not marked free")
 )
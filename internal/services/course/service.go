@@ -22,14 +22,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
 	courserepo "github.com/mikhail5545/product-service-go/internal/database/course"
 	coursepartrepo "github.com/mikhail5545/product-service-go/internal/database/course_part"
+	featuredrepo "github.com/mikhail5545/product-service-go/internal/database/featured"
 	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
+	coursepartmodel "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	"github.com/mikhail5545/product-service-go/internal/models/product"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -45,6 +53,14 @@ type Service interface {
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 	// or a database/internal error occurs.
 	Get(ctx context.Context, id string) (*coursemodel.CourseDetails, error)
+	// GetFull retrieves a single published and not soft-deleted course record with its product price
+	// and all of its published course parts ordered by number. Sellable parts include their own price;
+	// sellable parts without a product are left out. The course and part products are fetched in one query.
+	//
+	// Returns a CourseFullDetails struct containing the combined information.
+	// Returns an error if the ID is invalid (ErrInvalidArgument), the course or its product is not found (ErrNotFound),
+	// or a database/internal error occurs.
+	GetFull(ctx context.Context, id string) (*coursemodel.CourseFullDetails, error)
 	// GetWithDeleted retrieves a single course record from the database, including soft-deleted ones,
 	// along with its associated product details. Also it preloads all its associated course part records.
 	//
@@ -103,13 +119,15 @@ type Service interface {
 	// should be unpublished separately.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
+	// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+	// Publishing an already published course is a no-op.
 	Publish(ctx context.Context, id string) error
 	// Unpublish sets the `InStock` field to false for a course, its associated course parts
 	// and its associated product, archiving it from the catalog.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
+	// Unpublishing an already unpublished course is a no-op.
 	Unpublish(ctx context.Context, id string) error
 	// Update performs a partial update of a course and its related product.
 	// The request should contain the course's ID and the fields to be updated.
@@ -123,24 +141,45 @@ type Service interface {
 	// Delete performs a soft-delete of a course, its associated course parts
 	// and its associated product record.
 	// It also unpublishes all records, meaning they must be manually published again after restoration.
+	// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 	//
-	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// Returns the number of course parts that were soft-deleted together with the course.
+	// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, id, reason string) (int64, error)
 	// DeletePermanent performs a complete delete of a course, its associated course parts
 	// and its associated product record.
+	// If force is true, the related product count is not checked and whatever records exist are deleted.
+	// This is meant for cleaning up inconsistent data.
+	// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
-	DeletePermanent(ctx context.Context, id string) error
+	// the products are featured (ErrHasDependents), or a database/internal error occurs.
+	DeletePermanent(ctx context.Context, id string, force bool) error
+	// PurgeDeletedBefore permanently deletes all courses soft-deleted before the given time,
+	// together with their product records and course parts.
+	// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
+	//
+	// Returns the number of purged courses.
+	// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// CountDeletedBefore counts courses soft-deleted before cutoff, that is the courses
+	// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+	//
+	// Returns an error if a database/internal error occurs.
+	CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	// Restore performs a restore of a course, its associated course parts
-	// and its related product record.
+	// and its related product record. Only the course parts that were soft-deleted together
+	// with the course are restored; parts deleted on their own beforehand stay deleted.
 	// Course record, its associated course part records and its related product record
 	// are not being published. This should be done manually.
 	//
+	// Returns the number of course parts that were restored together with the course.
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
-	Restore(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) (int64, error)
 }
 
 // service provides service-layer business logic for course models.
@@ -148,22 +187,31 @@ type Service interface {
 // [product.Repository] and [coursepart.Repository]
 // instances to perform database operations.
 type service struct {
-	CourseRepo  courserepo.Repository
-	ProductRepo productrepo.Repository
-	PartRepo    coursepartrepo.Repository
+	CourseRepo   courserepo.Repository
+	ProductRepo  productrepo.Repository
+	PartRepo     coursepartrepo.Repository
+	FeaturedRepo featuredrepo.Repository
+	// now is the clock used to evaluate product sale windows, replaceable in tests.
+	now    func() time.Time
+	logger *slog.Logger
 }
 
 // New creates a new Service instance with provided
-// course, product and course part repositories.
+// course, product, course part and featured list repositories and logger.
 func New(
 	cr courserepo.Repository,
 	pr productrepo.Repository,
 	cpr coursepartrepo.Repository,
+	fr featuredrepo.Repository,
+	logger *slog.Logger,
 ) Service {
 	return &service{
-		CourseRepo:  cr,
-		ProductRepo: pr,
-		PartRepo:    cpr,
+		CourseRepo:   cr,
+		ProductRepo:  pr,
+		PartRepo:     cpr,
+		FeaturedRepo: fr,
+		now:          time.Now,
+		logger:       logger,
 	}
 }
 
@@ -175,6 +223,9 @@ func New(
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Get(ctx context.Context, id string) (*coursemodel.CourseDetails, error) {
+	ctx, span := tracing.Start(ctx, "course", "Get", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -194,11 +245,80 @@ func (s *service) Get(ctx context.Context, id string) (*coursemodel.CourseDetail
 	}
 	return &coursemodel.CourseDetails{
 		Course:    courseRec,
+		Available: productRec.IsAvailableAt(s.now()),
 		Price:     productRec.Price,
 		ProductID: productRec.ID,
 	}, nil
 }
 
+// GetFull retrieves a single published and not soft-deleted course record with its product price
+// and all of its published course parts ordered by number. Sellable parts include their own price;
+// sellable parts without a product are left out. The course and part products are fetched in one query.
+//
+// Returns a CourseFullDetails struct containing the combined information.
+// Returns an error if the ID is invalid (ErrInvalidArgument), the course or its product is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) GetFull(ctx context.Context, id string) (*coursemodel.CourseFullDetails, error) {
+	ctx, span := tracing.Start(ctx, "course", "GetFull", tracing.ID(id))
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	courseRec, err := s.CourseRepo.GetReduced(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve course: %w", err)
+	}
+	parts, err := s.PartRepo.ListOrdered(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve course parts: %w", err)
+	}
+
+	detailsIDs := []string{courseRec.ID}
+	for _, p := range parts {
+		if p.Sellable {
+			detailsIDs = append(detailsIDs, p.ID)
+		}
+	}
+	products, err := s.ProductRepo.SelectByDetailsIDs(ctx, detailsIDs, "id", "price", "details_id", "available_from", "available_until")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	productsMap := make(map[string]product.Product, len(products))
+	for _, p := range products {
+		productsMap[p.DetailsID] = p
+	}
+
+	courseProduct, ok := productsMap[courseRec.ID]
+	if !ok {
+		return nil, fmt.Errorf("%w: product for course %s not found", ErrNotFound, courseRec.ID)
+	}
+	details := &coursemodel.CourseFullDetails{
+		Course:    courseRec,
+		Price:     courseProduct.Price,
+		ProductID: courseProduct.ID,
+		Available: courseProduct.IsAvailableAt(s.now()),
+		Parts:     make([]coursepartmodel.CoursePartDetails, 0, len(parts)),
+	}
+	for i := range parts {
+		partDetails := coursepartmodel.CoursePartDetails{CoursePart: &parts[i]}
+		if parts[i].Sellable {
+			p, ok := productsMap[parts[i].ID]
+			if !ok {
+				s.logger.DebugContext(ctx, "dropped sellable course part without product", "course_part_id", parts[i].ID)
+				continue
+			}
+			partDetails.Price = p.Price
+			partDetails.ProductID = p.ID
+		}
+		details.Parts = append(details.Parts, partDetails)
+	}
+	return details, nil
+}
+
 // GetWithDeleted retrieves a single course record from the database, including soft-deleted ones,
 // along with its associated product details. Also it preloads all its associated course part records.
 //
@@ -206,6 +326,9 @@ func (s *service) Get(ctx context.Context, id string) (*coursemodel.CourseDetail
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithDeleted(ctx context.Context, id string) (*coursemodel.CourseDetails, error) {
+	ctx, span := tracing.Start(ctx, "course", "GetWithDeleted", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -224,9 +347,12 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*coursemodel.C
 		return nil, fmt.Errorf("failed to retrieve product for course: %w", err)
 	}
 	return &coursemodel.CourseDetails{
-		Course:    courseRec,
-		Price:     productRec.Price,
-		ProductID: productRec.ID,
+		Course:           courseRec,
+		Available:        productRec.IsAvailableAt(s.now()),
+		Price:            productRec.Price,
+		ProductID:        productRec.ID,
+		FirstPublishedAt: productRec.FirstPublishedAt,
+		LastPublishedAt:  productRec.LastPublishedAt,
 	}, nil
 }
 
@@ -237,6 +363,9 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*coursemodel.C
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithUnpublished(ctx context.Context, id string) (*coursemodel.CourseDetails, error) {
+	ctx, span := tracing.Start(ctx, "course", "GetWithUnpublished", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -255,9 +384,12 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*coursemod
 		return nil, fmt.Errorf("failed to retrieve product for course: %w", err)
 	}
 	return &coursemodel.CourseDetails{
-		Course:    courseRec,
-		Price:     productRec.Price,
-		ProductID: productRec.ID,
+		Course:           courseRec,
+		Available:        productRec.IsAvailableAt(s.now()),
+		Price:            productRec.Price,
+		ProductID:        productRec.ID,
+		FirstPublishedAt: productRec.FirstPublishedAt,
+		LastPublishedAt:  productRec.LastPublishedAt,
 	}, nil
 }
 
@@ -268,6 +400,9 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*coursemod
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetReduced(ctx context.Context, id string) (*coursemodel.CourseDetails, error) {
+	ctx, span := tracing.Start(ctx, "course", "GetReduced", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -288,6 +423,7 @@ func (s *service) GetReduced(ctx context.Context, id string) (*coursemodel.Cours
 
 	return &coursemodel.CourseDetails{
 		Course:    courseRec,
+		Available: productRec.IsAvailableAt(s.now()),
 		Price:     productRec.Price,
 		ProductID: productRec.ID,
 	}, nil
@@ -300,6 +436,9 @@ func (s *service) GetReduced(ctx context.Context, id string) (*coursemodel.Cours
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetReducedWithDeleted(ctx context.Context, id string) (*coursemodel.CourseDetails, error) {
+	ctx, span := tracing.Start(ctx, "course", "GetReducedWithDeleted", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -320,6 +459,7 @@ func (s *service) GetReducedWithDeleted(ctx context.Context, id string) (*course
 
 	return &coursemodel.CourseDetails{
 		Course:    courseRec,
+		Available: productRec.IsAvailableAt(s.now()),
 		Price:     productRec.Price,
 		ProductID: productRec.ID,
 	}, nil
@@ -331,6 +471,9 @@ func (s *service) GetReducedWithDeleted(ctx context.Context, id string) (*course
 // Returns a slice of CourseDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) List(ctx context.Context, limit, offset int) ([]coursemodel.CourseDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "course", "List")
+	defer span.End()
+
 	courses, err := s.CourseRepo.List(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve courses: %w", err)
@@ -347,7 +490,7 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]coursemodel.Co
 		courseIDs = append(courseIDs, courses[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectByDetailsIDs(ctx, courseIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectByDetailsIDs(ctx, courseIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -355,6 +498,7 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]coursemodel.Co
 	for _, p := range products {
 		allDetails = append(allDetails, coursemodel.CourseDetails{
 			Course:    coursesMap[p.DetailsID],
+			Available: p.IsAvailableAt(s.now()),
 			Price:     p.Price,
 			ProductID: p.ID,
 		})
@@ -368,6 +512,9 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]coursemodel.Co
 // Returns a slice of CourseDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]coursemodel.CourseDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "course", "ListDeleted")
+	defer span.End()
+
 	courses, err := s.CourseRepo.ListDeleted(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve courses: %w", err)
@@ -384,7 +531,7 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]coursem
 		courseIDs = append(courseIDs, courses[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectWithDeletedByDetailsIDs(ctx, courseIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectWithDeletedByDetailsIDs(ctx, courseIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -392,6 +539,7 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]coursem
 	for _, p := range products {
 		allDetails = append(allDetails, coursemodel.CourseDetails{
 			Course:    coursesMap[p.DetailsID],
+			Available: p.IsAvailableAt(s.now()),
 			Price:     p.Price,
 			ProductID: p.ID,
 		})
@@ -405,6 +553,9 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]coursem
 // Returns a slice of CourseDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]coursemodel.CourseDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "course", "ListUnpublished")
+	defer span.End()
+
 	courses, err := s.CourseRepo.ListUnpublished(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve courses: %w", err)
@@ -421,7 +572,7 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]cou
 		courseIDs = append(courseIDs, courses[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, courseIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, courseIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -429,6 +580,7 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]cou
 	for _, p := range products {
 		allDetails = append(allDetails, coursemodel.CourseDetails{
 			Course:    coursesMap[p.DetailsID],
+			Available: p.IsAvailableAt(s.now()),
 			Price:     p.Price,
 			ProductID: p.ID,
 		})
@@ -443,11 +595,15 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]cou
 // Returns a CreateResponse containing the newly created CourseID and ProductID.
 // Returns an error if the request payload is invalid (ErrInvalidArgument) or a database/internal error occurs.
 func (s *service) Create(ctx context.Context, req *coursemodel.CreateRequest) (*coursemodel.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "course", "Create")
+	defer span.End()
+
 	var courseID, productID string
 	err := s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txCourseRepo := s.CourseRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
 
+		req.Sanitize()
 		if err := req.Validate(); err != nil {
 			return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 		}
@@ -491,23 +647,44 @@ func (s *service) Create(ctx context.Context, req *coursemodel.CreateRequest) (*
 // should be unpublished separately.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
+// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+// Publishing an already published course is a no-op.
 func (s *service) Publish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "course", "Publish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
-		ra, err := s.CourseRepo.WithTx(tx).SetInStock(ctx, id, true)
+		txCourseRepo := s.CourseRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		products, err := txProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, []string{id}, "id", "price", "is_free", "in_stock")
 		if err != nil {
-			return fmt.Errorf("failed to publish course: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+			return fmt.Errorf("failed to get course product: %w", err)
+		}
+		if len(products) == 0 {
+			return fmt.Errorf("%w: course product", ErrNotFound)
+		}
+		productsPublished := true
+		for _, product := range products {
+			if !product.IsPublishable() {
+				return fmt.Errorf("%w: product %s", ErrCannotPublishFree, product.ID)
+			}
+			productsPublished = productsPublished && product.InStock
 		}
-		ra, err = s.ProductRepo.WithTx(tx).SetInStockByDetailsID(ctx, id, true)
+		res, err := txCourseRepo.SetInStock(ctx, id, true)
 		if err != nil {
+			return fmt.Errorf("failed to publish course: %w", err)
+		}
+		if res.NotFound() {
+			return fmt.Errorf("%w: course", ErrNotFound)
+		}
+		if res.AlreadyInState && productsPublished {
+			return nil
+		}
+		if _, err := txProductRepo.PublishByDetailsID(ctx, id, s.now()); err != nil {
 			return fmt.Errorf("failed to publish course product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
 		return nil
 	})
@@ -518,22 +695,33 @@ func (s *service) Publish(ctx context.Context, id string) error {
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
+// Unpublishing an already unpublished course is a no-op.
 func (s *service) Unpublish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "course", "Unpublish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
-		ra, err := s.CourseRepo.WithTx(tx).SetInStock(ctx, id, false)
+		txCourseRepo := s.CourseRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		res, err := txCourseRepo.SetInStock(ctx, id, false)
 		if err != nil {
 			return fmt.Errorf("failed to unpublish course: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
-		ra, err = s.ProductRepo.WithTx(tx).SetInStockByDetailsID(ctx, id, false)
+		if res.NotFound() {
+			return fmt.Errorf("%w: course", ErrNotFound)
+		}
+		productRes, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now())
 		if err != nil {
 			return fmt.Errorf("failed to unpublish course product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		if productRes.NotFound() {
+			return fmt.Errorf("%w: course product", ErrNotFound)
+		}
+		if res.AlreadyInState && productRes.AlreadyInState {
+			return nil
 		}
 		if _, err := s.PartRepo.WithTx(tx).SetPublishedByCourseID(ctx, id, false); err != nil {
 			return fmt.Errorf("failed to unpublish course parts: %w", err)
@@ -551,11 +739,15 @@ func (s *service) Unpublish(ctx context.Context, id string) error {
 // Returns an error if the request payload is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Update(ctx context.Context, req *coursemodel.UpdateRequest) (map[string]any, error) {
+	ctx, span := tracing.Start(ctx, "course", "Update", tracing.ID(req.ID))
+	defer span.End()
+
 	updates := make(map[string]any)
 	err := s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txCourseRepo := s.CourseRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
 
+		req.Sanitize()
 		if err := req.Validate(); err != nil {
 			return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 		}
@@ -597,8 +789,8 @@ func (s *service) Update(ctx context.Context, req *coursemodel.UpdateRequest) (m
 		if req.Topic != nil && *req.Topic != course.Topic {
 			courseUpdates["topic"] = *req.Topic
 		}
-		if len(req.Tags) > 0 {
-			courseUpdates["tags"] = req.Tags
+		if tags := req.TagsUpdate(); tags != nil && !slices.Equal(tags, course.Tags) {
+			courseUpdates["tags"] = tags
 		}
 
 		if len(productUpdates) > 0 {
@@ -626,14 +818,23 @@ func (s *service) Update(ctx context.Context, req *coursemodel.UpdateRequest) (m
 // Delete performs a soft-delete of a course, its associated course parts
 // and its associated product record.
 // It also unpublishes all records, meaning they must be manually published again after restoration.
+// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 //
-// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+// Returns the number of course parts that were soft-deleted together with the course.
+// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
-func (s *service) Delete(ctx context.Context, id string) error {
+func (s *service) Delete(ctx context.Context, id, reason string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "course", "Delete")
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
-		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+		return 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
-	return s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
+	if utf8.RuneCountInString(reason) > 255 {
+		return 0, fmt.Errorf("%w: reason must be at most 255 characters long", ErrInvalidArgument)
+	}
+	var partsAffected int64
+	err := s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txCourseRepo := s.CourseRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
 		txPartRepo := s.PartRepo.WithTx(tx)
@@ -655,15 +856,16 @@ func (s *service) Delete(ctx context.Context, id string) error {
 			return fmt.Errorf("failed to unpublish course parts: %w", err)
 		}
 
-		ra, err := txProductRepo.SetInStockByDetailsID(ctx, id, false)
+		productRes, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now())
 		if err != nil {
 			return fmt.Errorf("failed to unpublish course product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%s: %w", ErrNotFound, err)
+		} else if productRes.NotFound() {
+			return fmt.Errorf("%w: course product", ErrNotFound)
 		}
 
 		// Delete all instances
-		if _, err = txCourseRepo.Delete(ctx, id); err != nil {
+		// The product is deleted explicitly below, the course hook must not cascade.
+		if _, err = txCourseRepo.Delete(common.WithoutCascade(ctx), id, reason); err != nil {
 			return fmt.Errorf("failed to delete course: %w", err)
 		}
 
@@ -671,37 +873,66 @@ func (s *service) Delete(ctx context.Context, id string) error {
 			return fmt.Errorf("failed to delete course product: %w", err)
 		}
 
-		if _, err = txPartRepo.DeleteByCourseID(ctx, id); err != nil {
+		// Parts are deleted after the course so Restore can tell them apart from
+		// parts that were deleted on their own earlier.
+		partsAffected, err = txPartRepo.DeleteByCourseID(ctx, id)
+		if err != nil {
 			return fmt.Errorf("failed to delete course parts: %w", err)
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return partsAffected, nil
 }
 
 // DeletePermanent performs a complete delete of a course, its associated course parts
 // and its associated product record.
+// If force is true, the related product count is not checked and whatever records exist are deleted.
+// This is meant for cleaning up inconsistent data.
+// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
-func (s *service) DeletePermanent(ctx context.Context, id string) error {
+// the products are featured (ErrHasDependents), or a database/internal error occurs.
+func (s *service) DeletePermanent(ctx context.Context, id string, force bool) error {
+	ctx, span := tracing.Start(ctx, "course", "DeletePermanent", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txCourseRepo := s.CourseRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
 		txPartRepo := s.PartRepo.WithTx(tx)
 
-		ra, err := txCourseRepo.DeletePermanent(ctx, id)
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsID(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete course featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsID(ctx, id); err != nil {
+			return fmt.Errorf("failed to count course featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+
+		detailsRA, err := txCourseRepo.DeletePermanent(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to delete course: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		} else if detailsRA == 0 && !force {
+			return ErrNotFound
 		}
 
-		ra, err = txProductRepo.DeletePermanentByDetailsID(ctx, id)
+		ra, err := txProductRepo.DeletePermanentByDetailsID(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to delete course product: %w", err)
+		} else if force {
+			if detailsRA == 0 && ra == 0 {
+				return ErrNotFound
+			}
 		} else if ra == 0 {
 			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
@@ -713,22 +944,105 @@ func (s *service) DeletePermanent(ctx context.Context, id string) error {
 	})
 }
 
+// PurgeDeletedBefore permanently deletes all courses soft-deleted before the given time,
+// together with their product records and course parts.
+// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
+//
+// Returns the number of purged courses.
+// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+func (s *service) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "course", "PurgeDeletedBefore")
+	defer span.End()
+
+	var purged int64
+	err := s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txCourseRepo := s.CourseRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
+		txPartRepo := s.PartRepo.WithTx(tx)
+
+		ids, err := txCourseRepo.ListDeletedIDsBefore(ctx, before)
+		if err != nil {
+			return fmt.Errorf("failed to list deleted courses: %w", err)
+		} else if len(ids) == 0 {
+			return nil
+		}
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsIDs(ctx, ids); err != nil {
+				return fmt.Errorf("failed to delete course featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to count course featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+		if purged, err = txCourseRepo.DeletePermanentByIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete courses: %w", err)
+		}
+		if _, err = txProductRepo.DeletePermanentByDetailsIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete course products: %w", err)
+		}
+		// Courses may not have any parts
+		if _, err = txPartRepo.DeletePermanentByCourseIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete course parts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// CountDeletedBefore counts courses soft-deleted before cutoff, that is the courses
+// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+//
+// Returns an error if a database/internal error occurs.
+func (s *service) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "course", "CountDeletedBefore")
+	defer span.End()
+
+	count, err := s.CourseRepo.CountDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted courses: %w", err)
+	}
+	return count, nil
+}
+
 // Restore performs a restore of a course, its associated course parts
-// and its related product record.
+// and its related product record. Only the course parts that were soft-deleted together
+// with the course are restored; parts deleted on their own beforehand stay deleted.
 // Course record, its associated course part records and its related product record
 // are not being published. This should be done manually.
 //
+// Returns the number of course parts that were restored together with the course.
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
-func (s *service) Restore(ctx context.Context, id string) error {
+func (s *service) Restore(ctx context.Context, id string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "course", "Restore", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
-		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+		return 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
-	return s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
+	var partsAffected int64
+	err := s.CourseRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txCourseRepo := s.CourseRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
 		txPartRepo := s.PartRepo.WithTx(tx)
 
+		courseRec, err := txCourseRepo.GetReducedWithDeleted(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %w", ErrNotFound, err)
+			}
+			return fmt.Errorf("failed to retrieve course: %w", err)
+		}
+		if !courseRec.DeletedAt.Valid {
+			return fmt.Errorf("%w: course %s is not deleted", ErrNotFound, id)
+		}
+
 		ra, err := txCourseRepo.Restore(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to restore course: %w", err)
@@ -743,10 +1057,16 @@ func (s *service) Restore(ctx context.Context, id string) error {
 			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
 
-		// Course may not have any parts
-		if _, err := txPartRepo.RestoreByCourseID(ctx, id); err != nil {
+		// Course may not have any parts. Delete soft-deletes parts after the course,
+		// so parts removed together with it have deleted_at >= the course's deleted_at.
+		partsAffected, err = txPartRepo.RestoreByCourseIDDeletedSince(ctx, id, courseRec.DeletedAt.Time)
+		if err != nil {
 			return fmt.Errorf("failed to restore course parts: %w", err)
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return partsAffected, nil
 }
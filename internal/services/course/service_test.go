@@ -20,14 +20,22 @@ package course
 import (
 	"context"
 	"errors"
+	"fmt"
+	"log/slog"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
 	"github.com/mikhail5545/product-service-go/internal/models/course"
+	coursepart "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	"github.com/mikhail5545/product-service-go/internal/models/product"
 	coursemock "github.com/mikhail5545/product-service-go/internal/test/database/course_mock"
 	coursepartmock "github.com/mikhail5545/product-service-go/internal/test/database/course_part_mock"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/database/featured_mock"
 	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
 	"github.com/stretchr/testify/assert"
 	gomock "go.uber.org/mock/gomock"
@@ -43,7 +51,7 @@ func TestService_Get(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -63,12 +71,13 @@ func TestService_Get(t *testing.T) {
 		Course:    expectedCourse,
 		Price:     expectedProduct.Price,
 		ProductID: expectedProduct.ID,
+		Available: true,
 	}
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().Get(context.Background(), courseID).Return(expectedCourse, nil)
-		mockProductRepo.EXPECT().GetByDetailsID(context.Background(), courseID).Return(expectedProduct, nil)
+		mockCourseRepo.EXPECT().Get(gomock.Any(), courseID).Return(expectedCourse, nil)
+		mockProductRepo.EXPECT().GetByDetailsID(gomock.Any(), courseID).Return(expectedProduct, nil)
 
 		// Act
 		details, err := testService.Get(context.Background(), courseID)
@@ -82,7 +91,7 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("course not found", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().Get(context.Background(), courseID).Return(nil, gorm.ErrRecordNotFound)
+		mockCourseRepo.EXPECT().Get(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		_, err := testService.Get(context.Background(), courseID)
@@ -94,8 +103,8 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("course product not found", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().Get(context.Background(), courseID).Return(expectedCourse, nil)
-		mockProductRepo.EXPECT().GetByDetailsID(context.Background(), courseID).Return(nil, gorm.ErrRecordNotFound)
+		mockCourseRepo.EXPECT().Get(gomock.Any(), courseID).Return(expectedCourse, nil)
+		mockProductRepo.EXPECT().GetByDetailsID(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		_, err := testService.Get(context.Background(), courseID)
@@ -118,6 +127,159 @@ func TestService_Get(t *testing.T) {
 	})
 }
 
+func TestService_GetFull(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
+
+	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
+	productID := uuid.New().String()
+	productFields := []any{"id", "price", "details_id", "available_from", "available_until"}
+
+	t.Run("course with ordered parts", func(t *testing.T) {
+		// Arrange
+		sellableID, freeID, missingID := uuid.New().String(), uuid.New().String(), uuid.New().String()
+		partProductID := uuid.New().String()
+		parts := []coursepart.CoursePart{
+			{ID: freeID, CourseID: courseID, Number: 1, Published: true},
+			{ID: sellableID, CourseID: courseID, Number: 2, Published: true, Sellable: true},
+			{ID: missingID, CourseID: courseID, Number: 3, Published: true, Sellable: true},
+		}
+		mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(&course.Course{ID: courseID, Name: "Course"}, nil)
+		mockPartRepo.EXPECT().ListOrdered(gomock.Any(), courseID).Return(parts, nil)
+		mockProductRepo.EXPECT().SelectByDetailsIDs(gomock.Any(), []string{courseID, sellableID, missingID}, productFields...).
+			Return([]product.Product{
+				{ID: productID, Price: 199, DetailsID: courseID},
+				{ID: partProductID, Price: 29, DetailsID: sellableID},
+			}, nil)
+
+		// Act
+		details, err := testService.GetFull(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, courseID, details.ID)
+		assert.Equal(t, productID, details.ProductID)
+		assert.Equal(t, float32(199), details.Price)
+		assert.True(t, details.Available)
+		if assert.Len(t, details.Parts, 2) {
+			assert.Equal(t, freeID, details.Parts[0].ID)
+			assert.Empty(t, details.Parts[0].ProductID)
+			assert.Equal(t, sellableID, details.Parts[1].ID)
+			assert.Equal(t, partProductID, details.Parts[1].ProductID)
+			assert.Equal(t, float32(29), details.Parts[1].Price)
+		}
+	})
+
+	t.Run("empty course", func(t *testing.T) {
+		// Arrange
+		mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(&course.Course{ID: courseID}, nil)
+		mockPartRepo.EXPECT().ListOrdered(gomock.Any(), courseID).Return(nil, nil)
+		mockProductRepo.EXPECT().SelectByDetailsIDs(gomock.Any(), []string{courseID}, productFields...).
+			Return([]product.Product{{ID: productID, Price: 99, DetailsID: courseID}}, nil)
+
+		// Act
+		details, err := testService.GetFull(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, productID, details.ProductID)
+		assert.NotNil(t, details.Parts)
+		assert.Empty(t, details.Parts)
+	})
+
+	t.Run("course product missing", func(t *testing.T) {
+		// Arrange
+		mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(&course.Course{ID: courseID}, nil)
+		mockPartRepo.EXPECT().ListOrdered(gomock.Any(), courseID).Return(nil, nil)
+		mockProductRepo.EXPECT().SelectByDetailsIDs(gomock.Any(), []string{courseID}, productFields...).Return(nil, nil)
+
+		// Act
+		_, err := testService.GetFull(context.Background(), courseID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		_, err := testService.GetFull(context.Background(), courseID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		_, err := testService.GetFull(context.Background(), "invalid-UUID")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_GetFull_ProductsFromDB(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&product.Product{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	productRepo := productrepo.New(db)
+
+	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+	testService := New(mockCourseRepo, productRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
+
+	courseID := uuid.New().String()
+	partA, partB, partC := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	parts := []coursepart.CoursePart{
+		{ID: partA, CourseID: courseID, Number: 1, Published: true, Sellable: true},
+		{ID: partB, CourseID: courseID, Number: 2, Published: true, Sellable: true},
+		{ID: partC, CourseID: courseID, Number: 3, Published: true, Sellable: true},
+	}
+	// Part products are seeded first, so the course product is not the first row returned.
+	products := []*product.Product{
+		{ID: uuid.New().String(), InStock: true, Price: 10, DetailsID: partA, DetailsType: "course_part"},
+		{ID: uuid.New().String(), InStock: true, Price: 20, DetailsID: partB, DetailsType: "course_part"},
+		{ID: uuid.New().String(), InStock: true, Price: 30, DetailsID: partC, DetailsType: "course_part"},
+		{ID: uuid.New().String(), InStock: true, Price: 199, DetailsID: courseID, DetailsType: "course"},
+	}
+	if err := productRepo.CreateBatch(context.Background(), products...); err != nil {
+		t.Fatalf("failed to seed products: %v", err)
+	}
+
+	// Arrange
+	mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(&course.Course{ID: courseID, Name: "Course"}, nil)
+	mockPartRepo.EXPECT().ListOrdered(gomock.Any(), courseID).Return(parts, nil)
+
+	// Act
+	details, err := testService.GetFull(context.Background(), courseID)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, products[3].ID, details.ProductID)
+	assert.Equal(t, float32(199), details.Price)
+	if assert.Len(t, details.Parts, 3) {
+		for i, part := range details.Parts {
+			assert.Equal(t, products[i].ID, part.ProductID)
+			assert.Equal(t, products[i].Price, part.Price)
+		}
+	}
+}
+
 func TestService_GetWithDeleted(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -126,7 +288,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -146,6 +308,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 		Course:    expectedCourse,
 		Price:     expectedProduct.Price,
 		ProductID: expectedProduct.ID,
+		Available: true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -163,6 +326,24 @@ func TestService_GetWithDeleted(t *testing.T) {
 		}
 	})
 
+	t.Run("surfaces deletion reason", func(t *testing.T) {
+		// Arrange
+		deletedCourse := &course.Course{
+			ID:            courseID,
+			DeletedAt:     gorm.DeletedAt{Time: time.Now(), Valid: true},
+			DeletedReason: "duplicate listing",
+		}
+		mockCourseRepo.EXPECT().GetWithDeleted(gomock.Any(), courseID).Return(deletedCourse, nil)
+		mockProductRepo.EXPECT().GetWithDeletedByDetailsID(gomock.Any(), courseID).Return(expectedProduct, nil)
+
+		// Act
+		details, err := testService.GetWithDeleted(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "duplicate listing", details.DeletedReason)
+	})
+
 	t.Run("course not found", func(t *testing.T) {
 		// Arrange
 		mockCourseRepo.EXPECT().GetWithDeleted(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
@@ -209,7 +390,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -229,6 +410,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 		Course:    expectedCourse,
 		Price:     expectedProduct.Price,
 		ProductID: expectedProduct.ID,
+		Available: true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -292,7 +474,7 @@ func TestService_GetReduced(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -312,12 +494,13 @@ func TestService_GetReduced(t *testing.T) {
 		Course:    expectedCourse,
 		Price:     expectedProduct.Price,
 		ProductID: expectedProduct.ID,
+		Available: true,
 	}
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().GetReduced(context.Background(), courseID).Return(expectedCourse, nil)
-		mockProductRepo.EXPECT().GetByDetailsID(context.Background(), courseID).Return(expectedProduct, nil)
+		mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(expectedCourse, nil)
+		mockProductRepo.EXPECT().GetByDetailsID(gomock.Any(), courseID).Return(expectedProduct, nil)
 
 		// Act
 		details, err := testService.GetReduced(context.Background(), courseID)
@@ -331,7 +514,7 @@ func TestService_GetReduced(t *testing.T) {
 
 	t.Run("course not found", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().GetReduced(context.Background(), courseID).Return(nil, gorm.ErrRecordNotFound)
+		mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		_, err := testService.GetReduced(context.Background(), courseID)
@@ -343,8 +526,8 @@ func TestService_GetReduced(t *testing.T) {
 
 	t.Run("course product not found", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().GetReduced(context.Background(), courseID).Return(expectedCourse, nil)
-		mockProductRepo.EXPECT().GetByDetailsID(context.Background(), courseID).Return(nil, gorm.ErrRecordNotFound)
+		mockCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(expectedCourse, nil)
+		mockProductRepo.EXPECT().GetByDetailsID(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		_, err := testService.GetReduced(context.Background(), courseID)
@@ -375,7 +558,7 @@ func TestService_GetReducedWithDeleted(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -395,12 +578,13 @@ func TestService_GetReducedWithDeleted(t *testing.T) {
 		Course:    expectedCourse,
 		Price:     expectedProduct.Price,
 		ProductID: expectedProduct.ID,
+		Available: true,
 	}
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().GetReducedWithDeleted(context.Background(), courseID).Return(expectedCourse, nil)
-		mockProductRepo.EXPECT().GetWithDeletedByDetailsID(context.Background(), courseID).Return(expectedProduct, nil)
+		mockCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(expectedCourse, nil)
+		mockProductRepo.EXPECT().GetWithDeletedByDetailsID(gomock.Any(), courseID).Return(expectedProduct, nil)
 
 		// Act
 		details, err := testService.GetReducedWithDeleted(context.Background(), courseID)
@@ -414,7 +598,7 @@ func TestService_GetReducedWithDeleted(t *testing.T) {
 
 	t.Run("course not found", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().GetReducedWithDeleted(context.Background(), courseID).Return(nil, gorm.ErrRecordNotFound)
+		mockCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		_, err := testService.GetReducedWithDeleted(context.Background(), courseID)
@@ -426,8 +610,8 @@ func TestService_GetReducedWithDeleted(t *testing.T) {
 
 	t.Run("course product not found", func(t *testing.T) {
 		// Arrange
-		mockCourseRepo.EXPECT().GetReducedWithDeleted(context.Background(), courseID).Return(expectedCourse, nil)
-		mockProductRepo.EXPECT().GetWithDeletedByDetailsID(context.Background(), courseID).Return(nil, gorm.ErrRecordNotFound)
+		mockCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(expectedCourse, nil)
+		mockProductRepo.EXPECT().GetWithDeletedByDetailsID(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		_, err := testService.GetReducedWithDeleted(context.Background(), courseID)
@@ -458,7 +642,7 @@ func TestService_List(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	course1ID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 	course2ID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
@@ -494,11 +678,13 @@ func TestService_List(t *testing.T) {
 			Course:    &mockCourses[0],
 			Price:     mockProducts[0].Price,
 			ProductID: mockProducts[0].ID,
+			Available: true,
 		},
 		{
 			Course:    &mockCourses[1],
 			Price:     mockProducts[1].Price,
 			ProductID: mockProducts[1].ID,
+			Available: true,
 		},
 	}
 
@@ -507,7 +693,7 @@ func TestService_List(t *testing.T) {
 		limit, offset := 2, 0
 		mockCourseRepo.EXPECT().List(gomock.Any(), limit, offset).Return(mockCourses, nil)
 		mockCourseRepo.EXPECT().Count(gomock.Any()).Return(int64(2), nil)
-		mockProductRepo.EXPECT().SelectByDetailsIDs(gomock.Any(), []string{course1ID, course2ID}, "id", "price", "details_id").Return(mockProducts, nil)
+		mockProductRepo.EXPECT().SelectByDetailsIDs(gomock.Any(), []string{course1ID, course2ID}, "id", "price", "details_id", "available_from", "available_until").Return(mockProducts, nil)
 
 		// Act
 		courses, total, err := testService.List(context.Background(), limit, offset)
@@ -555,7 +741,7 @@ func TestService_ListDeleted(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	course1ID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 	course2ID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
@@ -591,11 +777,13 @@ func TestService_ListDeleted(t *testing.T) {
 			Course:    &mockCourses[0],
 			Price:     mockProducts[0].Price,
 			ProductID: mockProducts[0].ID,
+			Available: true,
 		},
 		{
 			Course:    &mockCourses[1],
 			Price:     mockProducts[1].Price,
 			ProductID: mockProducts[1].ID,
+			Available: true,
 		},
 	}
 
@@ -604,7 +792,7 @@ func TestService_ListDeleted(t *testing.T) {
 		limit, offset := 2, 0
 		mockCourseRepo.EXPECT().ListDeleted(gomock.Any(), limit, offset).Return(mockCourses, nil)
 		mockCourseRepo.EXPECT().CountDeleted(gomock.Any()).Return(int64(2), nil)
-		mockProductRepo.EXPECT().SelectWithDeletedByDetailsIDs(gomock.Any(), []string{course1ID, course2ID}, "id", "price", "details_id").Return(mockProducts, nil)
+		mockProductRepo.EXPECT().SelectWithDeletedByDetailsIDs(gomock.Any(), []string{course1ID, course2ID}, "id", "price", "details_id", "available_from", "available_until").Return(mockProducts, nil)
 
 		// Act
 		courses, total, err := testService.ListDeleted(context.Background(), limit, offset)
@@ -652,7 +840,7 @@ func TestService_ListUnpublished(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	course1ID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 	course2ID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
@@ -672,7 +860,7 @@ func TestService_ListUnpublished(t *testing.T) {
 		limit, offset := 2, 0
 		mockCourseRepo.EXPECT().ListUnpublished(gomock.Any(), limit, offset).Return(mockCourses, nil)
 		mockCourseRepo.EXPECT().CountUnpublished(gomock.Any()).Return(int64(2), nil)
-		mockProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{course1ID, course2ID}, "id", "price", "details_id").Return(mockProducts, nil)
+		mockProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{course1ID, course2ID}, "id", "price", "details_id", "available_from", "available_until").Return(mockProducts, nil)
 
 		// Act
 		courses, total, err := testService.ListUnpublished(context.Background(), limit, offset)
@@ -719,7 +907,7 @@ func TestService_Create(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	createReq := &course.CreateRequest{
 		Name:             "Course name",
@@ -816,6 +1004,25 @@ func TestService_Create(t *testing.T) {
 		// Assert
 		assert.Error(t, err)
 	})
+
+	t.Run("zero or negative price", func(t *testing.T) {
+		for _, price := range []float32{0, -10} {
+			// Arrange
+			req := *createReq
+			req.Price = price
+			mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+			mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(coursemock.NewMockRepository(ctrl))
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(productmock.NewMockRepository(ctrl))
+
+			// Act
+			resp, err := testService.Create(context.Background(), &req)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+			assert.ErrorContains(t, err, "price")
+			assert.Nil(t, resp)
+		}
+	})
 }
 
 func TestService_Publish(t *testing.T) {
@@ -826,7 +1033,7 @@ func TestService_Publish(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -842,6 +1049,8 @@ func TestService_Publish(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
+		publishedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		testService.(*service).now = func() time.Time { return publishedAt }
 		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
 
@@ -849,24 +1058,181 @@ func TestService_Publish(t *testing.T) {
 		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, true).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, true).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), courseID, publishedAt).Return(int64(1), nil)
 
 		// Act
-		err = testService.Publish(context.Background(), courseID)
+		err := testService.Publish(context.Background(), courseID)
 
 		// Assert
 		assert.NoError(t, err)
 	})
 
-	t.Run("invalid course UUID", func(t *testing.T) {
+	t.Run("already published", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25, InStock: true}}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already published course with unpublished product", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), courseID, gomock.Any()).Return(int64(1), nil)
+
 		// Act
-		err := testService.Publish(context.Background(), "Invalid-UUID")
+		err := testService.Publish(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
+
+		// Act
+		err := testService.Publish(context.Background(), invalidID)
 
 		// Assert
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrInvalidArgument)
 	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, true).Return(database.SetResult{}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		dbErr := errors.New("database error")
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, true).Return(database.SetResult{}, dbErr)
+
+		// Act
+		err := testService.Publish(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("zero price not marked free", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		productID := uuid.New().String()
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: productID, Price: 0}}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrCannotPublishFree)
+		assert.Contains(t, err.Error(), productID)
+	})
+
+	t.Run("zero price marked free", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{courseID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 0, IsFree: true}}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), courseID, gomock.Any()).Return(int64(1), nil)
+
+		// Act
+		err := testService.Publish(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
 }
 
 func TestService_Unpublish(t *testing.T) {
@@ -877,7 +1243,7 @@ func TestService_Unpublish(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -895,32 +1261,115 @@ func TestService_Unpublish(t *testing.T) {
 		// Arrange
 		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
-		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
 
 		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
-		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false).Return(int64(1), nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
 		mockTxPartRepo.EXPECT().SetPublishedByCourseID(gomock.Any(), courseID, false).Return(int64(1), nil)
 
 		// Act
-		err = testService.Unpublish(context.Background(), courseID)
+		err := testService.Unpublish(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already unpublished", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false, gomock.Any()).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Unpublish(context.Background(), courseID)
 
 		// Assert
 		assert.NoError(t, err)
 	})
 
 	t.Run("invalid course UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
+
 		// Act
-		err := testService.Unpublish(context.Background(), "Invalid-UUID")
+		err := testService.Unpublish(context.Background(), invalidID)
 
 		// Assert
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrInvalidArgument)
 	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Unpublish(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false, gomock.Any()).Return(database.SetResult{}, nil)
+
+		// Act
+		err := testService.Unpublish(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		dbErr := errors.New("database error")
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{}, dbErr)
+
+		// Act
+		err := testService.Unpublish(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
 }
 
 func TestService_Update(t *testing.T) {
@@ -931,7 +1380,7 @@ func TestService_Update(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -1109,7 +1558,7 @@ func TestService_Delete(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -1123,7 +1572,42 @@ func TestService_Delete(t *testing.T) {
 		t.Fatalf("failed to connect database: %v", err)
 	}
 
-	t.Run("success", func(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		parts int64
+	}{
+		{name: "success without parts", parts: 0},
+		{name: "success with one part", parts: 1},
+		{name: "success with several parts", parts: 4},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+			mockTxProductRepo := productmock.NewMockRepository(ctrl)
+			mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+
+			mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+			mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+			mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+
+			mockTxCourseRepo.EXPECT().GetWithUnpublished(gomock.Any(), courseID).Return(&course.Course{}, nil)
+			mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{Affected: 1}, nil)
+			mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+			mockTxPartRepo.EXPECT().SetPublishedByCourseID(gomock.Any(), courseID, false).Return(tc.parts, nil)
+
+			mockTxCourseRepo.EXPECT().Delete(gomock.Any(), courseID, "").Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
+			mockTxPartRepo.EXPECT().DeleteByCourseID(gomock.Any(), courseID).Return(tc.parts, nil)
+
+			partsAffected, err := testService.Delete(context.Background(), courseID, "")
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.parts, partsAffected)
+		})
+	}
+
+	t.Run("success with reason", func(t *testing.T) {
+		// Arrange
 		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
 		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
@@ -1134,22 +1618,32 @@ func TestService_Delete(t *testing.T) {
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
 		mockTxCourseRepo.EXPECT().GetWithUnpublished(gomock.Any(), courseID).Return(&course.Course{}, nil)
-		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false).Return(int64(1), nil)
-		mockTxPartRepo.EXPECT().SetPublishedByCourseID(gomock.Any(), courseID, false).Return(int64(1), nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+		mockTxPartRepo.EXPECT().SetPublishedByCourseID(gomock.Any(), courseID, false).Return(int64(0), nil)
 
-		mockTxCourseRepo.EXPECT().Delete(gomock.Any(), courseID).Return(int64(1), nil)
+		mockTxCourseRepo.EXPECT().Delete(gomock.Any(), courseID, "duplicate listing").Return(int64(1), nil)
 		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
-		mockTxPartRepo.EXPECT().DeleteByCourseID(gomock.Any(), courseID).Return(int64(1), nil)
+		mockTxPartRepo.EXPECT().DeleteByCourseID(gomock.Any(), courseID).Return(int64(0), nil)
 
-		err = testService.Delete(context.Background(), courseID)
+		// Act
+		_, err := testService.Delete(context.Background(), courseID, "duplicate listing")
 
+		// Assert
 		assert.NoError(t, err)
 	})
 
+	t.Run("reason too long", func(t *testing.T) {
+		// Act
+		_, err := testService.Delete(context.Background(), courseID, strings.Repeat("a", 256))
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
 	t.Run("invalid course UUID", func(t *testing.T) {
 		// Act
-		err := testService.Delete(context.Background(), "Invalid-UUID")
+		_, err := testService.Delete(context.Background(), "Invalid-UUID", "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1170,7 +1664,7 @@ func TestService_Delete(t *testing.T) {
 		mockTxCourseRepo.EXPECT().GetWithUnpublished(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
-		err = testService.Delete(context.Background(), courseID)
+		_, err = testService.Delete(context.Background(), courseID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1190,10 +1684,10 @@ func TestService_Delete(t *testing.T) {
 
 		dbErr := errors.New("database error")
 		mockTxCourseRepo.EXPECT().GetWithUnpublished(gomock.Any(), courseID).Return(&course.Course{}, nil)
-		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(int64(0), dbErr)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{}, dbErr)
 
 		// Act
-		err = testService.Delete(context.Background(), courseID)
+		_, err = testService.Delete(context.Background(), courseID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1208,7 +1702,13 @@ func TestService_DeletePermanent(t *testing.T) {
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	// Nothing is featured, so the dependents check always passes.
+	mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo).AnyTimes()
+	mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, mockFeaturedRepo, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -1238,7 +1738,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxPartRepo.EXPECT().DeletePermanentByCourseID(gomock.Any(), courseID).Return(int64(1), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), courseID)
+		err := testService.DeletePermanent(context.Background(), courseID, false)
 
 		// Assert
 		assert.NoError(t, err)
@@ -1246,7 +1746,7 @@ func TestService_DeletePermanent(t *testing.T) {
 
 	t.Run("invalid course UUID", func(t *testing.T) {
 		// Act
-		err := testService.DeletePermanent(context.Background(), "Invalid-UUID")
+		err := testService.DeletePermanent(context.Background(), "Invalid-UUID", false)
 
 		// Assert
 		assert.Error(t, err)
@@ -1267,7 +1767,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxCourseRepo.EXPECT().DeletePermanent(gomock.Any(), courseID).Return(int64(0), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), courseID)
+		err := testService.DeletePermanent(context.Background(), courseID, false)
 
 		// Assert
 		assert.Error(t, err)
@@ -1289,74 +1789,220 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxCourseRepo.EXPECT().DeletePermanent(gomock.Any(), courseID).Return(int64(0), dbErr)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), courseID)
+		err := testService.DeletePermanent(context.Background(), courseID, false)
 
 		// Assert
 		assert.Error(t, err)
 	})
 }
 
-func TestService_Restore(t *testing.T) {
+func TestService_DeletePermanent_Dependents(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
 
-	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo)
-
-	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, mockFeaturedRepo, slog.New(slog.DiscardHandler))
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
-		// This prevents GORM from starting a real DB transaction,
-		// allowing the mock repositories to work as expected.
 		SkipDefaultTransaction: true,
 	})
 	if err != nil {
 		t.Fatalf("failed to connect database: %v", err)
 	}
 
-	t.Run("success", func(t *testing.T) {
+	courseID := uuid.New().String()
+
+	t.Run("featured product blocks delete", func(t *testing.T) {
 		// Arrange
 		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
 		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
 
 		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
 
-		mockTxCourseRepo.EXPECT().Restore(gomock.Any(), courseID).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
-		mockTxPartRepo.EXPECT().RestoreByCourseID(gomock.Any(), courseID).Return(int64(1), nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
+		mockTxCourseRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), courseID, false)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrHasDependents)
+	})
+
+	t.Run("cascade removes featured entries", func(t *testing.T) {
+		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+		mockTxCourseRepo.EXPECT().DeletePermanent(gomock.Any(), courseID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
+		mockTxPartRepo.EXPECT().DeletePermanentByCourseID(gomock.Any(), courseID).Return(int64(1), nil)
 
 		// Act
-		err := testService.Restore(context.Background(), courseID)
+		err := testService.DeletePermanent(context.Background(), courseID, false)
 
 		// Assert
 		assert.NoError(t, err)
 	})
 
-	t.Run("invalid course UUID", func(t *testing.T) {
+	t.Run("cascade error", func(t *testing.T) {
 		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
 		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
 		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
 
 		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		dbErr := errors.New("database error")
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), courseID).Return(int64(0), dbErr)
+		mockTxCourseRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), courseID, false)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestService_Restore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, nil, slog.New(slog.DiscardHandler))
+
+	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
+	deletedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	deletedCourse := &course.Course{ID: courseID, DeletedAt: gorm.DeletedAt{Time: deletedAt, Valid: true}}
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		// This prevents GORM from starting a real DB transaction,
+		// allowing the mock repositories to work as expected.
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		parts int64
+	}{
+		{name: "success without parts", parts: 0},
+		{name: "success with one part", parts: 1},
+		{name: "success with several parts", parts: 4},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+			mockTxProductRepo := productmock.NewMockRepository(ctrl)
+			mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+
+			mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+			mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+			mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+
+			mockTxCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(deletedCourse, nil)
+			mockTxCourseRepo.EXPECT().Restore(gomock.Any(), courseID).Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
+			mockTxPartRepo.EXPECT().RestoreByCourseIDDeletedSince(gomock.Any(), courseID, deletedAt).Return(tc.parts, nil)
+
+			// Act
+			partsAffected, err := testService.Restore(context.Background(), courseID)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.parts, partsAffected)
+		})
+	}
+
+	t.Run("restores parts deleted with the course", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo).Times(2)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo).Times(2)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo).Times(2)
+
+		// Delete soft-deletes three parts together with the course.
+		mockTxCourseRepo.EXPECT().GetWithUnpublished(gomock.Any(), courseID).Return(&course.Course{ID: courseID}, nil)
+		mockTxCourseRepo.EXPECT().SetInStock(gomock.Any(), courseID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxPartRepo.EXPECT().SetPublishedByCourseID(gomock.Any(), courseID, false).Return(int64(3), nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), courseID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+		mockTxCourseRepo.EXPECT().Delete(gomock.Any(), courseID, "").Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
+		var deletedParts int64
+		mockTxPartRepo.EXPECT().DeleteByCourseID(gomock.Any(), courseID).DoAndReturn(func(context.Context, string) (int64, error) {
+			deletedParts = 3
+			return deletedParts, nil
+		})
 
+		// Restore brings back exactly those parts.
+		mockTxCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(deletedCourse, nil)
 		mockTxCourseRepo.EXPECT().Restore(gomock.Any(), courseID).Return(int64(1), nil)
 		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), courseID).Return(int64(1), nil)
-		mockTxPartRepo.EXPECT().RestoreByCourseID(gomock.Any(), courseID).Return(int64(1), nil)
+		var restoredParts int64
+		mockTxPartRepo.EXPECT().RestoreByCourseIDDeletedSince(gomock.Any(), courseID, deletedAt).DoAndReturn(func(context.Context, string, time.Time) (int64, error) {
+			restoredParts = deletedParts
+			return restoredParts, nil
+		})
+
+		// Act
+		deleted, deleteErr := testService.Delete(context.Background(), courseID, "")
+		restored, restoreErr := testService.Restore(context.Background(), courseID)
 
+		// Assert
+		assert.NoError(t, deleteErr)
+		assert.NoError(t, restoreErr)
+		assert.Equal(t, int64(3), deletedParts)
+		assert.Equal(t, deletedParts, restoredParts)
+		assert.Equal(t, deleted, restored)
+	})
+
+	t.Run("invalid course UUID", func(t *testing.T) {
 		// Act
-		err := testService.Restore(context.Background(), "Invalid-UUID")
+		_, err := testService.Restore(context.Background(), "Invalid-UUID")
 
 		// Assert
 		assert.Error(t, err)
@@ -1374,10 +2020,31 @@ func TestService_Restore(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
-		mockTxCourseRepo.EXPECT().Restore(gomock.Any(), courseID).Return(int64(0), nil)
+		mockTxCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
-		err := testService.Restore(context.Background(), courseID)
+		_, err := testService.Restore(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("course not deleted", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+
+		mockTxCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(&course.Course{ID: courseID}, nil)
+
+		// Act
+		_, err := testService.Restore(context.Background(), courseID)
 
 		// Assert
 		assert.Error(t, err)
@@ -1396,12 +2063,137 @@ func TestService_Restore(t *testing.T) {
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
 		dbErr := errors.New("database error")
+		mockTxCourseRepo.EXPECT().GetReducedWithDeleted(gomock.Any(), courseID).Return(deletedCourse, nil)
 		mockTxCourseRepo.EXPECT().Restore(gomock.Any(), courseID).Return(int64(0), dbErr)
 
 		// Act
-		err := testService.Restore(context.Background(), courseID)
+		_, err := testService.Restore(context.Background(), courseID)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestService_PurgeDeletedBefore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+	testService := New(mockCourseRepo, mockProductRepo, mockPartRepo, mockFeaturedRepo, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	before := time.Now().AddDate(0, 0, -30)
+	ids := []string{uuid.New().String(), uuid.New().String()}
+
+	setup := func() (*coursemock.MockRepository, *productmock.MockRepository, *coursepartmock.MockRepository, *featuredmock.MockRepository) {
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockCourseRepo.EXPECT().DB().Return(db)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+		return mockTxCourseRepo, mockTxProductRepo, mockTxPartRepo, mockTxFeaturedRepo
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo, mockTxProductRepo, mockTxPartRepo, mockTxFeaturedRepo := setup()
+
+		mockTxCourseRepo.EXPECT().ListDeletedIDsBefore(gomock.Any(), before).Return(ids, nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsIDs(gomock.Any(), ids).Return(int64(0), nil)
+		mockTxCourseRepo.EXPECT().DeletePermanentByIDs(gomock.Any(), ids).Return(int64(2), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsIDs(gomock.Any(), ids).Return(int64(2), nil)
+		mockTxPartRepo.EXPECT().DeletePermanentByCourseIDs(gomock.Any(), ids).Return(int64(7), nil)
+
+		// Act
+		purged, err := testService.PurgeDeletedBefore(context.Background(), before)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+	})
+
+	t.Run("featured product blocks purge", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo, mockTxProductRepo, _, mockTxFeaturedRepo := setup()
+
+		mockTxCourseRepo.EXPECT().ListDeletedIDsBefore(gomock.Any(), before).Return(ids, nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsIDs(gomock.Any(), ids).Return(int64(1), nil)
+		mockTxCourseRepo.EXPECT().DeletePermanentByIDs(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsIDs(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		purged, err := testService.PurgeDeletedBefore(context.Background(), before)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrHasDependents)
+		assert.Zero(t, purged)
+	})
+
+	t.Run("cascade removes featured entries", func(t *testing.T) {
+		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
+		mockTxCourseRepo, mockTxProductRepo, mockTxPartRepo, mockTxFeaturedRepo := setup()
+
+		mockTxCourseRepo.EXPECT().ListDeletedIDsBefore(gomock.Any(), before).Return(ids, nil)
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsIDs(gomock.Any(), ids).Return(int64(1), nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsIDs(gomock.Any(), gomock.Any()).Times(0)
+		mockTxCourseRepo.EXPECT().DeletePermanentByIDs(gomock.Any(), ids).Return(int64(2), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsIDs(gomock.Any(), ids).Return(int64(2), nil)
+		mockTxPartRepo.EXPECT().DeletePermanentByCourseIDs(gomock.Any(), ids).Return(int64(0), nil)
+
+		// Act
+		purged, err := testService.PurgeDeletedBefore(context.Background(), before)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), purged)
+	})
+
+	t.Run("nothing to purge", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo, _, _, _ := setup()
+
+		mockTxCourseRepo.EXPECT().ListDeletedIDsBefore(gomock.Any(), before).Return(nil, nil)
+
+		// Act
+		purged, err := testService.PurgeDeletedBefore(context.Background(), before)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, purged)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxCourseRepo, mockTxProductRepo, _, mockTxFeaturedRepo := setup()
+
+		mockTxCourseRepo.EXPECT().ListDeletedIDsBefore(gomock.Any(), before).Return(ids, nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsIDs(gomock.Any(), ids).Return(int64(0), nil)
+		mockTxCourseRepo.EXPECT().DeletePermanentByIDs(gomock.Any(), ids).Return(int64(2), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsIDs(gomock.Any(), ids).Return(int64(0), errors.New("database error"))
+
+		// Act
+		purged, err := testService.PurgeDeletedBefore(context.Background(), before)
 
 		// Assert
 		assert.Error(t, err)
+		assert.Zero(t, purged)
 	})
 }
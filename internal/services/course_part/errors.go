@@ -25,4 +25,6 @@ var (
 	ErrInvalidArgument = errors.New("invalid argument")
 	// ErrNotFound course part not found error
 	ErrNotFound = errors.New("course part not found")
+	// ErrProductNotFound sellable course part has no associated product error
+	ErrProductNotFound = errors.New("course part product not found")
 )
@@ -22,11 +22,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 	courserepo "github.com/mikhail5545/product-service-go/internal/database/course"
 	coursepartrepo "github.com/mikhail5545/product-service-go/internal/database/course_part"
+	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
 	coursepartmodel "github.com/mikhail5545/product-service-go/internal/models/course_part"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -35,12 +42,13 @@ import (
 // Service provides service-layer business logic for course part models.
 type Service interface {
 	// Get retrieves a single published and not soft-deleted course part record from the database.
+	// If the part is sellable, its associated product price and ID are included.
 	// It attempts to retrieve MUXVideo information by calling the media service.
 	//
-	// It returns the course part record with populated MUXVideo details if found.
+	// It returns the course part details with populated MUXVideo details if found.
 	// Returns an error if the ID is invalid (http.StatusBadRequest), the record is not found (http.StatusNotFound),
-	// or a database/internal error occurs (http.StatusInternalServerError).
-	Get(ctx context.Context, id string) (*coursepartmodel.CoursePart, error)
+	// a sellable part has no product (ErrProductNotFound) or a database/internal error occurs (http.StatusInternalServerError).
+	Get(ctx context.Context, id string) (*coursepartmodel.CoursePartDetails, error)
 	// GetWithDeleted retrieves a single course part record from the database, including soft-deleted ones.
 	// It attempts to retrieve MUXVideo information by calling the media service.
 	//
@@ -77,11 +85,12 @@ type Service interface {
 	// or a database/internal error occurs (http.StatusInternalServerError).
 	GetWithUnpublishedReduced(ctx context.Context, id string) (*coursepartmodel.CoursePart, error)
 	// List retrieves a paginated list of all published and not soft-deleted course part records for a given course ID.
+	// Sellable parts include their associated product price and ID; sellable parts without a product are skipped.
 	// It attempts to retrieve MUXVideo information for each course part by calling the media service.
 	//
-	// Returns a slice of course part records with populated MUXVideo details and the total count of such records.
+	// Returns a slice of course part details with populated MUXVideo details and the total count of such records.
 	// Returns an error if the course ID is invalid (http.StatusBadRequest) or a database/internal error occurs (http.StatusInternalServerError).
-	List(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, int64, error)
+	List(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePartDetails, int64, error)
 	// ListReduced retrieves a paginated list of all published and not soft-deleted course part records for a given course ID.
 	// It does not populate MUXVideo details for the course parts.
 	//
@@ -105,9 +114,30 @@ type Service interface {
 	// It also checks for uniqueness of the part number within the course.
 	//
 	// Returns a CreateResponse containing the newly created CoursePartID and CourseID.
-	// Returns an error if the request payload is invalid (http.StatusBadRequest), the associated course is not found (http.StatusNotFound),
+	// Returns an error if the request payload is invalid (http.StatusBadRequest), the associated course is not found or soft-deleted (http.StatusNotFound),
 	// the part number is not unique within the course (http.StatusBadRequest), or a database/internal error occurs (http.StatusInternalServerError).
 	Create(ctx context.Context, req *coursepartmodel.CreateRequest) (*coursepartmodel.CreateResponse, error)
+	// CreateBatch creates course parts for an existing course in one transaction, numbered in the order of reqs
+	// after the last part of the course. Number and CourseID of the requests are ignored, the requests
+	// themselves are not modified. Either all parts are created or none.
+	//
+	// Returns a CreateResponse for every request, in the same order.
+	// Returns an error if the course ID or any request is invalid (ErrInvalidArgument), the course is not found
+	// or soft-deleted (ErrNotFound), or a database/internal error occurs.
+	CreateBatch(ctx context.Context, courseID string, reqs []*coursepartmodel.CreateRequest) ([]*coursepartmodel.CreateResponse, error)
+	// NextPosition returns the number a new part of the course should get: one more than the highest number
+	// among its published and unpublished parts, or 1 for a course without parts, as numbers start at 1.
+	//
+	// Returns an error if the course ID is invalid (ErrInvalidArgument), the course is not found
+	// or soft-deleted (ErrNotFound), or a database/internal error occurs.
+	NextPosition(ctx context.Context, courseID string) (int, error)
+	// Resequence renumbers published and unpublished parts of the course to 1..N, closing gaps and
+	// resolving duplicate numbers left by deletes and reorders. The current order is kept, parts sharing
+	// a number stay in creation order. It runs in one transaction and only parts whose number changes are updated.
+	//
+	// Returns an error if the course ID is invalid (ErrInvalidArgument), the course is not found
+	// or soft-deleted (ErrNotFound), or a database/internal error occurs.
+	Resequence(ctx context.Context, courseID string) error
 	// Publish sets the 'published' field to true for a specific course part.
 	// It will fail if the parent course is not published.
 	//
@@ -118,6 +148,7 @@ type Service interface {
 	//
 	// Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 	// or a database/internal error occurs (http.StatusInternalServerError).
+	// Unpublishing an already unpublished course part is a no-op.
 	Unpublish(ctx context.Context, id string) error
 	// Update performs a partial update of a course part's information.
 	// The request should contain the course part's ID and the fields to be updated.
@@ -130,15 +161,26 @@ type Service interface {
 	Update(ctx context.Context, req *coursepartmodel.UpdateRequest) (map[string]any, error)
 	// Delete performs a soft-delete for a specific course part.
 	// It also unpublishes the course part, meaning it must be manually published again after restoration.
+	// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 	//
-	// Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
+	// Returns an error if the course part ID is invalid or the reason is longer than 255 characters (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 	// after restore.
-	Delete(ctx context.Context, id string) error
+	Delete(ctx context.Context, id, reason string) error
 	// DeletePermanent completely removes a course part record from the database.
 	//
 	// Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 	// or a database/internal error occurs (http.StatusInternalServerError).
 	DeletePermanent(ctx context.Context, id string) error
+	// PurgeDeletedBefore permanently deletes all course parts soft-deleted before the given time.
+	//
+	// Returns the number of purged course parts.
+	// Returns an error if a database/internal error occurs.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// CountDeletedBefore counts course parts soft-deleted before cutoff, that is the course parts
+	// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+	//
+	// Returns an error if a database/internal error occurs.
+	CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	// Restore restores a soft-deleted course part record.
 	// The course part will remain unpublished and must be manually published again.
 	//
@@ -149,25 +191,33 @@ type Service interface {
 
 // service provides service-layer business logic for course part models.
 type service struct {
-	partRepo   coursepartrepo.Repository
-	courseRepo courserepo.Repository
+	partRepo    coursepartrepo.Repository
+	courseRepo  courserepo.Repository
+	productRepo productrepo.Repository
+	logger      *slog.Logger
 }
 
-// New creates a new Service instance with the provided course part and course repositories.
-func New(pr coursepartrepo.Repository, cr courserepo.Repository) Service {
+// New creates a new Service instance with the provided course part, course and product repositories and logger.
+func New(pr coursepartrepo.Repository, cr courserepo.Repository, prodr productrepo.Repository, logger *slog.Logger) Service {
 	return &service{
-		partRepo:   pr,
-		courseRepo: cr,
+		partRepo:    pr,
+		courseRepo:  cr,
+		productRepo: prodr,
+		logger:      logger,
 	}
 }
 
 // Get retrieves a single published and not soft-deleted course part record from the database.
+// If the part is sellable, its associated product price and ID are included.
 // It attempts to retrieve MUXVideo information by calling the media service.
 //
-// It returns the course part record with populated MUXVideo details if found.
+// It returns the course part details with populated MUXVideo details if found.
 // Returns an error if the ID is invalid (http.StatusBadRequest), the record is not found (http.StatusNotFound),
-// or a database/internal error occurs (http.StatusInternalServerError).
-func (s *service) Get(ctx context.Context, id string) (*coursepartmodel.CoursePart, error) {
+// a sellable part has no product (ErrProductNotFound) or a database/internal error occurs (http.StatusInternalServerError).
+func (s *service) Get(ctx context.Context, id string) (*coursepartmodel.CoursePartDetails, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "Get", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -178,8 +228,20 @@ func (s *service) Get(ctx context.Context, id string) (*coursepartmodel.CoursePa
 		}
 		return nil, fmt.Errorf("failed to retrieve course part: %w", err)
 	}
+	details := &coursepartmodel.CoursePartDetails{CoursePart: part}
+	if part.Sellable {
+		productRec, err := s.productRepo.GetByDetailsID(ctx, part.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, fmt.Errorf("%w: %w", ErrProductNotFound, err)
+			}
+			return nil, fmt.Errorf("failed to retrieve product for course part: %w", err)
+		}
+		details.Price = productRec.Price
+		details.ProductID = productRec.ID
+	}
 	// TODO: Implement call to media service to retrieve mux video.
-	return part, nil
+	return details, nil
 }
 
 // GetWithDeleted retrieves a single course part record from the database, including soft-deleted ones.
@@ -189,6 +251,9 @@ func (s *service) Get(ctx context.Context, id string) (*coursepartmodel.CoursePa
 // Returns an error if the ID is invalid (http.StatusBadRequest), the record is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) GetWithDeleted(ctx context.Context, id string) (*coursepartmodel.CoursePart, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "GetWithDeleted", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -210,6 +275,9 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*coursepartmod
 // Returns an error if the ID is invalid (http.StatusBadRequest), the record is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) GetWithUnpublished(ctx context.Context, id string) (*coursepartmodel.CoursePart, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "GetWithUnpublished", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -231,6 +299,9 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*coursepar
 // Returns an error if the ID is invalid (http.StatusBadRequest), the record is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) GetReduced(ctx context.Context, id string) (*coursepartmodel.CoursePart, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "GetReduced", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -251,6 +322,9 @@ func (s *service) GetReduced(ctx context.Context, id string) (*coursepartmodel.C
 // Returns an error if the ID is invalid (http.StatusBadRequest), the record is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) GetWithDeletedReduced(ctx context.Context, id string) (*coursepartmodel.CoursePart, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "GetWithDeletedReduced", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -271,6 +345,9 @@ func (s *service) GetWithDeletedReduced(ctx context.Context, id string) (*course
 // Returns an error if the ID is invalid (http.StatusBadRequest), the record is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) GetWithUnpublishedReduced(ctx context.Context, id string) (*coursepartmodel.CoursePart, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "GetWithUnpublishedReduced", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -285,11 +362,15 @@ func (s *service) GetWithUnpublishedReduced(ctx context.Context, id string) (*co
 }
 
 // List retrieves a paginated list of all published and not soft-deleted course part records for a given course ID.
+// Sellable parts include their associated product price and ID; sellable parts without a product are skipped.
 // It attempts to retrieve MUXVideo information for each course part by calling the media service.
 //
-// Returns a slice of course part records with populated MUXVideo details and the total count of such records.
+// Returns a slice of course part details with populated MUXVideo details and the total count of such records.
 // Returns an error if the course ID is invalid (http.StatusBadRequest) or a database/internal error occurs (http.StatusInternalServerError).
-func (s *service) List(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, int64, error) {
+func (s *service) List(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePartDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "List", tracing.ID(courseID))
+	defer span.End()
+
 	if _, err := uuid.Parse(courseID); err != nil {
 		return nil, 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -301,8 +382,40 @@ func (s *service) List(ctx context.Context, courseID string, limit, offset int)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count course parts: %w", err)
 	}
+
+	var sellableIDs []string
+	for _, p := range parts {
+		if p.Sellable {
+			sellableIDs = append(sellableIDs, p.ID)
+		}
+	}
+	productsMap := make(map[string]productmodel.Product, len(sellableIDs))
+	if len(sellableIDs) > 0 {
+		products, err := s.productRepo.SelectByDetailsIDs(ctx, sellableIDs, "id", "price", "details_id")
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
+		}
+		for _, p := range products {
+			productsMap[p.DetailsID] = p
+		}
+	}
+
+	allDetails := make([]coursepartmodel.CoursePartDetails, 0, len(parts))
+	for i := range parts {
+		details := coursepartmodel.CoursePartDetails{CoursePart: &parts[i]}
+		if parts[i].Sellable {
+			p, ok := productsMap[parts[i].ID]
+			if !ok {
+				s.logger.DebugContext(ctx, "dropped sellable course part without product", "course_part_id", parts[i].ID)
+				continue
+			}
+			details.Price = p.Price
+			details.ProductID = p.ID
+		}
+		allDetails = append(allDetails, details)
+	}
 	// TODO: Implement call to media service to retrieve mux video.
-	return parts, total, nil
+	return allDetails, total, nil
 }
 
 // ListReduced retrieves a paginated list of all published and not soft-deleted course part records for a given course ID.
@@ -311,6 +424,9 @@ func (s *service) List(ctx context.Context, courseID string, limit, offset int)
 // Returns a slice of course part records and the total count of such records.
 // Returns an error if the course ID is invalid (http.StatusBadRequest) or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) ListReduced(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, int64, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "ListReduced", tracing.ID(courseID))
+	defer span.End()
+
 	if _, err := uuid.Parse(courseID); err != nil {
 		return nil, 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -331,6 +447,9 @@ func (s *service) ListReduced(ctx context.Context, courseID string, limit, offse
 // Returns a slice of soft-deleted course part records and the total count of such records.
 // Returns an error if the course ID is invalid (http.StatusBadRequest) or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) ListDeleted(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, int64, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "ListDeleted", tracing.ID(courseID))
+	defer span.End()
+
 	if _, err := uuid.Parse(courseID); err != nil {
 		return nil, 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -351,6 +470,9 @@ func (s *service) ListDeleted(ctx context.Context, courseID string, limit, offse
 // Returns a slice of unpublished course part records and the total count of such records.
 // Returns an error if the course ID is invalid (http.StatusBadRequest) or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) ListUnpublished(ctx context.Context, courseID string, limit, offset int) ([]coursepartmodel.CoursePart, int64, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "ListUnpublished", tracing.ID(courseID))
+	defer span.End()
+
 	if _, err := uuid.Parse(courseID); err != nil {
 		return nil, 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -370,9 +492,13 @@ func (s *service) ListUnpublished(ctx context.Context, courseID string, limit, o
 // It also checks for uniqueness of the part number within the course.
 //
 // Returns a CreateResponse containing the newly created CoursePartID and CourseID.
-// Returns an error if the request payload is invalid (http.StatusBadRequest), the associated course is not found (http.StatusNotFound),
+// Returns an error if the request payload is invalid (http.StatusBadRequest), the associated course is not found or soft-deleted (http.StatusNotFound),
 // the part number is not unique within the course (http.StatusBadRequest), or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) Create(ctx context.Context, req *coursepartmodel.CreateRequest) (*coursepartmodel.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "Create")
+	defer span.End()
+
+	req.Sanitize()
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -391,10 +517,12 @@ func (s *service) Create(ctx context.Context, req *coursepartmodel.CreateRequest
 			Published:        false,
 		}
 
-		_, err := txCourseRepo.Select(ctx, part.CourseID, "id") // Only need to check if course exists
+		// Only need to check if course exists. Soft-deleted courses are not found here,
+		// so parts can't be attached to a deleted course.
+		_, err := txCourseRepo.Select(ctx, part.CourseID, "id")
 		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("%w: %w", ErrNotFound, err)
+				return fmt.Errorf("%w: course %s does not exist: %w", ErrNotFound, part.CourseID, err)
 			}
 			return fmt.Errorf("failed to retrieve course: %w", err)
 		}
@@ -422,12 +550,155 @@ func (s *service) Create(ctx context.Context, req *coursepartmodel.CreateRequest
 	return &coursepartmodel.CreateResponse{ID: partID, CourseID: courseID}, err
 }
 
+// CreateBatch creates course parts for an existing course in one transaction, numbered in the order of reqs
+// after the last part of the course. Number and CourseID of the requests are ignored, the requests
+// themselves are not modified. Either all parts are created or none.
+//
+// Returns a CreateResponse for every request, in the same order.
+// Returns an error if the course ID or any request is invalid (ErrInvalidArgument), the course is not found
+// or soft-deleted (ErrNotFound), or a database/internal error occurs.
+func (s *service) CreateBatch(ctx context.Context, courseID string, reqs []*coursepartmodel.CreateRequest) ([]*coursepartmodel.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "CreateBatch", tracing.ID(courseID))
+	defer span.End()
+
+	if _, err := uuid.Parse(courseID); err != nil {
+		return nil, fmt.Errorf("%w: invalid course ID: %w", ErrInvalidArgument, err)
+	}
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("%w: no course parts to create", ErrInvalidArgument)
+	}
+
+	parts := make([]*coursepartmodel.CoursePart, 0, len(reqs))
+	for i, req := range reqs {
+		if req == nil {
+			return nil, fmt.Errorf("%w: course part %d: empty request", ErrInvalidArgument, i)
+		}
+		// Validate a copy with the course and a placeholder number, the real number is only known in the transaction.
+		r := *req
+		r.CourseID = courseID
+		r.Number = i + 1
+		r.Sanitize()
+		if err := r.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: course part %d: %w", ErrInvalidArgument, i, err)
+		}
+		parts = append(parts, &coursepartmodel.CoursePart{
+			ID:               uuid.New().String(),
+			Name:             r.Name,
+			ShortDescription: r.ShortDescription,
+			CourseID:         courseID,
+			Published:        false,
+		})
+	}
+
+	err := s.partRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txPartRepo := s.partRepo.WithTx(tx)
+		txCourseRepo := s.courseRepo.WithTx(tx)
+
+		if _, err := txCourseRepo.Select(ctx, courseID, "id"); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: course %s does not exist: %w", ErrNotFound, courseID, err)
+			}
+			return fmt.Errorf("failed to retrieve course: %w", err)
+		}
+
+		last, err := txPartRepo.MaxNumber(ctx, courseID)
+		if err != nil {
+			return fmt.Errorf("failed to get last course part number: %w", err)
+		}
+		for i, part := range parts {
+			part.Number = last + i + 1
+		}
+
+		if err := txPartRepo.CreateBatch(ctx, parts...); err != nil {
+			return fmt.Errorf("failed to create course parts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resps := make([]*coursepartmodel.CreateResponse, 0, len(parts))
+	for _, part := range parts {
+		resps = append(resps, &coursepartmodel.CreateResponse{ID: part.ID, CourseID: part.CourseID})
+	}
+	return resps, nil
+}
+
+// NextPosition returns the number a new part of the course should get: one more than the highest number
+// among its published and unpublished parts, or 1 for a course without parts, as numbers start at 1.
+//
+// Returns an error if the course ID is invalid (ErrInvalidArgument), the course is not found
+// or soft-deleted (ErrNotFound), or a database/internal error occurs.
+func (s *service) NextPosition(ctx context.Context, courseID string) (int, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "NextPosition", tracing.ID(courseID))
+	defer span.End()
+
+	if _, err := uuid.Parse(courseID); err != nil {
+		return 0, fmt.Errorf("%w: invalid course ID: %w", ErrInvalidArgument, err)
+	}
+	if _, err := s.courseRepo.Select(ctx, courseID, "id"); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("%w: course %s does not exist: %w", ErrNotFound, courseID, err)
+		}
+		return 0, fmt.Errorf("failed to retrieve course: %w", err)
+	}
+	last, err := s.partRepo.MaxNumber(ctx, courseID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last course part number: %w", err)
+	}
+	return last + 1, nil
+}
+
+// Resequence renumbers published and unpublished parts of the course to 1..N, closing gaps and
+// resolving duplicate numbers left by deletes and reorders. The current order is kept, parts sharing
+// a number stay in creation order. It runs in one transaction and only parts whose number changes are updated.
+//
+// Returns an error if the course ID is invalid (ErrInvalidArgument), the course is not found
+// or soft-deleted (ErrNotFound), or a database/internal error occurs.
+func (s *service) Resequence(ctx context.Context, courseID string) error {
+	ctx, span := tracing.Start(ctx, "course_part", "Resequence", tracing.ID(courseID))
+	defer span.End()
+
+	if _, err := uuid.Parse(courseID); err != nil {
+		return fmt.Errorf("%w: invalid course ID: %w", ErrInvalidArgument, err)
+	}
+	return s.partRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txPartRepo := s.partRepo.WithTx(tx)
+		txCourseRepo := s.courseRepo.WithTx(tx)
+
+		if _, err := txCourseRepo.Select(ctx, courseID, "id"); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: course %s does not exist: %w", ErrNotFound, courseID, err)
+			}
+			return fmt.Errorf("failed to retrieve course: %w", err)
+		}
+
+		parts, err := txPartRepo.ListNumbered(ctx, courseID)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve course parts: %w", err)
+		}
+		for i, part := range parts {
+			if part.Number == i+1 {
+				continue
+			}
+			if _, err := txPartRepo.SetNumber(ctx, part.ID, i+1); err != nil {
+				return fmt.Errorf("failed to update course part number: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
 // Publish sets the 'published' field to true for a specific course part.
 // It will fail if the parent course is not published.
 //
 // Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 // the parent course is unpublished (http.StatusBadRequest), or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) Publish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "course_part", "Publish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -466,16 +737,23 @@ func (s *service) Publish(ctx context.Context, id string) error {
 //
 // Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
+// Unpublishing an already unpublished course part is a no-op.
 func (s *service) Unpublish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "course_part", "Unpublish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.partRepo.DB().Transaction(func(tx *gorm.DB) error {
-		ra, err := s.partRepo.WithTx(tx).SetPublished(ctx, id, false)
+		txPartRepo := s.partRepo.WithTx(tx)
+
+		res, err := txPartRepo.SetPublished(ctx, id, false)
 		if err != nil {
 			return fmt.Errorf("failed to upublish course part: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		if res.NotFound() {
+			return fmt.Errorf("%w: course part", ErrNotFound)
 		}
 		return nil
 	})
@@ -490,6 +768,10 @@ func (s *service) Unpublish(ctx context.Context, id string) error {
 // Returns an error if the request payload is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 // the new part number is not unique within the course (http.StatusBadRequest), or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) Update(ctx context.Context, req *coursepartmodel.UpdateRequest) (map[string]any, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "Update", tracing.ID(req.ID))
+	defer span.End()
+
+	req.Sanitize()
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -525,8 +807,8 @@ func (s *service) Update(ctx context.Context, req *coursepartmodel.UpdateRequest
 			}
 			updates["number"] = *req.Number
 		}
-		if len(req.Tags) > 0 {
-			updates["tags"] = req.Tags
+		if tags := req.TagsUpdate(); tags != nil && !slices.Equal(tags, part.Tags) {
+			updates["tags"] = tags
 		}
 
 		if len(updates) > 0 {
@@ -542,17 +824,22 @@ func (s *service) Update(ctx context.Context, req *coursepartmodel.UpdateRequest
 	return updates, nil
 }
 
-func (s *service) AddVideo(ctx context.Context)
-
 // Delete performs a soft-delete for a specific course part.
 // It also unpublishes the course part, meaning it must be manually published again after restoration.
+// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 //
-// Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
+// Returns an error if the course part ID is invalid or the reason is longer than 255 characters (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 // after restore.
-func (s *service) Delete(ctx context.Context, id string) error {
+func (s *service) Delete(ctx context.Context, id, reason string) error {
+	ctx, span := tracing.Start(ctx, "course_part", "Delete")
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
+	if utf8.RuneCountInString(reason) > 255 {
+		return fmt.Errorf("%w: reason must be at most 255 characters long", ErrInvalidArgument)
+	}
 	return s.partRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txPartRepo := s.partRepo.WithTx(tx)
 
@@ -569,7 +856,7 @@ func (s *service) Delete(ctx context.Context, id string) error {
 		}
 
 		// Perform soft-delete
-		if _, err := txPartRepo.Delete(ctx, id); err != nil {
+		if _, err := txPartRepo.Delete(ctx, id, reason); err != nil {
 			return fmt.Errorf("failed to delete course part: %w", err)
 		}
 		return nil
@@ -581,6 +868,9 @@ func (s *service) Delete(ctx context.Context, id string) error {
 // Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) DeletePermanent(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "course_part", "DeletePermanent", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -595,12 +885,59 @@ func (s *service) DeletePermanent(ctx context.Context, id string) error {
 	})
 }
 
+// PurgeDeletedBefore permanently deletes all course parts soft-deleted before the given time.
+//
+// Returns the number of purged course parts.
+// Returns an error if a database/internal error occurs.
+func (s *service) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "PurgeDeletedBefore")
+	defer span.End()
+
+	var purged int64
+	err := s.partRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txPartRepo := s.partRepo.WithTx(tx)
+
+		ids, err := txPartRepo.ListDeletedIDsBefore(ctx, before)
+		if err != nil {
+			return fmt.Errorf("failed to list deleted course parts: %w", err)
+		} else if len(ids) == 0 {
+			return nil
+		}
+		if purged, err = txPartRepo.DeletePermanentByIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete course parts: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// CountDeletedBefore counts course parts soft-deleted before cutoff, that is the course parts
+// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+//
+// Returns an error if a database/internal error occurs.
+func (s *service) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "course_part", "CountDeletedBefore")
+	defer span.End()
+
+	count, err := s.partRepo.CountDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted course parts: %w", err)
+	}
+	return count, nil
+}
+
 // Restore restores a soft-deleted course part record.
 // The course part will remain unpublished and must be manually published again.
 //
 // Returns an error if the course part ID is invalid (http.StatusBadRequest), the course part is not found (http.StatusNotFound),
 // or a database/internal error occurs (http.StatusInternalServerError).
 func (s *service) Restore(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "course_part", "Restore", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
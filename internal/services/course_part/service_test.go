@@ -20,14 +20,20 @@ package coursepart
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	"log/slog"
 	"reflect"
 	"testing"
 
 	"github.com/google/uuid"
+	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
 	"github.com/mikhail5545/product-service-go/internal/models/course"
 	coursepart "github.com/mikhail5545/product-service-go/internal/models/course_part"
+	"github.com/mikhail5545/product-service-go/internal/models/product"
 	coursemock "github.com/mikhail5545/product-service-go/internal/test/database/course_mock"
 	coursepartmock "github.com/mikhail5545/product-service-go/internal/test/database/course_part_mock"
+	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
 	"github.com/stretchr/testify/assert"
 	gomock "go.uber.org/mock/gomock"
 	"gorm.io/driver/sqlite"
@@ -40,8 +46,9 @@ func TestService_Get(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -56,13 +63,46 @@ func TestService_Get(t *testing.T) {
 		mockPartRepo.EXPECT().Get(gomock.Any(), partID).Return(mockPart, nil)
 
 		// Act
-		part, err := testService.Get(context.Background(), partID)
+		details, err := testService.Get(context.Background(), partID)
 
 		// Assert
 		assert.NoError(t, err)
-		if !reflect.DeepEqual(part, mockPart) {
-			t.Errorf("Get() got = %v, want %v", part, mockPart)
+		if !reflect.DeepEqual(details.CoursePart, mockPart) {
+			t.Errorf("Get() got = %v, want %v", details.CoursePart, mockPart)
 		}
+		assert.Empty(t, details.ProductID)
+		assert.Zero(t, details.Price)
+	})
+
+	t.Run("sellable part", func(t *testing.T) {
+		// Arrange
+		sellablePart := &coursepart.CoursePart{ID: partID, Name: "Course part name", Sellable: true}
+		productID := uuid.New().String()
+		mockPartRepo.EXPECT().Get(gomock.Any(), partID).Return(sellablePart, nil)
+		mockProductRepo.EXPECT().GetByDetailsID(gomock.Any(), partID).Return(&product.Product{ID: productID, Price: 49.99, DetailsID: partID}, nil)
+
+		// Act
+		details, err := testService.Get(context.Background(), partID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, productID, details.ProductID)
+		assert.Equal(t, float32(49.99), details.Price)
+		assert.Equal(t, sellablePart, details.CoursePart)
+	})
+
+	t.Run("sellable part without product", func(t *testing.T) {
+		// Arrange
+		sellablePart := &coursepart.CoursePart{ID: partID, Name: "Course part name", Sellable: true}
+		mockPartRepo.EXPECT().Get(gomock.Any(), partID).Return(sellablePart, nil)
+		mockProductRepo.EXPECT().GetByDetailsID(gomock.Any(), partID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		_, err := testService.Get(context.Background(), partID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrProductNotFound)
 	})
 
 	t.Run("invalid UUID", func(t *testing.T) {
@@ -108,8 +148,9 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -176,8 +217,9 @@ func TestService_GetWithUnpublished(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -244,8 +286,9 @@ func TestService_GetReduced(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -312,8 +355,9 @@ func TestService_GetWithDeletedReduced(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -380,8 +424,9 @@ func TestService_GetWithUnpublishedReduced(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -448,8 +493,9 @@ func TestService_List(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	part1ID := "part-1-ID"
 	part2ID := "part-2-ID"
@@ -485,6 +531,37 @@ func TestService_List(t *testing.T) {
 		assert.Equal(t, len(mockParts), len(parts))
 	})
 
+	t.Run("sellable parts", func(t *testing.T) {
+		// Arrange
+		limit, offset := 3, 0
+		pricedID, missingID := "part-priced-ID", "part-missing-ID"
+		productID := uuid.New().String()
+		parts := []coursepart.CoursePart{
+			{ID: pricedID, CourseID: courseID, Sellable: true},
+			{ID: part1ID, CourseID: courseID},
+			{ID: missingID, CourseID: courseID, Sellable: true},
+		}
+		mockPartRepo.EXPECT().List(gomock.Any(), courseID, limit, offset).Return(parts, nil)
+		mockPartRepo.EXPECT().Count(gomock.Any(), courseID).Return(int64(3), nil)
+		mockProductRepo.EXPECT().SelectByDetailsIDs(gomock.Any(), []string{pricedID, missingID}, "id", "price", "details_id").
+			Return([]product.Product{{ID: productID, Price: 19.5, DetailsID: pricedID}}, nil)
+
+		// Act
+		details, total, err := testService.List(context.Background(), courseID, limit, offset)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		if assert.Len(t, details, 2) {
+			assert.Equal(t, pricedID, details[0].ID)
+			assert.Equal(t, productID, details[0].ProductID)
+			assert.Equal(t, float32(19.5), details[0].Price)
+			assert.Equal(t, part1ID, details[1].ID)
+			assert.Empty(t, details[1].ProductID)
+			assert.Zero(t, details[1].Price)
+		}
+	})
+
 	t.Run("invalid UUID", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-UUID"
@@ -512,14 +589,80 @@ func TestService_List(t *testing.T) {
 	})
 }
 
+func TestService_List_ProductsFromDB(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	if err := db.AutoMigrate(&product.Product{}); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+	productRepo := productrepo.New(db)
+
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+	testService := New(mockPartRepo, nil, productRepo, slog.New(slog.DiscardHandler))
+
+	courseID := uuid.New().String()
+	partA, partB, partC := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	parts := []coursepart.CoursePart{
+		{ID: partA, CourseID: courseID, Number: 1, Sellable: true},
+		{ID: partB, CourseID: courseID, Number: 2, Sellable: true},
+		{ID: partC, CourseID: courseID, Number: 3},
+	}
+
+	t.Run("no sellable part has a product", func(t *testing.T) {
+		// Arrange
+		mockPartRepo.EXPECT().List(gomock.Any(), courseID, 10, 0).Return(parts, nil)
+		mockPartRepo.EXPECT().Count(gomock.Any(), courseID).Return(int64(3), nil)
+
+		// Act
+		details, total, err := testService.List(context.Background(), courseID, 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		if assert.Len(t, details, 1) {
+			assert.Equal(t, partC, details[0].ID)
+		}
+	})
+
+	t.Run("every sellable part priced", func(t *testing.T) {
+		// Arrange
+		productA := &product.Product{ID: uuid.New().String(), InStock: true, Price: 10, DetailsID: partA, DetailsType: "course_part"}
+		productB := &product.Product{ID: uuid.New().String(), InStock: true, Price: 20, DetailsID: partB, DetailsType: "course_part"}
+		if err := productRepo.CreateBatch(context.Background(), productA, productB); err != nil {
+			t.Fatalf("failed to seed products: %v", err)
+		}
+		mockPartRepo.EXPECT().List(gomock.Any(), courseID, 10, 0).Return(parts, nil)
+		mockPartRepo.EXPECT().Count(gomock.Any(), courseID).Return(int64(3), nil)
+
+		// Act
+		details, _, err := testService.List(context.Background(), courseID, 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, details, 3) {
+			assert.Equal(t, productA.ID, details[0].ProductID)
+			assert.Equal(t, float32(10), details[0].Price)
+			assert.Equal(t, productB.ID, details[1].ProductID)
+			assert.Equal(t, float32(20), details[1].Price)
+			assert.Empty(t, details[2].ProductID)
+		}
+	})
+}
+
 func TestService_ListDeleted(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	part1ID := "part-1-ID"
 	part2ID := "part-2-ID"
@@ -588,8 +731,9 @@ func TestService_ListUnpublished(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	part1ID := "part-1-ID"
 	part2ID := "part-2-ID"
@@ -658,8 +802,9 @@ func TestService_Create(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -719,12 +864,7 @@ func TestService_Create(t *testing.T) {
 
 	t.Run("invalid request payload", func(t *testing.T) {
 		// Arrange
-		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
-		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
-
-		mockPartRepo.EXPECT().DB().Return(db).AnyTimes()
-		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
-		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		// Validation happens before the transaction is opened, so no repository calls are expected.
 
 		// Act
 		_, err := testService.Create(context.Background(), &coursepart.CreateRequest{
@@ -758,6 +898,29 @@ func TestService_Create(t *testing.T) {
 		assert.ErrorIs(t, err, ErrNotFound)
 	})
 
+	t.Run("soft-deleted course", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		// Soft-deleted courses are excluded by the default scope of Select, so they look missing.
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(nil, gorm.ErrRecordNotFound)
+		mockTxPartRepo.EXPECT().CountQuery(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockTxPartRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		_, err := testService.Create(context.Background(), &createReq)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Contains(t, err.Error(), courseID)
+	})
+
 	t.Run("part with this number already exists", func(t *testing.T) {
 		// Arrange
 		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
@@ -800,14 +963,352 @@ func TestService_Create(t *testing.T) {
 	})
 }
 
+func TestService_CreateBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
+
+	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
+
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	newReqs := func() []*coursepart.CreateRequest {
+		return []*coursepart.CreateRequest{
+			{Name: "Introduction", ShortDescription: "Course part short description"},
+			{Name: "Basics", ShortDescription: "Course part short description", Number: 7},
+			{Name: "Advanced", ShortDescription: "Course part short description"},
+		}
+	}
+
+	t.Run("parts numbered in order after the last part", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		mockTxPartRepo.EXPECT().MaxNumber(gomock.Any(), courseID).Return(2, nil)
+		var createdParts []*coursepart.CoursePart
+		mockTxPartRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, parts ...*coursepart.CoursePart) {
+				createdParts = parts
+			}).Return(nil)
+		reqs := newReqs()
+
+		// Act
+		resps, err := testService.CreateBatch(context.Background(), courseID, reqs)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, createdParts, 3) && assert.Len(t, resps, 3) {
+			for i, part := range createdParts {
+				assert.Equal(t, reqs[i].Name, part.Name)
+				assert.Equal(t, 3+i, part.Number)
+				assert.Equal(t, courseID, part.CourseID)
+				assert.False(t, part.Published)
+				assert.Equal(t, part.ID, resps[i].ID)
+				assert.Equal(t, courseID, resps[i].CourseID)
+			}
+		}
+		assert.Equal(t, newReqs(), reqs, "requests must not be modified")
+	})
+
+	t.Run("course not found", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(nil, gorm.ErrRecordNotFound)
+		mockTxPartRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		resps, err := testService.CreateBatch(context.Background(), courseID, newReqs())
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Contains(t, err.Error(), courseID)
+		assert.Nil(t, resps)
+	})
+
+	t.Run("one invalid part rejects the batch", func(t *testing.T) {
+		// Arrange
+		// Validation happens before the transaction is opened, so no repository calls are expected.
+		reqs := newReqs()
+		reqs[1].Name = "1name"
+
+		// Act
+		resps, err := testService.CreateBatch(context.Background(), courseID, reqs)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.ErrorContains(t, err, "course part 1")
+		assert.Nil(t, resps)
+	})
+
+	t.Run("invalid course ID or empty batch", func(t *testing.T) {
+		for name, args := range map[string]struct {
+			courseID string
+			reqs     []*coursepart.CreateRequest
+		}{
+			"invalid course ID": {"invalid-UUID", newReqs()},
+			"no requests":       {courseID, nil},
+			"nil request":       {courseID, []*coursepart.CreateRequest{nil}},
+		} {
+			// Act
+			resps, err := testService.CreateBatch(context.Background(), args.courseID, args.reqs)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument, name)
+			assert.Nil(t, resps, name)
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		mockTxPartRepo.EXPECT().MaxNumber(gomock.Any(), courseID).Return(0, nil)
+		dbErr := errors.New("database error")
+		mockTxPartRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(dbErr)
+
+		// Act
+		resps, err := testService.CreateBatch(context.Background(), courseID, newReqs())
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, resps)
+	})
+}
+
+func TestService_NextPosition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
+
+	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
+
+	t.Run("empty course", func(t *testing.T) {
+		// Arrange
+		mockCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		mockPartRepo.EXPECT().MaxNumber(gomock.Any(), courseID).Return(0, nil)
+
+		// Act
+		next, err := testService.NextPosition(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, next)
+	})
+
+	t.Run("course with gaps in numbers", func(t *testing.T) {
+		// Arrange
+		// Parts are numbered 1, 2 and 7, the gaps are not filled.
+		mockCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		mockPartRepo.EXPECT().MaxNumber(gomock.Any(), courseID).Return(7, nil)
+
+		// Act
+		next, err := testService.NextPosition(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 8, next)
+	})
+
+	t.Run("course not found", func(t *testing.T) {
+		// Arrange
+		mockCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(nil, gorm.ErrRecordNotFound)
+		mockPartRepo.EXPECT().MaxNumber(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		_, err := testService.NextPosition(context.Background(), courseID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid course ID", func(t *testing.T) {
+		// Act
+		_, err := testService.NextPosition(context.Background(), "invalid-UUID")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("database error")
+		mockCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		mockPartRepo.EXPECT().MaxNumber(gomock.Any(), courseID).Return(0, dbErr)
+
+		// Act
+		_, err := testService.NextPosition(context.Background(), courseID)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestService_Resequence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
+	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
+
+	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
+
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	t.Run("sparse and duplicate numbers", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		// Already in the order ListNumbered returns them in.
+		parts := []coursepart.CoursePart{
+			{ID: "first", Number: 1},
+			{ID: "second", Number: 4},
+			{ID: "third", Number: 4},
+			{ID: "fourth", Number: 9},
+		}
+		mockTxPartRepo.EXPECT().ListNumbered(gomock.Any(), courseID).Return(parts, nil)
+		numbers := map[string]int{}
+		for _, p := range parts {
+			numbers[p.ID] = p.Number
+		}
+		mockTxPartRepo.EXPECT().SetNumber(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, id string, number int) (int64, error) {
+				numbers[id] = number
+				return 1, nil
+			}).Times(3)
+
+		// Act
+		err := testService.Resequence(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"first": 1, "second": 2, "third": 3, "fourth": 4}, numbers)
+	})
+
+	t.Run("already contiguous", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		mockTxPartRepo.EXPECT().ListNumbered(gomock.Any(), courseID).Return([]coursepart.CoursePart{{ID: "first", Number: 1}, {ID: "second", Number: 2}}, nil)
+		mockTxPartRepo.EXPECT().SetNumber(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Resequence(context.Background(), courseID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("course not found", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(nil, gorm.ErrRecordNotFound)
+		mockTxPartRepo.EXPECT().ListNumbered(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Resequence(context.Background(), courseID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid course ID", func(t *testing.T) {
+		// Act
+		err := testService.Resequence(context.Background(), "invalid-UUID")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+		mockTxCourseRepo := coursemock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db)
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		mockTxCourseRepo.EXPECT().Select(gomock.Any(), courseID, "id").Return(&course.Course{ID: courseID}, nil)
+		mockTxPartRepo.EXPECT().ListNumbered(gomock.Any(), courseID).Return([]coursepart.CoursePart{{ID: "first", Number: 3}}, nil)
+		dbErr := errors.New("database error")
+		mockTxPartRepo.EXPECT().SetNumber(gomock.Any(), "first", 1).Return(int64(0), dbErr)
+
+		// Act
+		err := testService.Resequence(context.Background(), courseID)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
 func TestService_Publish(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 	partID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
@@ -847,7 +1348,7 @@ func TestService_Publish(t *testing.T) {
 
 		mockTxPartRepo.EXPECT().GetWithUnpublished(gomock.Any(), partID).Return(mockPart, nil)
 		mockTxCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(mockCourse, nil)
-		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, true).Return(int64(1), nil)
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, true).Return(database.SetResult{Affected: 1}, nil)
 
 		// Act
 		err := testService.Publish(context.Background(), partID)
@@ -899,7 +1400,7 @@ func TestService_Publish(t *testing.T) {
 		mockTxPartRepo.EXPECT().GetWithUnpublished(gomock.Any(), partID).Return(mockPart, nil)
 		mockTxCourseRepo.EXPECT().GetReduced(gomock.Any(), courseID).Return(mockCourse, nil)
 		dbErr := errors.New("database error")
-		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, true).Return(int64(0), dbErr)
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, true).Return(database.SetResult{}, dbErr)
 
 		// Act
 		err := testService.Publish(context.Background(), partID)
@@ -936,8 +1437,9 @@ func TestService_Unpublished(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
 
@@ -958,7 +1460,23 @@ func TestService_Unpublished(t *testing.T) {
 		mockPartRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
-		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(int64(1), nil)
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(database.SetResult{Affected: 1}, nil)
+
+		// Act
+		err := testService.Unpublish(context.Background(), partID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already unpublished", func(t *testing.T) {
+		// Arrange
+		mockTxPartRepo := coursepartmock.NewMockRepository(ctrl)
+
+		mockPartRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
+
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(database.SetResult{AlreadyInState: true}, nil)
 
 		// Act
 		err := testService.Unpublish(context.Background(), partID)
@@ -986,7 +1504,7 @@ func TestService_Unpublished(t *testing.T) {
 		mockPartRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
-		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(int64(0), nil)
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(database.SetResult{}, nil)
 
 		// Act
 		err := testService.Unpublish(context.Background(), partID)
@@ -1004,7 +1522,7 @@ func TestService_Unpublished(t *testing.T) {
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
 		dbErr := errors.New("database error")
-		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(int64(0), dbErr)
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(database.SetResult{}, dbErr)
 
 		// Act
 		err := testService.Unpublish(context.Background(), partID)
@@ -1020,8 +1538,9 @@ func TestService_Update(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	courseID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 	partID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
@@ -1206,8 +1725,9 @@ func TestService_Delete(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
 
@@ -1229,11 +1749,11 @@ func TestService_Delete(t *testing.T) {
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
 		mockTxPartRepo.EXPECT().GetWithUnpublished(gomock.Any(), partID).Return(&coursepart.CoursePart{}, nil)
-		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(int64(1), nil)
-		mockTxPartRepo.EXPECT().Delete(gomock.Any(), partID).Return(int64(1), nil)
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxPartRepo.EXPECT().Delete(gomock.Any(), partID, "").Return(int64(1), nil)
 
 		// Act
-		err := testService.Delete(context.Background(), partID)
+		err := testService.Delete(context.Background(), partID, "")
 
 		// Assert
 		assert.NoError(t, err)
@@ -1244,7 +1764,7 @@ func TestService_Delete(t *testing.T) {
 		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.Delete(context.Background(), invalidID)
+		err := testService.Delete(context.Background(), invalidID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1261,7 +1781,7 @@ func TestService_Delete(t *testing.T) {
 		mockTxPartRepo.EXPECT().GetWithUnpublished(gomock.Any(), partID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
-		err := testService.Delete(context.Background(), partID)
+		err := testService.Delete(context.Background(), partID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1276,12 +1796,12 @@ func TestService_Delete(t *testing.T) {
 		mockPartRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPartRepo)
 
 		mockTxPartRepo.EXPECT().GetWithUnpublished(gomock.Any(), partID).Return(&coursepart.CoursePart{}, nil)
-		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(int64(1), nil)
+		mockTxPartRepo.EXPECT().SetPublished(gomock.Any(), partID, false).Return(database.SetResult{Affected: 1}, nil)
 		dbErr := errors.New("database error")
-		mockTxPartRepo.EXPECT().Delete(gomock.Any(), partID).Return(int64(0), dbErr)
+		mockTxPartRepo.EXPECT().Delete(gomock.Any(), partID, "").Return(int64(0), dbErr)
 
 		// Act
-		err := testService.Delete(context.Background(), partID)
+		err := testService.Delete(context.Background(), partID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1294,8 +1814,9 @@ func TestService_DeletePermanent(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
 
@@ -1378,8 +1899,9 @@ func TestService_Restore(t *testing.T) {
 
 	mockPartRepo := coursepartmock.NewMockRepository(ctrl)
 	mockCourseRepo := coursemock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPartRepo, mockCourseRepo)
+	testService := New(mockPartRepo, mockCourseRepo, mockProductRepo, slog.New(slog.DiscardHandler))
 
 	partID := "a1b2c3d4-e5f6-7890-1234-567890abcdef"
 
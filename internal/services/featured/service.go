@@ -0,0 +1,288 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package featured provides service-layer business logic for the featured products list.
+package featured
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	featuredrepo "github.com/mikhail5545/product-service-go/internal/database/featured"
+	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
+	featuredmodel "github.com/mikhail5545/product-service-go/internal/models/featured"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	productservice "github.com/mikhail5545/product-service-go/internal/services/product"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -destination=../../test/services/featured_mock/service_mock.go -package=featured_mock . Service
+
+// Service provides service-layer business logic for the hand-picked, ordered list of
+// featured products shown on the homepage.
+type Service interface {
+	// List resolves the featured list to products with the records they sell, in the curated order.
+	// Only published and not soft-deleted products are returned, entries whose products or details
+	// records became unpublished or soft-deleted are skipped. Use ListEntries to see them.
+	//
+	// Returns an error if a database/internal error occures.
+	List(ctx context.Context) ([]productmodel.ProductWithDetails, error)
+	// ListEntries retrieves every featured entry in the curated order together with the current state
+	// of its product, including entries that are skipped by List.
+	//
+	// Returns an error if a database/internal error occures.
+	ListEntries(ctx context.Context) ([]featuredmodel.Entry, error)
+	// Add appends a product (including unpublished, but not soft-deleted) to the end of the featured list.
+	//
+	// Returns an error if the request payload is invalid (ErrInvalidArgument), the product is not found (ErrNotFound),
+	// the product is already featured (ErrAlreadyFeatured), or a database/internal error occures.
+	Add(ctx context.Context, req *featuredmodel.AddRequest) (*featuredmodel.Featured, error)
+	// Remove removes a product from the featured list.
+	//
+	// Returns an error if the ID is invalid (ErrInvalidArgument), the product is not featured (ErrNotFound),
+	// or a database/internal error occures.
+	Remove(ctx context.Context, productID string) error
+	// Reorder rearranges the featured list in a single transaction. req.ProductIDs must contain every
+	// featured product ID exactly once, in the new order.
+	//
+	// Returns an error if the request payload is invalid or is not a permutation of the current list (ErrInvalidArgument),
+	// or a database/internal error occures.
+	Reorder(ctx context.Context, req *featuredmodel.ReorderRequest) error
+}
+
+// service provides service-layer business logic for the featured list.
+// It holds [featuredrepo.Repository] and [productrepo.Repository] instances
+// to perform database operations, and [productservice.Service] to resolve products to their details.
+type service struct {
+	Repo           featuredrepo.Repository
+	ProductRepo    productrepo.Repository
+	ProductService productservice.Service
+}
+
+// New creates a new service instance with provided featured and product repositories and product service.
+func New(fr featuredrepo.Repository, pr productrepo.Repository, ps productservice.Service) Service {
+	return &service{Repo: fr, ProductRepo: pr, ProductService: ps}
+}
+
+// List resolves the featured list to products with the records they sell, in the curated order.
+// Only published and not soft-deleted products are returned, entries whose products or details
+// records became unpublished or soft-deleted are skipped. Use ListEntries to see them.
+//
+// Returns an error if a database/internal error occures.
+func (s *service) List(ctx context.Context) ([]productmodel.ProductWithDetails, error) {
+	ctx, span := tracing.Start(ctx, "featured", "List")
+	defer span.End()
+
+	entries, err := s.Repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve featured list: %w", err)
+	}
+	if len(entries) == 0 {
+		return []productmodel.ProductWithDetails{}, nil
+	}
+
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ProductID
+	}
+	// GetDetailsBatch keeps the order of ids and leaves out unpublished and soft-deleted products.
+	details, err := s.ProductService.GetDetailsBatch(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve products: %w", err)
+	}
+	resolved := make([]productmodel.ProductWithDetails, 0, len(details))
+	for _, d := range details {
+		if detailsInStock(d) {
+			resolved = append(resolved, d)
+		}
+	}
+	return resolved, nil
+}
+
+// detailsInStock reports whether the details record of d is set and published.
+// It is not set for orphaned products, whose details record is missing or soft-deleted.
+func detailsInStock(d productmodel.ProductWithDetails) bool {
+	switch {
+	case d.Course != nil:
+		return d.Course.InStock
+	case d.Seminar != nil:
+		return d.Seminar.InStock
+	case d.TrainingSession != nil:
+		return d.TrainingSession.InStock
+	case d.PhysicalGood != nil:
+		return d.PhysicalGood.InStock
+	default:
+		return false
+	}
+}
+
+// ListEntries retrieves every featured entry in the curated order together with the current state
+// of its product, including entries that are skipped by List.
+//
+// Returns an error if a database/internal error occures.
+func (s *service) ListEntries(ctx context.Context) ([]featuredmodel.Entry, error) {
+	ctx, span := tracing.Start(ctx, "featured", "ListEntries")
+	defer span.End()
+
+	entries, err := s.Repo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve featured list: %w", err)
+	}
+	if len(entries) == 0 {
+		return []featuredmodel.Entry{}, nil
+	}
+
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ProductID
+	}
+	products, err := s.ProductRepo.SelectWithDeletedByIDs(ctx, ids, "id", "price", "in_stock", "details_id", "details_type", "deleted_at")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	byID := make(map[string]*productmodel.Product, len(products))
+	for i := range products {
+		byID[products[i].ID] = &products[i]
+	}
+
+	result := make([]featuredmodel.Entry, len(entries))
+	for i, e := range entries {
+		result[i] = featuredmodel.Entry{Featured: e, State: featuredmodel.StateMissing}
+		if p, ok := byID[e.ProductID]; ok {
+			p.State = p.CurrentState()
+			result[i].Product = p
+			result[i].State = p.State
+		}
+	}
+	return result, nil
+}
+
+// Add appends a product (including unpublished, but not soft-deleted) to the end of the featured list.
+//
+// Returns an error if the request payload is invalid (ErrInvalidArgument), the product is not found (ErrNotFound),
+// the product is already featured (ErrAlreadyFeatured), or a database/internal error occures.
+func (s *service) Add(ctx context.Context, req *featuredmodel.AddRequest) (*featuredmodel.Featured, error) {
+	ctx, span := tracing.Start(ctx, "featured", "Add", tracing.ID(req.ProductID))
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+
+	var entry *featuredmodel.Featured
+	err := s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		txRepo := s.Repo.WithTx(tx)
+
+		if _, err := s.ProductRepo.WithTx(tx).GetWithUnpublished(ctx, req.ProductID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %w", ErrNotFound, err)
+			}
+			return fmt.Errorf("failed to retrieve product: %w", err)
+		}
+
+		entries, err := txRepo.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve featured list: %w", err)
+		}
+		for _, e := range entries {
+			if e.ProductID == req.ProductID {
+				return ErrAlreadyFeatured
+			}
+		}
+
+		position, err := txRepo.MaxPosition(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve featured list position: %w", err)
+		}
+		entry = &featuredmodel.Featured{ProductID: req.ProductID, Position: position + 1}
+		if err := txRepo.Create(ctx, entry); err != nil {
+			return fmt.Errorf("failed to create featured entry: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// Remove removes a product from the featured list.
+//
+// Returns an error if the ID is invalid (ErrInvalidArgument), the product is not featured (ErrNotFound),
+// or a database/internal error occures.
+func (s *service) Remove(ctx context.Context, productID string) error {
+	ctx, span := tracing.Start(ctx, "featured", "Remove", tracing.ID(productID))
+	defer span.End()
+
+	if _, err := uuid.Parse(productID); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	affected, err := s.Repo.Delete(ctx, productID)
+	if err != nil {
+		return fmt.Errorf("failed to remove featured entry: %w", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Reorder rearranges the featured list in a single transaction. req.ProductIDs must contain every
+// featured product ID exactly once, in the new order.
+//
+// Returns an error if the request payload is invalid or is not a permutation of the current list (ErrInvalidArgument),
+// or a database/internal error occures.
+func (s *service) Reorder(ctx context.Context, req *featuredmodel.ReorderRequest) error {
+	ctx, span := tracing.Start(ctx, "featured", "Reorder")
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+
+	return s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		txRepo := s.Repo.WithTx(tx)
+
+		entries, err := txRepo.List(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve featured list: %w", err)
+		}
+		if len(entries) != len(req.ProductIDs) {
+			return fmt.Errorf("%w: expected %d product ids, got %d", ErrInvalidArgument, len(entries), len(req.ProductIDs))
+		}
+		current := make(map[string]bool, len(entries))
+		for _, e := range entries {
+			current[e.ProductID] = true
+		}
+		for _, id := range req.ProductIDs {
+			if !current[id] {
+				return fmt.Errorf("%w: product %s is not featured or is listed twice", ErrInvalidArgument, id)
+			}
+			delete(current, id)
+		}
+
+		for position, id := range req.ProductIDs {
+			if _, err := txRepo.SetPosition(ctx, id, position); err != nil {
+				return fmt.Errorf("failed to update featured position: %w", err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,445 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package featured
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
+	featuredmodel "github.com/mikhail5545/product-service-go/internal/models/featured"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/database/featured_mock"
+	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
+	productservicemock "github.com/mikhail5545/product-service-go/internal/test/services/product_mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestService_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := featuredmock.NewMockRepository(ctrl)
+	mockProductService := productservicemock.NewMockService(ctrl)
+
+	testService := New(mockRepo, productmock.NewMockRepository(ctrl), mockProductService)
+
+	first, second, third := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	entries := []featuredmodel.Featured{
+		{ProductID: first, Position: 0},
+		{ProductID: second, Position: 1},
+		{ProductID: third, Position: 2},
+	}
+	withCourse := func(id string, inStock bool) productmodel.ProductWithDetails {
+		return productmodel.ProductWithDetails{
+			Product: &productmodel.Product{ID: id, InStock: true, DetailsType: "course"},
+			Course:  &coursemodel.Course{ID: uuid.New().String(), Name: "course " + id, InStock: inStock},
+		}
+	}
+
+	t.Run("keeps curated order with details", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+		mockProductService.EXPECT().GetDetailsBatch(gomock.Any(), []string{first, second, third}).Return([]productmodel.ProductWithDetails{
+			withCourse(first, true), withCourse(second, true), withCourse(third, true),
+		}, nil)
+
+		// Act
+		products, err := testService.List(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, products, 3) {
+			assert.Equal(t, first, products[0].Product.ID)
+			assert.Equal(t, second, products[1].Product.ID)
+			assert.Equal(t, third, products[2].Product.ID)
+			assert.Equal(t, "course "+first, products[0].Course.Name)
+		}
+	})
+
+	t.Run("skips deleted or unpublished product", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+		// second was soft-deleted after being featured, so it is not resolved.
+		mockProductService.EXPECT().GetDetailsBatch(gomock.Any(), []string{first, second, third}).Return([]productmodel.ProductWithDetails{
+			withCourse(first, true), withCourse(third, true),
+		}, nil)
+
+		// Act
+		products, err := testService.List(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, products, 2) {
+			assert.Equal(t, first, products[0].Product.ID)
+			assert.Equal(t, third, products[1].Product.ID)
+		}
+	})
+
+	t.Run("skips unpublished or missing details", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+		// first's course was unpublished, third is orphaned.
+		mockProductService.EXPECT().GetDetailsBatch(gomock.Any(), []string{first, second, third}).Return([]productmodel.ProductWithDetails{
+			withCourse(first, false), withCourse(second, true), {Product: &productmodel.Product{ID: third, InStock: true}},
+		}, nil)
+
+		// Act
+		products, err := testService.List(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, products, 1) {
+			assert.Equal(t, second, products[0].Product.ID)
+		}
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return([]featuredmodel.Featured{}, nil)
+
+		// Act
+		products, err := testService.List(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, products)
+		assert.Empty(t, products)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return(nil, errors.New("database error"))
+
+		// Act
+		products, err := testService.List(context.Background())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, products)
+	})
+}
+
+func TestService_ListEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := featuredmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockProductService := productservicemock.NewMockService(ctrl)
+
+	testService := New(mockRepo, mockProductRepo, mockProductService)
+
+	live, unpublished, deleted, purged := uuid.New().String(), uuid.New().String(), uuid.New().String(), uuid.New().String()
+	entries := []featuredmodel.Featured{
+		{ProductID: unpublished, Position: 0},
+		{ProductID: live, Position: 1},
+		{ProductID: purged, Position: 2},
+		{ProductID: deleted, Position: 3},
+	}
+
+	t.Run("reports state of every entry", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+		mockProductRepo.EXPECT().SelectWithDeletedByIDs(gomock.Any(), []string{unpublished, live, purged, deleted}, gomock.Any()).
+			Return([]productmodel.Product{
+				{ID: live, InStock: true},
+				{ID: deleted, InStock: true, DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}},
+				{ID: unpublished, InStock: false},
+			}, nil)
+
+		// Act
+		result, err := testService.ListEntries(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, result, 4) {
+			assert.Equal(t, unpublished, result[0].ProductID)
+			assert.Equal(t, productmodel.StateUnpublished, result[0].State)
+			assert.Equal(t, live, result[1].ProductID)
+			assert.Equal(t, productmodel.StateLive, result[1].State)
+			assert.Equal(t, purged, result[2].ProductID)
+			assert.Equal(t, featuredmodel.StateMissing, result[2].State)
+			assert.Nil(t, result[2].Product)
+			assert.Equal(t, deleted, result[3].ProductID)
+			assert.Equal(t, productmodel.StateDeleted, result[3].State)
+		}
+	})
+
+	t.Run("public list keeps only live entries", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+		// GetDetailsBatch filters out unpublished and soft-deleted products.
+		mockProductService.EXPECT().GetDetailsBatch(gomock.Any(), []string{unpublished, live, purged, deleted}).
+			Return([]productmodel.ProductWithDetails{{
+				Product: &productmodel.Product{ID: live, InStock: true},
+				Course:  &coursemodel.Course{InStock: true},
+			}}, nil)
+
+		// Act
+		products, err := testService.List(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, products, 1) {
+			assert.Equal(t, live, products[0].Product.ID)
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+		mockProductRepo.EXPECT().SelectWithDeletedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+
+		// Act
+		result, err := testService.ListEntries(context.Background())
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestService_Add(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := featuredmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockRepo, mockProductRepo, productservicemock.NewMockService(ctrl))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	productID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxRepo := featuredmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockRepo.EXPECT().DB().Return(db)
+		mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).Return(&productmodel.Product{ID: productID}, nil)
+		mockTxRepo.EXPECT().List(gomock.Any()).Return([]featuredmodel.Featured{{ProductID: uuid.New().String(), Position: 0}}, nil)
+		mockTxRepo.EXPECT().MaxPosition(gomock.Any()).Return(0, nil)
+		mockTxRepo.EXPECT().Create(gomock.Any(), &featuredmodel.Featured{ProductID: productID, Position: 1}).Return(nil)
+
+		// Act
+		entry, err := testService.Add(context.Background(), &featuredmodel.AddRequest{ProductID: productID})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, entry.Position)
+	})
+
+	t.Run("already featured", func(t *testing.T) {
+		// Arrange
+		mockTxRepo := featuredmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockRepo.EXPECT().DB().Return(db)
+		mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).Return(&productmodel.Product{ID: productID}, nil)
+		mockTxRepo.EXPECT().List(gomock.Any()).Return([]featuredmodel.Featured{{ProductID: productID, Position: 0}}, nil)
+
+		// Act
+		entry, err := testService.Add(context.Background(), &featuredmodel.AddRequest{ProductID: productID})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrAlreadyFeatured)
+		assert.Nil(t, entry)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxRepo := featuredmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockRepo.EXPECT().DB().Return(db)
+		mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		entry, err := testService.Add(context.Background(), &featuredmodel.AddRequest{ProductID: productID})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, entry)
+	})
+
+	t.Run("invalid product id", func(t *testing.T) {
+		// Act
+		entry, err := testService.Add(context.Background(), &featuredmodel.AddRequest{ProductID: "invalid-uuid"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, entry)
+	})
+}
+
+func TestService_Remove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := featuredmock.NewMockRepository(ctrl)
+	testService := New(mockRepo, productmock.NewMockRepository(ctrl), productservicemock.NewMockService(ctrl))
+
+	productID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().Delete(gomock.Any(), productID).Return(int64(1), nil)
+
+		// Act
+		err := testService.Remove(context.Background(), productID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("not featured", func(t *testing.T) {
+		// Arrange
+		mockRepo.EXPECT().Delete(gomock.Any(), productID).Return(int64(0), nil)
+
+		// Act
+		err := testService.Remove(context.Background(), productID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		// Act
+		err := testService.Remove(context.Background(), "invalid-uuid")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_Reorder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := featuredmock.NewMockRepository(ctrl)
+	testService := New(mockRepo, productmock.NewMockRepository(ctrl), productservicemock.NewMockService(ctrl))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	first, second, third := uuid.New().String(), uuid.New().String(), uuid.New().String()
+	entries := []featuredmodel.Featured{
+		{ProductID: first, Position: 0},
+		{ProductID: second, Position: 1},
+		{ProductID: third, Position: 2},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockRepo.EXPECT().DB().Return(db)
+		mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+		gomock.InOrder(
+			mockTxRepo.EXPECT().SetPosition(gomock.Any(), third, 0).Return(int64(1), nil),
+			mockTxRepo.EXPECT().SetPosition(gomock.Any(), first, 1).Return(int64(1), nil),
+			mockTxRepo.EXPECT().SetPosition(gomock.Any(), second, 2).Return(int64(1), nil),
+		)
+
+		// Act
+		err := testService.Reorder(context.Background(), &featuredmodel.ReorderRequest{ProductIDs: []string{third, first, second}})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing entry", func(t *testing.T) {
+		// Arrange
+		mockTxRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockRepo.EXPECT().DB().Return(db)
+		mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+
+		// Act
+		err := testService.Reorder(context.Background(), &featuredmodel.ReorderRequest{ProductIDs: []string{third, first}})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("duplicate entry", func(t *testing.T) {
+		// Arrange
+		mockTxRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockRepo.EXPECT().DB().Return(db)
+		mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+
+		// Act
+		err := testService.Reorder(context.Background(), &featuredmodel.ReorderRequest{ProductIDs: []string{third, first, first}})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("unknown product", func(t *testing.T) {
+		// Arrange
+		mockTxRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockRepo.EXPECT().DB().Return(db)
+		mockRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxRepo)
+		mockTxRepo.EXPECT().List(gomock.Any()).Return(entries, nil)
+
+		// Act
+		err := testService.Reorder(context.Background(), &featuredmodel.ReorderRequest{ProductIDs: []string{third, first, uuid.New().String()}})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("invalid payload", func(t *testing.T) {
+		// Act
+		err := testService.Reorder(context.Background(), &featuredmodel.ReorderRequest{})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
@@ -36,6 +36,7 @@ import (
 
 	imagemanager "github.com/mikhail5545/product-service-go/internal/services/image_manager"
 	imageowner "github.com/mikhail5545/product-service-go/internal/types/image_owner"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 )
 
 // Service provides service-layer logic for images.
@@ -46,6 +47,8 @@ type Service interface {
 	Delete(ctx context.Context, ownerType string, req *imagemodel.DeleteRequest) error
 	AddBatch(ctx context.Context, ownerType string, req *imagemodel.AddBatchRequest) (int, error)
 	DeleteBatch(ctx context.Context, ownerType string, req *imagemodel.DeleteBatchRequst) (int, error)
+	AddImagesBatch(ctx context.Context, ownerType string, items []*imagemodel.AddRequest) (int, error)
+	DeleteByMediaServiceID(ctx context.Context, mediaServiceID string) (int64, error)
 }
 
 // service holds instances of [courserepo.Repository], [seminarrepo.Repository], [trainingsessionrepo.Repository],
@@ -96,6 +99,9 @@ func (s *service) getOwnerRepoAdapter(ownerType string) (imageowner.OwnerRepo[im
 
 // Add adds an image for owner using [imagemanager.AddImage] for specified owner type.
 func (s *service) Add(ctx context.Context, ownerType string, req *imagemodel.AddRequest) error {
+	ctx, span := tracing.Start(ctx, "image", "Add")
+	defer span.End()
+
 	adapter, err := s.getOwnerRepoAdapter(ownerType)
 	if err != nil {
 		return err
@@ -105,6 +111,9 @@ func (s *service) Add(ctx context.Context, ownerType string, req *imagemodel.Add
 
 // Delete deletes an image from owner using [imagemanager.DeleteImage] for specified owner type.
 func (s *service) Delete(ctx context.Context, ownerType string, req *imagemodel.DeleteRequest) error {
+	ctx, span := tracing.Start(ctx, "image", "Delete")
+	defer span.End()
+
 	adapter, err := s.getOwnerRepoAdapter(ownerType)
 	if err != nil {
 		return err
@@ -116,6 +125,9 @@ func (s *service) Delete(ctx context.Context, ownerType string, req *imagemodel.
 //
 // Returns the number of affected owners.
 func (s *service) AddBatch(ctx context.Context, ownerType string, req *imagemodel.AddBatchRequest) (int, error) {
+	ctx, span := tracing.Start(ctx, "image", "AddBatch")
+	defer span.End()
+
 	adapter, err := s.getOwnerRepoAdapter(ownerType)
 	if err != nil {
 		return 0, err
@@ -127,9 +139,42 @@ func (s *service) AddBatch(ctx context.Context, ownerType string, req *imagemode
 //
 // Returns the number of affected owners.
 func (s *service) DeleteBatch(ctx context.Context, ownerType string, req *imagemodel.DeleteBatchRequst) (int, error) {
+	ctx, span := tracing.Start(ctx, "image", "DeleteBatch")
+	defer span.End()
+
 	adapter, err := s.getOwnerRepoAdapter(ownerType)
 	if err != nil {
 		return 0, err
 	}
 	return s.manager.DeleteImageBatch(ctx, req, adapter)
 }
+
+// AddImagesBatch adds distinct images to owners using [imagemanager.AddImagesBatch] for specified owner type.
+//
+// Returns the number of added images.
+func (s *service) AddImagesBatch(ctx context.Context, ownerType string, items []*imagemodel.AddRequest) (int, error) {
+	ctx, span := tracing.Start(ctx, "image", "AddImagesBatch")
+	defer span.End()
+
+	adapter, err := s.getOwnerRepoAdapter(ownerType)
+	if err != nil {
+		return 0, err
+	}
+	return s.manager.AddImagesBatch(ctx, items, adapter)
+}
+
+// DeleteByMediaServiceID removes an image from every owner of every type using
+// [imagemanager.DeleteImageFromAllOwners]. It is meant for assets deleted in the media service.
+//
+// Returns the number of removed associations.
+func (s *service) DeleteByMediaServiceID(ctx context.Context, mediaServiceID string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "image", "DeleteByMediaServiceID")
+	defer span.End()
+
+	return s.manager.DeleteImageFromAllOwners(ctx, mediaServiceID,
+		course.NewOwnerRepoAdapter(s.courseRepo),
+		seminar.NewOwnerRepoAdapter(s.seminarRepo),
+		trainingsession.NewOwnerRepoAdapter(s.trainingSessionRepo),
+		physicalgood.NewOwnerRepoAdapter(s.physicalGoodRepo),
+	)
+}
@@ -22,10 +22,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
 
+	"github.com/google/uuid"
 	imagerepo "github.com/mikhail5545/product-service-go/internal/database/image"
 	imagemodel "github.com/mikhail5545/product-service-go/internal/models/image"
 	imageowner "github.com/mikhail5545/product-service-go/internal/types/image_owner"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -37,6 +41,8 @@ type Service interface {
 	// The owner must implement the Owner interface, and its repository
 	// must implement the OwnerRepo interface.
 	//
+	// A soft-deleted owner is reported as not found, unless [Config.AddToDeletedOwners] is set.
+	//
 	// Returns an error if the request payload is invalid (ErrInvalidArgument),
 	// the owner is not found (ErrOwnerNotFound), the image limit is exceeded (ErrImageLimitExceeded),
 	// or a database/internal error occurs.
@@ -45,6 +51,8 @@ type Service interface {
 	// The owner must implement the Owner interface, and its repository
 	// must implement the OwnerRepo interface.
 	//
+	// Images can be removed from soft-deleted owners for cleanup, unless [Config.DeleteFromDeletedOwners] is unset.
+	//
 	// Returns an error if the request payload is invalid (ErrInvalidArgument), the owner is not found (ErrOwnerNotFound),
 	// or a database/internal error occurs.
 	DeleteImage(ctx context.Context, req *imagemodel.DeleteRequest, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) error
@@ -64,26 +72,93 @@ type Service interface {
 	// Returns an error if no owners are found in the database (ErrOwnersNotFound), no associations between owners and image
 	// was found (ErrAssociationsNotFound), request payload is invalid (ErrInvalidArgument), or a databsae/internal error occures.
 	DeleteImageBatch(ctx context.Context, req *imagemodel.DeleteBatchRequst, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) (int, error)
+	// AddImagesBatch adds distinct images to owners in a single transaction. Several items may
+	// target the same owner; the image limit is checked against all images added to that owner
+	// in the batch, and nothing is added if any owner would exceed it.
+	//
+	// It returns the number of added images.
+	// Returns an error if the request payload is invalid or an image is listed twice for the same owner (ErrInvalidArgument),
+	// an owner is not found (ErrOwnerNotFound), the image limit is exceeded (ErrImageLimitExceeded),
+	// or a database/internal error occurs.
+	AddImagesBatch(ctx context.Context, items []*imagemodel.AddRequest, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) (int, error)
+	// DeleteImageFromAllOwners removes every association of an image with owners of all given
+	// owner repositories in a single transaction and decrements the owners' image counters.
+	//
+	// It returns the number of removed associations, which is 0 if the image had none.
+	// Returns an error if the media service ID is invalid (ErrInvalidArgument) or a database/internal error occurs.
+	DeleteImageFromAllOwners(ctx context.Context, mediaSvcID string, ownerRepos ...imageowner.OwnerRepo[imageowner.Owner]) (int64, error)
+}
+
+// Config configures how single-owner image operations treat soft-deleted owners.
+type Config struct {
+	// AddToDeletedOwners lets AddImage attach images to soft-deleted owners.
+	AddToDeletedOwners bool
+	// DeleteFromDeletedOwners lets DeleteImage detach images from soft-deleted owners,
+	// so the images of a deleted owner can still be cleaned up.
+	DeleteFromDeletedOwners bool
+}
+
+// DefaultConfig is used for values missing from the environment.
+var DefaultConfig = Config{AddToDeletedOwners: false, DeleteFromDeletedOwners: true}
+
+// ConfigFromEnv reads the IMAGES_ADD_TO_DELETED_OWNERS and IMAGES_DELETE_FROM_DELETED_OWNERS
+// environment variables. Missing or invalid values fall back to [DefaultConfig].
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig
+	if v, err := strconv.ParseBool(os.Getenv("IMAGES_ADD_TO_DELETED_OWNERS")); err == nil {
+		cfg.AddToDeletedOwners = v
+	}
+	if v, err := strconv.ParseBool(os.Getenv("IMAGES_DELETE_FROM_DELETED_OWNERS")); err == nil {
+		cfg.DeleteFromDeletedOwners = v
+	}
+	return cfg
 }
 
 // service holds [imagerepo.Repository] to perform database operations.
 type service struct {
 	ImageRepo imagerepo.Repository
+	cfg       Config
 }
 
-// New creates a new image service instance.
-func New(imageRepo imagerepo.Repository) Service {
-	return &service{ImageRepo: imageRepo}
+// New creates a new image service instance with provided config.
+func New(cfg Config, imageRepo imagerepo.Repository) Service {
+	return &service{ImageRepo: imageRepo, cfg: cfg}
+}
+
+// getOwner retrieves the owner for a single-owner operation. Soft-deleted owners
+// are only found if includeDeleted is set.
+func getOwner(ctx context.Context, repo imageowner.OwnerRepo[imageowner.Owner], id string, includeDeleted bool) (imageowner.Owner, error) {
+	var (
+		owner imageowner.Owner
+		err   error
+	)
+	if includeDeleted {
+		owner, err = repo.GetWithDeleted(ctx, id)
+	} else {
+		owner, err = repo.GetWithUnpublished(ctx, id)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrOwnerNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve owner: %w", err)
+	}
+	return owner, nil
 }
 
 // AddImage adds an image for a single owner.
 // The owner must implement the Owner interface, and its repository
 // must implement the OwnerRepo interface.
 //
+// A soft-deleted owner is reported as not found, unless [Config.AddToDeletedOwners] is set.
+//
 // Returns an error if the request payload is invalid (ErrInvalidArgument),
 // the owner is not found (ErrOwnerNotFound), the image limit is exceeded (ErrImageLimitExceeded),
 // or a database/internal error occurs.
 func (s *service) AddImage(ctx context.Context, req *imagemodel.AddRequest, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) error {
+	ctx, span := tracing.Start(ctx, "image_manager", "AddImage")
+	defer span.End()
+
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -91,12 +166,9 @@ func (s *service) AddImage(ctx context.Context, req *imagemodel.AddRequest, owne
 	return ownerRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txOwnerRepo := ownerRepo.WithTx(tx)
 
-		owner, err := txOwnerRepo.GetWithUnpublished(ctx, req.OwnerID)
+		owner, err := getOwner(ctx, txOwnerRepo, req.OwnerID, s.cfg.AddToDeletedOwners)
 		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("%w: %w", ErrOwnerNotFound, err)
-			}
-			return fmt.Errorf("failed to retrieve owner: %w", err)
+			return err
 		}
 
 		if owner.GetUploadedImageAmount() >= 5 {
@@ -126,9 +198,14 @@ func (s *service) AddImage(ctx context.Context, req *imagemodel.AddRequest, owne
 // The owner must implement the Owner interface, and its repository
 // must implement the OwnerRepo interface.
 //
+// Images can be removed from soft-deleted owners for cleanup, unless [Config.DeleteFromDeletedOwners] is unset.
+//
 // Returns an error if the request payload is invalid (ErrInvalidArgument), the owner is not found (ErrOwnerNotFound),
 // or a database/internal error occurs.
 func (s *service) DeleteImage(ctx context.Context, req *imagemodel.DeleteRequest, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) error {
+	ctx, span := tracing.Start(ctx, "image_manager", "DeleteImage")
+	defer span.End()
+
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -136,12 +213,9 @@ func (s *service) DeleteImage(ctx context.Context, req *imagemodel.DeleteRequest
 	return ownerRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txOwnerRepo := ownerRepo.WithTx(tx)
 
-		owner, err := txOwnerRepo.GetWithUnpublished(ctx, req.OwnerID)
+		owner, err := getOwner(ctx, txOwnerRepo, req.OwnerID, s.cfg.DeleteFromDeletedOwners)
 		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("%w: %w", ErrOwnerNotFound, err)
-			}
-			return fmt.Errorf("failed to retrieve owner: %w", err)
+			return err
 		}
 
 		if err := txOwnerRepo.DeleteImage(ctx, owner, req.MediaServiceID); err != nil {
@@ -167,6 +241,9 @@ func (s *service) DeleteImage(ctx context.Context, req *imagemodel.DeleteRequest
 // Returns an error if no owners are found in the database (ErrOwnersNotFound), request payload is
 // invalid (ErrInvalidArgument), or a databsae/internal error occures.
 func (s *service) AddImageBatch(ctx context.Context, req *imagemodel.AddBatchRequest, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) (int, error) {
+	ctx, span := tracing.Start(ctx, "image_manager", "AddImageBatch")
+	defer span.End()
+
 	affectedOwners := 0
 	if err := req.Validate(); err != nil {
 		return affectedOwners, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
@@ -229,6 +306,9 @@ func (s *service) AddImageBatch(ctx context.Context, req *imagemodel.AddBatchReq
 // Returns an error if no owners are found in the database (ErrOwnersNotFound), no associations between owners and image
 // was found (ErrAssociationsNotFound), request payload is invalid (ErrInvalidArgument), or a databsae/internal error occures.
 func (s *service) DeleteImageBatch(ctx context.Context, req *imagemodel.DeleteBatchRequst, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) (int, error) {
+	ctx, span := tracing.Start(ctx, "image_manager", "DeleteImageBatch")
+	defer span.End()
+
 	affectedOwners := 0
 	if err := req.Validate(); err != nil {
 		return affectedOwners, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
@@ -268,3 +348,125 @@ func (s *service) DeleteImageBatch(ctx context.Context, req *imagemodel.DeleteBa
 	}
 	return affectedOwners, nil
 }
+
+// AddImagesBatch adds distinct images to owners in a single transaction. Several items may
+// target the same owner; the image limit is checked against all images added to that owner
+// in the batch, and nothing is added if any owner would exceed it.
+//
+// It returns the number of added images.
+// Returns an error if the request payload is invalid or an image is listed twice for the same owner (ErrInvalidArgument),
+// an owner is not found (ErrOwnerNotFound), the image limit is exceeded (ErrImageLimitExceeded),
+// or a database/internal error occurs.
+func (s *service) AddImagesBatch(ctx context.Context, items []*imagemodel.AddRequest, ownerRepo imageowner.OwnerRepo[imageowner.Owner]) (int, error) {
+	ctx, span := tracing.Start(ctx, "image_manager", "AddImagesBatch")
+	defer span.End()
+
+	if len(items) == 0 {
+		return 0, fmt.Errorf("%w: no images to add", ErrInvalidArgument)
+	}
+
+	// Group the images by owner, keeping the order in which owners first appear.
+	var ownerIDs []string
+	perOwner := make(map[string][]*imagemodel.AddRequest)
+	for i, item := range items {
+		if err := item.Validate(); err != nil {
+			return 0, fmt.Errorf("%w: item %d: %w", ErrInvalidArgument, i, err)
+		}
+		for _, other := range perOwner[item.OwnerID] {
+			if other.MediaServiceID == item.MediaServiceID {
+				return 0, fmt.Errorf("%w: image %s is listed twice for owner %s", ErrInvalidArgument, item.MediaServiceID, item.OwnerID)
+			}
+		}
+		if _, ok := perOwner[item.OwnerID]; !ok {
+			ownerIDs = append(ownerIDs, item.OwnerID)
+		}
+		perOwner[item.OwnerID] = append(perOwner[item.OwnerID], item)
+	}
+
+	err := s.ImageRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txOwnerRepo := ownerRepo.WithTx(tx)
+
+		// Check every owner's limit before adding anything.
+		owners := make([]imageowner.Owner, 0, len(ownerIDs))
+		for _, id := range ownerIDs {
+			owner, err := txOwnerRepo.GetWithUnpublished(ctx, id)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return fmt.Errorf("%w: %s: %w", ErrOwnerNotFound, id, err)
+				}
+				return fmt.Errorf("failed to retrieve owner: %w", err)
+			}
+			if owner.GetUploadedImageAmount()+len(perOwner[id]) > 5 {
+				return fmt.Errorf("%w: owner %s", ErrImageLimitExceeded, id)
+			}
+			owners = append(owners, owner)
+		}
+
+		for i, id := range ownerIDs {
+			for _, item := range perOwner[id] {
+				newImage := &imagemodel.Image{
+					URL:            item.URL,
+					SecureURL:      item.SecureURL,
+					PublicID:       item.PublicID,
+					MediaServiceID: item.MediaServiceID,
+				}
+				if err := txOwnerRepo.AddImage(ctx, owners[i], newImage); err != nil {
+					return fmt.Errorf("failed to add image for owner: %w", err)
+				}
+			}
+			owners[i].SetUploadedImageAmount(owners[i].GetUploadedImageAmount() + len(perOwner[id]))
+		}
+
+		if _, err := txOwnerRepo.BatchUpdate(ctx, owners, 2); err != nil {
+			return fmt.Errorf("failed to batch update owners: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(items), nil
+}
+
+// DeleteImageFromAllOwners removes every association of an image with owners of all given
+// owner repositories in a single transaction and decrements the owners' image counters.
+//
+// It returns the number of removed associations, which is 0 if the image had none.
+// Returns an error if the media service ID is invalid (ErrInvalidArgument) or a database/internal error occurs.
+func (s *service) DeleteImageFromAllOwners(ctx context.Context, mediaSvcID string, ownerRepos ...imageowner.OwnerRepo[imageowner.Owner]) (int64, error) {
+	ctx, span := tracing.Start(ctx, "image_manager", "DeleteImageFromAllOwners")
+	defer span.End()
+
+	if _, err := uuid.Parse(mediaSvcID); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+
+	var removed int64
+	err := s.ImageRepo.DB().Transaction(func(tx *gorm.DB) error {
+		for _, ownerRepo := range ownerRepos {
+			txOwnerRepo := ownerRepo.WithTx(tx)
+
+			ownerIDs, err := txOwnerRepo.ListOwnerIDsByImageID(ctx, mediaSvcID)
+			if err != nil {
+				return fmt.Errorf("failed to retrieve owners of the image: %w", err)
+			}
+			if len(ownerIDs) == 0 {
+				continue
+			}
+
+			ra, err := txOwnerRepo.DeleteImageAssociations(ctx, mediaSvcID)
+			if err != nil {
+				return fmt.Errorf("failed to delete image from owners: %w", err)
+			}
+			if _, err := txOwnerRepo.DecrementImageCount(ctx, ownerIDs); err != nil {
+				return fmt.Errorf("failed to decrement uploaded image count from owners: %w", err)
+			}
+			removed += ra
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
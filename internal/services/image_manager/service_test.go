@@ -55,7 +55,7 @@ func TestService_AddImage(t *testing.T) {
 	mockImageRepo := imagerepomock.NewMockRepository(ctrl)
 	mockOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
 
-	testService := New(mockImageRepo)
+	testService := New(DefaultConfig, mockImageRepo)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -164,6 +164,45 @@ func TestService_AddImage(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to add image for owner")
 	})
+
+	t.Run("deleted owner rejected by default", func(t *testing.T) {
+		// Arrange
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().DB().Return(db)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		// The soft-deleted owner is invisible to GetWithUnpublished, and GetWithDeleted must not be called.
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		err := testService.AddImage(context.Background(), addReq, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrOwnerNotFound)
+	})
+
+	t.Run("deleted owner accepted when configured", func(t *testing.T) {
+		// Arrange
+		lenientService := New(Config{AddToDeletedOwners: true, DeleteFromDeletedOwners: true}, mockImageRepo)
+
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().DB().Return(db)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		deletedOwner := &mockOwner{id: ownerID, uploadedImageAmount: 0}
+
+		mockTxOwnerRepo.EXPECT().GetWithDeleted(gomock.Any(), ownerID).Return(deletedOwner, nil)
+		mockTxOwnerRepo.EXPECT().AddImage(gomock.Any(), deletedOwner, gomock.Any()).Return(nil)
+		mockTxOwnerRepo.EXPECT().BatchUpdate(gomock.Any(), gomock.Any(), uint(2)).Return(int64(1), nil)
+
+		// Act
+		err := lenientService.AddImage(context.Background(), addReq, mockOwnerRepo)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, deletedOwner.GetUploadedImageAmount())
+	})
 }
 
 func TestService_Delete(t *testing.T) {
@@ -173,7 +212,7 @@ func TestService_Delete(t *testing.T) {
 	mockImageRepo := imagerepomock.NewMockRepository(ctrl)
 	mockOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
 
-	testService := New(mockImageRepo)
+	testService := New(DefaultConfig, mockImageRepo)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -198,7 +237,7 @@ func TestService_Delete(t *testing.T) {
 
 		owner := &mockOwner{id: ownerID, uploadedImageAmount: 2}
 
-		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID).Return(owner, nil)
+		mockTxOwnerRepo.EXPECT().GetWithDeleted(gomock.Any(), ownerID).Return(owner, nil)
 		mockTxOwnerRepo.EXPECT().DeleteImage(gomock.Any(), owner, mediaSvcID).Return(nil)
 		mockTxOwnerRepo.EXPECT().DecrementImageCount(gomock.Any(), []string{ownerID}).Return(int64(1), nil)
 
@@ -227,7 +266,7 @@ func TestService_Delete(t *testing.T) {
 		mockOwnerRepo.EXPECT().DB().Return(db)
 		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
 
-		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxOwnerRepo.EXPECT().GetWithDeleted(gomock.Any(), ownerID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
 		err := testService.DeleteImage(context.Background(), deleteReq, mockOwnerRepo)
@@ -245,7 +284,7 @@ func TestService_Delete(t *testing.T) {
 
 		owner := &mockOwner{id: ownerID, uploadedImageAmount: 2}
 
-		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID).Return(owner, nil)
+		mockTxOwnerRepo.EXPECT().GetWithDeleted(gomock.Any(), ownerID).Return(owner, nil)
 		mockTxOwnerRepo.EXPECT().DeleteImage(gomock.Any(), owner, mediaSvcID).Return(gorm.ErrRecordNotFound)
 
 		// Act
@@ -264,7 +303,7 @@ func TestService_Delete(t *testing.T) {
 
 		owner := &mockOwner{id: ownerID, uploadedImageAmount: 2}
 
-		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID).Return(owner, nil)
+		mockTxOwnerRepo.EXPECT().GetWithDeleted(gomock.Any(), ownerID).Return(owner, nil)
 		mockTxOwnerRepo.EXPECT().DeleteImage(gomock.Any(), owner, mediaSvcID).Return(nil)
 		dbErr := errors.New("database error")
 		mockTxOwnerRepo.EXPECT().DecrementImageCount(gomock.Any(), []string{ownerID}).Return(int64(0), dbErr)
@@ -276,6 +315,44 @@ func TestService_Delete(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to decrement owner uploaded image count")
 	})
+
+	t.Run("deleted owner allowed for cleanup", func(t *testing.T) {
+		// Arrange
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().DB().Return(db)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		// GetWithUnpublished would not find the soft-deleted owner.
+		deletedOwner := &mockOwner{id: ownerID, uploadedImageAmount: 1}
+
+		mockTxOwnerRepo.EXPECT().GetWithDeleted(gomock.Any(), ownerID).Return(deletedOwner, nil)
+		mockTxOwnerRepo.EXPECT().DeleteImage(gomock.Any(), deletedOwner, mediaSvcID).Return(nil)
+		mockTxOwnerRepo.EXPECT().DecrementImageCount(gomock.Any(), []string{ownerID}).Return(int64(1), nil)
+
+		// Act
+		err := testService.DeleteImage(context.Background(), deleteReq, mockOwnerRepo)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("deleted owner rejected when configured", func(t *testing.T) {
+		// Arrange
+		strictService := New(Config{DeleteFromDeletedOwners: false}, mockImageRepo)
+
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().DB().Return(db)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		err := strictService.DeleteImage(context.Background(), deleteReq, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrOwnerNotFound)
+	})
 }
 
 func TestService_AddImageBatch(t *testing.T) {
@@ -285,7 +362,7 @@ func TestService_AddImageBatch(t *testing.T) {
 	mockImageRepo := imagerepomock.NewMockRepository(ctrl)
 	mockOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
 
-	testService := New(mockImageRepo)
+	testService := New(DefaultConfig, mockImageRepo)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -499,7 +576,7 @@ func TestService_DeleteImageBatch(t *testing.T) {
 	mockImageRepo := imagerepomock.NewMockRepository(ctrl)
 	mockOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
 
-	testService := New(mockImageRepo)
+	testService := New(DefaultConfig, mockImageRepo)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -728,3 +805,312 @@ func TestService_DeleteImageBatch(t *testing.T) {
 		assert.Contains(t, err.Error(), "failed to decrement uploaded image count from owners")
 	})
 }
+
+func TestService_AddImagesBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := imagerepomock.NewMockRepository(ctrl)
+	mockOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+
+	testService := New(DefaultConfig, mockImageRepo)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	ownerID_1 := uuid.New().String()
+	ownerID_2 := uuid.New().String()
+
+	newItem := func(ownerID string) *imagemodel.AddRequest {
+		mediaSvcID := uuid.New().String()
+		return &imagemodel.AddRequest{
+			URL:            "http://example.com/" + mediaSvcID + ".jpg",
+			SecureURL:      "https://example.com/" + mediaSvcID + ".jpg",
+			PublicID:       "public-" + mediaSvcID,
+			MediaServiceID: mediaSvcID,
+			OwnerID:        ownerID,
+		}
+	}
+
+	t.Run("success with distinct images per owner", func(t *testing.T) {
+		// Arrange
+		items := []*imagemodel.AddRequest{newItem(ownerID_1), newItem(ownerID_2)}
+		owner_1 := &mockOwner{id: ownerID_1, uploadedImageAmount: 2}
+		owner_2 := &mockOwner{id: ownerID_2, uploadedImageAmount: 0}
+
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_1).Return(owner_1, nil)
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_2).Return(owner_2, nil)
+		mockTxOwnerRepo.EXPECT().AddImage(gomock.Any(), owner_1, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ image_owner.Owner, img *imagemodel.Image) error {
+				assert.Equal(t, items[0].URL, img.URL)
+				assert.Equal(t, items[0].MediaServiceID, img.MediaServiceID)
+				return nil
+			})
+		mockTxOwnerRepo.EXPECT().AddImage(gomock.Any(), owner_2, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ image_owner.Owner, img *imagemodel.Image) error {
+				assert.Equal(t, items[1].URL, img.URL)
+				assert.Equal(t, items[1].MediaServiceID, img.MediaServiceID)
+				return nil
+			})
+		mockTxOwnerRepo.EXPECT().BatchUpdate(gomock.Any(), gomock.Any(), uint(2)).
+			DoAndReturn(func(_ context.Context, owners []image_owner.Owner, _ uint) (int64, error) {
+				assert.Len(t, owners, 2)
+				assert.Equal(t, 3, owners[0].GetUploadedImageAmount())
+				assert.Equal(t, 1, owners[1].GetUploadedImageAmount())
+				return int64(2), nil
+			})
+
+		// Act
+		added, err := testService.AddImagesBatch(context.Background(), items, mockOwnerRepo)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, added)
+	})
+
+	t.Run("success with two images for the same owner", func(t *testing.T) {
+		// Arrange
+		items := []*imagemodel.AddRequest{newItem(ownerID_1), newItem(ownerID_1)}
+		owner := &mockOwner{id: ownerID_1, uploadedImageAmount: 3}
+
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		var addedIDs []string
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_1).Return(owner, nil).Times(1)
+		mockTxOwnerRepo.EXPECT().AddImage(gomock.Any(), owner, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ image_owner.Owner, img *imagemodel.Image) error {
+				addedIDs = append(addedIDs, img.MediaServiceID)
+				return nil
+			}).Times(2)
+		mockTxOwnerRepo.EXPECT().BatchUpdate(gomock.Any(), gomock.Any(), uint(2)).
+			DoAndReturn(func(_ context.Context, owners []image_owner.Owner, _ uint) (int64, error) {
+				assert.Len(t, owners, 1)
+				assert.Equal(t, 5, owners[0].GetUploadedImageAmount())
+				return int64(1), nil
+			})
+
+		// Act
+		added, err := testService.AddImagesBatch(context.Background(), items, mockOwnerRepo)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, added)
+		assert.Equal(t, []string{items[0].MediaServiceID, items[1].MediaServiceID}, addedIDs)
+	})
+
+	t.Run("image limit exceeded rejects the whole batch", func(t *testing.T) {
+		// Arrange
+		// owner_1 can take both of its images, owner_2 can take only one of two.
+		items := []*imagemodel.AddRequest{newItem(ownerID_1), newItem(ownerID_2), newItem(ownerID_1), newItem(ownerID_2)}
+		owner_1 := &mockOwner{id: ownerID_1, uploadedImageAmount: 1}
+		owner_2 := &mockOwner{id: ownerID_2, uploadedImageAmount: 4}
+
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_1).Return(owner_1, nil)
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_2).Return(owner_2, nil)
+		mockTxOwnerRepo.EXPECT().AddImage(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockTxOwnerRepo.EXPECT().BatchUpdate(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		added, err := testService.AddImagesBatch(context.Background(), items, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrImageLimitExceeded)
+		assert.Contains(t, err.Error(), ownerID_2)
+		assert.Equal(t, 0, added)
+		assert.Equal(t, 1, owner_1.GetUploadedImageAmount())
+		assert.Equal(t, 4, owner_2.GetUploadedImageAmount())
+	})
+
+	t.Run("invalid request payload", func(t *testing.T) {
+		// Arrange
+		items := []*imagemodel.AddRequest{newItem(ownerID_1), {OwnerID: "not-a-uuid"}}
+
+		// Act
+		_, err := testService.AddImagesBatch(context.Background(), items, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		// Act
+		_, err := testService.AddImagesBatch(context.Background(), nil, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("same image twice for one owner", func(t *testing.T) {
+		// Arrange
+		item := newItem(ownerID_1)
+		items := []*imagemodel.AddRequest{item, item}
+
+		// Act
+		_, err := testService.AddImagesBatch(context.Background(), items, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("owner not found", func(t *testing.T) {
+		// Arrange
+		items := []*imagemodel.AddRequest{newItem(ownerID_1), newItem(ownerID_2)}
+
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_1).Return(&mockOwner{id: ownerID_1}, nil)
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_2).Return(nil, gorm.ErrRecordNotFound)
+		mockTxOwnerRepo.EXPECT().AddImage(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		_, err := testService.AddImagesBatch(context.Background(), items, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrOwnerNotFound)
+	})
+
+	t.Run("add image db error", func(t *testing.T) {
+		// Arrange
+		items := []*imagemodel.AddRequest{newItem(ownerID_1)}
+		owner := &mockOwner{id: ownerID_1}
+
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxOwnerRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockOwnerRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxOwnerRepo)
+
+		dbErr := errors.New("database error")
+		mockTxOwnerRepo.EXPECT().GetWithUnpublished(gomock.Any(), ownerID_1).Return(owner, nil)
+		mockTxOwnerRepo.EXPECT().AddImage(gomock.Any(), owner, gomock.Any()).Return(dbErr)
+
+		// Act
+		added, err := testService.AddImagesBatch(context.Background(), items, mockOwnerRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, 0, added)
+	})
+}
+
+func TestService_DeleteImageFromAllOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockImageRepo := imagerepomock.NewMockRepository(ctrl)
+	mockCourseRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+	mockSeminarRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+
+	testService := New(DefaultConfig, mockImageRepo)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	mediaSvcID := uuid.New().String()
+
+	t.Run("success with several owners", func(t *testing.T) {
+		// Arrange
+		courseIDs := []string{uuid.New().String(), uuid.New().String()}
+		seminarIDs := []string{uuid.New().String()}
+
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxCourseRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockTxSeminarRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+
+		mockTxCourseRepo.EXPECT().ListOwnerIDsByImageID(gomock.Any(), mediaSvcID).Return(courseIDs, nil)
+		mockTxCourseRepo.EXPECT().DeleteImageAssociations(gomock.Any(), mediaSvcID).Return(int64(2), nil)
+		mockTxCourseRepo.EXPECT().DecrementImageCount(gomock.Any(), courseIDs).Return(int64(2), nil)
+		mockTxSeminarRepo.EXPECT().ListOwnerIDsByImageID(gomock.Any(), mediaSvcID).Return(seminarIDs, nil)
+		mockTxSeminarRepo.EXPECT().DeleteImageAssociations(gomock.Any(), mediaSvcID).Return(int64(1), nil)
+		mockTxSeminarRepo.EXPECT().DecrementImageCount(gomock.Any(), seminarIDs).Return(int64(1), nil)
+
+		// Act
+		removed, err := testService.DeleteImageFromAllOwners(context.Background(), mediaSvcID, mockCourseRepo, mockSeminarRepo)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), removed)
+	})
+
+	t.Run("success with no owners", func(t *testing.T) {
+		// Arrange
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxCourseRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockTxSeminarRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+
+		mockTxCourseRepo.EXPECT().ListOwnerIDsByImageID(gomock.Any(), mediaSvcID).Return([]string{}, nil)
+		mockTxSeminarRepo.EXPECT().ListOwnerIDsByImageID(gomock.Any(), mediaSvcID).Return(nil, nil)
+		mockTxCourseRepo.EXPECT().DeleteImageAssociations(gomock.Any(), gomock.Any()).Times(0)
+		mockTxSeminarRepo.EXPECT().DeleteImageAssociations(gomock.Any(), gomock.Any()).Times(0)
+		mockTxCourseRepo.EXPECT().DecrementImageCount(gomock.Any(), gomock.Any()).Times(0)
+		mockTxSeminarRepo.EXPECT().DecrementImageCount(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		removed, err := testService.DeleteImageFromAllOwners(context.Background(), mediaSvcID, mockCourseRepo, mockSeminarRepo)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), removed)
+	})
+
+	t.Run("invalid media service ID", func(t *testing.T) {
+		// Act
+		_, err := testService.DeleteImageFromAllOwners(context.Background(), "not-a-uuid", mockCourseRepo, mockSeminarRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("decrement uploaded image amount db error", func(t *testing.T) {
+		// Arrange
+		courseIDs := []string{uuid.New().String()}
+
+		mockImageRepo.EXPECT().DB().Return(db)
+		mockTxCourseRepo := imageownermock.NewMockOwnerRepo[image_owner.Owner](ctrl)
+		mockCourseRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxCourseRepo)
+
+		dbErr := errors.New("database error")
+		mockTxCourseRepo.EXPECT().ListOwnerIDsByImageID(gomock.Any(), mediaSvcID).Return(courseIDs, nil)
+		mockTxCourseRepo.EXPECT().DeleteImageAssociations(gomock.Any(), mediaSvcID).Return(int64(1), nil)
+		mockTxCourseRepo.EXPECT().DecrementImageCount(gomock.Any(), courseIDs).Return(int64(0), dbErr)
+
+		// Act
+		removed, err := testService.DeleteImageFromAllOwners(context.Background(), mediaSvcID, mockCourseRepo, mockSeminarRepo)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+		assert.Equal(t, int64(0), removed)
+	})
+}
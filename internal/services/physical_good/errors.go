@@ -29,4 +29,12 @@ var (
 	ErrImageLimitExceeded = errors.New("maximum number of uploaded images is 5 per item")
 	// ErrImageNotFoundOnOwner can't find image on physical good error
 	ErrImageNotFoundOnOwner = errors.New("image not found on physical good")
+	// ErrInsufficientStock stock adjustment would make physical good amount negative error
+	ErrInsufficientStock = errors.New("insufficient physical good stock")
+	// ErrDuplicateSKU another not soft-deleted physical good already has the SKU error
+	ErrDuplicateSKU = errors.New("physical good with this SKU already exists")
+	// ErrHasDependents physical good products are referenced by other records (e.g. featured list) error
+	ErrHasDependents = errors.New("physical good products are referenced by other records")
+	// ErrCannotPublishFree physical good product priced at zero or below and not marked free error
+	ErrCannotPublishFree = errors.New("cannot publish physical good with a zero price product that is not marked free")
 )
@@ -22,12 +22,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	featuredrepo "github.com/mikhail5545/product-service-go/internal/database/featured"
 	physicalgoodrepo "github.com/mikhail5545/product-service-go/internal/database/physical_good"
 	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	physicalgoodmodel "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -56,6 +63,13 @@ type Service interface {
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 	// or a database/internal error occurs.
 	GetWithUnpublished(ctx context.Context, id string) (*physicalgoodmodel.PhysicalGoodDetails, error)
+	// GetBySKU retrieves a single physical good record from the database by its SKU, including unpublished ones (but not soft-deleted),
+	// along with its associated product details (price and product ID).
+	//
+	// Returns a PhysicalGoodDetails struct containing the combined information.
+	// Returns an error if the SKU is malformed (ErrInvalidArgument), the record is not found (ErrNotFound),
+	// or a database/internal error occurs.
+	GetBySKU(ctx context.Context, sku string) (*physicalgoodmodel.PhysicalGoodDetails, error)
 	// List retrieves a paginated list of all published and not soft-deleted physical good records.
 	// Each record is returned with its associated product details.
 	//
@@ -79,7 +93,8 @@ type Service interface {
 	// Both the physical good and the product are created in an unpublished state (`InStock: false`).
 	//
 	// Returns a CreateResponse containing the newly created PhysicalGoodID and ProductID.
-	// Returns an error if the request payload is invalid (ErrInvalidArgument) or a database/internal error occurs.
+	// Returns an error if the request payload is invalid (ErrInvalidArgument), the SKU is taken by another physical good (ErrDuplicateSKU),
+	// or a database/internal error occurs.
 	Create(ctx context.Context, req *physicalgoodmodel.CreateRequest) (*physicalgoodmodel.CreateResponse, error)
 	// Update performs a partial update of a physical good and its related product.
 	// The request should contain the physical good's ID and the fields to be updated.
@@ -88,31 +103,68 @@ type Service interface {
 	// Returns a map containing the fields that were actually changed, nested under "physical_good" and "product" keys.
 	// Example: `{"physical_good": {"name": "new name"}, "product": {"price": 99.99}}`
 	// Returns an error if the request payload is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
+	// the SKU is taken by another physical good (ErrDuplicateSKU), or a database/internal error occurs.
 	Update(ctx context.Context, req *physicalgoodmodel.UpdateRequest) (map[string]any, error)
 	// Publish sets the `InStock` field to true for a physical good and its associated product,
 	// making it available in the catalog.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
+	// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+	// Publishing an already published physical good is a no-op.
 	Publish(ctx context.Context, id string) error
 	// Unpublish sets the `InStock` field to false for a physical good and its associated product,
 	// archiving it from the catalog.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
+	// Unpublishing an already unpublished physical good is a no-op.
 	Unpublish(ctx context.Context, id string) error
 	// Delete performs a soft-delete of a physical good and its related product record.
 	// It also unpublishes both records, meaning they must be manually published again after restoration.
+	// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 	//
-	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// or a database/internal error occurs.
+	Delete(ctx context.Context, id, reason string) error
+	// DeleteBatch performs a soft-delete of multiple physical goods and their related product records
+	// in a single transaction, applying the same guards as Delete to each record.
+	// All IDs are validated before anything is deleted. Duplicate IDs are deleted once.
+	// If any record fails, the whole batch is rolled back.
+	//
+	// Returns the number of deleted physical goods.
+	// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
 	// or a database/internal error occurs.
-	Delete(ctx context.Context, id string) error
+	DeleteBatch(ctx context.Context, ids []string) (int64, error)
+	// AdjustStockBatch applies signed deltas to the amount of each physical good (including unpublished ones)
+	// in a single transaction. Adjustments are keyed by physical good ID, zero deltas are skipped.
+	// If any adjustment fails, the whole batch is rolled back.
+	//
+	// Returns the number of adjusted physical goods.
+	// Returns an error if any ID is invalid or no adjustments are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+	// any amount would become negative (ErrInsufficientStock), or a database/internal error occurs.
+	AdjustStockBatch(ctx context.Context, adjustments map[string]int) (int64, error)
 	// DeletePermanent performs a complete delete of a physical good and its related product record.
+	// If force is true, the related product count is not checked and whatever records exist are deleted.
+	// This is meant for cleaning up inconsistent data.
+	// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
-	DeletePermanent(ctx context.Context, id string) error
+	// the products are featured (ErrHasDependents), or a database/internal error occurs.
+	DeletePermanent(ctx context.Context, id string, force bool) error
+	// PurgeDeletedBefore permanently deletes all physical goods soft-deleted before the given time,
+	// together with their product records.
+	// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
+	//
+	// Returns the number of purged physical goods.
+	// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// CountDeletedBefore counts physical goods soft-deleted before cutoff, that is the physical goods
+	// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+	//
+	// Returns an error if a database/internal error occurs.
+	CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	// Restore performs a restore of a physical good and its related product record.
 	// Physical good and its related product record are not being published. This should be
 	// done manually.
@@ -120,6 +172,15 @@ type Service interface {
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
 	Restore(ctx context.Context, id string) error
+	// RestoreBatch restores multiple soft-deleted physical goods and their related product records
+	// in a single transaction, applying the same guards as Restore to each record.
+	// All IDs are validated before anything is restored. Duplicate IDs are restored once.
+	// If any record fails, the whole batch is rolled back.
+	//
+	// Returns the number of restored physical goods.
+	// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+	// or a database/internal error occurs.
+	RestoreBatch(ctx context.Context, ids []string) (int64, error)
 }
 
 // service provides service-layer business logic for physical good models.
@@ -128,13 +189,18 @@ type Service interface {
 type service struct {
 	PhysicalGoodRepo physicalgoodrepo.Repository
 	ProductRepo      productrepo.Repository
+	FeaturedRepo     featuredrepo.Repository
+	// now is the clock used to evaluate product sale windows, replaceable in tests.
+	now func() time.Time
 }
 
-// New creates a new service instance with provided physical good and product repositories.
-func New(gr physicalgoodrepo.Repository, pr productrepo.Repository) Service {
+// New creates a new service instance with provided physical good, product and featured list repositories.
+func New(gr physicalgoodrepo.Repository, pr productrepo.Repository, fr featuredrepo.Repository) Service {
 	return &service{
 		PhysicalGoodRepo: gr,
 		ProductRepo:      pr,
+		FeaturedRepo:     fr,
+		now:              time.Now,
 	}
 }
 
@@ -145,6 +211,9 @@ func New(gr physicalgoodrepo.Repository, pr productrepo.Repository) Service {
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Get(ctx context.Context, id string) (*physicalgoodmodel.PhysicalGoodDetails, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "Get", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -155,7 +224,7 @@ func (s *service) Get(ctx context.Context, id string) (*physicalgoodmodel.Physic
 		}
 		return nil, fmt.Errorf("failed to retrieve physical good: %w", err)
 	}
-	product, err := s.ProductRepo.SelectByDetailsID(ctx, phGood.ID, "id", "price")
+	product, err := s.ProductRepo.SelectByDetailsID(ctx, phGood.ID, "id", "price", "available_from", "available_until")
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
@@ -164,6 +233,7 @@ func (s *service) Get(ctx context.Context, id string) (*physicalgoodmodel.Physic
 	}
 	return &physicalgoodmodel.PhysicalGoodDetails{
 		PhysicalGood: phGood,
+		Available:    product.IsAvailableAt(s.now()),
 		Price:        product.Price,
 		ProductID:    product.ID,
 	}, nil
@@ -176,6 +246,9 @@ func (s *service) Get(ctx context.Context, id string) (*physicalgoodmodel.Physic
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithDeleted(ctx context.Context, id string) (*physicalgoodmodel.PhysicalGoodDetails, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "GetWithDeleted", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -186,7 +259,7 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*physicalgoodm
 		}
 		return nil, fmt.Errorf("failed to retrieve physical good: %w", err)
 	}
-	product, err := s.ProductRepo.SelectWithDeletedByDetailsID(ctx, phGood.ID, "id", "price")
+	product, err := s.ProductRepo.SelectWithDeletedByDetailsID(ctx, phGood.ID, "id", "price", "available_from", "available_until", "first_published_at", "last_published_at")
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
@@ -194,9 +267,12 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*physicalgoodm
 		return nil, fmt.Errorf("failed to retrieve physical good product: %w", err)
 	}
 	return &physicalgoodmodel.PhysicalGoodDetails{
-		PhysicalGood: phGood,
-		Price:        product.Price,
-		ProductID:    product.ID,
+		PhysicalGood:     phGood,
+		Available:        product.IsAvailableAt(s.now()),
+		Price:            product.Price,
+		ProductID:        product.ID,
+		FirstPublishedAt: product.FirstPublishedAt,
+		LastPublishedAt:  product.LastPublishedAt,
 	}, nil
 }
 
@@ -207,6 +283,9 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*physicalgoodm
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithUnpublished(ctx context.Context, id string) (*physicalgoodmodel.PhysicalGoodDetails, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "GetWithUnpublished", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -217,7 +296,35 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*physicalg
 		}
 		return nil, fmt.Errorf("failed to retrieve physical good: %w", err)
 	}
-	product, err := s.ProductRepo.SelectWithUnpublishedByDetailsID(ctx, phGood.ID, "id", "price")
+	return s.withUnpublishedProduct(ctx, phGood)
+}
+
+// GetBySKU retrieves a single physical good record from the database by its SKU, including unpublished ones (but not soft-deleted),
+// along with its associated product details (price and product ID).
+//
+// Returns a PhysicalGoodDetails struct containing the combined information.
+// Returns an error if the SKU is malformed (ErrInvalidArgument), the record is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) GetBySKU(ctx context.Context, sku string) (*physicalgoodmodel.PhysicalGoodDetails, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "GetBySKU")
+	defer span.End()
+
+	if !physicalgoodmodel.SKUPattern.MatchString(sku) {
+		return nil, fmt.Errorf("%w: malformed SKU %q", ErrInvalidArgument, sku)
+	}
+	phGood, err := s.PhysicalGoodRepo.GetBySKU(ctx, sku)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve physical good: %w", err)
+	}
+	return s.withUnpublishedProduct(ctx, phGood)
+}
+
+// withUnpublishedProduct combines phGood with its product record, which may be unpublished.
+func (s *service) withUnpublishedProduct(ctx context.Context, phGood *physicalgoodmodel.PhysicalGood) (*physicalgoodmodel.PhysicalGoodDetails, error) {
+	product, err := s.ProductRepo.SelectWithUnpublishedByDetailsID(ctx, phGood.ID, "id", "price", "available_from", "available_until", "first_published_at", "last_published_at")
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
@@ -225,18 +332,39 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*physicalg
 		return nil, fmt.Errorf("failed to retrieve physical good product: %w", err)
 	}
 	return &physicalgoodmodel.PhysicalGoodDetails{
-		PhysicalGood: phGood,
-		Price:        product.Price,
-		ProductID:    product.ID,
+		PhysicalGood:     phGood,
+		Available:        product.IsAvailableAt(s.now()),
+		Price:            product.Price,
+		ProductID:        product.ID,
+		FirstPublishedAt: product.FirstPublishedAt,
+		LastPublishedAt:  product.LastPublishedAt,
 	}, nil
 }
 
+// checkSKUAvailable returns ErrDuplicateSKU if a not soft-deleted physical good other than id already has sku.
+func checkSKUAvailable(ctx context.Context, repo physicalgoodrepo.Repository, sku, id string) error {
+	existing, err := repo.GetBySKU(ctx, sku)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return fmt.Errorf("failed to check physical good SKU: %w", err)
+	}
+	if existing.ID != id {
+		return fmt.Errorf("%w: %s", ErrDuplicateSKU, sku)
+	}
+	return nil
+}
+
 // List retrieves a paginated list of all published and not soft-deleted physical good records.
 // Each record is returned with its associated product details.
 //
 // Returns a slice of PhysicalGoodDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) List(ctx context.Context, limit, offset int) ([]physicalgoodmodel.PhysicalGoodDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "List")
+	defer span.End()
+
 	phGoods, err := s.PhysicalGoodRepo.List(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve physical goods: %w", err)
@@ -253,7 +381,7 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]physicalgoodmo
 		phGoodsIDs = append(phGoodsIDs, phGoods[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectByDetailsIDs(ctx, phGoodsIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectByDetailsIDs(ctx, phGoodsIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -261,6 +389,7 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]physicalgoodmo
 	for _, p := range products {
 		allDetails = append(allDetails, physicalgoodmodel.PhysicalGoodDetails{
 			PhysicalGood: phGoodsMap[p.DetailsID],
+			Available:    p.IsAvailableAt(s.now()),
 			Price:        p.Price,
 			ProductID:    p.ID,
 		})
@@ -274,6 +403,9 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]physicalgoodmo
 // Returns a slice of PhysicalGoodDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]physicalgoodmodel.PhysicalGoodDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "ListUnpublished")
+	defer span.End()
+
 	phGoods, err := s.PhysicalGoodRepo.ListUnpublished(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve physical goods: %w", err)
@@ -290,7 +422,7 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]phy
 		phGoodsIDs = append(phGoodsIDs, phGoods[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, phGoodsIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, phGoodsIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -298,6 +430,7 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]phy
 	for _, p := range products {
 		allDetails = append(allDetails, physicalgoodmodel.PhysicalGoodDetails{
 			PhysicalGood: phGoodsMap[p.DetailsID],
+			Available:    p.IsAvailableAt(s.now()),
 			Price:        p.Price,
 			ProductID:    p.ID,
 		})
@@ -311,6 +444,9 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]phy
 // Returns a slice of PhysicalGoodDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]physicalgoodmodel.PhysicalGoodDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "ListDeleted")
+	defer span.End()
+
 	phGoods, err := s.PhysicalGoodRepo.ListDeleted(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve physical goods: %w", err)
@@ -327,7 +463,7 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]physica
 		phGoodsIDs = append(phGoodsIDs, phGoods[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectWithDeletedByDetailsIDs(ctx, phGoodsIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectWithDeletedByDetailsIDs(ctx, phGoodsIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -335,6 +471,7 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]physica
 	for _, p := range products {
 		allDetails = append(allDetails, physicalgoodmodel.PhysicalGoodDetails{
 			PhysicalGood: phGoodsMap[p.DetailsID],
+			Available:    p.IsAvailableAt(s.now()),
 			Price:        p.Price,
 			ProductID:    p.ID,
 		})
@@ -347,8 +484,13 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]physica
 // Both the physical good and the product are created in an unpublished state (`InStock: false`).
 //
 // Returns a CreateResponse containing the newly created PhysicalGoodID and ProductID.
-// Returns an error if the request payload is invalid (ErrInvalidArgument) or a database/internal error occurs.
+// Returns an error if the request payload is invalid (ErrInvalidArgument), the SKU is taken by another physical good (ErrDuplicateSKU),
+// or a database/internal error occurs.
 func (s *service) Create(ctx context.Context, req *physicalgoodmodel.CreateRequest) (*physicalgoodmodel.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "Create")
+	defer span.End()
+
+	req.Sanitize()
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -364,6 +506,9 @@ func (s *service) Create(ctx context.Context, req *physicalgoodmodel.CreateReque
 			ShortDescription: req.ShortDescription,
 			Amount:           req.Amount,
 			ShippingRequired: req.ShippingRequired,
+			SKU:              req.SKU,
+			WeightGrams:      req.WeightGrams,
+			Dimensions:       req.Dimensions,
 			InStock:          false,
 		}
 
@@ -375,6 +520,11 @@ func (s *service) Create(ctx context.Context, req *physicalgoodmodel.CreateReque
 			InStock:     false,
 		}
 
+		if phGood.SKU != "" {
+			if err := checkSKUAvailable(ctx, txPhysicalGoodRepo, phGood.SKU, phGood.ID); err != nil {
+				return err
+			}
+		}
 		if err := txPhysicalGoodRepo.Create(ctx, phGood); err != nil {
 			return fmt.Errorf("failed to create physical good: %w", err)
 		}
@@ -396,25 +546,44 @@ func (s *service) Create(ctx context.Context, req *physicalgoodmodel.CreateReque
 // making it available in the catalog.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
+// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+// Publishing an already published physical good is a no-op.
 func (s *service) Publish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "physical_good", "Publish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txPhysicalGoodRepo.SetInStock(ctx, id, true)
+		products, err := txProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, []string{id}, "id", "price", "is_free", "in_stock")
 		if err != nil {
-			return fmt.Errorf("failed to publish physical good: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+			return fmt.Errorf("failed to get physical good product: %w", err)
+		}
+		if len(products) == 0 {
+			return fmt.Errorf("%w: physical good product", ErrNotFound)
+		}
+		productsPublished := true
+		for _, product := range products {
+			if !product.IsPublishable() {
+				return fmt.Errorf("%w: product %s", ErrCannotPublishFree, product.ID)
+			}
+			productsPublished = productsPublished && product.InStock
 		}
-		ra, err = txProductRepo.SetInStockByDetailsID(ctx, id, true)
+		res, err := txPhysicalGoodRepo.SetInStock(ctx, id, true)
 		if err != nil {
+			return fmt.Errorf("failed to publish physical good: %w", err)
+		}
+		if res.NotFound() {
+			return fmt.Errorf("%w: physical good", ErrNotFound)
+		}
+		if res.AlreadyInState && productsPublished {
+			return nil
+		}
+		if _, err := txProductRepo.PublishByDetailsID(ctx, id, s.now()); err != nil {
 			return fmt.Errorf("failed to publish physical good product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
 		return nil
 	})
@@ -425,24 +594,30 @@ func (s *service) Publish(ctx context.Context, id string) error {
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
+// Unpublishing an already unpublished physical good is a no-op.
 func (s *service) Unpublish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "physical_good", "Unpublish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txPhysicalGoodRepo.SetInStock(ctx, id, false)
+		res, err := txPhysicalGoodRepo.SetInStock(ctx, id, false)
 		if err != nil {
 			return fmt.Errorf("failed to unpublish physical good: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
-		ra, err = txProductRepo.SetInStockByDetailsID(ctx, id, false)
+		if res.NotFound() {
+			return fmt.Errorf("%w: physical good", ErrNotFound)
+		}
+		productRes, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now())
 		if err != nil {
 			return fmt.Errorf("failed to unpublish physical good product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		if productRes.NotFound() {
+			return fmt.Errorf("%w: physical good product", ErrNotFound)
 		}
 		return nil
 	})
@@ -455,8 +630,12 @@ func (s *service) Unpublish(ctx context.Context, id string) error {
 // Returns a map containing the fields that were actually changed, nested under "physical_good" and "product" keys.
 // Example: `{"physical_good": {"name": "new name"}, "product": {"price": 99.99}}`
 // Returns an error if the request payload is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
+// the SKU is taken by another physical good (ErrDuplicateSKU), or a database/internal error occurs.
 func (s *service) Update(ctx context.Context, req *physicalgoodmodel.UpdateRequest) (map[string]any, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "Update", tracing.ID(req.ID))
+	defer span.End()
+
+	req.Sanitize()
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -498,8 +677,42 @@ func (s *service) Update(ctx context.Context, req *physicalgoodmodel.UpdateReque
 		if req.ShippingRequired != nil && *req.ShippingRequired != phGood.ShippingRequired {
 			updates["shipping_required"] = *req.ShippingRequired
 		}
-		if len(req.Tags) > 0 {
-			updates["tags"] = req.Tags
+		if req.SKU != nil && *req.SKU != phGood.SKU {
+			if *req.SKU != "" {
+				if err := checkSKUAvailable(ctx, txPhysicalGoodRepo, *req.SKU, phGood.ID); err != nil {
+					return err
+				}
+			}
+			updates["sku"] = *req.SKU
+		}
+		if req.WeightGrams != nil && *req.WeightGrams != phGood.WeightGrams {
+			updates["weight_grams"] = *req.WeightGrams
+		}
+		if req.Dimensions != nil && *req.Dimensions != phGood.Dimensions {
+			updates["dimensions_length"] = req.Dimensions.Length
+			updates["dimensions_width"] = req.Dimensions.Width
+			updates["dimensions_height"] = req.Dimensions.Height
+		}
+
+		// Shipping data is checked against the record as it will be after the update, but only if the update
+		// touches it, so records created before weight and dimensions existed stay editable.
+		if req.ShippingRequired != nil || req.WeightGrams != nil || req.Dimensions != nil {
+			shippingRequired, weight, dimensions := phGood.ShippingRequired, phGood.WeightGrams, phGood.Dimensions
+			if req.ShippingRequired != nil {
+				shippingRequired = *req.ShippingRequired
+			}
+			if req.WeightGrams != nil {
+				weight = *req.WeightGrams
+			}
+			if req.Dimensions != nil {
+				dimensions = *req.Dimensions
+			}
+			if shippingRequired && (weight <= 0 || !dimensions.IsComplete()) {
+				return fmt.Errorf("%w: weight and dimensions are required if shipping is required", ErrInvalidArgument)
+			}
+		}
+		if tags := req.TagsUpdate(); tags != nil && !slices.Equal(tags, phGood.Tags) {
+			updates["tags"] = tags
 		}
 		if req.Price != nil && *req.Price != product.Price {
 			productUpdates["price"] = *req.Price
@@ -528,68 +741,215 @@ func (s *service) Update(ctx context.Context, req *physicalgoodmodel.UpdateReque
 
 // Delete performs a soft-delete of a physical good and its related product record.
 // It also unpublishes both records, meaning they must be manually published again after restoration.
+// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 //
-// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
-func (s *service) Delete(ctx context.Context, id string) error {
+func (s *service) Delete(ctx context.Context, id, reason string) error {
+	ctx, span := tracing.Start(ctx, "physical_good", "Delete")
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
+	if utf8.RuneCountInString(reason) > 255 {
+		return fmt.Errorf("%w: reason must be at most 255 characters long", ErrInvalidArgument)
+	}
 	return s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
+		return s.deleteInTx(ctx, txPhysicalGoodRepo, txProductRepo, id, reason)
+	})
+}
 
-		// Check if the record exists first (including unpublished, but not soft-deleted)
-		if _, err := txPhysicalGoodRepo.GetWithUnpublished(ctx, id); err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("%w: %w", ErrNotFound, err)
+// deleteInTx unpublishes and soft-deletes a single physical good with its products using
+// transaction-bound repositories. It is shared by Delete and DeleteBatch.
+func (s *service) deleteInTx(ctx context.Context, txPhysicalGoodRepo physicalgoodrepo.Repository, txProductRepo productrepo.Repository, id, reason string) error {
+	// Check if the record exists first (including unpublished, but not soft-deleted)
+	if _, err := txPhysicalGoodRepo.GetWithUnpublished(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return fmt.Errorf("failed to retrieve physical good: %w", err)
+	}
+
+	// Unpublish all instances
+	if _, err := txPhysicalGoodRepo.SetInStock(ctx, id, false); err != nil {
+		return fmt.Errorf("failed to unpublish physical good: %w", err)
+	}
+	productRes, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now())
+	if err != nil {
+		return fmt.Errorf("failed to unpublish physical good product: %w", err)
+	}
+	if productRes.NotFound() {
+		return fmt.Errorf("%w: physical good product", ErrNotFound)
+	}
+	// Delete
+	// The product is deleted explicitly below, the physical good hook must not cascade.
+	if _, err = txPhysicalGoodRepo.Delete(common.WithoutCascade(ctx), id, reason); err != nil {
+		return fmt.Errorf("failed to delete physical good: %w", err)
+	}
+	if _, err = txProductRepo.DeleteByDetailsID(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete physical good product: %w", err)
+	}
+	return nil
+}
+
+// DeleteBatch performs a soft-delete of multiple physical goods and their related product records
+// in a single transaction, applying the same guards as Delete to each record.
+// All IDs are validated before anything is deleted. Duplicate IDs are deleted once.
+// If any record fails, the whole batch is rolled back.
+//
+// Returns the number of deleted physical goods.
+// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) DeleteBatch(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "DeleteBatch")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%w: no IDs provided", ErrInvalidArgument)
+	}
+	seen := make(map[string]struct{}, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid physical good ID %q: %w", ErrInvalidArgument, id, err)
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	var deleted int64
+	err := s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		for _, id := range uniqueIDs {
+			if err := s.deleteInTx(ctx, txPhysicalGoodRepo, txProductRepo, id, ""); err != nil {
+				return fmt.Errorf("failed to delete physical good %s: %w", id, err)
 			}
-			return fmt.Errorf("failed to retrieve physical good: %w", err)
+			deleted++
 		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
+}
 
-		// Unpublish all instances
-		if _, err := txPhysicalGoodRepo.SetInStock(ctx, id, false); err != nil {
-			return fmt.Errorf("failed to unpublish physical good: %w", err)
+// AdjustStockBatch applies signed deltas to the amount of each physical good (including unpublished ones)
+// in a single transaction. Adjustments are keyed by physical good ID, zero deltas are skipped.
+// If any adjustment fails, the whole batch is rolled back.
+//
+// Returns the number of adjusted physical goods.
+// Returns an error if any ID is invalid or no adjustments are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+// any amount would become negative (ErrInsufficientStock), or a database/internal error occurs.
+func (s *service) AdjustStockBatch(ctx context.Context, adjustments map[string]int) (int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "AdjustStockBatch")
+	defer span.End()
+
+	if len(adjustments) == 0 {
+		return 0, fmt.Errorf("%w: no adjustments provided", ErrInvalidArgument)
+	}
+	ids := make([]string, 0, len(adjustments))
+	for id := range adjustments {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid physical good ID %q: %w", ErrInvalidArgument, id, err)
 		}
-		ra, err := txProductRepo.SetInStockByDetailsID(ctx, id, false)
+		ids = append(ids, id)
+	}
+	// Apply in a stable order so concurrent batches lock rows consistently.
+	slices.Sort(ids)
+
+	var applied int64
+	err := s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
+
+		goods, err := txPhysicalGoodRepo.ListWithUnpublishedByIDs(ctx, ids...)
 		if err != nil {
-			return fmt.Errorf("failed to unpublish physical good product: %w", err)
+			return fmt.Errorf("failed to retrieve physical goods: %w", err)
 		}
-		if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
-		}
-		// Delete
-		if _, err = txPhysicalGoodRepo.Delete(ctx, id); err != nil {
-			return fmt.Errorf("failed to delete physical good: %w", err)
+		amounts := make(map[string]int, len(goods))
+		for _, good := range goods {
+			amounts[good.ID] = good.Amount
 		}
-		if _, err = txProductRepo.DeleteByDetailsID(ctx, id); err != nil {
-			return fmt.Errorf("failed to delete physical good product: %w", err)
+
+		for _, id := range ids {
+			amount, ok := amounts[id]
+			if !ok {
+				return fmt.Errorf("%w: %s", ErrNotFound, id)
+			}
+			delta := adjustments[id]
+			if delta == 0 {
+				continue
+			}
+			if amount+delta < 0 {
+				return fmt.Errorf("%w: %s has %d, cannot adjust by %d", ErrInsufficientStock, id, amount, delta)
+			}
+			ra, err := txPhysicalGoodRepo.AdjustAmount(ctx, id, delta)
+			if err != nil {
+				return fmt.Errorf("failed to adjust physical good amount: %w", err)
+			}
+			if ra == 0 {
+				// The amount changed concurrently since it was read.
+				return fmt.Errorf("%w: %s cannot be adjusted by %d", ErrInsufficientStock, id, delta)
+			}
+			applied += ra
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return applied, nil
 }
 
 // DeletePermanent performs a complete delete of a physical good and its related product record.
+// If force is true, the related product count is not checked and whatever records exist are deleted.
+// This is meant for cleaning up inconsistent data.
+// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
-func (s *service) DeletePermanent(ctx context.Context, id string) error {
+// the products are featured (ErrHasDependents), or a database/internal error occurs.
+func (s *service) DeletePermanent(ctx context.Context, id string, force bool) error {
+	ctx, span := tracing.Start(ctx, "physical_good", "DeletePermanent", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
 
-		ra, err := txPhysicalGoodRepo.DeletePermanent(ctx, id)
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsID(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete physical good featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsID(ctx, id); err != nil {
+			return fmt.Errorf("failed to count physical good featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+
+		detailsRA, err := txPhysicalGoodRepo.DeletePermanent(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to delete physical good: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		} else if detailsRA == 0 && !force {
+			return ErrNotFound
 		}
-		ra, err = txProductRepo.DeletePermanentByDetailsID(ctx, id)
+		ra, err := txProductRepo.DeletePermanentByDetailsID(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to delete physical good product: %w", err)
+		} else if force {
+			if detailsRA == 0 && ra == 0 {
+				return ErrNotFound
+			}
 		} else if ra == 0 {
 			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
@@ -597,6 +957,67 @@ func (s *service) DeletePermanent(ctx context.Context, id string) error {
 	})
 }
 
+// PurgeDeletedBefore permanently deletes all physical goods soft-deleted before the given time,
+// together with their product records.
+// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
+//
+// Returns the number of purged physical goods.
+// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+func (s *service) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "PurgeDeletedBefore")
+	defer span.End()
+
+	var purged int64
+	err := s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
+
+		ids, err := txPhysicalGoodRepo.ListDeletedIDsBefore(ctx, before)
+		if err != nil {
+			return fmt.Errorf("failed to list deleted physical goods: %w", err)
+		} else if len(ids) == 0 {
+			return nil
+		}
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsIDs(ctx, ids); err != nil {
+				return fmt.Errorf("failed to delete physical good featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to count physical good featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+		if purged, err = txPhysicalGoodRepo.DeletePermanentByIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete physical goods: %w", err)
+		}
+		if _, err = txProductRepo.DeletePermanentByDetailsIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete physical good products: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// CountDeletedBefore counts physical goods soft-deleted before cutoff, that is the physical goods
+// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+//
+// Returns an error if a database/internal error occurs.
+func (s *service) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "CountDeletedBefore")
+	defer span.End()
+
+	count, err := s.PhysicalGoodRepo.CountDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted physical goods: %w", err)
+	}
+	return count, nil
+}
+
 // Restore performs a restore of a physical good and its related product record.
 // Physical good and its related product record are not being published. This should be
 // done manually.
@@ -604,24 +1025,78 @@ func (s *service) DeletePermanent(ctx context.Context, id string) error {
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Restore(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "physical_good", "Restore", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txPhysicalGoodRepo.Restore(ctx, id)
-		if err != nil {
-			return fmt.Errorf("failed to restore physical good: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		return s.restoreInTx(ctx, txPhysicalGoodRepo, txProductRepo, id)
+	})
+}
+
+// restoreInTx restores a single physical good with its product using transaction-bound repositories.
+// It is shared by Restore and RestoreBatch.
+func (s *service) restoreInTx(ctx context.Context, txPhysicalGoodRepo physicalgoodrepo.Repository, txProductRepo productrepo.Repository, id string) error {
+	ra, err := txPhysicalGoodRepo.Restore(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore physical good: %w", err)
+	} else if ra == 0 {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	ra, err = txProductRepo.RestoreByDetailsID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore physical good: %w", err)
+	} else if ra == 0 {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return nil
+}
+
+// RestoreBatch restores multiple soft-deleted physical goods and their related product records
+// in a single transaction, applying the same guards as Restore to each record.
+// All IDs are validated before anything is restored. Duplicate IDs are restored once.
+// If any record fails, the whole batch is rolled back.
+//
+// Returns the number of restored physical goods.
+// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) RestoreBatch(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "physical_good", "RestoreBatch")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%w: no IDs provided", ErrInvalidArgument)
+	}
+	seen := make(map[string]struct{}, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid physical good ID %q: %w", ErrInvalidArgument, id, err)
 		}
-		ra, err = txProductRepo.RestoreByDetailsID(ctx, id)
-		if err != nil {
-			return fmt.Errorf("failed to restore physical good: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	var restored int64
+	err := s.PhysicalGoodRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txPhysicalGoodRepo := s.PhysicalGoodRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		for _, id := range uniqueIDs {
+			if err := s.restoreInTx(ctx, txPhysicalGoodRepo, txProductRepo, id); err != nil {
+				return fmt.Errorf("failed to restore physical good %s: %w", id, err)
+			}
+			restored++
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return restored, nil
 }
@@ -21,11 +21,15 @@ import (
 	"context"
 	"errors"
 	"reflect"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
 	physicalgood "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	"github.com/mikhail5545/product-service-go/internal/models/product"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/database/featured_mock"
 	physicalgoodmock "github.com/mikhail5545/product-service-go/internal/test/database/physical_good_mock"
 	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
 
@@ -42,7 +46,7 @@ func TestService_Get(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	physicalGoodID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -66,6 +70,7 @@ func TestService_Get(t *testing.T) {
 		PhysicalGood: mockPhysicalGood,
 		Price:        mockProduct.Price,
 		ProductID:    mockProduct.ID,
+		Available:    true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -127,7 +132,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	physicalGoodID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -151,6 +156,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 		PhysicalGood: mockPhysicalGood,
 		Price:        mockProduct.Price,
 		ProductID:    mockProduct.ID,
+		Available:    true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -212,7 +218,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	physicalGoodID := "d17081f3-4a56-4d00-b63e-f942537a702f"
 
@@ -236,6 +242,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 		PhysicalGood: mockPhysicalGood,
 		Price:        mockProduct.Price,
 		ProductID:    mockProduct.ID,
+		Available:    true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -297,7 +304,7 @@ func TestService_List(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	phg1ID := "0d9828df-c57b-4629-9729-8c9641598e17"
 	phg2ID := "a33845f2-1c3c-4397-9380-7ecdb1d8c853"
@@ -335,11 +342,13 @@ func TestService_List(t *testing.T) {
 			PhysicalGood: &mockPhysicalGoods[0],
 			Price:        mockProducts[0].Price,
 			ProductID:    mockProducts[0].ID,
+			Available:    true,
 		},
 		{
 			PhysicalGood: &mockPhysicalGoods[1],
 			Price:        mockProducts[1].Price,
 			ProductID:    mockProducts[1].ID,
+			Available:    true,
 		},
 	}
 
@@ -395,7 +404,7 @@ func TestService_ListDeleted(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	phg1ID := "0d9828df-c57b-4629-9729-8c9641598e17"
 	phg2ID := "a33845f2-1c3c-4397-9380-7ecdb1d8c853"
@@ -433,11 +442,13 @@ func TestService_ListDeleted(t *testing.T) {
 			PhysicalGood: &mockPhysicalGoods[0],
 			Price:        mockProducts[0].Price,
 			ProductID:    mockProducts[0].ID,
+			Available:    true,
 		},
 		{
 			PhysicalGood: &mockPhysicalGoods[1],
 			Price:        mockProducts[1].Price,
 			ProductID:    mockProducts[1].ID,
+			Available:    true,
 		},
 	}
 
@@ -493,7 +504,7 @@ func TestService_ListUnpublished(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	phg1ID := "0d9828df-c57b-4629-9729-8c9641598e17"
 	phg2ID := "a33845f2-1c3c-4397-9380-7ecdb1d8c853"
@@ -531,11 +542,13 @@ func TestService_ListUnpublished(t *testing.T) {
 			PhysicalGood: &mockPhysicalGoods[0],
 			Price:        mockProducts[0].Price,
 			ProductID:    mockProducts[0].ID,
+			Available:    true,
 		},
 		{
 			PhysicalGood: &mockPhysicalGoods[1],
 			Price:        mockProducts[1].Price,
 			ProductID:    mockProducts[1].ID,
+			Available:    true,
 		},
 	}
 
@@ -591,7 +604,7 @@ func TestSesrvice_Create(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	createReq := physicalgood.CreateRequest{
 		Name:             "Physical good name",
@@ -681,6 +694,22 @@ func TestSesrvice_Create(t *testing.T) {
 		assert.ErrorIs(t, err, ErrInvalidArgument)
 	})
 
+	t.Run("zero or negative price", func(t *testing.T) {
+		for _, price := range []float32{0, -10} {
+			// Arrange
+			req := createReq
+			req.Price = price
+
+			// Act
+			resp, err := testService.Create(context.Background(), &req)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+			assert.ErrorContains(t, err, "price")
+			assert.Nil(t, resp)
+		}
+	})
+
 	t.Run("db error", func(t *testing.T) {
 		// Arrange
 		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
@@ -708,7 +737,7 @@ func TestService_Publish(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	goodID := "0d9828df-c57b-4629-9729-8c9641598e17"
 
@@ -724,6 +753,8 @@ func TestService_Publish(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
+		publishedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		testService.(*service).now = func() time.Time { return publishedAt }
 		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
 
@@ -731,8 +762,10 @@ func TestService_Publish(t *testing.T) {
 		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, true).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), goodID, publishedAt).Return(int64(1), nil)
 
 		// Act
 		err := testService.Publish(context.Background(), goodID)
@@ -741,7 +774,28 @@ func TestService_Publish(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
-	t.Run("invalid UUID", func(t *testing.T) {
+	t.Run("already published", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25, InStock: true}}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), goodID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already published physical good with unpublished product", func(t *testing.T) {
 		// Arrange
 		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -750,10 +804,24 @@ func TestService_Publish(t *testing.T) {
 		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		ivnalidID := "invalid-UUID"
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), goodID, gomock.Any()).Return(int64(1), nil)
+
+		// Act
+		err := testService.Publish(context.Background(), goodID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.Publish(context.Background(), ivnalidID)
+		err := testService.Publish(context.Background(), invalidID)
 
 		// Assert
 		assert.Error(t, err)
@@ -769,7 +837,31 @@ func TestService_Publish(t *testing.T) {
 		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(int64(0), nil).AnyTimes()
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(database.SetResult{}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), goodID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
 		err := testService.Publish(context.Background(), goodID)
@@ -789,13 +881,61 @@ func TestService_Publish(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		dbErr := errors.New("database error")
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(int64(0), dbErr)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(database.SetResult{}, dbErr)
+
+		// Act
+		err := testService.Publish(context.Background(), goodID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("zero price not marked free", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		productID := uuid.New().String()
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: productID, Price: 0}}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
 		err := testService.Publish(context.Background(), goodID)
 
 		// Assert
 		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrCannotPublishFree)
+		assert.Contains(t, err.Error(), productID)
+	})
+
+	t.Run("zero price marked free", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{goodID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 0, IsFree: true}}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), goodID, gomock.Any()).Return(int64(1), nil)
+
+		// Act
+		err := testService.Publish(context.Background(), goodID)
+
+		// Assert
+		assert.NoError(t, err)
 	})
 }
 
@@ -806,7 +946,7 @@ func TestService_Unpublish(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	goodID := "0d9828df-c57b-4629-9729-8c9641598e17"
 
@@ -829,8 +969,8 @@ func TestService_Unpublish(t *testing.T) {
 		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false).Return(int64(1), nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
 
 		// Act
 		err := testService.Unpublish(context.Background(), goodID)
@@ -839,7 +979,7 @@ func TestService_Unpublish(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
-	t.Run("invalid UUID", func(t *testing.T) {
+	t.Run("already unpublished", func(t *testing.T) {
 		// Arrange
 		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -848,10 +988,22 @@ func TestService_Unpublish(t *testing.T) {
 		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		ivnalidID := "invalid-UUID"
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false, gomock.Any()).Return(database.SetResult{AlreadyInState: true}, nil)
+
+		// Act
+		err := testService.Unpublish(context.Background(), goodID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.Unpublish(context.Background(), ivnalidID)
+		err := testService.Unpublish(context.Background(), invalidID)
 
 		// Assert
 		assert.Error(t, err)
@@ -867,7 +1019,28 @@ func TestService_Unpublish(t *testing.T) {
 		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(int64(0), nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(database.SetResult{}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Unpublish(context.Background(), goodID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false, gomock.Any()).Return(database.SetResult{}, nil)
 
 		// Act
 		err := testService.Unpublish(context.Background(), goodID)
@@ -887,13 +1060,14 @@ func TestService_Unpublish(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		dbErr := errors.New("database error")
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(int64(0), dbErr)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(database.SetResult{}, dbErr)
 
 		// Act
 		err := testService.Unpublish(context.Background(), goodID)
 
 		// Assert
 		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
 	})
 }
 
@@ -904,7 +1078,7 @@ func TestService_Update(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	goodID := "0d9828df-c57b-4629-9729-8c9641598e17"
 
@@ -1015,6 +1189,68 @@ func TestService_Update(t *testing.T) {
 		}
 	})
 
+	t.Run("zero values are applied", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		tagged := *mockPhysicalGood
+		tagged.Tags = []string{"old", "tags"}
+		tagged.SKU = "OLD-SKU"
+		tagged.WeightGrams = 250
+		mockTxPhysicalGoodRepo.EXPECT().Get(gomock.Any(), goodID).Return(&tagged, nil)
+		mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), goodID, gomock.Any()).Return(mockProduct, nil)
+
+		var goodUpdates map[string]any
+		mockTxPhysicalGoodRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, _ *physicalgood.PhysicalGood, u map[string]any) {
+				goodUpdates = u
+			}).Return(int64(1), nil)
+
+		zeroAmount, zeroWeight, emptySKU := 0, 0, ""
+
+		// Act
+		updates, err := testService.Update(context.Background(), &physicalgood.UpdateRequest{
+			ID:          goodID,
+			Amount:      &zeroAmount,
+			WeightGrams: &zeroWeight,
+			SKU:         &emptySKU,
+			Tags:        []string{},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"amount": 0, "weight_grams": 0, "sku": "", "tags": []string{}}, goodUpdates)
+		assert.Equal(t, goodUpdates, updates["physical_good"])
+	})
+
+	t.Run("nil tags are kept", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		tagged := *mockPhysicalGood
+		tagged.Tags = []string{"old", "tags"}
+		mockTxPhysicalGoodRepo.EXPECT().Get(gomock.Any(), goodID).Return(&tagged, nil)
+		mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), goodID, gomock.Any()).Return(mockProduct, nil)
+		mockTxPhysicalGoodRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		updates, err := testService.Update(context.Background(), &physicalgood.UpdateRequest{ID: goodID})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, updates["physical_good"])
+	})
+
 	t.Run("invalid request payload", func(t *testing.T) {
 		// Arrange
 		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
@@ -1103,7 +1339,7 @@ func TestService_Delete(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	goodID := "0d9828df-c57b-4629-9729-8c9641598e17"
 
@@ -1127,13 +1363,13 @@ func TestService_Delete(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), goodID).Return(&physicalgood.PhysicalGood{}, nil)
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false).Return(int64(1), nil)
-		mockTxPhysicalGoodRepo.EXPECT().Delete(gomock.Any(), goodID).Return(int64(1), nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().Delete(gomock.Any(), goodID, "").Return(int64(1), nil)
 		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), goodID).Return(int64(1), nil)
 
 		// Act
-		err := testService.Delete(context.Background(), goodID)
+		err := testService.Delete(context.Background(), goodID, "")
 
 		// Assert
 		assert.NoError(t, err)
@@ -1144,7 +1380,7 @@ func TestService_Delete(t *testing.T) {
 		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.Delete(context.Background(), invalidID)
+		err := testService.Delete(context.Background(), invalidID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1163,7 +1399,7 @@ func TestService_Delete(t *testing.T) {
 		mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), goodID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
-		err := testService.Delete(context.Background(), goodID)
+		err := testService.Delete(context.Background(), goodID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1180,13 +1416,13 @@ func TestService_Delete(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), goodID).Return(&physicalgood.PhysicalGood{}, nil)
-		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false).Return(int64(1), nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), goodID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), goodID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
 		dbErr := errors.New("database error")
-		mockTxPhysicalGoodRepo.EXPECT().Delete(gomock.Any(), goodID).Return(int64(0), dbErr)
+		mockTxPhysicalGoodRepo.EXPECT().Delete(gomock.Any(), goodID, "").Return(int64(0), dbErr)
 
 		// Act
-		err := testService.Delete(context.Background(), goodID)
+		err := testService.Delete(context.Background(), goodID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1200,7 +1436,13 @@ func TestService_DeletePermanent(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	// Nothing is featured, so the dependents check always passes.
+	mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo).AnyTimes()
+	mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, mockFeaturedRepo)
 
 	goodID := "0d9828df-c57b-4629-9729-8c9641598e17"
 
@@ -1227,7 +1469,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), goodID).Return(int64(1), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), goodID)
+		err := testService.DeletePermanent(context.Background(), goodID, false)
 
 		// Assert
 		assert.NoError(t, err)
@@ -1238,7 +1480,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), invalidID)
+		err := testService.DeletePermanent(context.Background(), invalidID, false)
 
 		// Assert
 		assert.Error(t, err)
@@ -1257,7 +1499,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxPhysicalGoodRepo.EXPECT().DeletePermanent(gomock.Any(), goodID).Return(int64(0), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), goodID)
+		err := testService.DeletePermanent(context.Background(), goodID, false)
 
 		// Assert
 		assert.Error(t, err)
@@ -1277,13 +1519,106 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxPhysicalGoodRepo.EXPECT().DeletePermanent(gomock.Any(), goodID).Return(int64(0), dbErr)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), goodID)
+		err := testService.DeletePermanent(context.Background(), goodID, false)
 
 		// Assert
 		assert.Error(t, err)
 	})
 }
 
+func TestService_DeletePermanent_Dependents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, mockFeaturedRepo)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	goodID := uuid.New().String()
+
+	t.Run("featured product blocks delete", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), goodID).Return(int64(1), nil)
+		mockTxPhysicalGoodRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), goodID, false)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrHasDependents)
+	})
+
+	t.Run("cascade removes featured entries", func(t *testing.T) {
+		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), goodID).Return(int64(1), nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+		mockTxPhysicalGoodRepo.EXPECT().DeletePermanent(gomock.Any(), goodID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), goodID).Return(int64(1), nil)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), goodID, false)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("cascade error", func(t *testing.T) {
+		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		dbErr := errors.New("database error")
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), goodID).Return(int64(0), dbErr)
+		mockTxPhysicalGoodRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), goodID, false)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
 func TestService_Restore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1291,7 +1626,7 @@ func TestService_Restore(t *testing.T) {
 	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockPhysicalGoodRepo, mockProductRepo)
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
 
 	goodID := "0d9828df-c57b-4629-9729-8c9641598e17"
 
@@ -1374,3 +1709,557 @@ func TestService_Restore(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestService_DeleteBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	id1, id2 := uuid.New().String(), uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		for _, id := range []string{id1, id2} {
+			mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), id).Return(&physicalgood.PhysicalGood{ID: id}, nil)
+			mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), id, false).Return(database.SetResult{Affected: 1}, nil)
+			mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+			mockTxPhysicalGoodRepo.EXPECT().Delete(gomock.Any(), id, "").Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), id).Return(int64(1), nil)
+		}
+
+		// Act
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, id2, id1})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, "invalid-UUID"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Equal(t, int64(0), deleted)
+	})
+
+	t.Run("guard failure rolls back batch", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), id1).Return(&physicalgood.PhysicalGood{ID: id1}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), id1, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id1, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().Delete(gomock.Any(), id1, "").Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), id1).Return(int64(1), nil)
+
+		mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), id2).Return(&physicalgood.PhysicalGood{ID: id2}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().SetInStock(gomock.Any(), id2, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id2, false, gomock.Any()).Return(database.SetResult{}, nil)
+
+		// Act
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, id2})
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), deleted)
+	})
+}
+
+func TestService_RestoreBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	id1, id2 := uuid.New().String(), uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		for _, id := range []string{id1, id2} {
+			mockTxPhysicalGoodRepo.EXPECT().Restore(gomock.Any(), id).Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id).Return(int64(1), nil)
+		}
+
+		// Act
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, id2, id1})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), restored)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, "invalid-UUID"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Equal(t, int64(0), restored)
+	})
+
+	t.Run("product count mismatch rolls back batch", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().Restore(gomock.Any(), id1).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id1).Return(int64(1), nil)
+
+		mockTxPhysicalGoodRepo.EXPECT().Restore(gomock.Any(), id2).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id2).Return(int64(0), nil)
+
+		// Act
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, id2})
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), restored)
+	})
+}
+
+func TestService_AdjustStockBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	// Adjustments are applied in ID order.
+	ids := []string{uuid.New().String(), uuid.New().String(), uuid.New().String()}
+	slices.Sort(ids)
+	goods := []physicalgood.PhysicalGood{
+		{ID: ids[0], Amount: 5},
+		{ID: ids[1], Amount: 2},
+		{ID: ids[2], Amount: 0},
+	}
+
+	t.Run("all valid", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().ListWithUnpublishedByIDs(gomock.Any(), ids[0], ids[1], ids[2]).Return(goods, nil)
+		mockTxPhysicalGoodRepo.EXPECT().AdjustAmount(gomock.Any(), ids[0], -5).Return(int64(1), nil)
+		mockTxPhysicalGoodRepo.EXPECT().AdjustAmount(gomock.Any(), ids[2], 10).Return(int64(1), nil)
+
+		// Act
+		applied, err := testService.AdjustStockBatch(context.Background(), map[string]int{ids[0]: -5, ids[1]: 0, ids[2]: 10})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), applied)
+	})
+
+	t.Run("one would go negative", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().ListWithUnpublishedByIDs(gomock.Any(), ids[0], ids[1], ids[2]).Return(goods, nil)
+		mockTxPhysicalGoodRepo.EXPECT().AdjustAmount(gomock.Any(), ids[0], 1).Return(int64(1), nil)
+		// ids[1] fails the check and ids[2] is never reached, the transaction is rolled back.
+
+		// Act
+		applied, err := testService.AdjustStockBatch(context.Background(), map[string]int{ids[0]: 1, ids[1]: -3, ids[2]: 1})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+		assert.Equal(t, int64(0), applied)
+	})
+
+	t.Run("amount changed concurrently", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().ListWithUnpublishedByIDs(gomock.Any(), ids[1]).Return(goods[1:2], nil)
+		mockTxPhysicalGoodRepo.EXPECT().AdjustAmount(gomock.Any(), ids[1], -2).Return(int64(0), nil)
+
+		// Act
+		_, err := testService.AdjustStockBatch(context.Background(), map[string]int{ids[1]: -2})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+
+		unknownID := uuid.New().String()
+		mockTxPhysicalGoodRepo.EXPECT().ListWithUnpublishedByIDs(gomock.Any(), gomock.Any()).Return(goods[:1], nil)
+		mockTxPhysicalGoodRepo.EXPECT().AdjustAmount(gomock.Any(), ids[0], 1).Return(int64(1), nil).MaxTimes(1)
+
+		// Act
+		applied, err := testService.AdjustStockBatch(context.Background(), map[string]int{ids[0]: 1, unknownID: 1})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, unknownID)
+		assert.Equal(t, int64(0), applied)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		// Act
+		_, emptyErr := testService.AdjustStockBatch(context.Background(), nil)
+		_, idErr := testService.AdjustStockBatch(context.Background(), map[string]int{"invalid-UUID": 1})
+
+		// Assert
+		assert.ErrorIs(t, emptyErr, ErrInvalidArgument)
+		assert.ErrorIs(t, idErr, ErrInvalidArgument)
+	})
+}
+
+func TestService_SKU(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	sku := "WH-1042.b"
+	createReq := physicalgood.CreateRequest{
+		Name:             "Physical good name",
+		ShortDescription: "Physical good short description",
+		Price:            25,
+		Amount:           3,
+		SKU:              sku,
+	}
+
+	t.Run("create with SKU", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().GetBySKU(gomock.Any(), sku).Return(nil, gorm.ErrRecordNotFound)
+		var created *physicalgood.PhysicalGood
+		mockTxPhysicalGoodRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, g *physicalgood.PhysicalGood) { created = g }).Return(nil)
+		mockTxProductRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		// Act
+		resp, err := testService.Create(context.Background(), &createReq)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.NotNil(t, created) {
+			assert.Equal(t, sku, created.SKU)
+			assert.Equal(t, created.ID, resp.ID)
+		}
+	})
+
+	t.Run("create with duplicate SKU", func(t *testing.T) {
+		// Arrange
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().GetBySKU(gomock.Any(), sku).Return(&physicalgood.PhysicalGood{ID: uuid.New().String(), SKU: sku}, nil)
+
+		// Act
+		resp, err := testService.Create(context.Background(), &createReq)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDuplicateSKU)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("create with malformed SKU", func(t *testing.T) {
+		// Arrange
+		req := createReq
+		req.SKU = "-bad sku"
+
+		// Act
+		_, err := testService.Create(context.Background(), &req)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("update to duplicate SKU", func(t *testing.T) {
+		// Arrange
+		goodID := uuid.New().String()
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().Get(gomock.Any(), goodID).Return(&physicalgood.PhysicalGood{ID: goodID}, nil)
+		mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), goodID, "id", "price").Return(&product.Product{ID: uuid.New().String(), Price: 25}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().GetBySKU(gomock.Any(), sku).Return(&physicalgood.PhysicalGood{ID: uuid.New().String(), SKU: sku}, nil)
+
+		// Act
+		_, err := testService.Update(context.Background(), &physicalgood.UpdateRequest{ID: goodID, SKU: &sku})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDuplicateSKU)
+	})
+
+	t.Run("get by SKU", func(t *testing.T) {
+		// Arrange
+		goodID := uuid.New().String()
+		productID := uuid.New().String()
+		mockPhysicalGoodRepo.EXPECT().GetBySKU(gomock.Any(), sku).Return(&physicalgood.PhysicalGood{ID: goodID, SKU: sku}, nil)
+		mockProductRepo.EXPECT().SelectWithUnpublishedByDetailsID(gomock.Any(), goodID, "id", "price", "available_from", "available_until", "first_published_at", "last_published_at").
+			Return(&product.Product{ID: productID, Price: 25}, nil)
+
+		// Act
+		details, err := testService.GetBySKU(context.Background(), sku)
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.NotNil(t, details) {
+			assert.Equal(t, goodID, details.ID)
+			assert.Equal(t, sku, details.SKU)
+			assert.Equal(t, productID, details.ProductID)
+			assert.Equal(t, float32(25), details.Price)
+		}
+	})
+
+	t.Run("get by SKU not found", func(t *testing.T) {
+		// Arrange
+		mockPhysicalGoodRepo.EXPECT().GetBySKU(gomock.Any(), "UNKNOWN-1").Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		_, err := testService.GetBySKU(context.Background(), "UNKNOWN-1")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("get by malformed SKU", func(t *testing.T) {
+		// Act
+		_, err := testService.GetBySKU(context.Background(), "")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_ShippingData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockPhysicalGoodRepo, mockProductRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockPhysicalGoodRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	dimensions := physicalgood.Dimensions{Length: 300, Width: 200, Height: 50}
+	newCreateRequest := func(shippingRequired bool) physicalgood.CreateRequest {
+		return physicalgood.CreateRequest{
+			Name:             "Physical good name",
+			ShortDescription: "Physical good short description",
+			Price:            25,
+			Amount:           3,
+			ShippingRequired: shippingRequired,
+		}
+	}
+	expectCreate := func() *physicalgood.PhysicalGood {
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		created := &physicalgood.PhysicalGood{}
+		mockTxPhysicalGoodRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, g *physicalgood.PhysicalGood) { *created = *g }).Return(nil)
+		mockTxProductRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+		return created
+	}
+
+	t.Run("shipping required without dimensions", func(t *testing.T) {
+		// Arrange
+		req := newCreateRequest(true)
+		req.WeightGrams = 500
+		req.Dimensions = physicalgood.Dimensions{Length: 300, Width: 200}
+
+		// Act
+		_, err := testService.Create(context.Background(), &req)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.ErrorContains(t, err, "dimensions")
+	})
+
+	t.Run("shipping required without weight", func(t *testing.T) {
+		// Arrange
+		req := newCreateRequest(true)
+		req.Dimensions = dimensions
+
+		// Act
+		_, err := testService.Create(context.Background(), &req)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.ErrorContains(t, err, "weight_grams")
+	})
+
+	t.Run("shipping required with dimensions", func(t *testing.T) {
+		// Arrange
+		req := newCreateRequest(true)
+		req.WeightGrams = 500
+		req.Dimensions = dimensions
+		created := expectCreate()
+
+		// Act
+		_, err := testService.Create(context.Background(), &req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 500, created.WeightGrams)
+		assert.Equal(t, dimensions, created.Dimensions)
+	})
+
+	t.Run("shipping not required without dimensions", func(t *testing.T) {
+		// Arrange
+		req := newCreateRequest(false)
+		created := expectCreate()
+
+		// Act
+		_, err := testService.Create(context.Background(), &req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, physicalgood.Dimensions{}, created.Dimensions)
+	})
+
+	t.Run("negative dimensions", func(t *testing.T) {
+		// Arrange
+		req := newCreateRequest(false)
+		req.Dimensions = physicalgood.Dimensions{Length: -1}
+
+		// Act
+		_, err := testService.Create(context.Background(), &req)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("update to shipping required checks stored dimensions", func(t *testing.T) {
+		// Arrange
+		goodID := uuid.New().String()
+		shippingRequired := true
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().Get(gomock.Any(), goodID).Return(&physicalgood.PhysicalGood{ID: goodID, WeightGrams: 500}, nil)
+		mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), goodID, "id", "price").Return(&product.Product{ID: uuid.New().String(), Price: 25}, nil)
+
+		// Act
+		_, err := testService.Update(context.Background(), &physicalgood.UpdateRequest{ID: goodID, ShippingRequired: &shippingRequired})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("update dimensions of shipped good", func(t *testing.T) {
+		// Arrange
+		goodID := uuid.New().String()
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxPhysicalGoodRepo.EXPECT().Get(gomock.Any(), goodID).
+			Return(&physicalgood.PhysicalGood{ID: goodID, ShippingRequired: true, WeightGrams: 500}, nil)
+		mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), goodID, "id", "price").Return(&product.Product{ID: uuid.New().String(), Price: 25}, nil)
+		mockTxPhysicalGoodRepo.EXPECT().Update(gomock.Any(), gomock.Any(), map[string]any{
+			"dimensions_length": 300,
+			"dimensions_width":  200,
+			"dimensions_height": 50,
+		}).Return(int64(1), nil)
+
+		// Act
+		updates, err := testService.Update(context.Background(), &physicalgood.UpdateRequest{ID: goodID, Dimensions: &dimensions})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, updates["physical_good"], 3)
+	})
+}
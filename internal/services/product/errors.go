@@ -25,4 +25,12 @@ var (
 	ErrInvalidArgument = errors.New("invalid argument")
 	// ErrNotFound product not found error
 	ErrNotFound = errors.New("product not found")
+	// ErrDetailsNotFound product details record not found error
+	ErrDetailsNotFound = errors.New("product details not found")
+	// ErrNotPublished product is unpublished and can't be sold error
+	ErrNotPublished = errors.New("product is not published")
+	// ErrProductInUse product is still referenced by its details record error
+	ErrProductInUse = errors.New("product is referenced by its details record")
+	// ErrDetailsHasProduct details record of a single-product type already has a product error
+	ErrDetailsHasProduct = errors.New("product details already have a product")
 )
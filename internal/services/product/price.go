@@ -0,0 +1,174 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
+	seminarrepo "github.com/mikhail5545/product-service-go/internal/database/seminar"
+	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+)
+
+// PriceResolver computes the price to charge for products of one details type.
+type PriceResolver interface {
+	// ResolvePrice returns the price to charge for product p at the given time.
+	// Returns gorm.ErrRecordNotFound if a details record the price depends on is missing or soft-deleted.
+	ResolvePrice(ctx context.Context, p *productmodel.Product, now time.Time) (float32, error)
+	// ResolvePrices returns the prices to charge for products at the given time, keyed by product ID,
+	// with a fixed number of queries regardless of len(products). Products whose price cannot be
+	// resolved because a details record is missing or soft-deleted are left out.
+	ResolvePrices(ctx context.Context, products []productmodel.Product, now time.Time) (map[string]float32, error)
+}
+
+// fixedPriceResolver resolves the price of details types sold at the single product price:
+// courses, training sessions and physical goods.
+type fixedPriceResolver struct{}
+
+func (fixedPriceResolver) ResolvePrice(_ context.Context, p *productmodel.Product, _ time.Time) (float32, error) {
+	return p.Price, nil
+}
+
+func (fixedPriceResolver) ResolvePrices(_ context.Context, products []productmodel.Product, _ time.Time) (map[string]float32, error) {
+	prices := make(map[string]float32, len(products))
+	for _, p := range products {
+		prices[p.ID] = p.Price
+	}
+	return prices, nil
+}
+
+// seminarPriceResolver resolves the date-dependent seminar price. An early or late product is
+// replaced with the one [seminarmodel.Seminar.PriceRoleAt] picks, an early or late surcharge with
+// the matching surcharge. Any other product, or one whose replacement is missing, keeps its own price.
+type seminarPriceResolver struct {
+	products productrepo.Repository
+	seminars seminarrepo.Repository
+}
+
+func (r seminarPriceResolver) ResolvePrice(ctx context.Context, p *productmodel.Product, now time.Time) (float32, error) {
+	seminar, err := r.seminars.GetWithUnpublished(ctx, p.DetailsID)
+	if err != nil {
+		return 0, err
+	}
+	currentID := currentSeminarProductID(seminar, p.ID, now)
+	if currentID == nil {
+		return p.Price, nil
+	}
+	current, err := r.products.SelectWithUnpublishedByIDs(ctx, []string{*currentID}, "id", "price")
+	if err != nil {
+		return 0, fmt.Errorf("failed to retrieve current seminar product: %w", err)
+	}
+	if len(current) == 0 {
+		return p.Price, nil
+	}
+	return current[0].Price, nil
+}
+
+func (r seminarPriceResolver) ResolvePrices(ctx context.Context, products []productmodel.Product, now time.Time) (map[string]float32, error) {
+	detailsIDs := make([]string, 0, len(products))
+	for _, p := range products {
+		if !slices.Contains(detailsIDs, p.DetailsID) {
+			detailsIDs = append(detailsIDs, p.DetailsID)
+		}
+	}
+	seminars, err := r.seminars.ListWithUnpublishedByIDs(ctx, detailsIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve seminars: %w", err)
+	}
+	seminarMap := make(map[string]*seminarmodel.Seminar, len(seminars))
+	for i := range seminars {
+		seminarMap[seminars[i].ID] = &seminars[i]
+	}
+
+	prices := make(map[string]float32, len(products))
+	// replaced maps products priced by another product to the ID of that product.
+	replaced := make(map[string]string)
+	var currentIDs []string
+	for _, p := range products {
+		seminar, ok := seminarMap[p.DetailsID]
+		if !ok {
+			continue
+		}
+		prices[p.ID] = p.Price
+		if currentID := currentSeminarProductID(seminar, p.ID, now); currentID != nil {
+			replaced[p.ID] = *currentID
+			if !slices.Contains(currentIDs, *currentID) {
+				currentIDs = append(currentIDs, *currentID)
+			}
+		}
+	}
+	if len(currentIDs) == 0 {
+		return prices, nil
+	}
+	current, err := r.products.SelectWithUnpublishedByIDs(ctx, currentIDs, "id", "price")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve current seminar products: %w", err)
+	}
+	currentPrices := make(map[string]float32, len(current))
+	for _, p := range current {
+		currentPrices[p.ID] = p.Price
+	}
+	for productID, currentID := range replaced {
+		if price, ok := currentPrices[currentID]; ok {
+			prices[productID] = price
+		}
+	}
+	return prices, nil
+}
+
+// currentSeminarProductID returns the ID of the seminar product whose price is charged at now
+// for the seminar product with given productID, or nil if the product keeps its own price.
+func currentSeminarProductID(seminar *seminarmodel.Seminar, productID string, now time.Time) *string {
+	var role seminarmodel.ProductRole
+	for _, candidate := range seminar.ProductRoles() {
+		if *seminar.ProductID(candidate) == productID {
+			role = candidate
+		}
+	}
+	priceRole := seminar.PriceRoleAt(now)
+	switch role {
+	case seminarmodel.RoleEarly, seminarmodel.RoleLate:
+		role = priceRole
+	case seminarmodel.RoleEarlySurcharge, seminarmodel.RoleLateSurcharge:
+		role = seminarmodel.RoleEarlySurcharge
+		if priceRole == seminarmodel.RoleLate {
+			role = seminarmodel.RoleLateSurcharge
+		}
+	default:
+		return nil
+	}
+	currentID := seminar.ProductID(role)
+	if currentID == nil || *currentID == productID {
+		return nil
+	}
+	return currentID
+}
+
+// newPriceResolvers returns the resolver of every details type.
+func newPriceResolvers(pr productrepo.Repository, sr seminarrepo.Repository) map[string]PriceResolver {
+	return map[string]PriceResolver{
+		"course":           fixedPriceResolver{},
+		"seminar":          seminarPriceResolver{products: pr, seminars: sr},
+		"training_session": fixedPriceResolver{},
+		"physical_good":    fixedPriceResolver{},
+	}
+}
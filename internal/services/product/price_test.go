@@ -0,0 +1,285 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package product
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/models/product"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/database/seminar_mock"
+	"github.com/stretchr/testify/assert"
+	gomock "go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestService_ResolvePrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, mockSeminarRepo, nil, nil, nil)
+
+	lateCutoff := time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+	beforeCutoff, afterCutoff := lateCutoff.Add(-time.Hour), lateCutoff.Add(time.Hour)
+
+	reservationProductID := uuid.New().String()
+	earlyProductID, lateProductID := uuid.New().String(), uuid.New().String()
+	earlySurchargeProductID, lateSurchargeProductID := uuid.New().String(), uuid.New().String()
+	seminar := &seminarmodel.Seminar{
+		ID:                      uuid.New().String(),
+		ReservationProductID:    &reservationProductID,
+		EarlyProductID:          &earlyProductID,
+		LateProductID:           &lateProductID,
+		EarlySurchargeProductID: &earlySurchargeProductID,
+		LateSurchargeProductID:  &lateSurchargeProductID,
+		LatePaymentDate:         lateCutoff,
+	}
+	seminarProduct := func(id string, price float32) *product.Product {
+		return &product.Product{ID: id, DetailsID: seminar.ID, DetailsType: "seminar", Price: price, InStock: true}
+	}
+
+	for _, detailsType := range []string{"course", "training_session", "physical_good"} {
+		t.Run(detailsType+" product price", func(t *testing.T) {
+			// Arrange
+			p := &product.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: detailsType, Price: 42, InStock: true}
+			mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), p.ID).Return(p, nil)
+
+			// Act
+			price, err := testService.ResolvePrice(context.Background(), p.ID, afterCutoff)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, float32(42), price)
+		})
+	}
+
+	tests := []struct {
+		name      string
+		product   *product.Product
+		now       time.Time
+		currentID string
+		want      float32
+	}{
+		{name: "early product before the cutoff", product: seminarProduct(earlyProductID, 100), now: beforeCutoff, want: 100},
+		{name: "early product after the cutoff", product: seminarProduct(earlyProductID, 100), now: afterCutoff, currentID: lateProductID, want: 150},
+		{name: "late product before the cutoff", product: seminarProduct(lateProductID, 150), now: beforeCutoff, currentID: earlyProductID, want: 100},
+		{name: "late surcharge before the cutoff", product: seminarProduct(lateSurchargeProductID, 30), now: beforeCutoff, currentID: earlySurchargeProductID, want: 20},
+		{name: "reservation keeps its own price", product: seminarProduct(reservationProductID, 10), now: afterCutoff, want: 10},
+	}
+	for _, tt := range tests {
+		t.Run("seminar "+tt.name, func(t *testing.T) {
+			// Arrange
+			mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), tt.product.ID).Return(tt.product, nil)
+			mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminar.ID).Return(seminar, nil)
+			if tt.currentID != "" {
+				mockProductRepo.EXPECT().
+					SelectWithUnpublishedByIDs(gomock.Any(), []string{tt.currentID}, "id", "price").
+					Return([]product.Product{{ID: tt.currentID, Price: tt.want}}, nil)
+			}
+
+			// Act
+			price, err := testService.ResolvePrice(context.Background(), tt.product.ID, tt.now)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, price)
+		})
+	}
+
+	t.Run("unpublished product", func(t *testing.T) {
+		// Arrange
+		p := &product.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "course", Price: 42}
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), p.ID).Return(p, nil)
+
+		// Act
+		price, err := testService.ResolvePrice(context.Background(), p.ID, afterCutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotPublished)
+		assert.Zero(t, price)
+	})
+
+	t.Run("seminar details not found", func(t *testing.T) {
+		// Arrange
+		p := seminarProduct(earlyProductID, 100)
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), p.ID).Return(p, nil)
+		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminar.ID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		price, err := testService.ResolvePrice(context.Background(), p.ID, afterCutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDetailsNotFound)
+		assert.Zero(t, price)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		id := uuid.New().String()
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), id).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		_, err := testService.ResolvePrice(context.Background(), id, afterCutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		p := seminarProduct(earlyProductID, 100)
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), p.ID).Return(p, nil)
+		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminar.ID).Return(seminar, nil)
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), []string{lateProductID}, "id", "price").
+			Return(nil, dbErr)
+
+		// Act
+		_, err := testService.ResolvePrice(context.Background(), p.ID, afterCutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		// Act
+		_, err := testService.ResolvePrice(context.Background(), "invalid-uuid", afterCutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_ResolvePrices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, mockSeminarRepo, nil, nil, nil)
+
+	lateCutoff := time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC)
+	afterCutoff := lateCutoff.Add(time.Hour)
+
+	reservationProductID := uuid.New().String()
+	earlyProductID, lateProductID := uuid.New().String(), uuid.New().String()
+	seminar := seminarmodel.Seminar{
+		ID:                   uuid.New().String(),
+		ReservationProductID: &reservationProductID,
+		EarlyProductID:       &earlyProductID,
+		LateProductID:        &lateProductID,
+		LatePaymentDate:      lateCutoff,
+	}
+	earlyProduct := product.Product{ID: earlyProductID, DetailsID: seminar.ID, DetailsType: "seminar", Price: 100, InStock: true}
+	reservationProduct := product.Product{ID: reservationProductID, DetailsID: seminar.ID, DetailsType: "seminar", Price: 10, InStock: true}
+	orphanedProduct := product.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "seminar", Price: 70, InStock: true}
+	sessionProduct := product.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "training_session", Price: 50, InStock: true}
+	unpublishedProduct := product.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "training_session", Price: 60}
+
+	t.Run("mixed cart", func(t *testing.T) {
+		// Arrange
+		missingID := uuid.New().String()
+		ids := []string{earlyProduct.ID, sessionProduct.ID, missingID, reservationProduct.ID, orphanedProduct.ID}
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), ids, "id", "details_id", "details_type", "price", "in_stock").
+			Return([]product.Product{earlyProduct, sessionProduct, reservationProduct, orphanedProduct}, nil)
+		// One query for all seminars and one for the products setting their current prices.
+		mockSeminarRepo.EXPECT().
+			ListWithUnpublishedByIDs(gomock.Any(), seminar.ID, orphanedProduct.DetailsID).
+			Return([]seminarmodel.Seminar{seminar}, nil).
+			Times(1)
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), []string{lateProductID}, "id", "price").
+			Return([]product.Product{{ID: lateProductID, Price: 150}}, nil).
+			Times(1)
+
+		// Act
+		prices, unresolved, err := testService.ResolvePrices(context.Background(), ids, afterCutoff)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float32{
+			earlyProduct.ID:       150,
+			sessionProduct.ID:     50,
+			reservationProduct.ID: 10,
+		}, prices)
+		assert.Equal(t, []string{missingID, orphanedProduct.ID}, unresolved)
+	})
+
+	t.Run("unpublished product is unresolved", func(t *testing.T) {
+		// Arrange
+		ids := []string{unpublishedProduct.ID, sessionProduct.ID}
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), ids, "id", "details_id", "details_type", "price", "in_stock").
+			Return([]product.Product{unpublishedProduct, sessionProduct}, nil)
+
+		// Act
+		prices, unresolved, err := testService.ResolvePrices(context.Background(), ids, afterCutoff)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float32{sessionProduct.ID: 50}, prices)
+		assert.Equal(t, []string{unpublishedProduct.ID}, unresolved)
+	})
+
+	t.Run("seminar db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		ids := []string{earlyProduct.ID}
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), ids, "id", "details_id", "details_type", "price", "in_stock").
+			Return([]product.Product{earlyProduct}, nil)
+		mockSeminarRepo.EXPECT().ListWithUnpublishedByIDs(gomock.Any(), seminar.ID).Return(nil, dbErr)
+
+		// Act
+		prices, unresolved, err := testService.ResolvePrices(context.Background(), ids, afterCutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, prices)
+		assert.Nil(t, unresolved)
+	})
+
+	t.Run("empty cart", func(t *testing.T) {
+		// Act
+		prices, unresolved, err := testService.ResolvePrices(context.Background(), nil, afterCutoff)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, prices)
+		assert.Empty(t, unresolved)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		// Act
+		_, _, err := testService.ResolvePrices(context.Background(), []string{earlyProduct.ID, "invalid-uuid"}, afterCutoff)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
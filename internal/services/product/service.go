@@ -22,10 +22,25 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	courserepo "github.com/mikhail5545/product-service-go/internal/database/course"
+	physicalgoodrepo "github.com/mikhail5545/product-service-go/internal/database/physical_good"
 	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
+	seminarrepo "github.com/mikhail5545/product-service-go/internal/database/seminar"
+	trainingsessionrepo "github.com/mikhail5545/product-service-go/internal/database/training_session"
+	"github.com/mikhail5545/product-service-go/internal/events"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
+	coursemodel "github.com/mikhail5545/product-service-go/internal/models/course"
+	physicalgoodmodel "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -88,23 +103,206 @@ type Service interface {
 	// Returns a slice of ProductDetails, the total count of such records, and an error if one occurs.
 	// Returns an error if a database/internal error occures.
 	ListUnpublished(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error)
-	// List retrieves a paginated list of all published and not soft-deleted product records with specified DetailsType.
+	// ListWithUnpublished retrieves a paginated list of all not soft-deleted product records,
+	// including unpublished (out of stock) ones. It is meant for admin listings only.
 	//
-	// Returns a slice of ProductDetails, the total count of such records, and an error if one occurs.
+	// Returns a slice of products, the total count of such records, and an error if one occurs.
+	// Returns an error if a database/internal error occures.
+	ListWithUnpublished(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error)
+	// ListNeverPublished retrieves a paginated list of unpublished (but not soft-deleted) product records
+	// that have never been published, drafts that never went live.
+	//
+	// Returns a slice of products, the total count of such records, and an error if one occurs.
+	// Returns an error if a database/internal error occures.
+	ListNeverPublished(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error)
+	// ListByType retrieves a paginated list of all published and not soft-deleted product records with specified DetailsType,
+	// without joining their details.
+	//
+	// Returns a slice of products, the total count of such records, and an error if one occurs.
+	// Returns an error if detailsType is not one of [productmodel.DetailsTypes] (ErrInvalidArgument)
+	// or a database/internal error occurs.
+	ListByType(ctx context.Context, detailsType string, limit, offset int) ([]productmodel.Product, int64, error)
+	// ListUpdatedSince retrieves up to limit product records positioned strictly after the cursor,
+	// ordered by UpdatedAt and ID ascending. It is meant for downstream caches polling for changes,
+	// so unpublished and soft-deleted records are included with their State filled.
+	// A cursor with an empty ID includes records updated exactly at its UpdatedAt.
+	//
+	// Returns a slice of Product and the watermark to pass as the cursor on the next poll: the position
+	// of the last returned record, or after itself if nothing has changed.
+	// Returns an error if limit is not positive (ErrInvalidArgument) or a database/internal error occures.
+	ListUpdatedSince(ctx context.Context, after productmodel.ChangeCursor, limit int) ([]productmodel.Product, productmodel.ChangeCursor, error)
+	// ListModifiedSince retrieves a paginated list of all published and not soft-deleted product records
+	// updated strictly after updatedSince, an RFC3339 timestamp. Unlike ListUpdatedSince it keeps the
+	// default list order and offset pagination like the 'updated_since' filter of GET /products.
+	//
+	// Returns a slice of Product, the total count of such records, and an error if one occurs.
+	// Returns an error if updatedSince is not a valid RFC3339 timestamp (ErrInvalidArgument)
+	// or a database/internal error occures.
+	ListModifiedSince(ctx context.Context, updatedSince string, limit, offset int) ([]productmodel.Product, int64, error)
+	// ListFiltered retrieves a paginated list of all not soft-deleted product records matching filter.
+	// Unpublished products are included unless filter.InStock is set.
+	//
+	// Returns a slice of Product, the total count of such records, and an error if one occurs.
+	// Returns an error if the filter is invalid (ErrInvalidArgument) or a database/internal error occures.
+	ListFiltered(ctx context.Context, filter productmodel.ProductFilter, limit, offset int) ([]productmodel.Product, int64, error)
+	// CountFiltered returns the total amount of not soft-deleted product records matching filter,
+	// the same amount ListFiltered reports for it, without retrieving the records.
+	//
+	// Returns an error if the filter is invalid (ErrInvalidArgument) or a database/internal error occures.
+	CountFiltered(ctx context.Context, filter productmodel.ProductFilter) (int64, error)
+	// ListAvailable retrieves a paginated list of all published and not soft-deleted product records
+	// which sale window contains the current time.
+	//
+	// Returns a slice of Product, the total count of such records, and an error if one occurs.
 	// Returns an error if a database/internal error occures.
-	ListByDetailsType(ctx context.Context, detailsType string, limit, offset int) ([]productmodel.Product, int64, error)
+	ListAvailable(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error)
+	// ListByOwnerAllStates retrieves all product records of a details owner regardless of their state:
+	// published, unpublished and soft-deleted. Each product has its State set.
+	//
+	// Returns an error if the details ID is invalid (ErrInvalidArgument) or a database/internal error occurs.
+	ListByOwnerAllStates(ctx context.Context, detailsID string) ([]productmodel.Product, error)
+	// Create creates a new unpublished product directly for the details record req.DetailsID of type req.DetailsType
+	// and publishes [events.ProductCreated]. A publish failure only fails the call with a strict publisher,
+	// the product stays created either way.
+	//
+	// Returns the created product.
+	// Returns an error if the request payload is invalid or DetailsType is not one of [productmodel.DetailsTypes]
+	// (ErrInvalidArgument) or a database/internal error occurs.
+	Create(ctx context.Context, req *productmodel.AddRequest) (*productmodel.Product, error)
+	// SetAvailability sets the sale window of a single product. Nil bounds leave the window open on that side.
+	//
+	// Returns an error if the request payload is invalid or AvailableFrom is after AvailableUntil (ErrInvalidArgument),
+	// the record is not found (ErrNotFound), or a database/internal error occures.
+	SetAvailability(ctx context.Context, req *productmodel.SetAvailabilityRequest) error
+	// CountByTag returns the number of product details records (including soft-deleted and unpublished) tagged with tag,
+	// which is the number of records [Service.RemoveTagGlobally] would update. Details records with several
+	// products, like seminars, are counted once.
+	//
+	// Returns an error if the tag is empty (ErrInvalidArgument) or a database/internal error occures.
+	CountByTag(ctx context.Context, tag string) (int64, error)
+	// RemoveTagGlobally strips tag from the details of every product (including soft-deleted and unpublished)
+	// in a single transaction. Other tags are left intact.
+	//
+	// Returns the number of updated records.
+	// Returns an error if the tag is empty (ErrInvalidArgument) or a database/internal error occures.
+	RemoveTagGlobally(ctx context.Context, tag string) (int64, error)
+	// RenameTag replaces tag from with tag to in the details of every product (including soft-deleted and unpublished)
+	// in a single transaction. Products already tagged with to keep a single copy of it.
+	//
+	// Returns the number of updated records.
+	// Returns an error if either tag is empty or both are equal (ErrInvalidArgument), or a database/internal error occures.
+	RenameTag(ctx context.Context, from, to string) (int64, error)
+	// SwapPrices exchanges the prices of two products (including unpublished) of the same details type
+	// in a single transaction. It is meant for A/B price tests between variants.
+	//
+	// Returns an error if either ID is invalid or both are equal, the products have different details types (ErrInvalidArgument),
+	// either record is not found (ErrNotFound), or a database/internal error occures.
+	SwapPrices(ctx context.Context, idA, idB string) error
+	// Related retrieves up to limit published products that share tags with the published product with given id,
+	// most shared tags first. The product itself is never included.
+	//
+	// Returns an error if the ID is invalid or limit is not positive (ErrInvalidArgument), the record is not found (ErrNotFound),
+	// or a database/internal error occures.
+	Related(ctx context.Context, id string, limit int) ([]productmodel.Product, error)
+	// GetDetailsBatch resolves published and not soft-deleted products to the records they sell.
+	// Details records are fetched with one query per details type (including unpublished ones).
+	//
+	// Returns the products in the order of productIDs, unknown IDs are left out. Orphaned products,
+	// whose details record is missing or soft-deleted, are returned with no details set.
+	// Returns an error if any ID is invalid (ErrInvalidArgument) or a database/internal error occures.
+	GetDetailsBatch(ctx context.Context, productIDs []string) ([]productmodel.ProductWithDetails, error)
+	// GetOwner resolves a not soft-deleted product (including unpublished) to the record it sells,
+	// normalized to the same shape for every details type. InStock is set only if both the product
+	// and its details record are published. Price is resolved for the current time like [Service.ResolvePrice]
+	// does, unpublished products included.
+	//
+	// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
+	// its details record is missing or soft-deleted (ErrDetailsNotFound), or a database/internal error occures.
+	GetOwner(ctx context.Context, id string) (*productmodel.ProductOwner, error)
+	// ResolvePrice returns the price to charge at now for the published and not soft-deleted product
+	// with given productID, computed by the [PriceResolver] of its details type. It is the one place
+	// checkout integrations should take prices from.
+	//
+	// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
+	// the product is unpublished and can't be sold (ErrNotPublished), a details record the price depends on
+	// is missing or soft-deleted (ErrDetailsNotFound), or a database/internal error occures.
+	ResolvePrice(ctx context.Context, productID string, now time.Time) (float32, error)
+	// ResolvePrices is the batch form of [Service.ResolvePrice] for a whole cart. Products are loaded
+	// with one query and prices are resolved with a fixed number of queries per details type.
+	//
+	// Returns the resolved prices keyed by product ID and, in the order of productIDs, the IDs that could not
+	// be resolved because the product is not found, is unpublished, or a details record its price depends on
+	// is missing or soft-deleted.
+	// Returns an error if any ID is invalid (ErrInvalidArgument) or a database/internal error occures.
+	ResolvePrices(ctx context.Context, productIDs []string, now time.Time) (map[string]float32, []string, error)
+	// Recategorize moves the product (including unpublished) with given productID to the details record newDetailsID
+	// of type newDetailsType in a single transaction. It is meant for fixing miscategorized products.
+	// A seminar product that still fills one of the seminar's price roles can't be moved, and neither can a product
+	// be moved to a course, training session or physical good that already has one, soft-deleted products included.
+	//
+	// Returns an error if any ID is invalid or newDetailsType is not one of [productmodel.DetailsTypes] (ErrInvalidArgument),
+	// the product is not found (ErrNotFound), the new details record is not found (ErrDetailsNotFound),
+	// the product fills a seminar price role (ErrProductInUse), the new details record already has a product
+	// (ErrDetailsHasProduct) or a database/internal error occures.
+	Recategorize(ctx context.Context, productID, newDetailsType, newDetailsID string) error
+	// SetTagsBatch replaces the tags of the details of every product (including unpublished) with given ids
+	// with tags in a single transaction. It is meant for tagging a whole campaign at once.
+	//
+	// Returns the number of updated details records.
+	// Returns an error if any ID is invalid or tags are invalid (ErrInvalidArgument), any product is not found (ErrNotFound),
+	// or a database/internal error occures.
+	SetTagsBatch(ctx context.Context, ids []string, tags []string) (int64, error)
+	// AddTagsBatch merges tags into the existing tags of the details of every product (including unpublished)
+	// with given ids in a single transaction. Unlike [Service.SetTagsBatch] it never drops tags,
+	// and a tag the details already have is not added twice.
+	//
+	// Returns the number of updated details records.
+	// Returns an error if any ID is invalid or tags are invalid (ErrInvalidArgument), any product is not found (ErrNotFound),
+	// or a database/internal error occures.
+	AddTagsBatch(ctx context.Context, ids []string, tags []string) (int64, error)
 }
 
 // service provides service-layer business logic for product models.
-// It holds [productrepo.Repository] instance
+// It holds [productrepo.Repository] instance and repositories of every details type
 // to perform database operations.
 type service struct {
-	Repo productrepo.Repository
+	Repo                productrepo.Repository
+	CourseRepo          courserepo.Repository
+	SeminarRepo         seminarrepo.Repository
+	TrainingSessionRepo trainingsessionrepo.Repository
+	PhysicalGoodRepo    physicalgoodrepo.Repository
+	// Events receives domain events about products.
+	Events events.Publisher
+	// priceResolvers maps every details type to the [PriceResolver] of its products.
+	priceResolvers map[string]PriceResolver
+	// now is the clock used to evaluate sale windows and prices, replaceable in tests.
+	now func() time.Time
 }
 
-// New creates a new service instance with provided product repository.
-func New(pr productrepo.Repository) Service {
-	return &service{Repo: pr}
+// New creates a new service instance with provided product repository,
+// course, seminar, training session and physical good repositories used to resolve product details
+// and event publisher. A nil publisher drops events.
+func New(
+	pr productrepo.Repository,
+	cr courserepo.Repository,
+	sr seminarrepo.Repository,
+	tsr trainingsessionrepo.Repository,
+	pgr physicalgoodrepo.Repository,
+	publisher events.Publisher,
+) Service {
+	if publisher == nil {
+		publisher = events.Noop{}
+	}
+	return &service{
+		Repo:                pr,
+		CourseRepo:          cr,
+		SeminarRepo:         sr,
+		TrainingSessionRepo: tsr,
+		PhysicalGoodRepo:    pgr,
+		Events:              publisher,
+		priceResolvers:      newPriceResolvers(pr, sr),
+		now:                 time.Now,
+	}
 }
 
 // Get retrieves a single published and not soft-deleted product record from the database.
@@ -113,6 +311,9 @@ func New(pr productrepo.Repository) Service {
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occures.
 func (s *service) Get(ctx context.Context, id string) (*productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "Get", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -132,6 +333,9 @@ func (s *service) Get(ctx context.Context, id string) (*productmodel.Product, er
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occures.
 func (s *service) GetWithDeleted(ctx context.Context, id string) (*productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "GetWithDeleted", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -151,6 +355,9 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*productmodel.
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occures.
 func (s *service) GetWithUnpublished(ctx context.Context, id string) (*productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "GetWithUnpublished", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -171,6 +378,9 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*productmo
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occures.
 func (s *service) GetByDetailsID(ctx context.Context, detailsID string) (*productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "GetByDetailsID", tracing.ID(detailsID))
+	defer span.End()
+
 	if _, err := uuid.Parse(detailsID); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -191,6 +401,9 @@ func (s *service) GetByDetailsID(ctx context.Context, detailsID string) (*produc
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occures.
 func (s *service) GetWithDeletedByDetailsID(ctx context.Context, detailsID string) (*productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "GetWithDeletedByDetailsID", tracing.ID(detailsID))
+	defer span.End()
+
 	if _, err := uuid.Parse(detailsID); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -212,6 +425,9 @@ func (s *service) GetWithDeletedByDetailsID(ctx context.Context, detailsID strin
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occures.
 func (s *service) GetWithUnpublishedByDetailsID(ctx context.Context, detailsID string) (*productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "GetWithUnpublishedByDetailsID", tracing.ID(detailsID))
+	defer span.End()
+
 	if _, err := uuid.Parse(detailsID); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -230,15 +446,11 @@ func (s *service) GetWithUnpublishedByDetailsID(ctx context.Context, detailsID s
 // Returns a slice of ProductDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occures.
 func (s *service) List(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error) {
-	products, err := s.Repo.List(ctx, limit, offset)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
-	}
-	total, err := s.Repo.Count(ctx)
-	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count products: %w", err)
-	}
-	return products, total, nil
+	ctx, span := tracing.Start(ctx, "product", "List")
+	defer span.End()
+
+	published := true
+	return s.list(ctx, productmodel.ProductFilter{InStock: &published}, limit, offset)
 }
 
 // ListDeleted retrieves a paginated list of all soft-deleted product records.
@@ -246,6 +458,9 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]productmodel.P
 // Returns a slice of ProductDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occures.
 func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListDeleted")
+	defer span.End()
+
 	products, err := s.Repo.ListDeleted(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
@@ -262,29 +477,860 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]product
 // Returns a slice of ProductDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occures.
 func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error) {
-	products, err := s.Repo.ListUnpublished(ctx, limit, offset)
+	ctx, span := tracing.Start(ctx, "product", "ListUnpublished")
+	defer span.End()
+
+	unpublished := false
+	return s.list(ctx, productmodel.ProductFilter{InStock: &unpublished}, limit, offset)
+}
+
+// ListNeverPublished retrieves a paginated list of unpublished (but not soft-deleted) product records
+// that have never been published, drafts that never went live.
+//
+// Returns a slice of products, the total count of such records, and an error if one occurs.
+// Returns an error if a database/internal error occures.
+func (s *service) ListNeverPublished(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListNeverPublished")
+	defer span.End()
+
+	return s.list(ctx, productmodel.ProductFilter{NeverPublished: true}, limit, offset)
+}
+
+// ListWithUnpublished retrieves a paginated list of all not soft-deleted product records,
+// including unpublished (out of stock) ones. It is meant for admin listings only.
+//
+// Returns a slice of products, the total count of such records, and an error if one occurs.
+// Returns an error if a database/internal error occures.
+func (s *service) ListWithUnpublished(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListWithUnpublished")
+	defer span.End()
+
+	return s.list(ctx, productmodel.ProductFilter{}, limit, offset)
+}
+
+// ListByType retrieves a paginated list of all published and not soft-deleted product records with specified DetailsType,
+// without joining their details.
+//
+// Returns a slice of products, the total count of such records, and an error if one occurs.
+// Returns an error if detailsType is not one of [productmodel.DetailsTypes] (ErrInvalidArgument)
+// or a database/internal error occurs.
+func (s *service) ListByType(ctx context.Context, detailsType string, limit, offset int) ([]productmodel.Product, int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListByType")
+	defer span.End()
+
+	published := true
+	if !productmodel.IsValidDetailsType(detailsType) {
+		return nil, 0, fmt.Errorf("%w: unknown details type %q", ErrInvalidArgument, detailsType)
+	}
+	return s.list(ctx, productmodel.ProductFilter{DetailsType: detailsType, InStock: &published}, limit, offset)
+}
+
+// ListUpdatedSince retrieves up to limit product records positioned strictly after the cursor,
+// ordered by UpdatedAt and ID ascending. It is meant for downstream caches polling for changes,
+// so unpublished and soft-deleted records are included with their State filled.
+// A cursor with an empty ID includes records updated exactly at its UpdatedAt.
+//
+// Returns a slice of Product and the watermark to pass as the cursor on the next poll: the position
+// of the last returned record, or after itself if nothing has changed.
+// Returns an error if limit is not positive (ErrInvalidArgument) or a database/internal error occures.
+func (s *service) ListUpdatedSince(ctx context.Context, after productmodel.ChangeCursor, limit int) ([]productmodel.Product, productmodel.ChangeCursor, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListUpdatedSince")
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, after, fmt.Errorf("%w: limit must be positive", ErrInvalidArgument)
+	}
+	products, err := s.Repo.ListUpdatedSince(ctx, after, limit)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
+		return nil, after, fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	if len(products) == 0 {
+		return products, after, nil
 	}
-	total, err := s.Repo.CountUnpublished(ctx)
+	return products, products[len(products)-1].Cursor(), nil
+}
+
+// ListModifiedSince retrieves a paginated list of all published and not soft-deleted product records
+// updated strictly after updatedSince, an RFC3339 timestamp. Unlike ListUpdatedSince it keeps the
+// default list order and offset pagination like the 'updated_since' filter of GET /products.
+//
+// Returns a slice of Product, the total count of such records, and an error if one occurs.
+// Returns an error if updatedSince is not a valid RFC3339 timestamp (ErrInvalidArgument)
+// or a database/internal error occures.
+func (s *service) ListModifiedSince(ctx context.Context, updatedSince string, limit, offset int) ([]productmodel.Product, int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListModifiedSince")
+	defer span.End()
+
+	published := true
+	since, err := time.Parse(time.RFC3339Nano, updatedSince)
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to count products: %w", err)
+		return nil, 0, fmt.Errorf("%w: updated_since must be an RFC3339 timestamp: %w", ErrInvalidArgument, err)
 	}
-	return products, total, nil
+	return s.list(ctx, productmodel.ProductFilter{InStock: &published, UpdatedAfter: &since}, limit, offset)
 }
 
-// List retrieves a paginated list of all published and not soft-deleted product records with specified DetailsType.
+// ListFiltered retrieves a paginated list of all not soft-deleted product records matching filter.
+// Unpublished products are included unless filter.InStock is set.
 //
-// Returns a slice of ProductDetails, the total count of such records, and an error if one occurs.
+// Returns a slice of Product, the total count of such records, and an error if one occurs.
+// Returns an error if the filter is invalid (ErrInvalidArgument) or a database/internal error occures.
+func (s *service) ListFiltered(ctx context.Context, filter productmodel.ProductFilter, limit, offset int) ([]productmodel.Product, int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListFiltered")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	return s.list(ctx, filter, limit, offset)
+}
+
+// CountFiltered returns the total amount of not soft-deleted product records matching filter,
+// the same amount ListFiltered reports for it, without retrieving the records.
+//
+// Returns an error if the filter is invalid (ErrInvalidArgument) or a database/internal error occures.
+func (s *service) CountFiltered(ctx context.Context, filter productmodel.ProductFilter) (int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "CountFiltered")
+	defer span.End()
+
+	if err := filter.Validate(); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	total, err := s.Repo.Count(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count products: %w", err)
+	}
+	return total, nil
+}
+
+// ListAvailable retrieves a paginated list of all published and not soft-deleted product records
+// which sale window contains the current time.
+//
+// Returns a slice of Product, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occures.
-func (s *service) ListByDetailsType(ctx context.Context, detailsType string, limit, offset int) ([]productmodel.Product, int64, error) {
-	products, err := s.Repo.ListByDetailsType(ctx, detailsType, limit, offset)
+func (s *service) ListAvailable(ctx context.Context, limit, offset int) ([]productmodel.Product, int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListAvailable")
+	defer span.End()
+
+	published, now := true, s.now()
+	return s.list(ctx, productmodel.ProductFilter{InStock: &published, AvailableAt: &now}, limit, offset)
+}
+
+// list retrieves a page of products matching filter together with their total count.
+// Every listing of not soft-deleted products goes through it, so they share [productmodel.ProductFilter] semantics.
+func (s *service) list(ctx context.Context, filter productmodel.ProductFilter, limit, offset int) ([]productmodel.Product, int64, error) {
+	products, err := s.Repo.List(ctx, filter, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
-	total, err := s.Repo.CountByDetailsType(ctx, detailsType)
+	total, err := s.Repo.Count(ctx, filter)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to count products: %w", err)
 	}
 	return products, total, nil
 }
+
+// Create creates a new unpublished product directly for the details record req.DetailsID of type req.DetailsType
+// and publishes [events.ProductCreated]. A publish failure only fails the call with a strict publisher,
+// the event is published inside the create transaction, so the product is rolled back then.
+//
+// Returns the created product.
+// Returns an error if the request payload is invalid or DetailsType is not one of [productmodel.DetailsTypes]
+// (ErrInvalidArgument) or a database/internal error occurs.
+func (s *service) Create(ctx context.Context, req *productmodel.AddRequest) (*productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "Create")
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	// Validate already covers it, but downstream dispatch on DetailsType must never see an unknown value.
+	if !productmodel.IsValidDetailsType(req.DetailsType) {
+		return nil, fmt.Errorf("%w: unknown details type %q", ErrInvalidArgument, req.DetailsType)
+	}
+
+	product := &productmodel.Product{
+		ID:          uuid.New().String(),
+		Price:       req.Price,
+		IsFree:      req.IsFree,
+		DetailsID:   req.DetailsID,
+		DetailsType: req.DetailsType,
+		InStock:     false,
+	}
+	err := s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		if err := s.Repo.WithTx(tx).Create(ctx, product); err != nil {
+			return fmt.Errorf("failed to create product: %w", err)
+		}
+		if err := s.Events.Publish(ctx, events.New(events.ProductCreated, product.ID, product)); err != nil {
+			return fmt.Errorf("failed to publish product created event: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// SetAvailability sets the sale window of a single product. Nil bounds leave the window open on that side.
+//
+// Returns an error if the request payload is invalid or AvailableFrom is after AvailableUntil (ErrInvalidArgument),
+// the record is not found (ErrNotFound), or a database/internal error occures.
+func (s *service) SetAvailability(ctx context.Context, req *productmodel.SetAvailabilityRequest) error {
+	ctx, span := tracing.Start(ctx, "product", "SetAvailability", tracing.ID(req.ID))
+	defer span.End()
+
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	affected, err := s.Repo.SetAvailability(ctx, req.ID, req.AvailableFrom, req.AvailableUntil)
+	if err != nil {
+		return fmt.Errorf("failed to set product availability: %w", err)
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ListByOwnerAllStates retrieves all product records of a details owner regardless of their state:
+// published, unpublished and soft-deleted. Each product has its State set.
+//
+// Returns an error if the details ID is invalid (ErrInvalidArgument) or a database/internal error occurs.
+func (s *service) ListByOwnerAllStates(ctx context.Context, detailsID string) ([]productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "ListByOwnerAllStates", tracing.ID(detailsID))
+	defer span.End()
+
+	if _, err := uuid.Parse(detailsID); err != nil {
+		return nil, fmt.Errorf("%w: invalid details ID: %w", ErrInvalidArgument, err)
+	}
+	products, err := s.Repo.ListWithDeletedByDetailsID(ctx, detailsID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	for i := range products {
+		products[i].State = products[i].CurrentState()
+	}
+	return products, nil
+}
+
+// CountByTag returns the number of product details records (including soft-deleted and unpublished) tagged with tag,
+// which is the number of records [Service.RemoveTagGlobally] would update. Details records with several
+// products, like seminars, are counted once.
+//
+// Returns an error if the tag is empty (ErrInvalidArgument) or a database/internal error occures.
+func (s *service) CountByTag(ctx context.Context, tag string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "CountByTag")
+	defer span.End()
+
+	if err := validateTag(tag); err != nil {
+		return 0, err
+	}
+	count, err := s.Repo.CountDetailsByTag(ctx, tag)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count details by tag: %w", err)
+	}
+	return count, nil
+}
+
+// RemoveTagGlobally strips tag from the details of every product (including soft-deleted and unpublished)
+// in a single transaction. Other tags are left intact.
+//
+// Returns the number of updated records.
+// Returns an error if the tag is empty (ErrInvalidArgument) or a database/internal error occures.
+func (s *service) RemoveTagGlobally(ctx context.Context, tag string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "RemoveTagGlobally")
+	defer span.End()
+
+	if err := validateTag(tag); err != nil {
+		return 0, err
+	}
+	var removed int64
+	err := s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		affected, err := s.Repo.WithTx(tx).RemoveTag(ctx, tag)
+		if err != nil {
+			return fmt.Errorf("failed to remove tag: %w", err)
+		}
+		removed = affected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
+
+// RenameTag replaces tag from with tag to in the details of every product (including soft-deleted and unpublished)
+// in a single transaction. Products already tagged with to keep a single copy of it.
+//
+// Returns the number of updated records.
+// Returns an error if either tag is empty or both are equal (ErrInvalidArgument), or a database/internal error occures.
+func (s *service) RenameTag(ctx context.Context, from, to string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "RenameTag")
+	defer span.End()
+
+	if err := validateTag(from); err != nil {
+		return 0, err
+	}
+	if err := validateTag(to); err != nil {
+		return 0, err
+	}
+	if from == to {
+		return 0, fmt.Errorf("%w: tags must differ", ErrInvalidArgument)
+	}
+	var renamed int64
+	err := s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		affected, err := s.Repo.WithTx(tx).RenameTag(ctx, from, to)
+		if err != nil {
+			return fmt.Errorf("failed to rename tag: %w", err)
+		}
+		renamed = affected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return renamed, nil
+}
+
+// SwapPrices exchanges the prices of two products (including unpublished) of the same details type
+// in a single transaction. It is meant for A/B price tests between variants.
+//
+// Returns an error if either ID is invalid or both are equal, the products have different details types (ErrInvalidArgument),
+// either record is not found (ErrNotFound), or a database/internal error occures.
+func (s *service) SwapPrices(ctx context.Context, idA, idB string) error {
+	ctx, span := tracing.Start(ctx, "product", "SwapPrices")
+	defer span.End()
+
+	if _, err := uuid.Parse(idA); err != nil {
+		return fmt.Errorf("%w: invalid product ID: %w", ErrInvalidArgument, err)
+	}
+	if _, err := uuid.Parse(idB); err != nil {
+		return fmt.Errorf("%w: invalid product ID: %w", ErrInvalidArgument, err)
+	}
+	if idA == idB {
+		return fmt.Errorf("%w: product IDs must differ", ErrInvalidArgument)
+	}
+	return s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		txRepo := s.Repo.WithTx(tx)
+		products, err := txRepo.SelectWithUnpublishedByIDs(ctx, []string{idA, idB}, "id", "price", "details_type")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve products: %w", err)
+		}
+		if len(products) != 2 {
+			return ErrNotFound
+		}
+		a, b := products[0], products[1]
+		if a.DetailsType != b.DetailsType {
+			return fmt.Errorf("%w: cannot swap prices between %s and %s products", ErrInvalidArgument, a.DetailsType, b.DetailsType)
+		}
+		// Capture both prices up front: Update writes the new values back into the passed model.
+		priceA, priceB := a.Price, b.Price
+		if _, err := txRepo.Update(ctx, &a, map[string]any{"price": priceB}); err != nil {
+			return fmt.Errorf("failed to update product price: %w", err)
+		}
+		if _, err := txRepo.Update(ctx, &b, map[string]any{"price": priceA}); err != nil {
+			return fmt.Errorf("failed to update product price: %w", err)
+		}
+		return nil
+	})
+}
+
+// Recategorize moves the product (including unpublished) with given productID to the details record newDetailsID
+// of type newDetailsType in a single transaction. It is meant for fixing miscategorized products.
+// A seminar product that still fills one of the seminar's price roles can't be moved, and neither can a product
+// be moved to a course, training session or physical good that already has one, soft-deleted products included.
+//
+// Returns an error if any ID is invalid or newDetailsType is not one of [productmodel.DetailsTypes] (ErrInvalidArgument),
+// the product is not found (ErrNotFound), the new details record is not found (ErrDetailsNotFound),
+// the product fills a seminar price role (ErrProductInUse), the new details record already has a product
+// (ErrDetailsHasProduct) or a database/internal error occures.
+func (s *service) Recategorize(ctx context.Context, productID, newDetailsType, newDetailsID string) error {
+	ctx, span := tracing.Start(ctx, "product", "Recategorize", tracing.ID(productID))
+	defer span.End()
+
+	if _, err := uuid.Parse(productID); err != nil {
+		return fmt.Errorf("%w: invalid product ID: %w", ErrInvalidArgument, err)
+	}
+	if _, err := uuid.Parse(newDetailsID); err != nil {
+		return fmt.Errorf("%w: invalid details ID: %w", ErrInvalidArgument, err)
+	}
+	if !productmodel.IsValidDetailsType(newDetailsType) {
+		return fmt.Errorf("%w: unknown details type %q", ErrInvalidArgument, newDetailsType)
+	}
+	return s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		txRepo := s.Repo.WithTx(tx)
+		product, err := txRepo.GetWithUnpublished(ctx, productID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %w", ErrNotFound, err)
+			}
+			return fmt.Errorf("failed to retrieve product: %w", err)
+		}
+		if err := s.checkDetailsExist(ctx, tx, newDetailsType, newDetailsID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %w", ErrDetailsNotFound, err)
+			}
+			return fmt.Errorf("failed to retrieve %s: %w", newDetailsType, err)
+		}
+		if product.DetailsType == "seminar" {
+			seminar, err := s.SeminarRepo.WithTx(tx).GetWithUnpublished(ctx, product.DetailsID)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("failed to retrieve seminar: %w", err)
+			}
+			if err == nil && slices.Contains(seminar.ProductIDs(), product.ID) {
+				return fmt.Errorf("%w: seminar %s", ErrProductInUse, seminar.ID)
+			}
+		}
+		// Seminars reference several products by price role, every other details type has exactly one.
+		if newDetailsType != "seminar" {
+			owned, err := txRepo.SelectWithDeletedByDetailsIDs(ctx, []string{newDetailsID}, "id")
+			if err != nil {
+				return fmt.Errorf("failed to retrieve %s products: %w", newDetailsType, err)
+			}
+			for _, other := range owned {
+				if other.ID != product.ID {
+					return fmt.Errorf("%w: %s %s", ErrDetailsHasProduct, newDetailsType, newDetailsID)
+				}
+			}
+		}
+		if _, err := txRepo.Update(ctx, product, map[string]any{
+			"details_type": newDetailsType,
+			"details_id":   newDetailsID,
+		}); err != nil {
+			return fmt.Errorf("failed to update product: %w", err)
+		}
+		return nil
+	})
+}
+
+// checkDetailsExist retrieves the details record (including unpublished) of given type within tx.
+// Returns gorm.ErrRecordNotFound if it is missing or soft-deleted.
+func (s *service) checkDetailsExist(ctx context.Context, tx *gorm.DB, detailsType, detailsID string) error {
+	var err error
+	switch detailsType {
+	case "course":
+		_, err = s.CourseRepo.WithTx(tx).GetWithUnpublished(ctx, detailsID)
+	case "seminar":
+		_, err = s.SeminarRepo.WithTx(tx).GetWithUnpublished(ctx, detailsID)
+	case "training_session":
+		_, err = s.TrainingSessionRepo.WithTx(tx).GetWithUnpublished(ctx, detailsID)
+	case "physical_good":
+		_, err = s.PhysicalGoodRepo.WithTx(tx).GetWithUnpublished(ctx, detailsID)
+	default:
+		err = fmt.Errorf("unknown details type %q", detailsType)
+	}
+	return err
+}
+
+func validateTag(tag string) error {
+	if strings.TrimSpace(tag) == "" {
+		return fmt.Errorf("%w: tag must not be empty", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// Related retrieves up to limit published products that share tags with the published product with given id,
+// most shared tags first. The product itself is never included.
+//
+// Returns an error if the ID is invalid or limit is not positive (ErrInvalidArgument), the record is not found (ErrNotFound),
+// or a database/internal error occures.
+func (s *service) Related(ctx context.Context, id string, limit int) ([]productmodel.Product, error) {
+	ctx, span := tracing.Start(ctx, "product", "Related", tracing.ID(id))
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	if limit <= 0 {
+		return nil, fmt.Errorf("%w: limit must be positive", ErrInvalidArgument)
+	}
+	if _, err := s.Repo.Get(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve product: %w", err)
+	}
+	products, err := s.Repo.ListRelated(ctx, id, limit, s.now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve related products: %w", err)
+	}
+	return products, nil
+}
+
+// GetDetailsBatch resolves published and not soft-deleted products to the records they sell.
+// Details records are fetched with one query per details type (including unpublished ones).
+//
+// Returns the products in the order of productIDs, unknown IDs are left out. Orphaned products,
+// whose details record is missing or soft-deleted, are returned with no details set.
+// Returns an error if any ID is invalid (ErrInvalidArgument) or a database/internal error occures.
+func (s *service) GetDetailsBatch(ctx context.Context, productIDs []string) ([]productmodel.ProductWithDetails, error) {
+	ctx, span := tracing.Start(ctx, "product", "GetDetailsBatch")
+	defer span.End()
+
+	for _, id := range productIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, fmt.Errorf("%w: invalid product ID %q: %w", ErrInvalidArgument, id, err)
+		}
+	}
+	if len(productIDs) == 0 {
+		return []productmodel.ProductWithDetails{}, nil
+	}
+
+	products, err := s.Repo.ListByIDs(ctx, productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	productMap := make(map[string]*productmodel.Product, len(products))
+	detailsIDs := make(map[string][]string)
+	for i := range products {
+		productMap[products[i].ID] = &products[i]
+		detailsIDs[products[i].DetailsType] = append(detailsIDs[products[i].DetailsType], products[i].DetailsID)
+	}
+
+	resolved := make(map[string]productmodel.ProductWithDetails, len(products))
+	set := func(detailsType, detailsID string, fill func(*productmodel.ProductWithDetails)) {
+		for _, p := range products {
+			if p.DetailsType == detailsType && p.DetailsID == detailsID {
+				d := resolved[p.ID]
+				fill(&d)
+				resolved[p.ID] = d
+			}
+		}
+	}
+	if ids := detailsIDs["course"]; len(ids) > 0 {
+		courses, err := s.CourseRepo.ListWithUnpublishedByIDs(ctx, ids...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve courses: %w", err)
+		}
+		for i := range courses {
+			set("course", courses[i].ID, func(d *productmodel.ProductWithDetails) { d.Course = &courses[i] })
+		}
+	}
+	if ids := detailsIDs["seminar"]; len(ids) > 0 {
+		seminars, err := s.SeminarRepo.ListWithUnpublishedByIDs(ctx, ids...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve seminars: %w", err)
+		}
+		for i := range seminars {
+			set("seminar", seminars[i].ID, func(d *productmodel.ProductWithDetails) { d.Seminar = &seminars[i] })
+		}
+	}
+	if ids := detailsIDs["training_session"]; len(ids) > 0 {
+		sessions, err := s.TrainingSessionRepo.ListWithUnpublishedByIDs(ctx, ids...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve training sessions: %w", err)
+		}
+		for i := range sessions {
+			set("training_session", sessions[i].ID, func(d *productmodel.ProductWithDetails) { d.TrainingSession = &sessions[i] })
+		}
+	}
+	if ids := detailsIDs["physical_good"]; len(ids) > 0 {
+		goods, err := s.PhysicalGoodRepo.ListWithUnpublishedByIDs(ctx, ids...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve physical goods: %w", err)
+		}
+		for i := range goods {
+			set("physical_good", goods[i].ID, func(d *productmodel.ProductWithDetails) { d.PhysicalGood = &goods[i] })
+		}
+	}
+
+	result := make([]productmodel.ProductWithDetails, 0, len(products))
+	for _, id := range productIDs {
+		p, ok := productMap[id]
+		if !ok {
+			continue
+		}
+		details := resolved[id]
+		details.Product = p
+		result = append(result, details)
+	}
+	return result, nil
+}
+
+// GetOwner resolves a not soft-deleted product (including unpublished) to the record it sells,
+// normalized to the same shape for every details type. InStock is set only if both the product
+// and its details record are published. Price is resolved for the current time like [Service.ResolvePrice]
+// does, unpublished products included.
+//
+// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
+// its details record is missing or soft-deleted (ErrDetailsNotFound), or a database/internal error occures.
+func (s *service) GetOwner(ctx context.Context, id string) (*productmodel.ProductOwner, error) {
+	ctx, span := tracing.Start(ctx, "product", "GetOwner", tracing.ID(id))
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	product, err := s.Repo.GetWithUnpublished(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve product: %w", err)
+	}
+
+	owner := &productmodel.ProductOwner{
+		Type:     product.DetailsType,
+		Price:    product.Price,
+		Currency: common.DefaultCurrency(),
+	}
+	var detailsInStock bool
+	switch product.DetailsType {
+	case "course":
+		var course *coursemodel.Course
+		course, err = s.CourseRepo.GetWithUnpublished(ctx, product.DetailsID)
+		if err == nil {
+			owner.Name, detailsInStock, owner.Details = course.Name, course.InStock, course
+		}
+	case "seminar":
+		var seminar *seminarmodel.Seminar
+		seminar, err = s.SeminarRepo.GetWithUnpublished(ctx, product.DetailsID)
+		if err == nil {
+			owner.Name, detailsInStock, owner.Details = seminar.Name, seminar.InStock, seminar
+		}
+	case "training_session":
+		var session *trainingsessionmodel.TrainingSession
+		session, err = s.TrainingSessionRepo.GetWithUnpublished(ctx, product.DetailsID)
+		if err == nil {
+			owner.Name, detailsInStock, owner.Details = session.Name, session.InStock, session
+		}
+	case "physical_good":
+		var good *physicalgoodmodel.PhysicalGood
+		good, err = s.PhysicalGoodRepo.GetWithUnpublished(ctx, product.DetailsID)
+		if err == nil {
+			owner.Name, detailsInStock, owner.Details = good.Name, good.InStock, good
+		}
+	default:
+		return nil, fmt.Errorf("unknown details type %q", product.DetailsType)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %w", ErrDetailsNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to retrieve product details: %w", err)
+	}
+	owner.InStock = product.InStock && detailsInStock
+	if owner.Price, err = s.resolvePrice(ctx, product, s.now()); err != nil {
+		return nil, err
+	}
+	return owner, nil
+}
+
+// ResolvePrice returns the price to charge at now for the published and not soft-deleted product
+// with given productID, computed by the [PriceResolver] of its details type.
+//
+// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
+// the product is unpublished and can't be sold (ErrNotPublished), a details record the price depends on
+// is missing or soft-deleted (ErrDetailsNotFound), or a database/internal error occures.
+func (s *service) ResolvePrice(ctx context.Context, productID string, now time.Time) (float32, error) {
+	ctx, span := tracing.Start(ctx, "product", "ResolvePrice", tracing.ID(productID))
+	defer span.End()
+
+	if _, err := uuid.Parse(productID); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	product, err := s.Repo.GetWithUnpublished(ctx, productID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return 0, fmt.Errorf("failed to retrieve product: %w", err)
+	}
+	if !product.InStock {
+		return 0, ErrNotPublished
+	}
+	return s.resolvePrice(ctx, product, now)
+}
+
+// ResolvePrices is the batch form of [Service.ResolvePrice] for a whole cart. Products are loaded
+// with one query and prices are resolved with a fixed number of queries per details type.
+//
+// Returns the resolved prices keyed by product ID and, in the order of productIDs, the IDs that could not
+// be resolved because the product is not found, is unpublished, or a details record its price depends on
+// is missing or soft-deleted.
+// Returns an error if any ID is invalid (ErrInvalidArgument) or a database/internal error occures.
+func (s *service) ResolvePrices(ctx context.Context, productIDs []string, now time.Time) (map[string]float32, []string, error) {
+	ctx, span := tracing.Start(ctx, "product", "ResolvePrices")
+	defer span.End()
+
+	for _, id := range productIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, nil, fmt.Errorf("%w: invalid product ID %q: %w", ErrInvalidArgument, id, err)
+		}
+	}
+	prices := make(map[string]float32, len(productIDs))
+	if len(productIDs) == 0 {
+		return prices, []string{}, nil
+	}
+
+	products, err := s.Repo.SelectWithUnpublishedByIDs(ctx, productIDs, "id", "details_id", "details_type", "price", "in_stock")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	byType := make(map[string][]productmodel.Product)
+	for _, p := range products {
+		// Unpublished products can't be sold, they are reported as unresolved.
+		if !p.InStock {
+			continue
+		}
+		byType[p.DetailsType] = append(byType[p.DetailsType], p)
+	}
+	for _, detailsType := range productmodel.DetailsTypes {
+		group := byType[detailsType]
+		if len(group) == 0 {
+			continue
+		}
+		resolved, err := s.priceResolvers[detailsType].ResolvePrices(ctx, group, now)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to resolve prices: %w", err)
+		}
+		maps.Copy(prices, resolved)
+	}
+
+	unresolved := []string{}
+	for _, id := range productIDs {
+		if _, ok := prices[id]; !ok && !slices.Contains(unresolved, id) {
+			unresolved = append(unresolved, id)
+		}
+	}
+	return prices, unresolved, nil
+}
+
+// resolvePrice resolves the price of p at now with the resolver of its details type.
+func (s *service) resolvePrice(ctx context.Context, p *productmodel.Product, now time.Time) (float32, error) {
+	resolver, ok := s.priceResolvers[p.DetailsType]
+	if !ok {
+		return 0, fmt.Errorf("unknown details type %q", p.DetailsType)
+	}
+	price, err := resolver.ResolvePrice(ctx, p, now)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, fmt.Errorf("%w: %w", ErrDetailsNotFound, err)
+		}
+		return 0, fmt.Errorf("failed to resolve price: %w", err)
+	}
+	return price, nil
+}
+
+// SetTagsBatch replaces the tags of the details of every product (including unpublished) with given ids
+// with tags in a single transaction. It is meant for tagging a whole campaign at once.
+//
+// Returns the number of updated details records.
+// Returns an error if any ID is invalid or tags are invalid (ErrInvalidArgument), any product is not found (ErrNotFound),
+// or a database/internal error occures.
+func (s *service) SetTagsBatch(ctx context.Context, ids []string, tags []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "SetTagsBatch")
+	defer span.End()
+
+	ids, err := validateBatchIDs(ids)
+	if err != nil {
+		return 0, err
+	}
+	if err := productmodel.ValidateTags(tags); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	var updated int64
+	err = s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		txRepo := s.Repo.WithTx(tx)
+		if err := checkProductsExist(ctx, txRepo, ids); err != nil {
+			return err
+		}
+		affected, err := txRepo.SetTags(ctx, ids, uniqueTags(tags))
+		if err != nil {
+			return fmt.Errorf("failed to set tags: %w", err)
+		}
+		updated = affected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// AddTagsBatch merges tags into the existing tags of the details of every product (including unpublished)
+// with given ids in a single transaction. Unlike [Service.SetTagsBatch] it never drops tags,
+// and a tag the details already have is not added twice.
+//
+// Returns the number of updated details records.
+// Returns an error if any ID is invalid or tags are invalid (ErrInvalidArgument), any product is not found (ErrNotFound),
+// or a database/internal error occures.
+func (s *service) AddTagsBatch(ctx context.Context, ids []string, tags []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "product", "AddTagsBatch")
+	defer span.End()
+
+	ids, err := validateBatchIDs(ids)
+	if err != nil {
+		return 0, err
+	}
+	if err := productmodel.ValidateTags(tags); err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	var updated int64
+	err = s.Repo.DB().Transaction(func(tx *gorm.DB) error {
+		txRepo := s.Repo.WithTx(tx)
+		if err := checkProductsExist(ctx, txRepo, ids); err != nil {
+			return err
+		}
+		affected, err := txRepo.AddTags(ctx, ids, uniqueTags(tags))
+		if err != nil {
+			return fmt.Errorf("failed to add tags: %w", err)
+		}
+		updated = affected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+// validateBatchIDs checks that ids is not empty and holds only valid UUIDs.
+// It returns ids with duplicates removed.
+func validateBatchIDs(ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("%w: no product IDs provided", ErrInvalidArgument)
+	}
+	unique := make([]string, 0, len(ids))
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, fmt.Errorf("%w: invalid product ID %q: %w", ErrInvalidArgument, id, err)
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique, nil
+}
+
+// checkProductsExist returns ErrNotFound naming the first of ids that has no product (including unpublished).
+func checkProductsExist(ctx context.Context, repo productrepo.Repository, ids []string) error {
+	products, err := repo.SelectWithUnpublishedByIDs(ctx, ids, "id")
+	if err != nil {
+		return fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	found := make(map[string]struct{}, len(products))
+	for _, p := range products {
+		found[p.ID] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			return fmt.Errorf("%w: %s", ErrNotFound, id)
+		}
+	}
+	return nil
+}
+
+// uniqueTags returns tags with duplicates removed, keeping the first occurrence of each.
+func uniqueTags(tags []string) []string {
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if !slices.Contains(unique, tag) {
+			unique = append(unique, tag)
+		}
+	}
+	return unique
+}
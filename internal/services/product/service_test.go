@@ -19,17 +19,31 @@
 package product
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/google/uuid"
+	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
+	"github.com/mikhail5545/product-service-go/internal/events"
 	"github.com/mikhail5545/product-service-go/internal/models/product"
+	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
+	physicalgoodmock "github.com/mikhail5545/product-service-go/internal/test/database/physical_good_mock"
 	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/database/seminar_mock"
+	trainingsessionmock "github.com/mikhail5545/product-service-go/internal/test/database/training_session_mock"
+	eventsfake "github.com/mikhail5545/product-service-go/internal/test/events_fake"
 	gomock "go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
@@ -39,7 +53,7 @@ func TestService_Get(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
 
 	productID := uuid.New().String()
 	mockProduct := &product.Product{
@@ -60,7 +74,7 @@ func TestService_Get(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		if !reflect.DeepEqual(product, mockProduct) {
-			t.Errorf("Get() expected %w, got %w", mockProduct, product)
+			t.Errorf("Get() expected %v, got %v", mockProduct, product)
 		}
 	})
 
@@ -107,7 +121,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
 
 	productID := uuid.New().String()
 	mockProduct := &product.Product{
@@ -128,7 +142,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		if !reflect.DeepEqual(product, mockProduct) {
-			t.Errorf("GetWithDeleted() expected %w, got %w", mockProduct, product)
+			t.Errorf("GetWithDeleted() expected %v, got %v", mockProduct, product)
 		}
 	})
 
@@ -175,7 +189,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
 
 	productID := uuid.New().String()
 	mockProduct := &product.Product{
@@ -196,7 +210,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		if !reflect.DeepEqual(product, mockProduct) {
-			t.Errorf("GetWithUnpublished() expected %w, got %w", mockProduct, product)
+			t.Errorf("GetWithUnpublished() expected %v, got %v", mockProduct, product)
 		}
 	})
 
@@ -243,7 +257,7 @@ func TestService_GetByDetailsID(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
 
 	productID := uuid.New().String()
 	detailsID := uuid.New().String()
@@ -266,7 +280,7 @@ func TestService_GetByDetailsID(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		if !reflect.DeepEqual(product, mockProduct) {
-			t.Errorf("GetByDetailsID() expected %w, got %w", mockProduct, product)
+			t.Errorf("GetByDetailsID() expected %v, got %v", mockProduct, product)
 		}
 	})
 
@@ -313,7 +327,7 @@ func TestService_GetWithDeletedByDetailsID(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
 
 	productID := uuid.New().String()
 	detailsID := uuid.New().String()
@@ -336,7 +350,7 @@ func TestService_GetWithDeletedByDetailsID(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		if !reflect.DeepEqual(product, mockProduct) {
-			t.Errorf("GetWithDeletedByDetailsID() expected %w, got %w", mockProduct, product)
+			t.Errorf("GetWithDeletedByDetailsID() expected %v, got %v", mockProduct, product)
 		}
 	})
 
@@ -383,7 +397,7 @@ func TestService_GetWithUnpublishedByDetailsID(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
 
 	productID := uuid.New().String()
 	detailsID := uuid.New().String()
@@ -406,7 +420,7 @@ func TestService_GetWithUnpublishedByDetailsID(t *testing.T) {
 		// Assert
 		assert.NoError(t, err)
 		if !reflect.DeepEqual(product, mockProduct) {
-			t.Errorf("GetWithUnpublishedByDetailsID() expected %w, got %w", mockProduct, product)
+			t.Errorf("GetWithUnpublishedByDetailsID() expected %v, got %v", mockProduct, product)
 		}
 	})
 
@@ -453,7 +467,10 @@ func TestService_List(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	inStock := true
+	filter := product.ProductFilter{InStock: &inStock}
 
 	productID_1 := uuid.New().String()
 	productID_2 := uuid.New().String()
@@ -478,8 +495,8 @@ func TestService_List(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
-		mockProductRepo.EXPECT().List(gomock.Any(), limit, offset).Return(mockProducts, nil)
-		mockProductRepo.EXPECT().Count(gomock.Any()).Return(int64(2), nil)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, limit, offset).Return(mockProducts, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(2), nil)
 
 		// Act
 		products, total, err := testService.List(context.Background(), limit, offset)
@@ -493,8 +510,8 @@ func TestService_List(t *testing.T) {
 	t.Run("success with empty list", func(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
-		mockProductRepo.EXPECT().List(gomock.Any(), limit, offset).Return([]product.Product{}, nil)
-		mockProductRepo.EXPECT().Count(gomock.Any()).Return(int64(0), nil)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, limit, offset).Return([]product.Product{}, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(0), nil)
 
 		// Act
 		products, total, err := testService.List(context.Background(), limit, offset)
@@ -509,7 +526,7 @@ func TestService_List(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
 		dbErr := errors.New("database error")
-		mockProductRepo.EXPECT().List(gomock.Any(), limit, offset).Return(nil, dbErr)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, limit, offset).Return(nil, dbErr)
 
 		// Act
 		_, _, err := testService.List(context.Background(), limit, offset)
@@ -525,7 +542,7 @@ func TestService_ListDeleted(t *testing.T) {
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
 
 	productID_1 := uuid.New().String()
 	productID_2 := uuid.New().String()
@@ -591,13 +608,56 @@ func TestService_ListDeleted(t *testing.T) {
 	})
 }
 
+func TestService_ListNeverPublished(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	filter := product.ProductFilter{NeverPublished: true}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		limit, offset := 1, 1
+		mockProducts := []product.Product{{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "course"}}
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, limit, offset).Return(mockProducts, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(2), nil)
+
+		// Act
+		products, total, err := testService.ListNeverPublished(context.Background(), limit, offset)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, mockProducts, products)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("database error")
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, 10, 0).Return(nil, dbErr)
+
+		// Act
+		_, _, err := testService.ListNeverPublished(context.Background(), 10, 0)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
 func TestService_ListUnpublished(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	inStock := false
+	filter := product.ProductFilter{InStock: &inStock}
 
 	productID_1 := uuid.New().String()
 	productID_2 := uuid.New().String()
@@ -622,8 +682,8 @@ func TestService_ListUnpublished(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
-		mockProductRepo.EXPECT().ListUnpublished(gomock.Any(), limit, offset).Return(mockProducts, nil)
-		mockProductRepo.EXPECT().CountUnpublished(gomock.Any()).Return(int64(2), nil)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, limit, offset).Return(mockProducts, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(2), nil)
 
 		// Act
 		products, total, err := testService.ListUnpublished(context.Background(), limit, offset)
@@ -637,8 +697,8 @@ func TestService_ListUnpublished(t *testing.T) {
 	t.Run("success with empty list", func(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
-		mockProductRepo.EXPECT().ListUnpublished(gomock.Any(), limit, offset).Return([]product.Product{}, nil)
-		mockProductRepo.EXPECT().CountUnpublished(gomock.Any()).Return(int64(0), nil)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, limit, offset).Return([]product.Product{}, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(0), nil)
 
 		// Act
 		products, total, err := testService.ListUnpublished(context.Background(), limit, offset)
@@ -653,7 +713,7 @@ func TestService_ListUnpublished(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
 		dbErr := errors.New("database error")
-		mockProductRepo.EXPECT().ListUnpublished(gomock.Any(), limit, offset).Return(nil, dbErr)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, limit, offset).Return(nil, dbErr)
 
 		// Act
 		_, _, err := testService.ListUnpublished(context.Background(), limit, offset)
@@ -663,13 +723,18 @@ func TestService_ListUnpublished(t *testing.T) {
 	})
 }
 
-func TestService_ListByDetailsType(t *testing.T) {
+func TestService_ListByType(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockProductRepo)
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	inStock := true
+	filter := func(detailsType string) product.ProductFilter {
+		return product.ProductFilter{DetailsType: detailsType, InStock: &inStock}
+	}
 
 	productID_1 := uuid.New().String()
 	productID_2 := uuid.New().String()
@@ -695,11 +760,11 @@ func TestService_ListByDetailsType(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
-		mockProductRepo.EXPECT().ListByDetailsType(gomock.Any(), detailsType, limit, offset).Return(mockProducts, nil)
-		mockProductRepo.EXPECT().CountByDetailsType(gomock.Any(), detailsType).Return(int64(2), nil)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter(detailsType), limit, offset).Return(mockProducts, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter(detailsType)).Return(int64(2), nil)
 
 		// Act
-		products, total, err := testService.ListByDetailsType(context.Background(), detailsType, limit, offset)
+		products, total, err := testService.ListByType(context.Background(), detailsType, limit, offset)
 
 		// Assert
 		assert.NoError(t, err)
@@ -710,11 +775,11 @@ func TestService_ListByDetailsType(t *testing.T) {
 	t.Run("success with empty list", func(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
-		mockProductRepo.EXPECT().ListByDetailsType(gomock.Any(), detailsType, limit, offset).Return([]product.Product{}, nil)
-		mockProductRepo.EXPECT().CountByDetailsType(gomock.Any(), detailsType).Return(int64(0), nil)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter(detailsType), limit, offset).Return([]product.Product{}, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter(detailsType)).Return(int64(0), nil)
 
 		// Act
-		products, total, err := testService.ListByDetailsType(context.Background(), detailsType, limit, offset)
+		products, total, err := testService.ListByType(context.Background(), detailsType, limit, offset)
 
 		// Assert
 		assert.NoError(t, err)
@@ -726,12 +791,1420 @@ func TestService_ListByDetailsType(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
 		dbErr := errors.New("database error")
-		mockProductRepo.EXPECT().ListByDetailsType(gomock.Any(), detailsType, limit, offset).Return(nil, dbErr)
+		mockProductRepo.EXPECT().List(gomock.Any(), filter(detailsType), limit, offset).Return(nil, dbErr)
+
+		// Act
+		_, _, err := testService.ListByType(context.Background(), detailsType, limit, offset)
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("each known type", func(t *testing.T) {
+		for _, dt := range product.DetailsTypes {
+			// Arrange
+			limit, offset := 10, 0
+			mockProductRepo.EXPECT().List(gomock.Any(), filter(dt), limit, offset).Return([]product.Product{{ID: uuid.New().String(), DetailsType: dt}}, nil)
+			mockProductRepo.EXPECT().Count(gomock.Any(), filter(dt)).Return(int64(1), nil)
+
+			// Act
+			products, total, err := testService.ListByType(context.Background(), dt, limit, offset)
+
+			// Assert
+			assert.NoError(t, err, dt)
+			assert.Equal(t, int64(1), total)
+			if assert.Len(t, products, 1) {
+				assert.Equal(t, dt, products[0].DetailsType)
+			}
+		}
+	})
+
+	t.Run("pagination", func(t *testing.T) {
+		// Arrange
+		limit, offset := 1, 1
+		mockProductRepo.EXPECT().List(gomock.Any(), filter(detailsType), limit, offset).Return(mockProducts[1:], nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter(detailsType)).Return(int64(2), nil)
+
+		// Act
+		products, total, err := testService.ListByType(context.Background(), detailsType, limit, offset)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, mockProducts[1:], products)
+	})
+
+	t.Run("unknown type", func(t *testing.T) {
+		// Act
+		_, _, err := testService.ListByType(context.Background(), "video", 10, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_CountFiltered(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	low, high := float32(5), float32(10)
+	inStock := true
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		filter := product.ProductFilter{DetailsType: "course", Tags: []string{"yoga"}, InStock: &inStock}
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(3), nil)
+
+		// Act
+		total, err := testService.CountFiltered(context.Background(), filter)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("matches ListFiltered total", func(t *testing.T) {
+		// Arrange
+		filter := product.ProductFilter{MinPrice: &low, MaxPrice: &high}
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, 10, 0).Return([]product.Product{{ID: uuid.New().String()}}, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(1), nil).Times(2)
+
+		// Act
+		products, listTotal, listErr := testService.ListFiltered(context.Background(), filter, 10, 0)
+		total, err := testService.CountFiltered(context.Background(), filter)
+
+		// Assert
+		assert.NoError(t, listErr)
+		assert.NoError(t, err)
+		assert.Len(t, products, 1)
+		assert.Equal(t, listTotal, total)
+	})
+
+	t.Run("invalid filter", func(t *testing.T) {
+		for name, filter := range map[string]product.ProductFilter{
+			"unknown type":   {DetailsType: "video"},
+			"inverted range": {MinPrice: &high, MaxPrice: &low},
+			"malformed tag":  {Tags: []string{"no spaces"}},
+		} {
+			// Act
+			_, err := testService.CountFiltered(context.Background(), filter)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument, name)
+		}
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("database error")
+		mockProductRepo.EXPECT().Count(gomock.Any(), product.ProductFilter{}).Return(int64(0), dbErr)
+
+		// Act
+		_, err := testService.CountFiltered(context.Background(), product.ProductFilter{})
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestService_ListByOwnerAllStates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	ownerID := uuid.New().String()
+
+	t.Run("success with mixed states", func(t *testing.T) {
+		// Arrange
+		mockProducts := []product.Product{
+			{ID: uuid.New().String(), DetailsID: ownerID, InStock: true},
+			{ID: uuid.New().String(), DetailsID: ownerID, InStock: false},
+			{ID: uuid.New().String(), DetailsID: ownerID, InStock: false, DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}},
+		}
+		mockProductRepo.EXPECT().ListWithDeletedByDetailsID(gomock.Any(), ownerID).Return(mockProducts, nil)
+
+		// Act
+		products, err := testService.ListByOwnerAllStates(context.Background(), ownerID)
+
+		// Assert
+		assert.NoError(t, err)
+		states := make([]product.State, 0, len(products))
+		for _, p := range products {
+			states = append(states, p.State)
+		}
+		assert.Equal(t, []product.State{product.StateLive, product.StateUnpublished, product.StateDeleted}, states)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		_, err := testService.ListByOwnerAllStates(context.Background(), "invalid-UUID")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().ListWithDeletedByDetailsID(gomock.Any(), ownerID).Return(nil, errors.New("database error"))
+
+		// Act
+		_, err := testService.ListByOwnerAllStates(context.Background(), ownerID)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestService_ListUpdatedSince(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	after := product.ChangeCursor{UpdatedAt: since, ID: uuid.New().String()}
+	mockProducts := []product.Product{
+		{
+			ID:          uuid.New().String(),
+			DetailsID:   uuid.New().String(),
+			DetailsType: "course",
+			InStock:     true,
+			Price:       33.33,
+			UpdatedAt:   since.Add(time.Hour),
+		},
+		{
+			ID:          uuid.New().String(),
+			DetailsID:   uuid.New().String(),
+			DetailsType: "seminar",
+			InStock:     true,
+			Price:       32.22,
+			UpdatedAt:   since.Add(time.Hour),
+		},
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().ListUpdatedSince(gomock.Any(), after, 10).Return(mockProducts, nil)
+
+		// Act
+		products, watermark, err := testService.ListUpdatedSince(context.Background(), after, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, products, 2)
+		assert.Equal(t, mockProducts[1].Cursor(), watermark)
+	})
+
+	t.Run("nothing changed keeps watermark", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().ListUpdatedSince(gomock.Any(), after, 10).Return([]product.Product{}, nil)
+
+		// Act
+		products, watermark, err := testService.ListUpdatedSince(context.Background(), after, 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, products, 0)
+		assert.Equal(t, after, watermark)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		// Act
+		_, _, err := testService.ListUpdatedSince(context.Background(), after, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("database error")
+		mockProductRepo.EXPECT().ListUpdatedSince(gomock.Any(), after, 10).Return(nil, dbErr)
 
 		// Act
-		_, _, err := testService.ListByDetailsType(context.Background(), detailsType, limit, offset)
+		_, _, err := testService.ListUpdatedSince(context.Background(), after, 10)
 
 		// Assert
 		assert.Error(t, err)
 	})
 }
+
+func TestService_ListModifiedSince(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	cutoff := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	inStock := true
+	filter := product.ProductFilter{InStock: &inStock, UpdatedAfter: &cutoff}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		recent := product.Product{ID: uuid.New().String(), InStock: true, UpdatedAt: cutoff.Add(time.Hour)}
+		mockProductRepo.EXPECT().List(gomock.Any(), filter, 10, 0).Return([]product.Product{recent}, nil)
+		mockProductRepo.EXPECT().Count(gomock.Any(), filter).Return(int64(1), nil)
+
+		// Act
+		products, total, err := testService.ListModifiedSince(context.Background(), cutoff.Format(time.RFC3339), 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, []product.Product{recent}, products)
+	})
+
+	t.Run("malformed timestamp", func(t *testing.T) {
+		// Act
+		_, _, err := testService.ListModifiedSince(context.Background(), "2025-01-01 12:00", 10, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_SetAvailability(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	productID := uuid.New().String()
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	until := from.Add(24 * time.Hour)
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().SetAvailability(gomock.Any(), productID, &from, &until).Return(int64(1), nil)
+
+		// Act
+		err := testService.SetAvailability(context.Background(), &product.SetAvailabilityRequest{
+			ID: productID, AvailableFrom: &from, AvailableUntil: &until,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("from after until", func(t *testing.T) {
+		// Act
+		err := testService.SetAvailability(context.Background(), &product.SetAvailabilityRequest{
+			ID: productID, AvailableFrom: &until, AvailableUntil: &from,
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().SetAvailability(gomock.Any(), productID, nil, &until).Return(int64(0), nil)
+
+		// Act
+		err := testService.SetAvailability(context.Background(), &product.SetAvailabilityRequest{
+			ID: productID, AvailableUntil: &until,
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestService_CountByTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	t.Run("tag on several products", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().CountDetailsByTag(gomock.Any(), "yoga").Return(int64(3), nil)
+
+		// Act
+		count, err := testService.CountByTag(context.Background(), "yoga")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+	})
+
+	t.Run("tag on none", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().CountDetailsByTag(gomock.Any(), "unused").Return(int64(0), nil)
+
+		// Act
+		count, err := testService.CountByTag(context.Background(), "unused")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("empty tag", func(t *testing.T) {
+		// Act
+		count, err := testService.CountByTag(context.Background(), "  ")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Zero(t, count)
+	})
+}
+
+func TestService_RemoveTagGlobally(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().RemoveTag(gomock.Any(), "yoga").Return(int64(3), nil)
+
+		// Act
+		removed, err := testService.RemoveTagGlobally(context.Background(), "yoga")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), removed)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		dbErr := errors.New("db error")
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().RemoveTag(gomock.Any(), "yoga").Return(int64(0), dbErr)
+
+		// Act
+		removed, err := testService.RemoveTagGlobally(context.Background(), "yoga")
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+		assert.Zero(t, removed)
+	})
+
+	t.Run("empty tag", func(t *testing.T) {
+		// Act
+		removed, err := testService.RemoveTagGlobally(context.Background(), "")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Zero(t, removed)
+	})
+}
+
+func TestService_RenameTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	// The repository reports every touched record, whether from was replaced or only removed
+	// because the record already had to.
+	tests := []struct {
+		name     string
+		affected int64
+	}{
+		{name: "only from exists", affected: 2},
+		{name: "both exist", affected: 1},
+		{name: "neither exists", affected: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+			mockProductRepo.EXPECT().DB().Return(db)
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+			mockTxProductRepo.EXPECT().RenameTag(gomock.Any(), "yoga", "pilates").Return(tt.affected, nil)
+
+			// Act
+			renamed, err := testService.RenameTag(context.Background(), "yoga", "pilates")
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.affected, renamed)
+		})
+	}
+
+	t.Run("invalid tags", func(t *testing.T) {
+		for _, args := range [][2]string{{"", "pilates"}, {"yoga", " "}, {"yoga", "yoga"}} {
+			// Act
+			renamed, err := testService.RenameTag(context.Background(), args[0], args[1])
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+			assert.Zero(t, renamed)
+		}
+	})
+}
+
+func TestService_SwapPrices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	idA := uuid.New().String()
+	idB := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		products := []product.Product{
+			{ID: idA, Price: 10, DetailsType: "course"},
+			{ID: idB, Price: 25, DetailsType: "course"},
+		}
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), []string{idA, idB}, "id", "price", "details_type").Return(products, nil)
+		gomock.InOrder(
+			mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), map[string]any{"price": float32(25)}).
+				DoAndReturn(func(_ context.Context, p *product.Product, updates any) (int64, error) {
+					assert.Equal(t, idA, p.ID)
+					// Mimic gorm writing the new value back into the model.
+					p.Price = 25
+					return 1, nil
+				}),
+			mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), map[string]any{"price": float32(10)}).
+				DoAndReturn(func(_ context.Context, p *product.Product, updates any) (int64, error) {
+					assert.Equal(t, idB, p.ID)
+					return 1, nil
+				}),
+		)
+
+		// Act
+		err := testService.SwapPrices(context.Background(), idA, idB)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("nonexistent ID", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), []string{idA, idB}, "id", "price", "details_type").
+			Return([]product.Product{{ID: idA, Price: 10, DetailsType: "course"}}, nil)
+
+		// Act
+		err := testService.SwapPrices(context.Background(), idA, idB)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("different details types", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		products := []product.Product{
+			{ID: idA, Price: 10, DetailsType: "course"},
+			{ID: idB, Price: 25, DetailsType: "seminar"},
+		}
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), []string{idA, idB}, "id", "price", "details_type").Return(products, nil)
+
+		// Act
+		err := testService.SwapPrices(context.Background(), idA, idB)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("invalid IDs", func(t *testing.T) {
+		for _, args := range [][2]string{{"invalid", idB}, {idA, "invalid"}, {idA, idA}} {
+			// Act
+			err := testService.SwapPrices(context.Background(), args[0], args[1])
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		}
+	})
+}
+
+func TestService_Related(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	sourceID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		// Ranked by the repository: three, two and one shared tags.
+		ranked := []product.Product{
+			{ID: uuid.New().String(), InStock: true},
+			{ID: uuid.New().String(), InStock: true},
+			{ID: uuid.New().String(), InStock: true},
+		}
+		mockProductRepo.EXPECT().Get(gomock.Any(), sourceID).Return(&product.Product{ID: sourceID, InStock: true}, nil)
+		mockProductRepo.EXPECT().ListRelated(gomock.Any(), sourceID, 3, gomock.Any()).Return(ranked, nil)
+
+		// Act
+		products, err := testService.Related(context.Background(), sourceID, 3)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, ranked, products)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockProductRepo.EXPECT().Get(gomock.Any(), sourceID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		products, err := testService.Related(context.Background(), sourceID, 3)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, products)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		// Act
+		products, err := testService.Related(context.Background(), "invalid-uuid", 3)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, products)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		// Act
+		products, err := testService.Related(context.Background(), sourceID, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, products)
+	})
+}
+
+func TestService_GetDetailsBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, mockSeminarRepo, mockTrainingSessionRepo, nil, nil)
+
+	seminarA := seminarmodel.Seminar{ID: uuid.New().String(), Name: "Seminar A"}
+	seminarB := seminarmodel.Seminar{ID: uuid.New().String(), Name: "Seminar B"}
+	session := trainingsessionmodel.TrainingSession{ID: uuid.New().String(), Name: "Session"}
+
+	seminarAProduct := product.Product{ID: uuid.New().String(), DetailsID: seminarA.ID, DetailsType: "seminar"}
+	seminarBProduct := product.Product{ID: uuid.New().String(), DetailsID: seminarB.ID, DetailsType: "seminar"}
+	sessionProduct := product.Product{ID: uuid.New().String(), DetailsID: session.ID, DetailsType: "training_session"}
+	orphanedProduct := product.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "seminar"}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		ids := []string{sessionProduct.ID, seminarAProduct.ID, orphanedProduct.ID, seminarBProduct.ID}
+		mockProductRepo.EXPECT().ListByIDs(gomock.Any(), ids).Return([]product.Product{
+			seminarAProduct, seminarBProduct, sessionProduct, orphanedProduct,
+		}, nil)
+		// One query per details type.
+		mockSeminarRepo.EXPECT().
+			ListWithUnpublishedByIDs(gomock.Any(), seminarA.ID, seminarB.ID, orphanedProduct.DetailsID).
+			Return([]seminarmodel.Seminar{seminarA, seminarB}, nil).
+			Times(1)
+		mockTrainingSessionRepo.EXPECT().
+			ListWithUnpublishedByIDs(gomock.Any(), session.ID).
+			Return([]trainingsessionmodel.TrainingSession{session}, nil).
+			Times(1)
+
+		// Act
+		details, err := testService.GetDetailsBatch(context.Background(), ids)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, details, 4)
+
+		assert.Equal(t, sessionProduct.ID, details[0].Product.ID)
+		assert.Equal(t, &session, details[0].TrainingSession)
+		assert.Nil(t, details[0].Seminar)
+
+		assert.Equal(t, seminarAProduct.ID, details[1].Product.ID)
+		assert.Equal(t, &seminarA, details[1].Seminar)
+		assert.Nil(t, details[1].TrainingSession)
+
+		assert.Equal(t, orphanedProduct.ID, details[2].Product.ID)
+		assert.Nil(t, details[2].Course)
+		assert.Nil(t, details[2].Seminar)
+		assert.Nil(t, details[2].TrainingSession)
+		assert.Nil(t, details[2].PhysicalGood)
+
+		assert.Equal(t, seminarBProduct.ID, details[3].Product.ID)
+		assert.Equal(t, &seminarB, details[3].Seminar)
+	})
+
+	t.Run("unknown product skipped", func(t *testing.T) {
+		// Arrange
+		unknownID := uuid.New().String()
+		ids := []string{unknownID, sessionProduct.ID}
+		mockProductRepo.EXPECT().ListByIDs(gomock.Any(), ids).Return([]product.Product{sessionProduct}, nil)
+		mockTrainingSessionRepo.EXPECT().
+			ListWithUnpublishedByIDs(gomock.Any(), session.ID).
+			Return([]trainingsessionmodel.TrainingSession{session}, nil)
+
+		// Act
+		details, err := testService.GetDetailsBatch(context.Background(), ids)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, details, 1)
+		assert.Equal(t, sessionProduct.ID, details[0].Product.ID)
+	})
+
+	t.Run("details db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		ids := []string{seminarAProduct.ID}
+		mockProductRepo.EXPECT().ListByIDs(gomock.Any(), ids).Return([]product.Product{seminarAProduct}, nil)
+		mockSeminarRepo.EXPECT().ListWithUnpublishedByIDs(gomock.Any(), seminarA.ID).Return(nil, dbErr)
+
+		// Act
+		details, err := testService.GetDetailsBatch(context.Background(), ids)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, details)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		// Act
+		details, err := testService.GetDetailsBatch(context.Background(), nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, details)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		// Act
+		details, err := testService.GetDetailsBatch(context.Background(), []string{seminarAProduct.ID, "invalid-uuid"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, details)
+	})
+}
+
+func TestService_GetOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, mockSeminarRepo, mockTrainingSessionRepo, nil, nil)
+	testService.(*service).now = func() time.Time { return time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC) }
+
+	earlyProductID, lateProductID := uuid.New().String(), uuid.New().String()
+	seminar := seminarmodel.Seminar{
+		ID:              uuid.New().String(),
+		Name:            "Seminar",
+		EarlyProductID:  &earlyProductID,
+		LateProductID:   &lateProductID,
+		LatePaymentDate: time.Date(2030, 2, 1, 0, 0, 0, 0, time.UTC),
+		InStock:         true,
+	}
+	session := trainingsessionmodel.TrainingSession{ID: uuid.New().String(), Name: "Session", InStock: true}
+
+	t.Run("seminar product priced by the current role", func(t *testing.T) {
+		// Arrange
+		earlyProduct := &product.Product{ID: earlyProductID, DetailsID: seminar.ID, DetailsType: "seminar", Price: 100, InStock: true}
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), earlyProductID).Return(earlyProduct, nil)
+		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminar.ID).Return(&seminar, nil).Times(2)
+		// The late payment date has passed, so the late product sets the price.
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), []string{lateProductID}, "id", "price").
+			Return([]product.Product{{ID: lateProductID, Price: 150}}, nil)
+
+		// Act
+		owner, err := testService.GetOwner(context.Background(), earlyProductID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &product.ProductOwner{
+			Type:     "seminar",
+			Name:     "Seminar",
+			Price:    150,
+			Currency: "RUB",
+			InStock:  true,
+			Details:  &seminar,
+		}, owner)
+	})
+
+	t.Run("training session product", func(t *testing.T) {
+		// Arrange
+		sessionProduct := &product.Product{ID: uuid.New().String(), DetailsID: session.ID, DetailsType: "training_session", Price: 50}
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), sessionProduct.ID).Return(sessionProduct, nil)
+		mockTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), session.ID).Return(&session, nil)
+
+		// Act
+		owner, err := testService.GetOwner(context.Background(), sessionProduct.ID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "training_session", owner.Type)
+		assert.Equal(t, "Session", owner.Name)
+		assert.Equal(t, float32(50), owner.Price)
+		// The product itself is unpublished.
+		assert.False(t, owner.InStock)
+		assert.Equal(t, &session, owner.Details)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		id := uuid.New().String()
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), id).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		owner, err := testService.GetOwner(context.Background(), id)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Nil(t, owner)
+	})
+
+	t.Run("orphaned product", func(t *testing.T) {
+		// Arrange
+		orphan := &product.Product{ID: uuid.New().String(), DetailsID: uuid.New().String(), DetailsType: "seminar", InStock: true}
+		mockProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), orphan.ID).Return(orphan, nil)
+		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), orphan.DetailsID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		owner, err := testService.GetOwner(context.Background(), orphan.ID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDetailsNotFound)
+		assert.Nil(t, owner)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		// Act
+		owner, err := testService.GetOwner(context.Background(), "invalid-uuid")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, owner)
+	})
+}
+
+// newTxDB returns an empty in-memory database for services that open transactions.
+func newTxDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(fmt.Sprintf("file:%s?mode=memory&cache=shared", uuid.New().String())), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	return db
+}
+
+func TestService_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockProductRepo.EXPECT().DB().Return(newTxDB(t)).AnyTimes()
+	mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockProductRepo).AnyTimes()
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	for _, detailsType := range product.DetailsTypes {
+		t.Run("success "+detailsType, func(t *testing.T) {
+			// Arrange
+			req := &product.AddRequest{Price: 100, DetailsID: uuid.New().String(), DetailsType: detailsType}
+			mockProductRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, p *product.Product) error {
+					assert.Equal(t, req.DetailsID, p.DetailsID)
+					assert.Equal(t, detailsType, p.DetailsType)
+					assert.False(t, p.InStock)
+					return nil
+				},
+			)
+
+			// Act
+			created, err := testService.Create(context.Background(), req)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotEmpty(t, created.ID)
+			assert.Equal(t, detailsType, created.DetailsType)
+		})
+	}
+
+	t.Run("unknown details type", func(t *testing.T) {
+		// Arrange
+		req := &product.AddRequest{Price: 100, DetailsID: uuid.New().String(), DetailsType: "bogus"}
+
+		// Act
+		created, err := testService.Create(context.Background(), req)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, created)
+	})
+
+	t.Run("invalid details id", func(t *testing.T) {
+		// Arrange
+		req := &product.AddRequest{Price: 100, DetailsID: "invalid-uuid", DetailsType: "course"}
+
+		// Act
+		created, err := testService.Create(context.Background(), req)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, created)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		dbErr := errors.New("db error")
+		req := &product.AddRequest{Price: 100, DetailsID: uuid.New().String(), DetailsType: "seminar"}
+		mockProductRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(dbErr)
+
+		// Act
+		created, err := testService.Create(context.Background(), req)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+		assert.Nil(t, created)
+	})
+}
+
+func TestService_Create_PublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Arrange
+	broker := eventsfake.NewBroker(t)
+	publisher, err := events.NewPublisher(context.Background(), events.Config{BrokerURL: broker.URL(), Subject: "vitianmove.product"}, slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("failed to connect to fake broker: %v", err)
+	}
+	defer publisher.Close()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockProductRepo.EXPECT().DB().Return(newTxDB(t)).AnyTimes()
+	mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockProductRepo).AnyTimes()
+	testService := New(mockProductRepo, nil, nil, nil, nil, publisher)
+
+	req := &product.AddRequest{Price: 100, DetailsID: uuid.New().String(), DetailsType: "course"}
+	mockProductRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+	// Act
+	created, err := testService.Create(context.Background(), req)
+
+	// Assert
+	assert.NoError(t, err)
+	messages := broker.Messages()
+	if assert.Len(t, messages, 1) {
+		assert.Equal(t, "vitianmove.product.product.created", messages[0].Subject)
+		var event struct {
+			SchemaVersion int             `json:"schema_version"`
+			Type          string          `json:"type"`
+			AggregateID   string          `json:"aggregate_id"`
+			Payload       product.Product `json:"payload"`
+		}
+		assert.NoError(t, json.Unmarshal(messages[0].Data, &event))
+		assert.Equal(t, events.SchemaVersion, event.SchemaVersion)
+		assert.Equal(t, events.ProductCreated, event.Type)
+		assert.Equal(t, created.ID, event.AggregateID)
+		assert.Equal(t, req.DetailsID, event.Payload.DetailsID)
+		assert.Equal(t, req.DetailsType, event.Payload.DetailsType)
+	}
+}
+
+func TestService_Create_PublisherFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockProductRepo.EXPECT().DB().Return(newTxDB(t)).AnyTimes()
+	mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockProductRepo).AnyTimes()
+	req := &product.AddRequest{Price: 100, DetailsID: uuid.New().String(), DetailsType: "course"}
+
+	t.Run("tolerant publisher keeps create successful", func(t *testing.T) {
+		// Arrange
+		broker := eventsfake.NewBroker(t)
+		var logs bytes.Buffer
+		publisher, err := events.NewPublisher(context.Background(), events.Config{BrokerURL: broker.URL(), Subject: "test"}, slog.New(slog.NewTextHandler(&logs, nil)))
+		if err != nil {
+			t.Fatalf("failed to connect to fake broker: %v", err)
+		}
+		defer publisher.Close()
+		broker.Stop()
+		failures := events.PublishFailures.Value()
+
+		testService := New(mockProductRepo, nil, nil, nil, nil, publisher)
+		mockProductRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+		// Act
+		created, err := testService.Create(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, created)
+		assert.Equal(t, failures+1, events.PublishFailures.Value())
+		assert.Contains(t, logs.String(), "failed to publish event")
+		assert.Contains(t, logs.String(), "aggregate_id="+created.ID)
+	})
+
+	t.Run("strict publisher fails create", func(t *testing.T) {
+		// Arrange
+		broker := eventsfake.NewBroker(t)
+		publisher, err := events.NewPublisher(context.Background(), events.Config{BrokerURL: broker.URL(), Subject: "test", Strict: true}, slog.New(slog.DiscardHandler))
+		if err != nil {
+			t.Fatalf("failed to connect to fake broker: %v", err)
+		}
+		defer publisher.Close()
+		broker.Stop()
+
+		db := newTxDB(t)
+		if err := db.AutoMigrate(&product.Product{}); err != nil {
+			t.Fatalf("failed to migrate database: %v", err)
+		}
+		testService := New(productrepo.New(db), nil, nil, nil, nil, publisher)
+
+		// Act
+		created, err := testService.Create(context.Background(), req)
+
+		// Assert
+		assert.ErrorContains(t, err, "failed to publish product created event")
+		assert.Nil(t, created)
+		var count int64
+		assert.NoError(t, db.Unscoped().Model(&product.Product{}).Count(&count).Error)
+		assert.Zero(t, count, "the product must be rolled back, so a retry doesn't create a duplicate")
+	})
+}
+
+func TestService_Recategorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, mockSeminarRepo, nil, mockPhysicalGoodRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	productID := uuid.New().String()
+	seminarID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		existing := &product.Product{ID: productID, DetailsID: uuid.New().String(), DetailsType: "physical_good"}
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).Return(existing, nil)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(&seminarmodel.Seminar{ID: seminarID}, nil)
+		mockTxProductRepo.EXPECT().
+			Update(gomock.Any(), existing, map[string]any{"details_type": "seminar", "details_id": seminarID}).
+			Return(int64(1), nil)
+
+		// Act
+		err := testService.Recategorize(context.Background(), productID, "seminar", seminarID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("details not found", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).
+			Return(&product.Product{ID: productID, DetailsType: "physical_good"}, nil)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Recategorize(context.Background(), productID, "seminar", seminarID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDetailsNotFound)
+	})
+
+	t.Run("seminar product filling a price role", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		goodID := uuid.New().String()
+		existing := &product.Product{ID: productID, DetailsID: seminarID, DetailsType: "seminar"}
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).Return(existing, nil)
+		mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), goodID).Return(nil, nil)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).
+			Return(&seminarmodel.Seminar{ID: seminarID, EarlyProductID: &productID}, nil)
+		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Recategorize(context.Background(), productID, "physical_good", goodID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrProductInUse)
+	})
+
+	t.Run("single-product details already have a product", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxPhysicalGoodRepo := physicalgoodmock.NewMockRepository(ctrl)
+		goodID := uuid.New().String()
+		existing := &product.Product{ID: productID, DetailsID: uuid.New().String(), DetailsType: "physical_good"}
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockPhysicalGoodRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxPhysicalGoodRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).Return(existing, nil)
+		mockTxPhysicalGoodRepo.EXPECT().GetWithUnpublished(gomock.Any(), goodID).Return(nil, nil)
+		mockTxProductRepo.EXPECT().SelectWithDeletedByDetailsIDs(gomock.Any(), []string{goodID}, "id").
+			Return([]product.Product{{ID: uuid.New().String()}}, nil)
+		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Recategorize(context.Background(), productID, "physical_good", goodID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrDetailsHasProduct)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().GetWithUnpublished(gomock.Any(), productID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		err := testService.Recategorize(context.Background(), productID, "seminar", seminarID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("unknown details type", func(t *testing.T) {
+		// Act
+		err := testService.Recategorize(context.Background(), productID, "bogus", seminarID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_SetTagsBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	ids := []string{uuid.New().String(), uuid.New().String(), uuid.New().String()}
+	tags := []string{"summer", "campaign"}
+
+	t.Run("success across multiple products", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids, "id").
+			Return([]product.Product{{ID: ids[0]}, {ID: ids[1]}, {ID: ids[2]}}, nil)
+		mockTxProductRepo.EXPECT().SetTags(gomock.Any(), ids, tags).Return(int64(3), nil)
+
+		// Act
+		updated, err := testService.SetTagsBatch(context.Background(), ids, tags)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), updated)
+	})
+
+	t.Run("duplicate IDs and tags are collapsed", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids[:1], "id").
+			Return([]product.Product{{ID: ids[0]}}, nil)
+		mockTxProductRepo.EXPECT().SetTags(gomock.Any(), ids[:1], []string{"summer"}).Return(int64(1), nil)
+
+		// Act
+		updated, err := testService.SetTagsBatch(context.Background(), []string{ids[0], ids[0]}, []string{"summer", "summer"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), updated)
+	})
+
+	t.Run("invalid tags", func(t *testing.T) {
+		for _, invalid := range [][]string{nil, {"ab"}, {"not a tag"}, make([]string, 11)} {
+			// Act
+			_, err := testService.SetTagsBatch(context.Background(), ids, invalid)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		}
+	})
+
+	t.Run("invalid ID", func(t *testing.T) {
+		// Act
+		_, err := testService.SetTagsBatch(context.Background(), []string{"invalid-UUID"}, tags)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids, "id").
+			Return([]product.Product{{ID: ids[0]}, {ID: ids[2]}}, nil)
+		mockTxProductRepo.EXPECT().SetTags(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		updated, err := testService.SetTagsBatch(context.Background(), ids, tags)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorContains(t, err, ids[1])
+		assert.Zero(t, updated)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		dbErr := errors.New("db error")
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids, "id").
+			Return([]product.Product{{ID: ids[0]}, {ID: ids[1]}, {ID: ids[2]}}, nil)
+		mockTxProductRepo.EXPECT().SetTags(gomock.Any(), ids, tags).Return(int64(0), dbErr)
+
+		// Act
+		updated, err := testService.SetTagsBatch(context.Background(), ids, tags)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+		assert.Zero(t, updated)
+	})
+}
+
+func TestService_AddTagsBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockProductRepo, nil, nil, nil, nil, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	ids := []string{uuid.New().String(), uuid.New().String()}
+	found := []product.Product{{ID: ids[0]}, {ID: ids[1]}}
+
+	t.Run("merges into existing tags", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids, "id").Return(found, nil)
+		mockTxProductRepo.EXPECT().AddTags(gomock.Any(), ids, []string{"summer"}).Return(int64(2), nil)
+		mockTxProductRepo.EXPECT().SetTags(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		updated, err := testService.AddTagsBatch(context.Background(), ids, []string{"summer"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), updated)
+	})
+
+	t.Run("duplicate tags are added once", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids, "id").Return(found, nil)
+		mockTxProductRepo.EXPECT().AddTags(gomock.Any(), ids, []string{"summer", "yoga"}).Return(int64(2), nil)
+
+		// Act
+		_, err := testService.AddTagsBatch(context.Background(), ids, []string{"summer", "yoga", "summer"})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid tags", func(t *testing.T) {
+		// Act
+		_, err := testService.AddTagsBatch(context.Background(), ids, []string{"x"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("unknown ID", func(t *testing.T) {
+		// Arrange
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockProductRepo.EXPECT().DB().Return(db)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids, "id").Return(found[:1], nil)
+		mockTxProductRepo.EXPECT().AddTags(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		_, err := testService.AddTagsBatch(context.Background(), ids, []string{"summer"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("rolls back on error", func(t *testing.T) {
+		// Arrange
+		type tagWrite struct{ ID string }
+		atomicDB, err := gorm.Open(sqlite.Open("file:add_tags_batch?mode=memory&cache=shared"), &gorm.Config{
+			SkipDefaultTransaction: true,
+		})
+		if err != nil {
+			t.Fatalf("failed to connect database: %v", err)
+		}
+		if err := atomicDB.AutoMigrate(&tagWrite{}); err != nil {
+			t.Fatalf("failed to migrate database: %v", err)
+		}
+
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		dbErr := errors.New("db error")
+		var tx *gorm.DB
+
+		mockProductRepo.EXPECT().DB().Return(atomicDB)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).DoAndReturn(func(txDB *gorm.DB) productrepo.Repository {
+			tx = txDB
+			return mockTxProductRepo
+		})
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), ids, "id").Return(found, nil)
+		mockTxProductRepo.EXPECT().AddTags(gomock.Any(), ids, []string{"summer"}).
+			DoAndReturn(func(context.Context, []string, []string) (int64, error) {
+				// The first details table is written before the second one fails.
+				if err := tx.Create(&tagWrite{ID: ids[0]}).Error; err != nil {
+					return 0, err
+				}
+				return 0, dbErr
+			})
+
+		// Act
+		updated, err := testService.AddTagsBatch(context.Background(), ids, []string{"summer"})
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+		assert.Zero(t, updated)
+		var count int64
+		assert.NoError(t, atomicDB.Model(&tagWrite{}).Count(&count).Error)
+		assert.Zero(t, count)
+	})
+}
@@ -24,6 +24,8 @@ var (
 	ErrInvalidArgument = errors.New("invalid argument")
 	// ErrNotFound seminar not found error
 	ErrNotFound = errors.New("seminar not found")
+	// ErrGone seminar exists but is soft-deleted error, always returned wrapped together with ErrNotFound
+	ErrGone = errors.New("seminar has been deleted")
 	// ErrIncompleteData seminar missing one or more required product IDs error
 	ErrIncompleteData = errors.New("seminar record is missing one or more required product IDs")
 	// ErrProductsNotFound unable to find all products for seminar error
@@ -32,4 +34,10 @@ var (
 	ErrImageLimitExceeded = errors.New("maximum number of uploaded images is 5 per item")
 	// ErrImageNotFoundOnOwner can't find image on seminar error
 	ErrImageNotFoundOnOwner = errors.New("image not found on seminar")
+	// ErrHasDependents seminar products are referenced by other records (e.g. featured list) error
+	ErrHasDependents = errors.New("seminar products are referenced by other records")
+	// ErrDuplicateName another seminar, soft-deleted ones included, already uses the slug of the name error
+	ErrDuplicateName = errors.New("seminar with this name already exists")
+	// ErrCannotPublishFree seminar products priced at zero or below and not marked free error
+	ErrCannotPublishFree = errors.New("cannot publish seminar with a zero price product that is not marked free")
 )
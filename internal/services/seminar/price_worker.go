@@ -0,0 +1,149 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package seminar
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	seminarrepo "github.com/mikhail5545/product-service-go/internal/database/seminar"
+	"github.com/mikhail5545/product-service-go/internal/events"
+)
+
+// PriceInvalidator is told about seminars whose current price has just changed, so cached
+// seminar details can be dropped or recomputed.
+type PriceInvalidator interface {
+	InvalidatePrice(ctx context.Context, seminarID string, cutoff time.Time) error
+}
+
+// EventInvalidator is a [PriceInvalidator] publishing [events.SeminarPriceChanged] for every
+// invalidated seminar. Caches consuming the event bus drop their entries on it.
+type EventInvalidator struct {
+	Publisher events.Publisher
+}
+
+// InvalidatePrice publishes [events.SeminarPriceChanged] for the seminar.
+func (i EventInvalidator) InvalidatePrice(ctx context.Context, seminarID string, cutoff time.Time) error {
+	return i.Publisher.Publish(ctx, events.New(events.SeminarPriceChanged, seminarID, map[string]any{"late_cutoff": cutoff}))
+}
+
+// PriceWorkerConfig configures the [PriceWorker].
+type PriceWorkerConfig struct {
+	// Interval is the time between two checks. A seminar is invalidated at most Interval
+	// after its cutoff. Zero or negative disables the worker.
+	Interval time.Duration
+}
+
+// DefaultPriceWorkerConfig is used for values missing from the environment.
+var DefaultPriceWorkerConfig = PriceWorkerConfig{Interval: time.Minute}
+
+// PriceWorkerConfigFromEnv reads the SEMINAR_PRICE_WORKER_INTERVAL environment variable,
+// a Go duration such as "30s". Missing values fall back to [DefaultPriceWorkerConfig].
+func PriceWorkerConfigFromEnv() (PriceWorkerConfig, error) {
+	cfg := DefaultPriceWorkerConfig
+	if value := os.Getenv("SEMINAR_PRICE_WORKER_INTERVAL"); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid SEMINAR_PRICE_WORKER_INTERVAL value %q: %w", value, err)
+		}
+		cfg.Interval = interval
+	}
+	return cfg, nil
+}
+
+// cutoffLookbehind bounds how long before the late payment date its cutoff can be:
+// the cutoff is midnight of that day in the default timezone, a day at most, plus a DST shift.
+const cutoffLookbehind = 25 * time.Hour
+
+// PriceWorker invalidates cached seminar details when the current price of a seminar changes
+// because its late payment cutoff ([seminarmodel.Seminar.LateCutoff]) has passed.
+// Every check covers the window since the previous one, so each cutoff is reported once
+// while the worker runs. Cutoffs passed before the worker started are not reported.
+type PriceWorker struct {
+	repo        seminarrepo.Repository
+	invalidator PriceInvalidator
+	interval    time.Duration
+	logger      *slog.Logger
+	// now is the clock deciding which cutoffs have passed, replaceable in tests.
+	now  func() time.Time
+	last time.Time
+}
+
+// NewPriceWorker creates a new price worker reading seminars from repo and reporting them to invalidator.
+func NewPriceWorker(repo seminarrepo.Repository, invalidator PriceInvalidator, cfg PriceWorkerConfig, logger *slog.Logger) *PriceWorker {
+	return newPriceWorker(repo, invalidator, cfg, logger, time.Now)
+}
+
+func newPriceWorker(repo seminarrepo.Repository, invalidator PriceInvalidator, cfg PriceWorkerConfig, logger *slog.Logger, now func() time.Time) *PriceWorker {
+	return &PriceWorker{
+		repo:        repo,
+		invalidator: invalidator,
+		interval:    cfg.Interval,
+		logger:      logger,
+		now:         now,
+		last:        now(),
+	}
+}
+
+// Run checks for passed cutoffs every interval until ctx is canceled.
+// It returns immediately if the worker is disabled.
+func (w *PriceWorker) Run(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := w.Tick(ctx); err != nil {
+				w.logger.ErrorContext(ctx, "failed to invalidate seminar prices", "error", err)
+			}
+		}
+	}
+}
+
+// Tick invalidates every published seminar whose cutoff passed since the previous successful tick.
+// If the seminars cannot be listed, the window is kept and retried on the next tick.
+// A failed invalidation is logged and not retried.
+func (w *PriceWorker) Tick(ctx context.Context) error {
+	from, to := w.last, w.now()
+	if !to.After(from) {
+		return nil
+	}
+	seminars, err := w.repo.ListByLatePaymentDateBetween(ctx, from, to.Add(cutoffLookbehind), "id", "late_payment_date")
+	if err != nil {
+		return fmt.Errorf("failed to retrieve seminars: %w", err)
+	}
+	for _, seminar := range seminars {
+		cutoff := seminar.LateCutoff()
+		if !cutoff.After(from) || cutoff.After(to) {
+			continue
+		}
+		if err := w.invalidator.InvalidatePrice(ctx, seminar.ID, cutoff); err != nil {
+			w.logger.ErrorContext(ctx, "failed to invalidate seminar price", "seminar_id", seminar.ID, "error", err)
+		}
+	}
+	w.last = to
+	return nil
+}
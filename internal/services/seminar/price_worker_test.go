@@ -0,0 +1,163 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package seminar
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/models/seminar"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/database/seminar_mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+// recordingInvalidator remembers the seminars it was told about.
+type recordingInvalidator struct {
+	calls []string
+	err   error
+}
+
+func (i *recordingInvalidator) InvalidatePrice(_ context.Context, seminarID string, _ time.Time) error {
+	i.calls = append(i.calls, seminarID)
+	return i.err
+}
+
+func TestPriceWorker_Tick(t *testing.T) {
+	cutoff := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	crossing := seminar.Seminar{ID: uuid.New().String(), LatePaymentDate: cutoff}
+	later := seminar.Seminar{ID: uuid.New().String(), LatePaymentDate: cutoff.Add(48 * time.Hour)}
+
+	t.Run("cutoff crossed once", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		mockRepo := seminarmock.NewMockRepository(ctrl)
+		invalidator := &recordingInvalidator{}
+		now := cutoff.Add(-2 * time.Minute)
+		worker := newPriceWorker(mockRepo, invalidator, DefaultPriceWorkerConfig, slog.New(slog.DiscardHandler), func() time.Time { return now })
+
+		// The repository returns both seminars on every tick, so only the
+		// worker's own window decides what is invalidated.
+		mockRepo.EXPECT().ListByLatePaymentDateBetween(gomock.Any(), gomock.Any(), gomock.Any(), "id", "late_payment_date").
+			Return([]seminar.Seminar{crossing, later}, nil).Times(3)
+
+		// Act
+		var afterTicks []int
+		for _, step := range []time.Duration{time.Minute, time.Minute, time.Minute} {
+			now = now.Add(step)
+			assert.NoError(t, worker.Tick(context.Background()))
+			afterTicks = append(afterTicks, len(invalidator.calls))
+		}
+
+		// Assert
+		assert.Equal(t, []int{0, 1, 1}, afterTicks)
+		assert.Equal(t, []string{crossing.ID}, invalidator.calls)
+	})
+
+	t.Run("cutoff exactly at tick", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		mockRepo := seminarmock.NewMockRepository(ctrl)
+		invalidator := &recordingInvalidator{}
+		now := cutoff.Add(-time.Minute)
+		worker := newPriceWorker(mockRepo, invalidator, DefaultPriceWorkerConfig, slog.New(slog.DiscardHandler), func() time.Time { return now })
+		mockRepo.EXPECT().ListByLatePaymentDateBetween(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]seminar.Seminar{crossing}, nil).Times(2)
+
+		// Act
+		now = cutoff
+		assert.NoError(t, worker.Tick(context.Background()))
+		now = cutoff.Add(time.Minute)
+		assert.NoError(t, worker.Tick(context.Background()))
+
+		// Assert
+		assert.Equal(t, []string{crossing.ID}, invalidator.calls)
+	})
+
+	t.Run("repository error retries window", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		mockRepo := seminarmock.NewMockRepository(ctrl)
+		invalidator := &recordingInvalidator{}
+		now := cutoff.Add(-time.Minute)
+		worker := newPriceWorker(mockRepo, invalidator, DefaultPriceWorkerConfig, slog.New(slog.DiscardHandler), func() time.Time { return now })
+		start := now
+		dbErr := errors.New("database error")
+		gomock.InOrder(
+			mockRepo.EXPECT().ListByLatePaymentDateBetween(gomock.Any(), start, gomock.Any(), gomock.Any()).Return(nil, dbErr),
+			mockRepo.EXPECT().ListByLatePaymentDateBetween(gomock.Any(), start, gomock.Any(), gomock.Any()).Return([]seminar.Seminar{crossing}, nil),
+		)
+
+		// Act
+		now = cutoff.Add(time.Second)
+		firstErr := worker.Tick(context.Background())
+		now = cutoff.Add(time.Minute)
+		secondErr := worker.Tick(context.Background())
+
+		// Assert
+		assert.ErrorIs(t, firstErr, dbErr)
+		assert.NoError(t, secondErr)
+		assert.Equal(t, []string{crossing.ID}, invalidator.calls)
+	})
+
+	t.Run("invalidation error does not stop others", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		mockRepo := seminarmock.NewMockRepository(ctrl)
+		invalidator := &recordingInvalidator{err: errors.New("broker down")}
+		other := seminar.Seminar{ID: uuid.New().String(), LatePaymentDate: cutoff}
+		now := cutoff.Add(-time.Minute)
+		worker := newPriceWorker(mockRepo, invalidator, DefaultPriceWorkerConfig, slog.New(slog.DiscardHandler), func() time.Time { return now })
+		mockRepo.EXPECT().ListByLatePaymentDateBetween(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]seminar.Seminar{crossing, other}, nil)
+
+		// Act
+		now = cutoff.Add(time.Minute)
+		err := worker.Tick(context.Background())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{crossing.ID, other.ID}, invalidator.calls)
+	})
+}
+
+func TestPriceWorkerConfigFromEnv(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		t.Setenv("SEMINAR_PRICE_WORKER_INTERVAL", "")
+		cfg, err := PriceWorkerConfigFromEnv()
+		assert.NoError(t, err)
+		assert.Equal(t, DefaultPriceWorkerConfig, cfg)
+	})
+
+	t.Run("interval", func(t *testing.T) {
+		t.Setenv("SEMINAR_PRICE_WORKER_INTERVAL", "30s")
+		cfg, err := PriceWorkerConfigFromEnv()
+		assert.NoError(t, err)
+		assert.Equal(t, 30*time.Second, cfg.Interval)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Setenv("SEMINAR_PRICE_WORKER_INTERVAL", "soon")
+		_, err := PriceWorkerConfigFromEnv()
+		assert.Error(t, err)
+	})
+}
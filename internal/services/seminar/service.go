@@ -23,12 +23,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	featuredrepo "github.com/mikhail5545/product-service-go/internal/database/featured"
 	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
 	seminarrepo "github.com/mikhail5545/product-service-go/internal/database/seminar"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
 	seminarmodel "github.com/mikhail5545/product-service-go/internal/models/seminar"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -37,12 +45,27 @@ import (
 // Service provides service-layer business logic for seminar models.
 type Service interface {
 	// Get retrieves a single published and not soft-deleted seminar record from the database,
-	// along with all of its associated products details (prices and product IDs).
+	// along with all of its associated products details (prices and product IDs) if includeProducts is true.
+	// Otherwise products are not queried and the details have ProductsOmitted set.
 	//
 	// Returns a SeminarDetails struct containing the combined information.
-	// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
-	// or a database/internal error occurs.
-	Get(ctx context.Context, id string) (*seminarmodel.SeminarDetails, error)
+	// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound,
+	// together with ErrGone if it is soft-deleted), or a database/internal error occurs.
+	Get(ctx context.Context, id string, includeProducts bool) (*seminarmodel.SeminarDetails, error)
+	// GetByIDs retrieves multiple published and not soft-deleted seminar records along with
+	// their associated products details, like [Service.Get] does for a single record.
+	// Records are fetched in bulk, duplicate IDs are looked up once.
+	//
+	// Returns the found details keyed by seminar ID, and the reason every other ID failed keyed by that ID:
+	// ErrInvalidArgument for an invalid ID, ErrNotFound, ErrIncompleteData or ErrProductsNotFound for
+	// a missing or broken record. A database/internal error is reported for every ID it affected.
+	GetByIDs(ctx context.Context, ids []string) (map[string]*seminarmodel.SeminarDetails, map[string]error)
+	// GetBySlug retrieves a single published and not soft-deleted seminar record by its slug,
+	// like [Service.Get] does by ID.
+	//
+	// Returns an error if the slug is malformed (ErrInvalidArgument), the record is not found (ErrNotFound,
+	// together with ErrGone if it is soft-deleted), or a database/internal error occurs.
+	GetBySlug(ctx context.Context, slug string, includeProducts bool) (*seminarmodel.SeminarDetails, error)
 	// GetWithDeleted retrieves a single seminar record from the database, including soft-deleted ones,
 	// along with all of its associated products details.
 	//
@@ -85,22 +108,41 @@ type Service interface {
 	// It validates the request payload to ensure all required fields are present.
 	// The seminar and all of the associated products are created in an unpublished state (`InStock: false`).
 	//
+	// A product is created for every role in req.Roles, or for all of [seminarmodel.AllProductRoles] if it is empty.
+	//
 	// Returns a CreateResponse containing the newly created SeminarID, ReservationProductID, EarlyProductID,
-	// LateProductID, EarlySurchargeProductID, LateSurchargeProductID.
+	// LateProductID, EarlySurchargeProductID, LateSurchargeProductID. IDs of roles that were not created are empty.
 	// Returns an error if the request payload is invalid (ErrInvalidArgument) or a database/internal error occurs.
 	Create(ctx context.Context, req *seminarmodel.CreateRequest) (*seminarmodel.CreateResponse, error)
+	// CreateIfAbsent creates a seminar like [Service.Create], but only if the slug of req.Name is not used
+	// by another seminar, soft-deleted ones included, instead of adding a numeric suffix to it.
+	// The check and the create run in one transaction and the unique slug constraint rejects
+	// a concurrent create of the same name.
+	//
+	// Returns an error if the request payload is invalid (ErrInvalidArgument), the name is taken (ErrDuplicateName),
+	// or a database/internal error occurs.
+	CreateIfAbsent(ctx context.Context, req *seminarmodel.CreateRequest) (*seminarmodel.CreateResponse, error)
 	// Publish sets the `InStock` field to true for a seminar and all of its associated products,
 	// making it available in the catalog.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
+	// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+	// Publishing an already published seminar is a no-op.
 	Publish(ctx context.Context, id string) error
 	// Unpublish sets the `InStock` field to false for a seminar and all of its associated products,
 	// archiving it from the catalog.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
+	// Unpublishing an already unpublished seminar is a no-op.
 	Unpublish(ctx context.Context, id string) error
+	// ReconcileInStock repairs drift of the `InStock` field between a seminar and its products.
+	// The seminar's state is taken as the source of truth and all of its products are set to match it.
+	// If the products already agree with the seminar, nothing is written.
+	//
+	// Returns an error if the ID is invalid (ErrInvalidArgument), the seminar is not found (ErrNotFound),
+	// or a database/internal error occurs.
+	ReconcileInStock(ctx context.Context, id string) error
 	// Update performs a partial update of a seminar and all of its related products.
 	// The request should contain the seminar's ID and the fields to be updated.
 	// At least one field must be provided for an update to occur.
@@ -111,17 +153,59 @@ type Service interface {
 	// Returns an error if the request payload is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
 	Update(ctx context.Context, req *seminarmodel.UpdateRequest) (map[string]any, error)
+	// UpdateSurcharges updates the prices of the seminar's early and late surcharge products only.
+	// A nil price leaves the corresponding product untouched; if both are nil, nothing is updated.
+	// Unpublished seminars can be updated as well.
+	//
+	// Returns an error if the ID or any price is invalid, or a price is provided for a surcharge
+	// the seminar does not have (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// or a database/internal error occurs.
+	UpdateSurcharges(ctx context.Context, id string, early, late *float32) error
+	// PriceAsOf returns the seminar price and the ID of the product that set it at the given time,
+	// applying the same early/late selection as [seminarmodel.SeminarDetails.Current].
+	// Soft-deleted and unpublished seminars and products are included, so past prices can be looked up.
+	//
+	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound, ErrProductsNotFound),
+	// the seminar is missing the price product (ErrIncompleteData) or a database/internal error occurs.
+	PriceAsOf(ctx context.Context, id string, at time.Time) (price float32, productID string, err error)
 	// Delete performs a soft-delete of a seminar and all of its related product records.
 	// It also unpublishes all records, meaning they must be manually published again after restoration.
+	// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 	//
-	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// or a database/internal error occurs.
+	Delete(ctx context.Context, id, reason string) error
+	// DeleteBatch performs a soft-delete of multiple seminars and all of their related product records
+	// in a single transaction, applying the same guards as Delete to each record.
+	// All IDs are validated before anything is deleted. Duplicate IDs are deleted once.
+	// If any record fails, the whole batch is rolled back.
+	//
+	// Returns the number of deleted seminars.
+	// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
 	// or a database/internal error occurs.
-	Delete(ctx context.Context, id string) error
+	DeleteBatch(ctx context.Context, ids []string) (int64, error)
 	// DeletePermanent performs a complete delete of a seminar and its related product records.
+	// If force is true, the related product count is not checked and whatever records exist are deleted.
+	// This is meant for cleaning up inconsistent data.
+	// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
-	DeletePermanent(ctx context.Context, id string) error
+	// the products are featured (ErrHasDependents), or a database/internal error occurs.
+	DeletePermanent(ctx context.Context, id string, force bool) error
+	// PurgeDeletedBefore permanently deletes all seminars soft-deleted before the given time,
+	// together with their product records.
+	// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
+	//
+	// Returns the number of purged seminars.
+	// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// CountDeletedBefore counts seminars soft-deleted before cutoff, that is the seminars
+	// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+	//
+	// Returns an error if a database/internal error occurs.
+	CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	// Restore performs a restore of a seminar and its related product records.
 	// Seminar and its related product records are not being published. This should be
 	// done manually.
@@ -129,59 +213,129 @@ type Service interface {
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
 	Restore(ctx context.Context, id string) error
+	// RestoreBatch restores multiple soft-deleted seminars and all of their related product records
+	// in a single transaction, applying the same guards as Restore to each record.
+	// All IDs are validated before anything is restored. Duplicate IDs are restored once.
+	// If any record fails, the whole batch is rolled back.
+	//
+	// Returns the number of restored seminars.
+	// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+	// or a database/internal error occurs.
+	RestoreBatch(ctx context.Context, ids []string) (int64, error)
+	// VerifyIntegrity checks product references of a paginated list of not soft-deleted seminars
+	// (both published and unpublished). Every set product ID must point to an existing, not soft-deleted
+	// product with details type "seminar" owned by the same seminar. Required product roles must be set,
+	// surcharge roles are optional.
+	//
+	// Returns a slice of found issues, empty if all seminars on the page are consistent.
+	// Returns an error if limit is not positive or offset is negative (ErrInvalidArgument),
+	// or a database/internal error occurs.
+	VerifyIntegrity(ctx context.Context, limit, offset int) ([]seminarmodel.IntegrityIssue, error)
 }
 
 // service provides service-layer business logic for seminar models.
 // It holds [seminarrepo.Repository] and [productrepo.Repository] instances
 // to perform database operations.
 type service struct {
-	SeminarRepo seminarrepo.Repository
-	ProductRepo productrepo.Repository
+	SeminarRepo  seminarrepo.Repository
+	ProductRepo  productrepo.Repository
+	FeaturedRepo featuredrepo.Repository
+	// now is the clock used to evaluate product sale windows, replaceable in tests.
+	now    func() time.Time
+	logger *slog.Logger
 }
 
-// New creates a new service instance with provided seminar and product repositories.
-func New(sr seminarrepo.Repository, pr productrepo.Repository) Service {
+// New creates a new service instance with provided seminar, product and featured list repositories and logger.
+func New(sr seminarrepo.Repository, pr productrepo.Repository, fr featuredrepo.Repository, logger *slog.Logger) Service {
 	return &service{
-		SeminarRepo: sr,
-		ProductRepo: pr,
+		SeminarRepo:  sr,
+		ProductRepo:  pr,
+		FeaturedRepo: fr,
+		now:          time.Now,
+		logger:       logger,
 	}
 }
 
 // Get retrieves a single published and not soft-deleted seminar record from the database,
-// along with all of its associated products details (prices and product IDs).
+// along with all of its associated products details (prices and product IDs) if includeProducts is true.
+// Otherwise products are not queried and the details have ProductsOmitted set.
 //
 // Returns a SeminarDetails struct containing the combined information.
-// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
-// or a database/internal error occurs.
-func (s *service) Get(ctx context.Context, id string) (*seminarmodel.SeminarDetails, error) {
+// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound,
+// together with ErrGone if it is soft-deleted), or a database/internal error occurs.
+func (s *service) Get(ctx context.Context, id string, includeProducts bool) (*seminarmodel.SeminarDetails, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "Get", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	seminar, err := s.SeminarRepo.Get(ctx, id)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
+			return nil, notFound(err, func() (*seminarmodel.Seminar, error) { return s.SeminarRepo.GetWithDeleted(ctx, id) })
 		}
 		return nil, fmt.Errorf("failed to retrieve seminar: %w", err)
 	}
 
-	if seminar.ReservationProductID == nil || seminar.EarlyProductID == nil || seminar.LateProductID == nil || seminar.EarlySurchargeProductID == nil || seminar.LateSurchargeProductID == nil {
-		return nil, ErrIncompleteData
+	return s.details(ctx, seminar, includeProducts)
+}
+
+// GetBySlug retrieves a single published and not soft-deleted seminar record by its slug,
+// like [Service.Get] does by ID.
+//
+// Returns an error if the slug is malformed (ErrInvalidArgument), the record is not found (ErrNotFound,
+// together with ErrGone if it is soft-deleted), or a database/internal error occurs.
+func (s *service) GetBySlug(ctx context.Context, slug string, includeProducts bool) (*seminarmodel.SeminarDetails, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "GetBySlug")
+	defer span.End()
+
+	if slug == "" {
+		return nil, fmt.Errorf("%w: slug is required", ErrInvalidArgument)
+	}
+	if err := common.ValidateSlug(slug); err != nil {
+		return nil, fmt.Errorf("%w: slug %w", ErrInvalidArgument, err)
+	}
+	seminar, err := s.SeminarRepo.GetBySlug(ctx, slug)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, notFound(err, func() (*seminarmodel.Seminar, error) { return s.SeminarRepo.GetWithDeletedBySlug(ctx, slug) })
+		}
+		return nil, fmt.Errorf("failed to retrieve seminar: %w", err)
 	}
+	return s.details(ctx, seminar, includeProducts)
+}
+
+// notFound wraps err, returned when a published seminar was not found, in ErrNotFound.
+// ErrGone is wrapped as well if withDeleted finds the seminar soft-deleted. Unpublished seminars stay plain not found.
+func notFound(err error, withDeleted func() (*seminarmodel.Seminar, error)) error {
+	if seminar, lookupErr := withDeleted(); lookupErr == nil && seminar.DeletedAt.Valid {
+		return fmt.Errorf("%w: %w", ErrNotFound, ErrGone)
+	}
+	return fmt.Errorf("%w: %w", ErrNotFound, err)
+}
 
-	productIDs := []string{
-		*seminar.ReservationProductID,
-		*seminar.EarlyProductID,
-		*seminar.LateProductID,
-		*seminar.EarlySurchargeProductID,
-		*seminar.LateSurchargeProductID,
+// details combines a published seminar with its products details for [Service.Get] and [Service.GetBySlug].
+func (s *service) details(ctx context.Context, seminar *seminarmodel.Seminar, includeProducts bool) (*seminarmodel.SeminarDetails, error) {
+	if !seminar.HasRequiredProducts() {
+		return nil, ErrIncompleteData
+	}
+	if !includeProducts {
+		return &seminarmodel.SeminarDetails{
+			Seminar:         seminar,
+			CreatedAt:       seminar.CreatedAt,
+			UpdatedAt:       seminar.UpdatedAt,
+			ProductsOmitted: true,
+		}, nil
 	}
 
-	products, err := s.ProductRepo.SelectByIDs(ctx, productIDs, "price")
+	productIDs := seminar.ProductIDs()
+
+	products, err := s.ProductRepo.SelectByIDs(ctx, productIDs, "id", "price", "available_from", "available_until")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get seminar products: %w", err)
 	}
-	if len(products) != 5 {
+	if len(products) != len(productIDs) {
 		return nil, ErrProductsNotFound
 	}
 
@@ -190,19 +344,86 @@ func (s *service) Get(ctx context.Context, id string) (*seminarmodel.SeminarDeta
 		productMap[products[i].ID] = &products[i]
 	}
 
-	details := seminarmodel.SeminarDetails{
-		Seminar:             seminar,
-		ReservationPrice:    productMap[*seminar.ReservationProductID].Price,
-		EarlyPrice:          productMap[*seminar.EarlyProductID].Price,
-		LatePrice:           productMap[*seminar.LateProductID].Price,
-		EarlySurchargePrice: productMap[*seminar.EarlySurchargeProductID].Price,
-		LateSurchargePrice:  productMap[*seminar.LateSurchargeProductID].Price,
-	}
-	details.Current()
+	details := s.newDetails(*seminar, productMap)
 
 	return &details, nil
 }
 
+// GetByIDs retrieves multiple published and not soft-deleted seminar records along with
+// their associated products details, like [Service.Get] does for a single record.
+// Records are fetched in bulk, duplicate IDs are looked up once.
+//
+// Returns the found details keyed by seminar ID, and the reason every other ID failed keyed by that ID:
+// ErrInvalidArgument for an invalid ID, ErrNotFound, ErrIncompleteData or ErrProductsNotFound for
+// a missing or broken record. A database/internal error is reported for every ID it affected.
+func (s *service) GetByIDs(ctx context.Context, ids []string) (map[string]*seminarmodel.SeminarDetails, map[string]error) {
+	ctx, span := tracing.Start(ctx, "seminar", "GetByIDs")
+	defer span.End()
+
+	found := make(map[string]*seminarmodel.SeminarDetails, len(ids))
+	errs := make(map[string]error)
+	seen := make(map[string]struct{}, len(ids))
+	var validIDs []string
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		if _, err := uuid.Parse(id); err != nil {
+			errs[id] = fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+			continue
+		}
+		validIDs = append(validIDs, id)
+	}
+	if len(validIDs) == 0 {
+		return found, errs
+	}
+	failAll := func(err error) (map[string]*seminarmodel.SeminarDetails, map[string]error) {
+		for _, id := range validIDs {
+			errs[id] = err
+		}
+		return found, errs
+	}
+
+	seminars, err := s.SeminarRepo.ListByIDs(ctx, validIDs...)
+	if err != nil {
+		return failAll(fmt.Errorf("failed to retrieve seminars: %w", err))
+	}
+	var productIDs []string
+	for _, seminar := range seminars {
+		productIDs = append(productIDs, seminar.ProductIDs()...)
+	}
+	products, err := s.ProductRepo.SelectByIDs(ctx, productIDs, "id", "price", "available_from", "available_until")
+	if err != nil {
+		return failAll(fmt.Errorf("failed to get seminar products: %w", err))
+	}
+	productMap := make(map[string]*productmodel.Product, len(products))
+	for i := range products {
+		productMap[products[i].ID] = &products[i]
+	}
+
+	for _, seminar := range seminars {
+		switch {
+		case !seminar.HasRequiredProducts():
+			errs[seminar.ID] = ErrIncompleteData
+		case hasMissingProducts(productMap, &seminar):
+			errs[seminar.ID] = ErrProductsNotFound
+		default:
+			details := s.newDetails(seminar, productMap)
+			found[seminar.ID] = &details
+		}
+	}
+	for _, id := range validIDs {
+		if _, ok := found[id]; ok {
+			continue
+		}
+		if _, ok := errs[id]; !ok {
+			errs[id] = ErrNotFound
+		}
+	}
+	return found, errs
+}
+
 // GetWithDeleted retrieves a single seminar record from the database, including soft-deleted ones,
 // along with all of its associated products details.
 //
@@ -210,6 +431,9 @@ func (s *service) Get(ctx context.Context, id string) (*seminarmodel.SeminarDeta
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithDeleted(ctx context.Context, id string) (*seminarmodel.SeminarDetails, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "GetWithDeleted", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -221,23 +445,17 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*seminarmodel.
 		return nil, fmt.Errorf("failed to retrieve seminar: %w", err)
 	}
 
-	if seminar.ReservationProductID == nil || seminar.EarlyProductID == nil || seminar.LateProductID == nil || seminar.EarlySurchargeProductID == nil || seminar.LateSurchargeProductID == nil {
+	if !seminar.HasRequiredProducts() {
 		return nil, ErrIncompleteData
 	}
 
-	productIDs := []string{
-		*seminar.ReservationProductID,
-		*seminar.EarlyProductID,
-		*seminar.LateProductID,
-		*seminar.EarlySurchargeProductID,
-		*seminar.LateSurchargeProductID,
-	}
+	productIDs := seminar.ProductIDs()
 
-	products, err := s.ProductRepo.SelectWithDeletedByIDs(ctx, productIDs, "price")
+	products, err := s.ProductRepo.SelectWithDeletedByIDs(ctx, productIDs, "id", "price", "available_from", "available_until", "first_published_at", "last_published_at")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get seminar products: %w", err)
 	}
-	if len(products) != 5 {
+	if len(products) != len(productIDs) {
 		return nil, ErrProductsNotFound
 	}
 
@@ -246,15 +464,8 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*seminarmodel.
 		productMap[products[i].ID] = &products[i]
 	}
 
-	details := seminarmodel.SeminarDetails{
-		Seminar:             seminar,
-		ReservationPrice:    productMap[*seminar.ReservationProductID].Price,
-		EarlyPrice:          productMap[*seminar.EarlyProductID].Price,
-		LatePrice:           productMap[*seminar.LateProductID].Price,
-		EarlySurchargePrice: productMap[*seminar.EarlySurchargeProductID].Price,
-		LateSurchargePrice:  productMap[*seminar.LateSurchargeProductID].Price,
-	}
-	details.Current()
+	details := s.newDetails(*seminar, productMap)
+	details.FirstPublishedAt, details.LastPublishedAt = publishTimes(products)
 
 	return &details, nil
 }
@@ -266,6 +477,9 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*seminarmodel.
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithUnpublished(ctx context.Context, id string) (*seminarmodel.SeminarDetails, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "GetWithUnpublished", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -277,23 +491,17 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*seminarmo
 		return nil, fmt.Errorf("failed to retrieve seminar: %w", err)
 	}
 
-	if seminar.ReservationProductID == nil || seminar.EarlyProductID == nil || seminar.LateProductID == nil || seminar.EarlySurchargeProductID == nil || seminar.LateSurchargeProductID == nil {
+	if !seminar.HasRequiredProducts() {
 		return nil, ErrIncompleteData
 	}
 
-	productIDs := []string{
-		*seminar.ReservationProductID,
-		*seminar.EarlyProductID,
-		*seminar.LateProductID,
-		*seminar.EarlySurchargeProductID,
-		*seminar.LateSurchargeProductID,
-	}
+	productIDs := seminar.ProductIDs()
 
-	products, err := s.ProductRepo.SelectWithUnpublishedByIDs(ctx, productIDs, "price")
+	products, err := s.ProductRepo.SelectWithUnpublishedByIDs(ctx, productIDs, "id", "price", "available_from", "available_until", "first_published_at", "last_published_at")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get seminar products: %w", err)
 	}
-	if len(products) != 5 {
+	if len(products) != len(productIDs) {
 		return nil, ErrProductsNotFound
 	}
 
@@ -302,19 +510,33 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*seminarmo
 		productMap[products[i].ID] = &products[i]
 	}
 
-	details := seminarmodel.SeminarDetails{
-		Seminar:             seminar,
-		ReservationPrice:    productMap[*seminar.ReservationProductID].Price,
-		EarlyPrice:          productMap[*seminar.EarlyProductID].Price,
-		LatePrice:           productMap[*seminar.LateProductID].Price,
-		EarlySurchargePrice: productMap[*seminar.EarlySurchargeProductID].Price,
-		LateSurchargePrice:  productMap[*seminar.LateSurchargeProductID].Price,
-	}
-	details.Current()
+	details := s.newDetails(*seminar, productMap)
+	details.FirstPublishedAt, details.LastPublishedAt = publishTimes(products)
 
 	return &details, nil
 }
 
+// newDetails combines seminar with prices of its products from productMap. The seminar is taken by value
+// and its Tags and Images are cloned, so the details never alias a slice or record returned by the repository.
+func (s *service) newDetails(seminar seminarmodel.Seminar, productMap map[string]*productmodel.Product) seminarmodel.SeminarDetails {
+	seminar.Tags = slices.Clone(seminar.Tags)
+	seminar.Images = slices.Clone(seminar.Images)
+	details := seminarmodel.SeminarDetails{
+		Seminar:             &seminar,
+		CreatedAt:           seminar.CreatedAt,
+		UpdatedAt:           seminar.UpdatedAt,
+		ReservationPrice:    safeGetPrice(productMap, seminar.ReservationProductID),
+		EarlyPrice:          safeGetPrice(productMap, seminar.EarlyProductID),
+		LatePrice:           safeGetPrice(productMap, seminar.LateProductID),
+		EarlySurchargePrice: safeGetPrice(productMap, seminar.EarlySurchargeProductID),
+		LateSurchargePrice:  safeGetPrice(productMap, seminar.LateSurchargeProductID),
+	}
+	now := s.now()
+	details.Current(now)
+	details.Available = productMap[details.CurrentPriceProductID].IsAvailableAt(now)
+	return details
+}
+
 // safeGetPrice retrieves a product's price from the map, returning 0 if the ID pointer is nil or the product is not found.
 func safeGetPrice(productMap map[string]*productmodel.Product, id *string) float32 {
 	if id == nil {
@@ -326,14 +548,36 @@ func safeGetPrice(productMap map[string]*productmodel.Product, id *string) float
 	return 0
 }
 
-// hasMissingProducts checks if any of the required product IDs are missing from the product map.
+// publishTimes returns the earliest FirstPublishedAt and the latest LastPublishedAt of products,
+// nil if none of them has been published.
+func publishTimes(products []productmodel.Product) (first, last *time.Time) {
+	for i := range products {
+		if p := products[i].FirstPublishedAt; p != nil && (first == nil || p.Before(*first)) {
+			first = p
+		}
+		if p := products[i].LastPublishedAt; p != nil && (last == nil || p.After(*last)) {
+			last = p
+		}
+	}
+	return first, last
+}
+
+// derefString returns the value of s or an empty string if s is nil.
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// hasMissingProducts checks if any of the seminar's product IDs are missing from the product map.
 func hasMissingProducts(productMap map[string]*productmodel.Product, seminar *seminarmodel.Seminar) bool {
-	_, ok1 := productMap[*seminar.ReservationProductID]
-	_, ok2 := productMap[*seminar.EarlyProductID]
-	_, ok3 := productMap[*seminar.LateProductID]
-	_, ok4 := productMap[*seminar.EarlySurchargeProductID]
-	_, ok5 := productMap[*seminar.LateSurchargeProductID]
-	return !ok1 || !ok2 || !ok3 || !ok4 || !ok5
+	for _, id := range seminar.ProductIDs() {
+		if _, ok := productMap[id]; !ok {
+			return true
+		}
+	}
+	return false
 }
 
 // List retrieves a paginated list of all published and not soft-deleted seminar records.
@@ -344,6 +588,9 @@ func hasMissingProducts(productMap map[string]*productmodel.Product, seminar *se
 // Returns a slice of SeminarDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) List(ctx context.Context, limit, offset int) ([]seminarmodel.SeminarDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "List")
+	defer span.End()
+
 	seminars, err := s.SeminarRepo.List(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve seminars: %w", err)
@@ -352,25 +599,11 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]seminarmodel.S
 	// Collect all product IDs from all seminars
 	var productIDs []string
 	for _, seminar := range seminars {
-		if seminar.ReservationProductID != nil {
-			productIDs = append(productIDs, *seminar.ReservationProductID)
-		}
-		if seminar.EarlyProductID != nil {
-			productIDs = append(productIDs, *seminar.EarlyProductID)
-		}
-		if seminar.LateProductID != nil {
-			productIDs = append(productIDs, *seminar.LateProductID)
-		}
-		if seminar.EarlySurchargeProductID != nil {
-			productIDs = append(productIDs, *seminar.EarlySurchargeProductID)
-		}
-		if seminar.LateSurchargeProductID != nil {
-			productIDs = append(productIDs, *seminar.LateSurchargeProductID)
-		}
+		productIDs = append(productIDs, seminar.ProductIDs()...)
 	}
 
 	// Fetch all products in a single query
-	products, err := s.ProductRepo.SelectByIDs(ctx, productIDs, "price")
+	products, err := s.ProductRepo.SelectByIDs(ctx, productIDs, "id", "price", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -384,19 +617,12 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]seminarmodel.S
 	var allDetails []seminarmodel.SeminarDetails
 	for _, seminar := range seminars {
 		// Skip seminars that have missing product IDs or if their products weren't found.
-		if seminar.ReservationProductID == nil || seminar.EarlyProductID == nil || seminar.LateProductID == nil || seminar.EarlySurchargeProductID == nil || seminar.LateSurchargeProductID == nil || hasMissingProducts(productMap, &seminar) {
+		if !seminar.HasRequiredProducts() || hasMissingProducts(productMap, &seminar) {
+			s.logger.DebugContext(ctx, "dropped incomplete seminar", "seminar_id", seminar.ID)
 			continue
 		}
 
-		details := seminarmodel.SeminarDetails{
-			Seminar:             &seminar,
-			ReservationPrice:    safeGetPrice(productMap, seminar.ReservationProductID),
-			EarlyPrice:          safeGetPrice(productMap, seminar.EarlyProductID),
-			LatePrice:           safeGetPrice(productMap, seminar.LateProductID),
-			EarlySurchargePrice: safeGetPrice(productMap, seminar.EarlySurchargeProductID),
-			LateSurchargePrice:  safeGetPrice(productMap, seminar.LateSurchargeProductID),
-		}
-		details.Current()
+		details := s.newDetails(seminar, productMap)
 		allDetails = append(allDetails, details)
 	}
 	total, err := s.SeminarRepo.Count(ctx)
@@ -414,6 +640,9 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]seminarmodel.S
 // Returns a slice of SeminarDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]seminarmodel.SeminarDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "ListUnpublished")
+	defer span.End()
+
 	seminars, err := s.SeminarRepo.ListUnpublished(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve seminars: %w", err)
@@ -422,25 +651,11 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]sem
 	// Collect all product IDs from all seminars
 	var productIDs []string
 	for _, seminar := range seminars {
-		if seminar.ReservationProductID != nil {
-			productIDs = append(productIDs, *seminar.ReservationProductID)
-		}
-		if seminar.EarlyProductID != nil {
-			productIDs = append(productIDs, *seminar.EarlyProductID)
-		}
-		if seminar.LateProductID != nil {
-			productIDs = append(productIDs, *seminar.LateProductID)
-		}
-		if seminar.EarlySurchargeProductID != nil {
-			productIDs = append(productIDs, *seminar.EarlySurchargeProductID)
-		}
-		if seminar.LateSurchargeProductID != nil {
-			productIDs = append(productIDs, *seminar.LateSurchargeProductID)
-		}
+		productIDs = append(productIDs, seminar.ProductIDs()...)
 	}
 
 	// Fetch all products in a single query
-	products, err := s.ProductRepo.SelectWithUnpublishedByIDs(ctx, productIDs, "price")
+	products, err := s.ProductRepo.SelectWithUnpublishedByIDs(ctx, productIDs, "id", "price", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -454,19 +669,12 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]sem
 	var allDetails []seminarmodel.SeminarDetails
 	for _, seminar := range seminars {
 		// Skip seminars that have missing product IDs or if their products weren't found.
-		if seminar.ReservationProductID == nil || seminar.EarlyProductID == nil || seminar.LateProductID == nil || seminar.EarlySurchargeProductID == nil || seminar.LateSurchargeProductID == nil || hasMissingProducts(productMap, &seminar) {
+		if !seminar.HasRequiredProducts() || hasMissingProducts(productMap, &seminar) {
+			s.logger.DebugContext(ctx, "dropped incomplete seminar", "seminar_id", seminar.ID)
 			continue
 		}
 
-		details := seminarmodel.SeminarDetails{
-			Seminar:             &seminar,
-			ReservationPrice:    safeGetPrice(productMap, seminar.ReservationProductID),
-			EarlyPrice:          safeGetPrice(productMap, seminar.EarlyProductID),
-			LatePrice:           safeGetPrice(productMap, seminar.LateProductID),
-			EarlySurchargePrice: safeGetPrice(productMap, seminar.EarlySurchargeProductID),
-			LateSurchargePrice:  safeGetPrice(productMap, seminar.LateSurchargeProductID),
-		}
-		details.Current()
+		details := s.newDetails(seminar, productMap)
 		allDetails = append(allDetails, details)
 	}
 	total, err := s.SeminarRepo.CountUnpublished(ctx)
@@ -484,6 +692,9 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]sem
 // Returns a slice of SeminarDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]seminarmodel.SeminarDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "ListDeleted")
+	defer span.End()
+
 	seminars, err := s.SeminarRepo.ListDeleted(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve seminars: %w", err)
@@ -492,25 +703,11 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]seminar
 	// Collect all product IDs from all seminars
 	var productIDs []string
 	for _, seminar := range seminars {
-		if seminar.ReservationProductID != nil {
-			productIDs = append(productIDs, *seminar.ReservationProductID)
-		}
-		if seminar.EarlyProductID != nil {
-			productIDs = append(productIDs, *seminar.EarlyProductID)
-		}
-		if seminar.LateProductID != nil {
-			productIDs = append(productIDs, *seminar.LateProductID)
-		}
-		if seminar.EarlySurchargeProductID != nil {
-			productIDs = append(productIDs, *seminar.EarlySurchargeProductID)
-		}
-		if seminar.LateSurchargeProductID != nil {
-			productIDs = append(productIDs, *seminar.LateSurchargeProductID)
-		}
+		productIDs = append(productIDs, seminar.ProductIDs()...)
 	}
 
 	// Fetch all products in a single query
-	products, err := s.ProductRepo.SelectWithDeletedByIDs(ctx, productIDs, "price")
+	products, err := s.ProductRepo.SelectWithDeletedByIDs(ctx, productIDs, "id", "price", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to retrieve products: %w", err)
 	}
@@ -524,19 +721,12 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]seminar
 	var allDetails []seminarmodel.SeminarDetails
 	for _, seminar := range seminars {
 		// Skip seminars that have missing product IDs or if their products weren't found.
-		if seminar.ReservationProductID == nil || seminar.EarlyProductID == nil || seminar.LateProductID == nil || seminar.EarlySurchargeProductID == nil || seminar.LateSurchargeProductID == nil || hasMissingProducts(productMap, &seminar) {
+		if !seminar.HasRequiredProducts() || hasMissingProducts(productMap, &seminar) {
+			s.logger.DebugContext(ctx, "dropped incomplete seminar", "seminar_id", seminar.ID)
 			continue
 		}
 
-		details := seminarmodel.SeminarDetails{
-			Seminar:             &seminar,
-			ReservationPrice:    safeGetPrice(productMap, seminar.ReservationProductID),
-			EarlyPrice:          safeGetPrice(productMap, seminar.EarlyProductID),
-			LatePrice:           safeGetPrice(productMap, seminar.LateProductID),
-			EarlySurchargePrice: safeGetPrice(productMap, seminar.EarlySurchargeProductID),
-			LateSurchargePrice:  safeGetPrice(productMap, seminar.LateSurchargeProductID),
-		}
-		details.Current()
+		details := s.newDetails(seminar, productMap)
 		allDetails = append(allDetails, details)
 	}
 	total, err := s.SeminarRepo.CountDeleted(ctx)
@@ -550,21 +740,56 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]seminar
 // It validates the request payload to ensure all required fields are present.
 // The seminar and all of the associated products are created in an unpublished state (`InStock: false`).
 //
+// A product is created for every role in req.Roles, or for all of [seminarmodel.AllProductRoles] if it is empty.
+//
 // Returns a CreateResponse containing the newly created SeminarID, ReservationProductID, EarlyProductID,
-// LateProductID, EarlySurchargeProductID, LateSurchargeProductID.
+// LateProductID, EarlySurchargeProductID, LateSurchargeProductID. IDs of roles that were not created are empty.
 // Returns an error if the request payload is invalid (ErrInvalidArgument) or a database/internal error occurs.
 func (s *service) Create(ctx context.Context, req *seminarmodel.CreateRequest) (*seminarmodel.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "Create")
+	defer span.End()
+
+	return s.create(ctx, req, false)
+}
+
+// CreateIfAbsent creates a seminar like [Service.Create], but only if the slug of req.Name is not used
+// by another seminar, soft-deleted ones included, instead of adding a numeric suffix to it.
+// The check and the create run in one transaction and the unique slug constraint rejects
+// a concurrent create of the same name.
+//
+// Returns an error if the request payload is invalid (ErrInvalidArgument), the name is taken (ErrDuplicateName),
+// or a database/internal error occurs.
+func (s *service) CreateIfAbsent(ctx context.Context, req *seminarmodel.CreateRequest) (*seminarmodel.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "CreateIfAbsent")
+	defer span.End()
+
+	return s.create(ctx, req, true)
+}
+
+// create creates the seminar and its products. If ifAbsent is set, a taken slug is reported as
+// ErrDuplicateName instead of being suffixed.
+func (s *service) create(ctx context.Context, req *seminarmodel.CreateRequest, ifAbsent bool) (*seminarmodel.CreateResponse, error) {
 	seminar := &seminarmodel.Seminar{}
 	err := s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSeminarRepo := s.SeminarRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
 
+		req.Sanitize()
 		if err := req.Validate(); err != nil {
 			return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 		}
 
 		seminar.ID = uuid.New().String()
 		seminar.Name = req.Name
+		slugFn := uniqueSlug
+		if ifAbsent {
+			slugFn = freeSlug
+		}
+		slug, err := slugFn(ctx, txSeminarRepo, req.Name, "")
+		if err != nil {
+			return err
+		}
+		seminar.Slug = slug
 		seminar.ShortDescription = req.ShortDescription
 		seminar.Date = req.Date
 		seminar.EndingDate = req.EndingDate
@@ -572,38 +797,30 @@ func (s *service) Create(ctx context.Context, req *seminarmodel.CreateRequest) (
 		seminar.LatePaymentDate = req.LatePaymentDate
 		seminar.InStock = false
 
-		products := []*productmodel.Product{
-			{ID: uuid.New().String(), Price: req.ReservationPrice, InStock: false},
-			{ID: uuid.New().String(), Price: req.EarlyPrice, InStock: false},
-			{ID: uuid.New().String(), Price: req.LatePrice, InStock: false},
-			{ID: uuid.New().String(), Price: req.EarlySurchargePrice, InStock: false},
-			{ID: uuid.New().String(), Price: req.LateSurchargePrice, InStock: false},
-		}
-
-		for _, p := range products {
-			p.DetailsID = seminar.ID
-			p.DetailsType = "seminar"
+		roles := req.ProductRoles()
+		products := make([]*productmodel.Product, 0, len(roles))
+		for _, role := range roles {
+			products = append(products, &productmodel.Product{
+				ID:          uuid.New().String(),
+				Price:       req.Price(role),
+				InStock:     false,
+				DetailsID:   seminar.ID,
+				DetailsType: "seminar",
+			})
 		}
 
 		if err := txProductRepo.CreateBatch(ctx, products...); err != nil {
 			return fmt.Errorf("failed to create seminar products: %w", err)
 		}
 
-		productIDMap := map[float32]*string{
-			req.ReservationPrice:    &products[0].ID,
-			req.EarlyPrice:          &products[1].ID,
-			req.LatePrice:           &products[2].ID,
-			req.EarlySurchargePrice: &products[3].ID,
-			req.LateSurchargePrice:  &products[4].ID,
+		for i, role := range roles {
+			seminar.SetProductID(role, &products[i].ID)
 		}
 
-		seminar.ReservationProductID = productIDMap[req.ReservationPrice]
-		seminar.EarlyProductID = productIDMap[req.EarlyPrice]
-		seminar.LateProductID = productIDMap[req.LatePrice]
-		seminar.EarlySurchargeProductID = productIDMap[req.EarlySurchargePrice]
-		seminar.LateSurchargeProductID = productIDMap[req.LateSurchargePrice]
-
 		if err := txSeminarRepo.Create(ctx, seminar); err != nil {
+			if ifAbsent && errors.Is(err, gorm.ErrDuplicatedKey) {
+				return fmt.Errorf("%w: %s", ErrDuplicateName, req.Name)
+			}
 			return fmt.Errorf("failed to create seminar: %w", err)
 		}
 		return nil
@@ -613,38 +830,98 @@ func (s *service) Create(ctx context.Context, req *seminarmodel.CreateRequest) (
 	}
 	return &seminarmodel.CreateResponse{
 		ID:                      seminar.ID,
-		ReservationProductID:    *seminar.ReservationProductID,
-		EarlyProductID:          *seminar.EarlyProductID,
-		LateProductID:           *seminar.LateProductID,
-		EarlySurchargeProductID: *seminar.EarlySurchargeProductID,
-		LateSurchargeProductID:  *seminar.LateSurchargeProductID,
+		ReservationProductID:    derefString(seminar.ReservationProductID),
+		EarlyProductID:          derefString(seminar.EarlyProductID),
+		LateProductID:           derefString(seminar.LateProductID),
+		EarlySurchargeProductID: derefString(seminar.EarlySurchargeProductID),
+		LateSurchargeProductID:  derefString(seminar.LateSurchargeProductID),
 	}, nil
 }
 
+// uniqueSlug generates a slug from name that no other seminar uses, soft-deleted ones included,
+// adding a numeric suffix on collision. current is the seminar's own slug, it is not treated as taken.
+func uniqueSlug(ctx context.Context, repo seminarrepo.Repository, name, current string) (string, error) {
+	base := common.Slugify(name)
+	if base == "" {
+		base = "seminar"
+	}
+	taken, err := repo.ListSlugsByPrefix(ctx, base)
+	if err != nil {
+		return "", fmt.Errorf("failed to check seminar slugs: %w", err)
+	}
+	taken = slices.DeleteFunc(taken, func(slug string) bool { return slug == current })
+	return common.UniqueSlug(base, taken), nil
+}
+
+// freeSlug generates a slug from name like uniqueSlug, but returns ErrDuplicateName if another seminar,
+// soft-deleted ones included, already uses it. current is the seminar's own slug, it is not treated as taken.
+func freeSlug(ctx context.Context, repo seminarrepo.Repository, name, current string) (string, error) {
+	slug := common.Slugify(name)
+	if slug == "" {
+		slug = "seminar"
+	}
+	if slug == current {
+		return slug, nil
+	}
+	if _, err := repo.GetWithDeletedBySlug(ctx, slug); err == nil {
+		return "", fmt.Errorf("%w: %s", ErrDuplicateName, name)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", fmt.Errorf("failed to check seminar slug: %w", err)
+	}
+	return slug, nil
+}
+
 // Publish sets the `InStock` field to true for a seminar and all of its associated products,
 // making it available in the catalog.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
+// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+// Publishing an already published seminar is a no-op.
 func (s *service) Publish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "seminar", "Publish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: invalid seminar ID: %w", ErrInvalidArgument, err)
 	}
-	return s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
+	return database.Transaction(ctx, s.SeminarRepo.DB(), database.GuardIsolation(), func(tx *gorm.DB) error {
 		txSeminarRepo := s.SeminarRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txSeminarRepo.SetInStock(ctx, id, true)
+		seminar, err := txSeminarRepo.GetWithUnpublished(ctx, id)
 		if err != nil {
-			return fmt.Errorf("failed to publish seminar: %w", err)
-		} else if ra == 0 {
-			return ErrNotFound
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %w", ErrNotFound, err)
+			}
+			return fmt.Errorf("failed to get seminar: %w", err)
 		}
-		ra, err = txProductRepo.SetInStockByDetailsID(ctx, id, true)
+		expected := len(seminar.ProductIDs())
+		products, err := txProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, []string{id}, "id", "price", "is_free", "in_stock")
 		if err != nil {
-			return fmt.Errorf("failed to publish seminar products: %w", err)
-		} else if ra != 5 {
+			return fmt.Errorf("failed to get seminar products: %w", err)
+		}
+		if len(products) != expected {
 			// This indicates a data integrity issue.
-			return fmt.Errorf("failed to publish all 5 seminar products, only %d were updated", ra)
+			return fmt.Errorf("failed to publish all %d seminar products, only %d were found", expected, len(products))
+		}
+		productsPublished := true
+		for _, product := range products {
+			if !product.IsPublishable() {
+				return fmt.Errorf("%w: product %s", ErrCannotPublishFree, product.ID)
+			}
+			productsPublished = productsPublished && product.InStock
+		}
+		res, err := txSeminarRepo.SetInStock(ctx, id, true)
+		if err != nil {
+			return fmt.Errorf("failed to publish seminar: %w", err)
+		}
+		if res.NotFound() {
+			return fmt.Errorf("%w: seminar", ErrNotFound)
+		}
+		if res.AlreadyInState && productsPublished {
+			return nil
+		}
+		if _, err := txProductRepo.PublishByDetailsID(ctx, id, s.now()); err != nil {
+			return fmt.Errorf("failed to publish seminar products: %w", err)
 		}
 		return nil
 	})
@@ -655,26 +932,84 @@ func (s *service) Publish(ctx context.Context, id string) error {
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
+// Unpublishing an already unpublished seminar is a no-op.
 func (s *service) Unpublish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "seminar", "Unpublish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: invalid seminar ID: %w", ErrInvalidArgument, err)
 	}
-	return s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
+	return database.Transaction(ctx, s.SeminarRepo.DB(), database.GuardIsolation(), func(tx *gorm.DB) error {
 		txSeminarRepo := s.SeminarRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txSeminarRepo.SetInStock(ctx, id, false)
+		seminar, err := txSeminarRepo.GetWithUnpublished(ctx, id)
 		if err != nil {
-			return fmt.Errorf("failed to unpublish seminar: %w", err)
-		} else if ra == 0 {
-			return ErrNotFound
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %w", ErrNotFound, err)
+			}
+			return fmt.Errorf("failed to get seminar: %w", err)
 		}
-		ra, err = txProductRepo.SetInStockByDetailsID(ctx, id, false)
+		expected := len(seminar.ProductIDs())
+		products, err := txProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, []string{id}, "id")
 		if err != nil {
-			return fmt.Errorf("failed to unpublish seminar products: %w", err)
-		} else if ra != 5 {
+			return fmt.Errorf("failed to get seminar products: %w", err)
+		}
+		if len(products) != expected {
 			// This indicates a data integrity issue.
-			return fmt.Errorf("failed to unpublish all 5 seminar products, only %d were updated", ra)
+			return fmt.Errorf("failed to unpublish all %d seminar products, only %d were found", expected, len(products))
+		}
+		res, err := txSeminarRepo.SetInStock(ctx, id, false)
+		if err != nil {
+			return fmt.Errorf("failed to unpublish seminar: %w", err)
+		}
+		if res.NotFound() {
+			return fmt.Errorf("%w: seminar", ErrNotFound)
+		}
+		if _, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now()); err != nil {
+			return fmt.Errorf("failed to unpublish seminar products: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReconcileInStock repairs drift of the `InStock` field between a seminar and its products.
+// The seminar's state is taken as the source of truth and all of its products are set to match it.
+// If the products already agree with the seminar, nothing is written.
+//
+// Returns an error if the ID is invalid (ErrInvalidArgument), the seminar is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) ReconcileInStock(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "seminar", "ReconcileInStock", tracing.ID(id))
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: invalid seminar ID: %w", ErrInvalidArgument, err)
+	}
+	return database.Transaction(ctx, s.SeminarRepo.DB(), database.GuardIsolation(), func(tx *gorm.DB) error {
+		txSeminarRepo := s.SeminarRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		seminar, err := txSeminarRepo.GetWithUnpublished(ctx, id)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fmt.Errorf("%w: %w", ErrNotFound, err)
+			}
+			return fmt.Errorf("failed to get seminar: %w", err)
 		}
+		products, err := txProductRepo.SelectWithUnpublishedByIDs(ctx, seminar.ProductIDs(), "id", "in_stock")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve seminar products: %w", err)
+		}
+		drifted := slices.ContainsFunc(products, func(p productmodel.Product) bool {
+			return p.InStock != seminar.InStock
+		})
+		if !drifted {
+			return nil
+		}
+		if _, err := txProductRepo.SetInStockByDetailsID(ctx, id, seminar.InStock, s.now()); err != nil {
+			return fmt.Errorf("failed to reconcile seminar products: %w", err)
+		}
+		s.logger.InfoContext(ctx, "reconciled seminar products in_stock", "seminar_id", id, "in_stock", seminar.InStock)
 		return nil
 	})
 }
@@ -689,11 +1024,15 @@ func (s *service) Unpublish(ctx context.Context, id string) error {
 // Returns an error if the request payload is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Update(ctx context.Context, req *seminarmodel.UpdateRequest) (map[string]any, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "Update", tracing.ID(req.ID))
+	defer span.End()
+
 	allUpdates := make(map[string]any)
 	err := s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSeminarRepo := s.SeminarRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
 
+		req.Sanitize()
 		if err := req.Validate(); err != nil {
 			validationMsg, _ := json.Marshal(err)
 			return fmt.Errorf("%w: %s", ErrInvalidArgument, string(validationMsg))
@@ -707,23 +1046,17 @@ func (s *service) Update(ctx context.Context, req *seminarmodel.UpdateRequest) (
 			return fmt.Errorf("failed to find seminar: %w", err)
 		}
 
-		if seminar.ReservationProductID == nil || seminar.EarlyProductID == nil || seminar.LateProductID == nil || seminar.EarlySurchargeProductID == nil || seminar.LateSurchargeProductID == nil {
+		if !seminar.HasRequiredProducts() {
 			return ErrIncompleteData
 		}
 
-		productIDs := []string{
-			*seminar.ReservationProductID,
-			*seminar.EarlyProductID,
-			*seminar.LateProductID,
-			*seminar.EarlySurchargeProductID,
-			*seminar.LateSurchargeProductID,
-		}
+		productIDs := seminar.ProductIDs()
 
-		products, err := txProductRepo.SelectByIDs(ctx, productIDs, "id", "price", "details_id")
+		products, err := txProductRepo.SelectByIDs(ctx, productIDs, "id", "price", "details_id", "available_from", "available_until")
 		if err != nil {
 			return fmt.Errorf("failed to get seminar products: %w", err)
 		}
-		if len(products) != 5 {
+		if len(products) != len(productIDs) {
 			return ErrProductsNotFound
 		}
 
@@ -735,6 +1068,16 @@ func (s *service) Update(ctx context.Context, req *seminarmodel.UpdateRequest) (
 		seminarUpdates := make(map[string]any)
 		if req.Name != nil && *req.Name != seminar.Name {
 			seminarUpdates["name"] = *req.Name
+			// Seminars created before slugs existed get one on rename regardless of the policy.
+			if seminar.Slug == "" || common.SlugOnRename() == common.SlugRegenerate {
+				slug, err := uniqueSlug(ctx, txSeminarRepo, *req.Name, seminar.Slug)
+				if err != nil {
+					return err
+				}
+				if slug != seminar.Slug {
+					seminarUpdates["slug"] = slug
+				}
+			}
 		}
 		if req.ShortDescription != nil && *req.ShortDescription != seminar.ShortDescription {
 			seminarUpdates["short_description"] = *req.ShortDescription
@@ -742,20 +1085,20 @@ func (s *service) Update(ctx context.Context, req *seminarmodel.UpdateRequest) (
 		if req.Place != nil && *req.Place != seminar.Place {
 			seminarUpdates["place"] = *req.Place
 		}
-		if req.Date != nil && !req.Date.IsZero() && !req.Date.Equal(seminar.Date) {
+		if req.Date != nil && !req.Date.Equal(seminar.Date) {
 			seminarUpdates["date"] = *req.Date
 		}
-		if req.EndingDate != nil && !req.EndingDate.IsZero() && !req.EndingDate.Equal(seminar.EndingDate) {
+		if req.EndingDate != nil && !req.EndingDate.Equal(seminar.EndingDate) {
 			seminarUpdates["ending_date"] = *req.EndingDate
 		}
-		if req.LatePaymentDate != nil && !req.LatePaymentDate.IsZero() && !req.LatePaymentDate.Equal(seminar.LatePaymentDate) {
+		if req.LatePaymentDate != nil && !req.LatePaymentDate.Equal(seminar.LatePaymentDate) {
 			seminarUpdates["late_payment_date"] = *req.LatePaymentDate
 		}
 		if req.LongDescription != nil && *req.LongDescription != seminar.LongDescription {
 			seminarUpdates["long_description"] = *req.LongDescription
 		}
-		if len(req.Tags) > 0 {
-			seminarUpdates["tags"] = req.Tags
+		if tags := req.TagsUpdate(); tags != nil && !slices.Equal(tags, seminar.Tags) {
+			seminarUpdates["tags"] = tags
 		}
 
 		// helper function to update products
@@ -786,36 +1129,18 @@ func (s *service) Update(ctx context.Context, req *seminarmodel.UpdateRequest) (
 			return ErrProductsNotFound
 		}
 
-		// productReq represents product type as key and struct of new product price, product retrieved from the database
-		productReq := map[string]struct {
-			price   *float32
-			product *productmodel.Product
-		}{
-			"reservation_product": {
-				price:   req.ReservationPrice,
-				product: productMap[*seminar.ReservationProductID],
-			},
-			"early_product": {
-				price:   req.EarlyPrice,
-				product: productMap[*seminar.EarlyProductID],
-			},
-			"late_product": {
-				price:   req.LatePrice,
-				product: productMap[*seminar.LateProductID],
-			},
-			"early_surcharge_product": {
-				price:   req.EarlySurchargePrice,
-				product: productMap[*seminar.EarlySurchargeProductID],
-			},
-			"late_surcharge_product": {
-				price:   req.LateSurchargePrice,
-				product: productMap[*seminar.LateSurchargeProductID],
-			},
-		}
-
-		// update products
-		for key, p := range productReq {
-			pu, err := updateProduct(p.price, p.product)
+		// update products of present roles, keyed as "<role>_product" in the result
+		for _, role := range seminarmodel.AllProductRoles {
+			price := req.Price(role)
+			productID := seminar.ProductID(role)
+			if productID == nil {
+				if price != nil {
+					return fmt.Errorf("%w: seminar has no %s product", ErrInvalidArgument, role)
+				}
+				continue
+			}
+			key := string(role) + "_product"
+			pu, err := updateProduct(price, productMap[*productID])
 			if err != nil {
 				return fmt.Errorf("failed to update %s: %w", key, err)
 			}
@@ -838,76 +1163,345 @@ func (s *service) Update(ctx context.Context, req *seminarmodel.UpdateRequest) (
 	return allUpdates, nil
 }
 
-// Delete performs a soft-delete of a seminar and all of its related product records.
-// It also unpublishes all records, meaning they must be manually published again after restoration.
+// UpdateSurcharges updates the prices of the seminar's early and late surcharge products only.
+// A nil price leaves the corresponding product untouched; if both are nil, nothing is updated.
+// Unpublished seminars can be updated as well.
 //
-// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+// Returns an error if the ID or any price is invalid, or a price is provided for a surcharge
+// the seminar does not have (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
-func (s *service) Delete(ctx context.Context, id string) error {
+func (s *service) UpdateSurcharges(ctx context.Context, id string, early, late *float32) error {
+	ctx, span := tracing.Start(ctx, "seminar", "UpdateSurcharges", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: invalid seminar ID: %w", ErrInvalidArgument, err)
 	}
+	prices := map[seminarmodel.ProductRole]*float32{
+		seminarmodel.RoleEarlySurcharge: early,
+		seminarmodel.RoleLateSurcharge:  late,
+	}
+	for role, price := range prices {
+		if price == nil {
+			delete(prices, role)
+		} else if err := common.ValidatePrice(price); err != nil {
+			return fmt.Errorf("%w: %s price %w", ErrInvalidArgument, role, err)
+		}
+	}
+	if len(prices) == 0 {
+		return nil
+	}
+
 	return s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSeminarRepo := s.SeminarRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
 
-		// Check if seminar exists
-		if _, err := txSeminarRepo.GetWithUnpublished(ctx, id); err != nil {
+		seminar, err := txSeminarRepo.GetWithUnpublished(ctx, id)
+		if err != nil {
 			if errors.Is(err, gorm.ErrRecordNotFound) {
 				return fmt.Errorf("%w: %w", ErrNotFound, err)
 			}
-			return fmt.Errorf("failed to get seminar: %w", err)
+			return fmt.Errorf("failed to find seminar: %w", err)
 		}
 
-		// Unpublish all instances
-		if _, err := txSeminarRepo.SetInStock(ctx, id, false); err != nil {
-			return fmt.Errorf("failed to unpublish seminar: %w", err)
+		productIDs := make([]string, 0, len(prices))
+		for role := range prices {
+			productID := seminar.ProductID(role)
+			if productID == nil {
+				return fmt.Errorf("%w: seminar has no %s product", ErrInvalidArgument, role)
+			}
+			productIDs = append(productIDs, *productID)
 		}
-		ra, err := txProductRepo.SetInStockByDetailsID(ctx, id, false)
+
+		products, err := txProductRepo.SelectWithUnpublishedByIDs(ctx, productIDs, "id", "price")
 		if err != nil {
-			return fmt.Errorf("failed to unpublish seminar products: %w", err)
-		} else if ra != 5 {
-			return fmt.Errorf("failed to unpublish all 5 seminar products, only %d were updated", ra)
+			return fmt.Errorf("failed to get seminar products: %w", err)
+		}
+		if len(products) != len(productIDs) {
+			return ErrProductsNotFound
+		}
+		productMap := make(map[string]*productmodel.Product, len(products))
+		for i := range products {
+			productMap[products[i].ID] = &products[i]
 		}
 
-		// Delete all instances
-		if _, err = txSeminarRepo.Delete(ctx, id); err != nil {
-			return fmt.Errorf("failed to delete seminar: %w", err)
+		for role, price := range prices {
+			product := productMap[*seminar.ProductID(role)]
+			if product == nil {
+				return ErrProductsNotFound
+			}
+			if product.Price == *price {
+				continue
+			}
+			if _, err := txProductRepo.Update(ctx, product, map[string]any{"price": *price}); err != nil {
+				return fmt.Errorf("failed to update %s product: %w", role, err)
+			}
 		}
-		if _, err = txProductRepo.DeleteByDetailsID(ctx, id); err != nil {
-			return fmt.Errorf("failed to delete seminar products: %w", err)
+		return nil
+	})
+}
+
+// PriceAsOf returns the seminar price and the ID of the product that set it at the given time,
+// applying the same early/late selection as [seminarmodel.SeminarDetails.Current].
+// Soft-deleted and unpublished seminars and products are included, so past prices can be looked up.
+//
+// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound, ErrProductsNotFound),
+// the seminar is missing the price product (ErrIncompleteData) or a database/internal error occurs.
+func (s *service) PriceAsOf(ctx context.Context, id string, at time.Time) (float32, string, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "PriceAsOf", tracing.ID(id))
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return 0, "", fmt.Errorf("%w: %w", ErrInvalidArgument, err)
+	}
+	seminar, err := s.SeminarRepo.GetWithDeleted(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, "", fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return 0, "", fmt.Errorf("failed to retrieve seminar: %w", err)
+	}
+
+	productID := seminar.ProductID(seminar.PriceRoleAt(at))
+	if productID == nil {
+		return 0, "", ErrIncompleteData
+	}
+
+	products, err := s.ProductRepo.SelectWithDeletedByIDs(ctx, []string{*productID}, "id", "price")
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to get seminar product: %w", err)
+	}
+	if len(products) == 0 {
+		return 0, "", ErrProductsNotFound
+	}
+	return products[0].Price, products[0].ID, nil
+}
+
+// Delete performs a soft-delete of a seminar and all of its related product records.
+// It also unpublishes all records, meaning they must be manually published again after restoration.
+// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
+//
+// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) Delete(ctx context.Context, id, reason string) error {
+	ctx, span := tracing.Start(ctx, "seminar", "Delete")
+	defer span.End()
+
+	if _, err := uuid.Parse(id); err != nil {
+		return fmt.Errorf("%w: invalid seminar ID: %w", ErrInvalidArgument, err)
+	}
+	if utf8.RuneCountInString(reason) > 255 {
+		return fmt.Errorf("%w: reason must be at most 255 characters long", ErrInvalidArgument)
+	}
+	return database.Transaction(ctx, s.SeminarRepo.DB(), database.GuardIsolation(), func(tx *gorm.DB) error {
+		txSeminarRepo := s.SeminarRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		return s.deleteInTx(ctx, txSeminarRepo, txProductRepo, id, reason)
+	})
+}
+
+// deleteInTx unpublishes and soft-deletes a single seminar with its products using
+// transaction-bound repositories. It is shared by Delete and DeleteBatch.
+func (s *service) deleteInTx(ctx context.Context, txSeminarRepo seminarrepo.Repository, txProductRepo productrepo.Repository, id, reason string) error {
+	// Check if seminar exists
+	seminar, err := txSeminarRepo.GetWithUnpublished(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		return fmt.Errorf("failed to get seminar: %w", err)
+	}
+	expected := len(seminar.ProductIDs())
+	// Products already unpublished aren't counted by the setter, so they are counted here.
+	products, err := txProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, []string{id}, "id")
+	if err != nil {
+		return fmt.Errorf("failed to get seminar products: %w", err)
+	}
+	if len(products) != expected {
+		return fmt.Errorf("failed to unpublish all %d seminar products, only %d were found", expected, len(products))
+	}
+
+	// Unpublish all instances
+	if _, err := txSeminarRepo.SetInStock(ctx, id, false); err != nil {
+		return fmt.Errorf("failed to unpublish seminar: %w", err)
+	}
+	if _, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now()); err != nil {
+		return fmt.Errorf("failed to unpublish seminar products: %w", err)
+	}
+
+	// Delete all instances
+	// Products were counted by the unpublish guard above and are deleted explicitly, skip the hook cascade.
+	if _, err = txSeminarRepo.Delete(common.WithoutCascade(ctx), id, reason); err != nil {
+		return fmt.Errorf("failed to delete seminar: %w", err)
+	}
+	if _, err = txProductRepo.DeleteByDetailsID(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete seminar products: %w", err)
+	}
+	return nil
+}
+
+// DeleteBatch performs a soft-delete of multiple seminars and all of their related product records
+// in a single transaction, applying the same guards as Delete to each record.
+// All IDs are validated before anything is deleted. Duplicate IDs are deleted once.
+// If any record fails, the whole batch is rolled back.
+//
+// Returns the number of deleted seminars.
+// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) DeleteBatch(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "DeleteBatch")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%w: no IDs provided", ErrInvalidArgument)
+	}
+	seen := make(map[string]struct{}, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid seminar ID %q: %w", ErrInvalidArgument, id, err)
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	var deleted int64
+	err := database.Transaction(ctx, s.SeminarRepo.DB(), database.GuardIsolation(), func(tx *gorm.DB) error {
+		txSeminarRepo := s.SeminarRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		for _, id := range uniqueIDs {
+			if err := s.deleteInTx(ctx, txSeminarRepo, txProductRepo, id, ""); err != nil {
+				return fmt.Errorf("failed to delete seminar %s: %w", id, err)
+			}
+			deleted++
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
 }
 
 // DeletePermanent performs a complete delete of a seminar and its related product records.
+// If force is true, the related product count is not checked and whatever records exist are deleted.
+// This is meant for cleaning up inconsistent data.
+// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
-func (s *service) DeletePermanent(ctx context.Context, id string) error {
+// the products are featured (ErrHasDependents), or a database/internal error occurs.
+func (s *service) DeletePermanent(ctx context.Context, id string, force bool) error {
+	ctx, span := tracing.Start(ctx, "seminar", "DeletePermanent", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: invalid seminar ID: %w", ErrInvalidArgument, err)
 	}
 	return s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSeminarRepo := s.SeminarRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
 
-		ra, err := txSeminarRepo.DeletePermanent(ctx, id)
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsID(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete seminar featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsID(ctx, id); err != nil {
+			return fmt.Errorf("failed to count seminar featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+
+		var expected int64
+		if seminar, err := txSeminarRepo.GetWithDeleted(ctx, id); err == nil {
+			expected = int64(len(seminar.ProductIDs()))
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("failed to get seminar: %w", err)
+		}
+
+		detailsRA, err := txSeminarRepo.DeletePermanent(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to delete seminar: %w", err)
-		} else if ra == 0 {
+		} else if detailsRA == 0 && !force {
 			return ErrNotFound
 		}
 
-		ra, err = txProductRepo.DeletePermanentByDetailsID(ctx, id)
+		ra, err := txProductRepo.DeletePermanentByDetailsID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to delete seminar products: %w", err)
+		} else if force {
+			if detailsRA == 0 && ra == 0 {
+				return ErrNotFound
+			}
+		} else if ra != expected {
+			return fmt.Errorf("failed to delete all %d seminar products, only %d were updated", expected, ra)
+		}
+		return nil
+	})
+}
+
+// PurgeDeletedBefore permanently deletes all seminars soft-deleted before the given time,
+// together with their product records.
+// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
+//
+// Returns the number of purged seminars.
+// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+func (s *service) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "PurgeDeletedBefore")
+	defer span.End()
+
+	var purged int64
+	err := s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txSeminarRepo := s.SeminarRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
+
+		ids, err := txSeminarRepo.ListDeletedIDsBefore(ctx, before)
 		if err != nil {
+			return fmt.Errorf("failed to list deleted seminars: %w", err)
+		} else if len(ids) == 0 {
+			return nil
+		}
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsIDs(ctx, ids); err != nil {
+				return fmt.Errorf("failed to delete seminar featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to count seminar featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+		if purged, err = txSeminarRepo.DeletePermanentByIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete seminars: %w", err)
+		}
+		if _, err = txProductRepo.DeletePermanentByDetailsIDs(ctx, ids); err != nil {
 			return fmt.Errorf("failed to delete seminar products: %w", err)
-		} else if ra != 5 {
-			return fmt.Errorf("failed to delete all 5 seminar products, only %d were updated", ra)
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// CountDeletedBefore counts seminars soft-deleted before cutoff, that is the seminars
+// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+//
+// Returns an error if a database/internal error occurs.
+func (s *service) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "CountDeletedBefore")
+	defer span.End()
+
+	count, err := s.SeminarRepo.CountDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted seminars: %w", err)
+	}
+	return count, nil
 }
 
 // Restore performs a restore of a seminar and its related product records.
@@ -917,24 +1511,151 @@ func (s *service) DeletePermanent(ctx context.Context, id string) error {
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Restore(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "seminar", "Restore", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: invalid seminar ID: %w", ErrInvalidArgument, err)
 	}
 	return s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSeminarRepo := s.SeminarRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txSeminarRepo.Restore(ctx, id)
-		if err != nil {
-			return fmt.Errorf("failed to restore seminar: %w", err)
-		} else if ra == 0 {
-			return ErrNotFound
+		return s.restoreInTx(ctx, txSeminarRepo, txProductRepo, id)
+	})
+}
+
+// restoreInTx restores a single seminar with its products using transaction-bound repositories.
+// It is shared by Restore and RestoreBatch.
+func (s *service) restoreInTx(ctx context.Context, txSeminarRepo seminarrepo.Repository, txProductRepo productrepo.Repository, id string) error {
+	seminar, err := txSeminarRepo.GetWithDeleted(ctx, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
-		ra, err = txProductRepo.RestoreByDetailsID(ctx, id)
-		if err != nil {
-			return fmt.Errorf("failed to restore seminar products: %w", err)
-		} else if ra != 5 {
-			return fmt.Errorf("failed to restore all 5 seminar products, only %d were updated", ra)
+		return fmt.Errorf("failed to get seminar: %w", err)
+	}
+	expected := int64(len(seminar.ProductIDs()))
+	ra, err := txSeminarRepo.Restore(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore seminar: %w", err)
+	} else if ra == 0 {
+		return ErrNotFound
+	}
+	ra, err = txProductRepo.RestoreByDetailsID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore seminar products: %w", err)
+	} else if ra != expected {
+		return fmt.Errorf("failed to restore all %d seminar products, only %d were updated", expected, ra)
+	}
+	return nil
+}
+
+// RestoreBatch restores multiple soft-deleted seminars and all of their related product records
+// in a single transaction, applying the same guards as Restore to each record.
+// All IDs are validated before anything is restored. Duplicate IDs are restored once.
+// If any record fails, the whole batch is rolled back.
+//
+// Returns the number of restored seminars.
+// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) RestoreBatch(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "RestoreBatch")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%w: no IDs provided", ErrInvalidArgument)
+	}
+	seen := make(map[string]struct{}, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid seminar ID %q: %w", ErrInvalidArgument, id, err)
+		}
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	var restored int64
+	err := s.SeminarRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txSeminarRepo := s.SeminarRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		for _, id := range uniqueIDs {
+			if err := s.restoreInTx(ctx, txSeminarRepo, txProductRepo, id); err != nil {
+				return fmt.Errorf("failed to restore seminar %s: %w", id, err)
+			}
+			restored++
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return restored, nil
+}
+
+// VerifyIntegrity checks product references of a paginated list of not soft-deleted seminars
+// (both published and unpublished). Every set product ID must point to an existing, not soft-deleted
+// product with details type "seminar" owned by the same seminar. Required product roles must be set,
+// surcharge roles are optional.
+//
+// Returns a slice of found issues, empty if all seminars on the page are consistent.
+// Returns an error if limit is not positive or offset is negative (ErrInvalidArgument),
+// or a database/internal error occurs.
+func (s *service) VerifyIntegrity(ctx context.Context, limit, offset int) ([]seminarmodel.IntegrityIssue, error) {
+	ctx, span := tracing.Start(ctx, "seminar", "VerifyIntegrity")
+	defer span.End()
+
+	if limit <= 0 {
+		return nil, fmt.Errorf("%w: limit must be positive", ErrInvalidArgument)
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("%w: offset must not be negative", ErrInvalidArgument)
+	}
+	seminars, err := s.SeminarRepo.ListWithUnpublished(ctx, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve seminars: %w", err)
+	}
+
+	var productIDs []string
+	for _, seminar := range seminars {
+		productIDs = append(productIDs, seminar.ProductIDs()...)
+	}
+	products, err := s.ProductRepo.SelectWithUnpublishedByIDs(ctx, productIDs, "id", "details_id", "details_type")
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve products: %w", err)
+	}
+	productMap := make(map[string]productmodel.Product, len(products))
+	for _, p := range products {
+		productMap[p.ID] = p
+	}
+
+	issues := []seminarmodel.IntegrityIssue{}
+	for _, seminar := range seminars {
+		for _, role := range seminarmodel.AllProductRoles {
+			productID := seminar.ProductID(role)
+			issue := seminarmodel.IntegrityIssue{SeminarID: seminar.ID, Role: role, ProductID: productID}
+			if productID == nil {
+				if slices.Contains(seminarmodel.RequiredProductRoles, role) {
+					issue.Problem = seminarmodel.ProblemNilReference
+					issues = append(issues, issue)
+				}
+				continue
+			}
+			product, ok := productMap[*productID]
+			switch {
+			case !ok:
+				issue.Problem = seminarmodel.ProblemMissingProduct
+			case product.DetailsType != "seminar":
+				issue.Problem = seminarmodel.ProblemWrongType
+			case product.DetailsID != seminar.ID:
+				issue.Problem = seminarmodel.ProblemWrongOwner
+			default:
+				continue
+			}
+			issues = append(issues, issue)
+		}
+	}
+	return issues, nil
 }
@@ -18,24 +18,43 @@
 package seminar
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"log/slog"
 	"reflect"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	"github.com/mikhail5545/product-service-go/internal/models/product"
 	"github.com/mikhail5545/product-service-go/internal/models/seminar"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/database/featured_mock"
 	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
 	seminarmock "github.com/mikhail5545/product-service-go/internal/test/database/seminar_mock"
+	tracingtest "github.com/mikhail5545/product-service-go/internal/test/tracing"
+	"github.com/mikhail5545/product-service-go/internal/util/logger"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
+	"go.opentelemetry.io/otel/trace"
 	gomock "go.uber.org/mock/gomock"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 )
 
+// withLatePaymentDate returns a copy of s with LatePaymentDate set, so that subtests
+// don't change the seminar shared between them.
+func withLatePaymentDate(s *seminar.Seminar, date time.Time) *seminar.Seminar {
+	c := *s
+	c.LatePaymentDate = date
+	return &c
+}
+
 func TestService_Get(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -43,7 +62,7 @@ func TestService_Get(t *testing.T) {
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	seminarID := "c6248da5-a2eb-4abd-be56-a19715104c00"
 	rproductID := "866561c2-a65a-4159-a5d8-a0ae5401e0c1"
@@ -57,7 +76,7 @@ func TestService_Get(t *testing.T) {
 	beforeNow, _ := time.Parse(layout, "2024-Aug-03")
 	afterNow, _ := time.Parse(layout, "2099-Dec-03")
 
-	mockSeminar := &seminar.Seminar{
+	baseSeminar := &seminar.Seminar{
 		ID:                      seminarID,
 		Name:                    "Seminar name",
 		ShortDescription:        "Seminar short description",
@@ -103,7 +122,7 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("success with late_payment_date in future", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
 
@@ -119,10 +138,11 @@ func TestService_Get(t *testing.T) {
 			CurrentPriceProductID:          eproductID,
 			CurrentSurchargePrice:          mockProducts[3].Price,
 			CurrentSurchargePriceProductID: esproductID,
+			Available:                      true,
 		}
 
 		// Act
-		details, err := testService.Get(context.Background(), seminarID)
+		details, err := testService.Get(context.Background(), seminarID, true)
 
 		// Assert
 		assert.NoError(t, err)
@@ -133,7 +153,7 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("success with late_payment_date in past", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = beforeNow
+		mockSeminar := withLatePaymentDate(baseSeminar, beforeNow)
 		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
 
@@ -149,10 +169,11 @@ func TestService_Get(t *testing.T) {
 			CurrentPriceProductID:          lproductID,
 			CurrentSurchargePrice:          mockProducts[4].Price,
 			CurrentSurchargePriceProductID: lsproductID,
+			Available:                      true,
 		}
 
 		// Act
-		details, err := testService.Get(context.Background(), seminarID)
+		details, err := testService.Get(context.Background(), seminarID, true)
 
 		// Assert
 		assert.NoError(t, err)
@@ -161,12 +182,30 @@ func TestService_Get(t *testing.T) {
 		}
 	})
 
+	t.Run("current price follows service clock", func(t *testing.T) {
+		// Arrange
+		mockSeminar := withLatePaymentDate(baseSeminar, beforeNow)
+		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil)
+		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
+		testService.(*service).now = func() time.Time { return beforeNow.AddDate(0, 0, -7) }
+		t.Cleanup(func() { testService.(*service).now = time.Now })
+
+		// Act
+		details, err := testService.Get(context.Background(), seminarID, true)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, mockProducts[1].Price, details.CurrentPrice)
+		assert.Equal(t, eproductID, details.CurrentPriceProductID)
+		assert.Equal(t, esproductID, details.CurrentSurchargePriceProductID)
+	})
+
 	t.Run("invalid UUID", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-UUID"
 
 		// Act
-		_, err := testService.Get(context.Background(), invalidID)
+		_, err := testService.Get(context.Background(), invalidID, true)
 
 		// Assert
 		assert.Error(t, err)
@@ -175,25 +214,53 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
 		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
-		_, err := testService.Get(context.Background(), seminarID)
+		_, err := testService.Get(context.Background(), seminarID, true)
 
 		// Assert
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrNotFound)
+		assert.NotErrorIs(t, err, ErrGone)
+	})
+
+	t.Run("soft-deleted", func(t *testing.T) {
+		// Arrange
+		deleted := &seminar.Seminar{ID: seminarID, DeletedAt: gorm.DeletedAt{Time: beforeNow, Valid: true}}
+		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(deleted, nil)
+
+		// Act
+		_, err := testService.Get(context.Background(), seminarID, true)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, err, ErrGone)
+	})
+
+	t.Run("unpublished", func(t *testing.T) {
+		// Arrange
+		unpublished := &seminar.Seminar{ID: seminarID}
+		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(unpublished, nil)
+
+		// Act
+		_, err := testService.Get(context.Background(), seminarID, true)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.NotErrorIs(t, err, ErrGone)
 	})
 
 	t.Run("db error", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
 		dbErr := errors.New("database error")
 		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(nil, dbErr)
 
 		// Act
-		_, err := testService.Get(context.Background(), seminarID)
+		_, err := testService.Get(context.Background(), seminarID, true)
 
 		// Assert
 		assert.Error(t, err)
@@ -214,7 +281,7 @@ func TestService_Get(t *testing.T) {
 		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(seminarWithMissingID, nil)
 
 		// Act
-		_, err := testService.Get(context.Background(), seminarID)
+		_, err := testService.Get(context.Background(), seminarID, true)
 
 		// Assert
 		assert.Error(t, err)
@@ -223,13 +290,13 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("product repo returns error", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		dbErr := errors.New("product db error")
 		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErr)
 
 		// Act
-		_, err := testService.Get(context.Background(), seminarID)
+		_, err := testService.Get(context.Background(), seminarID, true)
 
 		// Assert
 		assert.Error(t, err)
@@ -237,19 +304,209 @@ func TestService_Get(t *testing.T) {
 
 	t.Run("product repo returns incomplete products", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		// Return only 4 products instead of 5
 		incompleteProducts := mockProducts[:4]
 		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(incompleteProducts, nil)
 
 		// Act
-		_, err := testService.Get(context.Background(), seminarID)
+		_, err := testService.Get(context.Background(), seminarID, true)
 
 		// Assert
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrProductsNotFound)
 	})
+
+	t.Run("success without products", func(t *testing.T) {
+		// Arrange
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
+		mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil)
+		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		expectedDetails := &seminar.SeminarDetails{
+			Seminar:         mockSeminar,
+			ProductsOmitted: true,
+		}
+
+		// Act
+		details, err := testService.Get(context.Background(), seminarID, false)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDetails, details)
+		assert.Zero(t, details.CurrentPrice)
+		assert.False(t, details.Available)
+	})
+}
+
+func TestService_GetByIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// productsOf returns priced products for every product ID of s except the skipped ones.
+	productsOf := func(s *seminar.Seminar, skip ...string) []product.Product {
+		var products []product.Product
+		for _, id := range s.ProductIDs() {
+			if !slices.Contains(skip, id) {
+				products = append(products, product.Product{ID: id, DetailsID: s.ID, DetailsType: "seminar", Price: 10})
+			}
+		}
+		return products
+	}
+
+	t.Run("mixed results", func(t *testing.T) {
+		// Arrange
+		complete := newTestSeminar(uuid.New().String())
+		incomplete := newTestSeminar(uuid.New().String(), seminar.RoleReservation)
+		brokenProducts := newTestSeminar(uuid.New().String())
+		missingID := uuid.New().String()
+		ids := []string{complete.ID, incomplete.ID, brokenProducts.ID, missingID, "invalid-UUID", complete.ID}
+
+		mockSeminarRepo.EXPECT().ListByIDs(gomock.Any(), complete.ID, incomplete.ID, brokenProducts.ID, missingID).
+			Return([]seminar.Seminar{*complete, *incomplete, *brokenProducts}, nil)
+		products := append(productsOf(complete), productsOf(incomplete)...)
+		products = append(products, productsOf(brokenProducts, *brokenProducts.LateProductID)...)
+		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), "id", "price", "available_from", "available_until").Return(products, nil)
+
+		// Act
+		found, errs := testService.GetByIDs(context.Background(), ids)
+
+		// Assert
+		assert.Len(t, found, 1)
+		if assert.Contains(t, found, complete.ID) {
+			assert.Equal(t, complete.ID, found[complete.ID].Seminar.ID)
+			assert.Equal(t, float32(10), found[complete.ID].ReservationPrice)
+		}
+		assert.Len(t, errs, 4)
+		assert.ErrorIs(t, errs[incomplete.ID], ErrIncompleteData)
+		assert.ErrorIs(t, errs[brokenProducts.ID], ErrProductsNotFound)
+		assert.ErrorIs(t, errs[missingID], ErrNotFound)
+		assert.ErrorIs(t, errs["invalid-UUID"], ErrInvalidArgument)
+	})
+
+	t.Run("details don't alias the repository slice", func(t *testing.T) {
+		// Arrange
+		complete := newTestSeminar(uuid.New().String())
+		complete.Tags = []string{"yoga", "retreat"}
+		seminars := []seminar.Seminar{*complete}
+		seminars[0].Tags = slices.Clone(complete.Tags)
+		mockSeminarRepo.EXPECT().ListByIDs(gomock.Any(), complete.ID).Return(seminars, nil)
+		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), "id", "price", "available_from", "available_until").Return(productsOf(complete), nil)
+
+		// Act
+		found, _ := testService.GetByIDs(context.Background(), []string{complete.ID})
+		seminars[0].Name = "Changed name"
+		seminars[0].Tags[0] = "changed"
+
+		// Assert
+		if assert.Contains(t, found, complete.ID) {
+			assert.Equal(t, complete.Name, found[complete.ID].Seminar.Name)
+			assert.Equal(t, complete.Tags, found[complete.ID].Seminar.Tags)
+		}
+	})
+
+	t.Run("only invalid IDs", func(t *testing.T) {
+		// Arrange
+		mockSeminarRepo.EXPECT().ListByIDs(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		found, errs := testService.GetByIDs(context.Background(), []string{"invalid-UUID"})
+
+		// Assert
+		assert.Empty(t, found)
+		assert.ErrorIs(t, errs["invalid-UUID"], ErrInvalidArgument)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		first, second := uuid.New().String(), uuid.New().String()
+		dbErr := errors.New("database error")
+		mockSeminarRepo.EXPECT().ListByIDs(gomock.Any(), first, second).Return(nil, dbErr)
+
+		// Act
+		found, errs := testService.GetByIDs(context.Background(), []string{first, second})
+
+		// Assert
+		assert.Empty(t, found)
+		assert.ErrorIs(t, errs[first], dbErr)
+		assert.ErrorIs(t, errs[second], dbErr)
+	})
+}
+
+func TestService_GetBySlug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		s := newTestSeminar(uuid.New().String())
+		s.Slug = "my-great-seminar"
+		products := make([]product.Product, 0, len(s.ProductIDs()))
+		for _, id := range s.ProductIDs() {
+			products = append(products, product.Product{ID: id, DetailsID: s.ID, DetailsType: "seminar", Price: 10})
+		}
+		mockSeminarRepo.EXPECT().GetBySlug(gomock.Any(), "my-great-seminar").Return(s, nil)
+		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), "id", "price", "available_from", "available_until").Return(products, nil)
+
+		// Act
+		details, err := testService.GetBySlug(context.Background(), "my-great-seminar", true)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, s.ID, details.Seminar.ID)
+		assert.Equal(t, float32(10), details.EarlyPrice)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockSeminarRepo.EXPECT().GetBySlug(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+		mockSeminarRepo.EXPECT().GetWithDeletedBySlug(gomock.Any(), "missing").Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		details, err := testService.GetBySlug(context.Background(), "missing", true)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+		assert.NotErrorIs(t, err, ErrGone)
+		assert.Nil(t, details)
+	})
+
+	t.Run("soft-deleted", func(t *testing.T) {
+		// Arrange
+		deleted := &seminar.Seminar{Slug: "old-seminar", DeletedAt: gorm.DeletedAt{Time: time.Now(), Valid: true}}
+		mockSeminarRepo.EXPECT().GetBySlug(gomock.Any(), "old-seminar").Return(nil, gorm.ErrRecordNotFound)
+		mockSeminarRepo.EXPECT().GetWithDeletedBySlug(gomock.Any(), "old-seminar").Return(deleted, nil)
+
+		// Act
+		_, err := testService.GetBySlug(context.Background(), "old-seminar", true)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrGone)
+	})
+
+	t.Run("malformed slug", func(t *testing.T) {
+		for _, slug := range []string{"", "My Seminar", "../admin"} {
+			// Arrange
+			mockSeminarRepo.EXPECT().GetBySlug(gomock.Any(), slug).Times(0)
+
+			// Act
+			_, err := testService.GetBySlug(context.Background(), slug, true)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument, slug)
+		}
+	})
 }
 
 func TestService_GetWithDeleted(t *testing.T) {
@@ -259,7 +516,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	seminarID := "c6248da5-a2eb-4abd-be56-a19715104c00"
 	rproductID := "866561c2-a65a-4159-a5d8-a0ae5401e0c1"
@@ -273,7 +530,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 	beforeNow, _ := time.Parse(layout, "2024-Aug-03")
 	afterNow, _ := time.Parse(layout, "2099-Dec-03")
 
-	mockSeminar := &seminar.Seminar{
+	baseSeminar := &seminar.Seminar{
 		ID:                      seminarID,
 		Name:                    "Seminar name",
 		ShortDescription:        "Seminar short description",
@@ -319,7 +576,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	t.Run("success with late_payment_date in future", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		mockProductRepo.EXPECT().SelectWithDeletedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
 
@@ -335,6 +592,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 			CurrentPriceProductID:          eproductID,
 			CurrentSurchargePrice:          mockProducts[3].Price,
 			CurrentSurchargePriceProductID: esproductID,
+			Available:                      true,
 		}
 
 		// Act
@@ -349,7 +607,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	t.Run("success with late_payment_date in past", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = beforeNow
+		mockSeminar := withLatePaymentDate(baseSeminar, beforeNow)
 		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		mockProductRepo.EXPECT().SelectWithDeletedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
 
@@ -365,6 +623,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 			CurrentPriceProductID:          lproductID,
 			CurrentSurchargePrice:          mockProducts[4].Price,
 			CurrentSurchargePriceProductID: lsproductID,
+			Available:                      true,
 		}
 
 		// Act
@@ -391,7 +650,6 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
 		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
@@ -404,7 +662,6 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	t.Run("db error", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
 		dbErr := errors.New("database error")
 		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, dbErr)
 
@@ -439,7 +696,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	t.Run("product repo returns error", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		dbErr := errors.New("product db error")
 		mockProductRepo.EXPECT().SelectWithDeletedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErr)
@@ -453,7 +710,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 
 	t.Run("product repo returns incomplete products", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		// Return only 4 products instead of 5
 		incompleteProducts := mockProducts[:4]
@@ -475,7 +732,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	seminarID := "c6248da5-a2eb-4abd-be56-a19715104c00"
 	rproductID := "866561c2-a65a-4159-a5d8-a0ae5401e0c1"
@@ -489,7 +746,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 	beforeNow, _ := time.Parse(layout, "2024-Aug-03")
 	afterNow, _ := time.Parse(layout, "2099-Dec-03")
 
-	mockSeminar := &seminar.Seminar{
+	baseSeminar := &seminar.Seminar{
 		ID:                      seminarID,
 		Name:                    "Seminar name",
 		ShortDescription:        "Seminar short description",
@@ -535,7 +792,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 
 	t.Run("success with late_payment_date in future", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		mockProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
 
@@ -551,6 +808,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 			CurrentPriceProductID:          eproductID,
 			CurrentSurchargePrice:          mockProducts[3].Price,
 			CurrentSurchargePriceProductID: esproductID,
+			Available:                      true,
 		}
 
 		// Act
@@ -563,9 +821,32 @@ func TestService_GetWithUnpublished(t *testing.T) {
 		}
 	})
 
+	t.Run("publish times", func(t *testing.T) {
+		// Arrange
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
+		firstPublished := time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC)
+		lastPublished := firstPublished.Add(72 * time.Hour)
+		products := slices.Clone(mockProducts)
+		for i := range products {
+			products[i].FirstPublishedAt = &firstPublished
+			products[i].LastPublishedAt = &firstPublished
+		}
+		products[2].LastPublishedAt = &lastPublished
+		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(mockSeminar, nil)
+		mockProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(products, nil)
+
+		// Act
+		details, err := testService.GetWithUnpublished(context.Background(), seminarID)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &firstPublished, details.FirstPublishedAt)
+		assert.Equal(t, &lastPublished, details.LastPublishedAt)
+	})
+
 	t.Run("success with late_payment_date in past", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = beforeNow
+		mockSeminar := withLatePaymentDate(baseSeminar, beforeNow)
 		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		mockProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
 
@@ -581,6 +862,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 			CurrentPriceProductID:          lproductID,
 			CurrentSurchargePrice:          mockProducts[4].Price,
 			CurrentSurchargePriceProductID: lsproductID,
+			Available:                      true,
 		}
 
 		// Act
@@ -607,7 +889,6 @@ func TestService_GetWithUnpublished(t *testing.T) {
 
 	t.Run("not found", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
 		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
@@ -620,7 +901,6 @@ func TestService_GetWithUnpublished(t *testing.T) {
 
 	t.Run("db error", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
 		dbErr := errors.New("database error")
 		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, dbErr)
 
@@ -655,7 +935,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 
 	t.Run("product repo returns error", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		dbErr := errors.New("product db error")
 		mockProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, dbErr)
@@ -669,7 +949,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 
 	t.Run("product repo returns incomplete products", func(t *testing.T) {
 		// Arrange
-		mockSeminar.LatePaymentDate = afterNow
+		mockSeminar := withLatePaymentDate(baseSeminar, afterNow)
 		mockSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(mockSeminar, nil)
 		// Return only 4 products instead of 5
 		incompleteProducts := mockProducts[:4]
@@ -691,7 +971,7 @@ func TestService_List(t *testing.T) {
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	seminarID_1 := uuid.New().String()
 	rproductID_1 := uuid.New().String()
@@ -811,6 +1091,7 @@ func TestService_List(t *testing.T) {
 		CurrentPriceProductID:          lproductID_1,
 		CurrentSurchargePrice:          mockProducts[4].Price,
 		CurrentSurchargePriceProductID: lsproductID_1,
+		Available:                      true,
 	}
 	expectedDetails_2 := &seminar.SeminarDetails{
 		Seminar:                        &mockSeminars[1],
@@ -823,6 +1104,7 @@ func TestService_List(t *testing.T) {
 		CurrentPriceProductID:          eproductID_2,
 		CurrentSurchargePrice:          mockProducts[8].Price,
 		CurrentSurchargePriceProductID: esproductID_2,
+		Available:                      true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -854,6 +1136,46 @@ func TestService_List(t *testing.T) {
 		}
 	})
 
+	t.Run("called twice with the same slice", func(t *testing.T) {
+		// Arrange
+		limit, offset := 2, 0
+		want := slices.Clone(mockSeminars)
+		mockSeminarRepo.EXPECT().List(gomock.Any(), limit, offset).Return(mockSeminars, nil).Times(2)
+		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).Times(2)
+		mockSeminarRepo.EXPECT().Count(gomock.Any()).Return(int64(2), nil).Times(2)
+
+		// Act
+		first, _, err := testService.List(context.Background(), limit, offset)
+		assert.NoError(t, err)
+		second, _, err := testService.List(context.Background(), limit, offset)
+		assert.NoError(t, err)
+
+		// Assert
+		assert.Equal(t, want, mockSeminars)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("details don't alias the repository slice", func(t *testing.T) {
+		// Arrange
+		limit, offset := 2, 0
+		seminars := slices.Clone(mockSeminars)
+		mockSeminarRepo.EXPECT().List(gomock.Any(), limit, offset).Return(seminars, nil)
+		mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil)
+		mockSeminarRepo.EXPECT().Count(gomock.Any()).Return(int64(2), nil)
+
+		// Act
+		details, _, err := testService.List(context.Background(), limit, offset)
+		seminars[0].Name = "Changed name"
+		seminars[1] = seminar.Seminar{}
+
+		// Assert
+		assert.NoError(t, err)
+		if assert.Len(t, details, 2) {
+			assert.Equal(t, mockSeminars[0], *details[0].Seminar)
+			assert.Equal(t, mockSeminars[1], *details[1].Seminar)
+		}
+	})
+
 	t.Run("db error", func(t *testing.T) {
 		limit, offset := 2, 0
 		dbErr := errors.New("database error")
@@ -941,6 +1263,36 @@ func TestService_List(t *testing.T) {
 		}
 	})
 
+	t.Run("dropped seminar is logged only at debug level", func(t *testing.T) {
+		for _, tc := range []struct {
+			level  slog.Level
+			logged bool
+		}{
+			{level: slog.LevelInfo, logged: false},
+			{level: slog.LevelDebug, logged: true},
+		} {
+			// Arrange
+			var buf bytes.Buffer
+			levelService := New(mockSeminarRepo, mockProductRepo, nil, logger.New(&buf, logger.Config{Level: tc.level, Format: logger.FormatText}))
+
+			mockSeminarRepo.EXPECT().List(gomock.Any(), 2, 0).Return(mockSeminars, nil)
+			mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts[5:], nil)
+			mockSeminarRepo.EXPECT().Count(gomock.Any()).Return(int64(2), nil)
+
+			// Act
+			_, _, err := levelService.List(context.Background(), 2, 0)
+
+			// Assert
+			assert.NoError(t, err)
+			if tc.logged {
+				assert.Contains(t, buf.String(), "dropped incomplete seminar")
+				assert.Contains(t, buf.String(), "seminar_id="+seminarID_1)
+			} else {
+				assert.Empty(t, buf.String())
+			}
+		}
+	})
+
 	t.Run("success empty list", func(t *testing.T) {
 		// Arrange
 		limit, offset := 2, 0
@@ -965,7 +1317,7 @@ func TestService_ListDeleted(t *testing.T) {
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	seminarID_1 := uuid.New().String()
 	rproductID_1 := uuid.New().String()
@@ -1085,6 +1437,7 @@ func TestService_ListDeleted(t *testing.T) {
 		CurrentPriceProductID:          lproductID_1,
 		CurrentSurchargePrice:          mockProducts[4].Price,
 		CurrentSurchargePriceProductID: lsproductID_1,
+		Available:                      true,
 	}
 	expectedDetails_2 := &seminar.SeminarDetails{
 		Seminar:                        &mockSeminars[1],
@@ -1097,6 +1450,7 @@ func TestService_ListDeleted(t *testing.T) {
 		CurrentPriceProductID:          eproductID_2,
 		CurrentSurchargePrice:          mockProducts[8].Price,
 		CurrentSurchargePriceProductID: esproductID_2,
+		Available:                      true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -1226,7 +1580,7 @@ func TestService_ListUnpublished(t *testing.T) {
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	seminarID_1 := uuid.New().String()
 	rproductID_1 := uuid.New().String()
@@ -1346,6 +1700,7 @@ func TestService_ListUnpublished(t *testing.T) {
 		CurrentPriceProductID:          lproductID_1,
 		CurrentSurchargePrice:          mockProducts[4].Price,
 		CurrentSurchargePriceProductID: lsproductID_1,
+		Available:                      true,
 	}
 	expectedDetails_2 := &seminar.SeminarDetails{
 		Seminar:                        &mockSeminars[1],
@@ -1358,6 +1713,7 @@ func TestService_ListUnpublished(t *testing.T) {
 		CurrentPriceProductID:          eproductID_2,
 		CurrentSurchargePrice:          mockProducts[8].Price,
 		CurrentSurchargePriceProductID: esproductID_2,
+		Available:                      true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -1487,7 +1843,7 @@ func TestService_Create(t *testing.T) {
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -1528,6 +1884,7 @@ func TestService_Create(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
+		mockTxSeminarRepo.EXPECT().ListSlugsByPrefix(gomock.Any(), "seminar-name").Return(nil, nil)
 		var createdSeminar *seminar.Seminar
 		mockTxSeminarRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
 			Do(func(_ context.Context, s *seminar.Seminar) {
@@ -1551,6 +1908,7 @@ func TestService_Create(t *testing.T) {
 			t.Errorf("Expected seminar.ID to be a valid UUID, got %s", createdSeminar.ID)
 		}
 		assert.Equal(t, createReq.Name, createdSeminar.Name)
+		assert.Equal(t, "seminar-name", createdSeminar.Slug)
 		assert.False(t, createdSeminar.InStock)
 
 		// Assert Products
@@ -1611,6 +1969,7 @@ func TestService_Create(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
+		mockTxSeminarRepo.EXPECT().ListSlugsByPrefix(gomock.Any(), gomock.Any()).Return(nil, nil)
 		mockTxProductRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil)
 		dbErr := errors.New("database error")
 		mockTxSeminarRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(dbErr).AnyTimes()
@@ -1621,126 +1980,212 @@ func TestService_Create(t *testing.T) {
 		// Assert
 		assert.Error(t, err)
 	})
+
+	t.Run("zero or negative price", func(t *testing.T) {
+		fields := map[string]func(req *seminar.CreateRequest) *float32{
+			"reservation_price":     func(req *seminar.CreateRequest) *float32 { return &req.ReservationPrice },
+			"early_price":           func(req *seminar.CreateRequest) *float32 { return &req.EarlyPrice },
+			"late_price":            func(req *seminar.CreateRequest) *float32 { return &req.LatePrice },
+			"early_surcharge_price": func(req *seminar.CreateRequest) *float32 { return &req.EarlySurchargePrice },
+			"late_surcharge_price":  func(req *seminar.CreateRequest) *float32 { return &req.LateSurchargePrice },
+		}
+		for field, price := range fields {
+			for _, value := range []float32{0, -10} {
+				// Arrange
+				req := *createReq
+				*price(&req) = value
+
+				mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+				mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(seminarmock.NewMockRepository(ctrl))
+				mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(productmock.NewMockRepository(ctrl))
+
+				// Act
+				resp, err := testService.Create(context.Background(), &req)
+
+				// Assert
+				assert.ErrorIs(t, err, ErrInvalidArgument, field)
+				assert.ErrorContains(t, err, field)
+				assert.Nil(t, resp)
+			}
+		}
+	})
 }
 
-func TestService_Publish(t *testing.T) {
+func TestService_CreateIfAbsent(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
-	mockProductRepo := productmock.NewMockRepository(ctrl)
-
-	testService := New(mockSeminarRepo, mockProductRepo)
-
-	// Use an in-memory SQLite DB for testing transactions.
-	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
-		// This prevents GORM from starting a real DB transaction,
-		// allowing the mock repositories to work as expected.
+	db, err := gorm.Open(sqlite.Open("file:"+uuid.New().String()+"?mode=memory&cache=shared"), &gorm.Config{
 		SkipDefaultTransaction: true,
 	})
 	if err != nil {
 		t.Fatalf("failed to connect database: %v", err)
 	}
 
-	seminarID := uuid.New().String()
+	layout := "2006-Jan-02"
+	date, _ := time.Parse(layout, "2033-Dec-05")
+	endingDate, _ := time.Parse(layout, "2033-Dec-07")
+	latePaymentDate, _ := time.Parse(layout, "2033-Nov-03")
+	newReq := func() *seminar.CreateRequest {
+		return &seminar.CreateRequest{
+			Name:                "Seminar name",
+			ShortDescription:    "Seminar short description",
+			ReservationPrice:    11.11,
+			EarlyPrice:          12.22,
+			LatePrice:           13.33,
+			EarlySurchargePrice: 14.44,
+			LateSurchargePrice:  15.55,
+			Date:                date,
+			EndingDate:          endingDate,
+			LatePaymentDate:     latePaymentDate,
+			Place:               "Seminar place",
+		}
+	}
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
+		mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockProductRepo := productmock.NewMockRepository(ctrl)
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
-		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().DB().Return(db)
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
-
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, true).Return(int64(5), nil)
+		mockTxSeminarRepo.EXPECT().GetWithDeletedBySlug(gomock.Any(), "seminar-name").Return(nil, gorm.ErrRecordNotFound)
+		mockTxProductRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil)
+		var created *seminar.Seminar
+		mockTxSeminarRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, s *seminar.Seminar) { created = s }).Return(nil)
 
 		// Act
-		err := testService.Publish(context.Background(), seminarID)
+		resp, err := testService.CreateIfAbsent(context.Background(), newReq())
 
 		// Assert
 		assert.NoError(t, err)
+		assert.Equal(t, "seminar-name", created.Slug)
+		assert.Equal(t, created.ID, resp.ID)
 	})
 
-	t.Run("invalid UUID", func(t *testing.T) {
-		// Arrange
-		invalidID := "invalid-UUID"
-
-		// Act
-		err := testService.Publish(context.Background(), invalidID)
-
-		// Assert
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, ErrInvalidArgument)
-	})
-
-	t.Run("seminar not found", func(t *testing.T) {
+	t.Run("name taken", func(t *testing.T) {
 		// Arrange
+		mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockProductRepo := productmock.NewMockRepository(ctrl)
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
-		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
-		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().DB().Return(db)
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
-		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
-
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(int64(0), nil)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(productmock.NewMockRepository(ctrl))
+		mockTxSeminarRepo.EXPECT().GetWithDeletedBySlug(gomock.Any(), "seminar-name").
+			Return(&seminar.Seminar{ID: uuid.New().String(), Slug: "seminar-name"}, nil)
 
 		// Act
-		err := testService.Publish(context.Background(), seminarID)
+		resp, err := testService.CreateIfAbsent(context.Background(), newReq())
 
 		// Assert
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, err, ErrDuplicateName)
+		assert.Nil(t, resp)
 	})
 
-	t.Run("not all products are found", func(t *testing.T) {
+	t.Run("unique constraint violation", func(t *testing.T) {
 		// Arrange
+		mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockProductRepo := productmock.NewMockRepository(ctrl)
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
-		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().DB().Return(db)
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
-
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, true).Return(int64(3), nil)
+		mockTxSeminarRepo.EXPECT().GetWithDeletedBySlug(gomock.Any(), "seminar-name").Return(nil, gorm.ErrRecordNotFound)
+		mockTxProductRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil)
+		mockTxSeminarRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(gorm.ErrDuplicatedKey)
 
 		// Act
-		err := testService.Publish(context.Background(), seminarID)
+		resp, err := testService.CreateIfAbsent(context.Background(), newReq())
 
 		// Assert
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrDuplicateName)
+		assert.Nil(t, resp)
 	})
 
-	t.Run("database error", func(t *testing.T) {
+	t.Run("concurrent creates of the same name", func(t *testing.T) {
 		// Arrange
+		const creates = 8
+		mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockProductRepo := productmock.NewMockRepository(ctrl)
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
-
-		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
-		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
-		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
-
-		dbErr := errors.New("database error")
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(int64(0), dbErr)
+		testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+		// slugs stands in for the seminars table and its unique slug constraint.
+		var mu sync.Mutex
+		slugs := map[string]bool{}
+		mockSeminarRepo.EXPECT().DB().Return(db).Times(creates)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo).Times(creates)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo).Times(creates)
+		mockTxSeminarRepo.EXPECT().GetWithDeletedBySlug(gomock.Any(), "seminar-name").
+			DoAndReturn(func(_ context.Context, slug string) (*seminar.Seminar, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				if slugs[slug] {
+					return &seminar.Seminar{Slug: slug}, nil
+				}
+				return nil, gorm.ErrRecordNotFound
+			}).Times(creates)
+		mockTxProductRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		mockTxSeminarRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, s *seminar.Seminar) error {
+				mu.Lock()
+				defer mu.Unlock()
+				if slugs[s.Slug] {
+					return gorm.ErrDuplicatedKey
+				}
+				slugs[s.Slug] = true
+				return nil
+			}).AnyTimes()
 
 		// Act
-		err := testService.Publish(context.Background(), seminarID)
+		start := make(chan struct{})
+		errs := make(chan error, creates)
+		var wg sync.WaitGroup
+		for range creates {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				<-start
+				_, err := testService.CreateIfAbsent(context.Background(), newReq())
+				errs <- err
+			}()
+		}
+		close(start)
+		wg.Wait()
+		close(errs)
 
 		// Assert
-		assert.Error(t, err)
+		succeeded := 0
+		for err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			assert.ErrorIs(t, err, ErrDuplicateName)
+		}
+		assert.Equal(t, 1, succeeded)
 	})
 }
 
-func TestService_Unpublish(t *testing.T) {
+func TestService_Publish(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -1756,6 +2201,8 @@ func TestService_Unpublish(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
+		publishedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		testService.(*service).now = func() time.Time { return publishedAt }
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
 
@@ -1763,29 +2210,21 @@ func TestService_Unpublish(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false).Return(int64(5), nil)
+		testSeminar := newTestSeminar(seminarID)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+			Return(newTestSeminarProducts(testSeminar), nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), seminarID, publishedAt).Return(int64(5), nil)
 
 		// Act
-		err := testService.Unpublish(context.Background(), seminarID)
+		err := testService.Publish(context.Background(), seminarID)
 
 		// Assert
 		assert.NoError(t, err)
 	})
 
-	t.Run("invalid UUID", func(t *testing.T) {
-		// Arrange
-		invalidID := "invalid-UUID"
-
-		// Act
-		err := testService.Unpublish(context.Background(), invalidID)
-
-		// Assert
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, ErrInvalidArgument)
-	})
-
-	t.Run("seminar not found", func(t *testing.T) {
+	t.Run("already published", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -1794,16 +2233,26 @@ func TestService_Unpublish(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(int64(0), nil)
+		testSeminar := newTestSeminar(seminarID)
+		testSeminar.InStock = true
+		products := newTestSeminarProducts(testSeminar)
+		for i := range products {
+			products[i].InStock = true
+		}
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+			Return(products, nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
-		err := testService.Unpublish(context.Background(), seminarID)
+		err := testService.Publish(context.Background(), seminarID)
 
 		// Assert
-		assert.Error(t, err)
+		assert.NoError(t, err)
 	})
 
-	t.Run("not all products are found", func(t *testing.T) {
+	t.Run("published seminar with an unpublished product", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -1812,11 +2261,74 @@ func TestService_Unpublish(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false).Return(int64(3), nil)
+		testSeminar := newTestSeminar(seminarID)
+		testSeminar.InStock = true
+		products := newTestSeminarProducts(testSeminar)
+		for i := range products[1:] {
+			products[i+1].InStock = true
+		}
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+			Return(products, nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), seminarID, gomock.Any()).Return(int64(1), nil)
 
 		// Act
-		err := testService.Unpublish(context.Background(), seminarID)
+		err := testService.Publish(context.Background(), seminarID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
+
+		// Act
+		err := testService.Publish(context.Background(), invalidID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("seminar not found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), seminarID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("not all products are found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+			Return(newTestSeminarProducts(testSeminar)[:3], nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), seminarID)
 
 		// Assert
 		assert.Error(t, err)
@@ -1832,24 +2344,82 @@ func TestService_Unpublish(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		dbErr := errors.New("database error")
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(int64(0), dbErr)
+		testSeminar := newTestSeminar(seminarID)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+			Return(newTestSeminarProducts(testSeminar), nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(database.SetResult{}, dbErr)
 
 		// Act
-		err := testService.Unpublish(context.Background(), seminarID)
+		err := testService.Publish(context.Background(), seminarID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("zero price not marked free", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		products := newTestSeminarProducts(testSeminar)
+		products[1].Price = 0
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+			Return(products, nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), seminarID)
 
 		// Assert
 		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrCannotPublishFree)
+		assert.Contains(t, err.Error(), products[1].ID)
+	})
+
+	t.Run("zero price marked free", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		products := newTestSeminarProducts(testSeminar)
+		products[1].Price = 0
+		products[1].IsFree = true
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+			Return(products, nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), seminarID, gomock.Any()).Return(int64(5), nil)
+
+		// Act
+		err := testService.Publish(context.Background(), seminarID)
+
+		// Assert
+		assert.NoError(t, err)
 	})
 }
 
-func TestService_Update(t *testing.T) {
+func TestService_Unpublish(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -1862,72 +2432,32 @@ func TestService_Update(t *testing.T) {
 	}
 
 	seminarID := uuid.New().String()
-	rproductID := uuid.New().String()
-	eproductID := uuid.New().String()
-	lproductID := uuid.New().String()
-	esproductID := uuid.New().String()
-	lsproductID := uuid.New().String()
 
-	layout := "2006-Jan-02"
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
 
-	date, _ := time.Parse(layout, "2025-Dec-03")
-	endingDate, _ := time.Parse(layout, "2025-Dec-06")
-	latePaymentDate, _ := time.Parse(layout, "2025-Nov-20")
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-	mockSeminar := &seminar.Seminar{
-		ID:                      seminarID,
-		Name:                    "Seminar old name",
-		ShortDescription:        "Seminar old short description",
-		Date:                    date,
-		EndingDate:              endingDate,
-		LatePaymentDate:         latePaymentDate,
-		ReservationProductID:    &rproductID,
-		EarlyProductID:          &eproductID,
-		LateProductID:           &lproductID,
-		EarlySurchargeProductID: &esproductID,
-		LateSurchargeProductID:  &lsproductID,
-	}
+		testSeminar := newTestSeminar(seminarID)
+		products := newTestSeminarProducts(testSeminar)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+			Return(products, nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false, gomock.Any()).Return(database.SetResult{Affected: 5}, nil)
 
-	mockProducts := []product.Product{
-		{
-			ID:          rproductID,
-			Price:       11.11,
-			DetailsID:   seminarID,
-			DetailsType: "seminar",
-		},
-		{
-			ID:          eproductID,
-			Price:       12.22,
-			DetailsID:   seminarID,
-			DetailsType: "seminar",
-		},
-		{
-			ID:          lproductID,
-			Price:       13.33,
-			DetailsID:   seminarID,
-			DetailsType: "seminar",
-		},
-		{
-			ID:          esproductID,
-			Price:       14.44,
-			DetailsID:   seminarID,
-			DetailsType: "seminar",
-		},
-		{
-			ID:          lsproductID,
-			Price:       15.55,
-			DetailsID:   seminarID,
-			DetailsType: "seminar",
-		},
-	}
+		// Act
+		err := testService.Unpublish(context.Background(), seminarID)
 
-	newName := "New seminar name"
-	newLongDescription := "New seminar long description"
-	newReservationPrice := float32(44.44)
-	newLatePaymentDate, _ := time.Parse(layout, "2025-Nov-12")
-	newTags := []string{"new", "seminar", "tags"}
+		// Assert
+		assert.NoError(t, err)
+	})
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("already unpublished", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -1936,77 +2466,33 @@ func TestService_Update(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
-		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
-
-		var seminarUpdates map[string]any
-		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
-			Do(func(_ context.Context, _ *seminar.Seminar, u map[string]any) {
-				seminarUpdates = u
-			}).Return(int64(1), nil).AnyTimes()
-
-		var productUpdates map[string]any
-		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
-			Do(func(_ context.Context, _ *product.Product, u map[string]any) {
-				productUpdates = u
-			}).Return(int64(1), nil).AnyTimes()
+		testSeminar := newTestSeminar(seminarID)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+			Return(newTestSeminarProducts(testSeminar), nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false, gomock.Any()).Return(database.SetResult{AlreadyInState: true}, nil)
 
 		// Act
-		updates, err := testService.Update(context.Background(), &seminar.UpdateRequest{
-			ID:               seminarID,
-			Name:             &newName,
-			LongDescription:  &newLongDescription,
-			ReservationPrice: &newReservationPrice,
-			LatePaymentDate:  &newLatePaymentDate,
-			Tags:             newTags,
-		})
+		err := testService.Unpublish(context.Background(), seminarID)
 
 		// Assert
 		assert.NoError(t, err)
-		// Assert seminar
-		seminarUpdatesFromResp, ok := updates["seminar"].(map[string]any)
-		if !ok {
-			t.Error("response does not contain 'seminar' key")
-		}
-		if name, ok := seminarUpdatesFromResp["name"].(string); !ok || name != newName {
-			t.Errorf("seminar.Name in response %v, want %s", seminarUpdatesFromResp["name"], newName)
-		}
-		if longDesc, ok := seminarUpdatesFromResp["long_description"].(string); !ok || longDesc != newLongDescription {
-			t.Errorf("seminar.LongDescription in response %v, want %s", seminarUpdatesFromResp["long_description"], newLongDescription)
-		}
-		if latePaymentDate, ok := seminarUpdatesFromResp["late_payment_date"].(time.Time); !ok || latePaymentDate != newLatePaymentDate {
-			t.Errorf("seminar.LatePaymentDate in response %v, want %v", seminarUpdatesFromResp["late_payment_date"], newLatePaymentDate)
-		}
-		if tags, ok := seminarUpdatesFromResp["tags"].([]string); !ok || !reflect.DeepEqual(tags, newTags) {
-			t.Errorf("seminar.Tags in response %v, want %v", seminarUpdatesFromResp["tags"], newTags)
-		}
-		if name, ok := seminarUpdates["name"].(string); !ok || name != newName {
-			t.Errorf("seminar.Name passed to repo %v, want %s", seminarUpdates["name"], newName)
-		}
-		if longDesc, ok := seminarUpdates["long_description"].(string); !ok || longDesc != newLongDescription {
-			t.Errorf("seminar.LongDescription passed to repo %v, want %s", seminarUpdates["long_description"], newLongDescription)
-		}
-		if latePaymentDate, ok := seminarUpdates["late_payment_date"].(time.Time); !ok || latePaymentDate != newLatePaymentDate {
-			t.Errorf("seminar.LatePaymentDate passed to repo %v, want %v", seminarUpdates["late_payment_date"], newLatePaymentDate)
-		}
-		if tags, ok := seminarUpdates["tags"].([]string); !ok || !reflect.DeepEqual(tags, newTags) {
-			t.Errorf("seminar.Tags passed to repo %v, want %v", seminarUpdates["tags"], newTags)
-		}
+	})
 
-		// Assert product
-		productUpdatesFromResp, ok := updates["reservation_product"].(map[string]any)
-		if !ok {
-			t.Error("response does not contain 'reservation_product' key")
-		}
-		if price, ok := productUpdatesFromResp["price"].(float32); !ok || price != newReservationPrice {
-			t.Errorf("product.Price in response %v, want %f", productUpdatesFromResp["price"], newReservationPrice)
-		}
-		if price, ok := productUpdates["price"].(float32); !ok || price != newReservationPrice {
-			t.Errorf("product.Price passed to repo %v, want %f", productUpdates["price"], newReservationPrice)
-		}
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
+
+		// Act
+		err := testService.Unpublish(context.Background(), invalidID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
 	})
 
-	t.Run("success with multiple updated products", func(t *testing.T) {
+	t.Run("seminar not found", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2015,75 +2501,1182 @@ func TestService_Update(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
-		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
-
-		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
-
-		allProductUpdates := make(map[string]any)
-		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
-			Do(func(_ context.Context, p *product.Product, u map[string]any) {
-				allProductUpdates[p.ID] = u
-			}).Return(int64(1), nil).AnyTimes()
-
-		newLatePrice := float32(23.55)
-		newLateSurchargePrice := float32(99.99)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
-		updates, err := testService.Update(context.Background(), &seminar.UpdateRequest{
-			ID:                 seminarID,
-			ReservationPrice:   &newReservationPrice,
-			LatePrice:          &newLatePrice,
-			LateSurchargePrice: &newLateSurchargePrice,
-		})
+		err := testService.Unpublish(context.Background(), seminarID)
 
 		// Assert
-		assert.NoError(t, err)
-		rproductUpdates, ok := allProductUpdates[rproductID].(map[string]any)
-		if !ok {
-			t.Error("reservation product updates was not passed to the repo")
-		}
-		if price, ok := rproductUpdates["price"].(float32); !ok || price != newReservationPrice {
-			t.Errorf("reservation_product.Price passed to repo %v, want %f", rproductUpdates["price"], newReservationPrice)
-		}
-		lproductUpdates, ok := allProductUpdates[lproductID].(map[string]any)
-		if !ok {
-			t.Error("late product updates was not passed to the repo")
-		}
-		if price, ok := lproductUpdates["price"].(float32); !ok || price != newLatePrice {
-			t.Errorf("late_product.Price passed to repo %v, want %f", lproductUpdates["price"], newLatePrice)
-		}
-		lsproductUpdates, ok := allProductUpdates[lsproductID].(map[string]any)
-		if !ok {
-			t.Error("late surcharge product updates was not passed to the repo")
-		}
-		if price, ok := lsproductUpdates["price"].(float32); !ok || price != newLateSurchargePrice {
-			t.Errorf("late_surcharge_product.Price passed to repo %v, want %f", lsproductUpdates["price"], newLateSurchargePrice)
-		}
-		rproductUpdatesFromResp, ok := updates["reservation_product"].(map[string]any)
-		if !ok {
-			t.Error("response does not have 'reservation_product' key")
-		}
-		if price, ok := rproductUpdatesFromResp["price"].(float32); !ok || price != newReservationPrice {
-			t.Errorf("reservation_product.Price from response %v, want %f", rproductUpdatesFromResp["price"], newReservationPrice)
-		}
-		lproductUpdatesFromResp, ok := updates["late_product"].(map[string]any)
-		if !ok {
-			t.Error("response does not have 'late_product' key")
-		}
-		if price, ok := lproductUpdatesFromResp["price"].(float32); !ok || price != newLatePrice {
-			t.Errorf("late_product.Price from response %v, want %f", lproductUpdatesFromResp["price"], newLatePrice)
-		}
-		lsproductUpdatesFromResp, ok := updates["late_surcharge_product"].(map[string]any)
-		if !ok {
-			t.Error("response does not have 'late_surcharge_product' key")
-		}
-		if price, ok := lsproductUpdatesFromResp["price"].(float32); !ok || price != newLateSurchargePrice {
-			t.Errorf("late_surcharge_product.Price from response %v, want %f", lsproductUpdatesFromResp["price"], newLateSurchargePrice)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("not all products are found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		products := newTestSeminarProducts(testSeminar)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+			Return(products[:3], nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Unpublish(context.Background(), seminarID)
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		products := newTestSeminarProducts(testSeminar)
+		dbErr := errors.New("database error")
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+			Return(products, nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(database.SetResult{}, dbErr)
+
+		// Act
+		err := testService.Unpublish(context.Background(), seminarID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestService_ReconcileInStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	seminarID := uuid.New().String()
+
+	// productsOf returns products of s with InStock set to inStock, except the first one
+	// if drifted is true.
+	productsOf := func(s *seminar.Seminar, inStock, drifted bool) []product.Product {
+		var products []product.Product
+		for i, id := range s.ProductIDs() {
+			products = append(products, product.Product{ID: id, InStock: inStock != (drifted && i == 0)})
 		}
+		return products
+	}
+
+	t.Run("published seminar with unpublished product", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		sem := newTestSeminar(seminarID)
+		sem.InStock = true
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(sem, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), sem.ProductIDs(), "id", "in_stock").Return(productsOf(sem, true, true), nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, true, gomock.Any()).Return(database.SetResult{Affected: 5}, nil)
+
+		// Act
+		err := testService.ReconcileInStock(context.Background(), seminarID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("unpublished seminar with published product", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		sem := newTestSeminar(seminarID)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(sem, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), sem.ProductIDs(), "id", "in_stock").Return(productsOf(sem, false, true), nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false, gomock.Any()).Return(database.SetResult{Affected: 5}, nil)
+
+		// Act
+		err := testService.ReconcileInStock(context.Background(), seminarID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already consistent", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		sem := newTestSeminar(seminarID)
+		sem.InStock = true
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(sem, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), sem.ProductIDs(), "id", "in_stock").Return(productsOf(sem, true, false), nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.ReconcileInStock(context.Background(), seminarID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		err := testService.ReconcileInStock(context.Background(), "invalid-UUID")
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("seminar not found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		err := testService.ReconcileInStock(context.Background(), seminarID)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("update error", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		sem := newTestSeminar(seminarID)
+		dbErr := errors.New("db error")
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(sem, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), sem.ProductIDs(), "id", "in_stock").Return(productsOf(sem, false, true), nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false, gomock.Any()).Return(database.SetResult{}, dbErr)
+
+		// Act
+		err := testService.ReconcileInStock(context.Background(), seminarID)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
+func TestService_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		// This prevents GORM from starting a real DB transaction,
+		// allowing the mock repositories to work as expected.
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	seminarID := uuid.New().String()
+	rproductID := uuid.New().String()
+	eproductID := uuid.New().String()
+	lproductID := uuid.New().String()
+	esproductID := uuid.New().String()
+	lsproductID := uuid.New().String()
+
+	layout := "2006-Jan-02"
+
+	date, _ := time.Parse(layout, "2025-Dec-03")
+	endingDate, _ := time.Parse(layout, "2025-Dec-06")
+	latePaymentDate, _ := time.Parse(layout, "2025-Nov-20")
+
+	mockSeminar := &seminar.Seminar{
+		ID:                      seminarID,
+		Name:                    "Seminar old name",
+		Slug:                    "seminar-old-name",
+		ShortDescription:        "Seminar old short description",
+		Date:                    date,
+		EndingDate:              endingDate,
+		LatePaymentDate:         latePaymentDate,
+		ReservationProductID:    &rproductID,
+		EarlyProductID:          &eproductID,
+		LateProductID:           &lproductID,
+		EarlySurchargeProductID: &esproductID,
+		LateSurchargeProductID:  &lsproductID,
+	}
+
+	mockProducts := []product.Product{
+		{
+			ID:          rproductID,
+			Price:       11.11,
+			DetailsID:   seminarID,
+			DetailsType: "seminar",
+		},
+		{
+			ID:          eproductID,
+			Price:       12.22,
+			DetailsID:   seminarID,
+			DetailsType: "seminar",
+		},
+		{
+			ID:          lproductID,
+			Price:       13.33,
+			DetailsID:   seminarID,
+			DetailsType: "seminar",
+		},
+		{
+			ID:          esproductID,
+			Price:       14.44,
+			DetailsID:   seminarID,
+			DetailsType: "seminar",
+		},
+		{
+			ID:          lsproductID,
+			Price:       15.55,
+			DetailsID:   seminarID,
+			DetailsType: "seminar",
+		},
+	}
+
+	newName := "New seminar name"
+	newLongDescription := "New seminar long description"
+	newReservationPrice := float32(44.44)
+	newLatePaymentDate, _ := time.Parse(layout, "2025-Nov-12")
+	newTags := []string{"new", "seminar", "tags"}
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
+		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
+
+		var seminarUpdates map[string]any
+		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, _ *seminar.Seminar, u map[string]any) {
+				seminarUpdates = u
+			}).Return(int64(1), nil).AnyTimes()
+
+		var productUpdates map[string]any
+		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, _ *product.Product, u map[string]any) {
+				productUpdates = u
+			}).Return(int64(1), nil).AnyTimes()
+
+		// Act
+		updates, err := testService.Update(context.Background(), &seminar.UpdateRequest{
+			ID:               seminarID,
+			Name:             &newName,
+			LongDescription:  &newLongDescription,
+			ReservationPrice: &newReservationPrice,
+			LatePaymentDate:  &newLatePaymentDate,
+			Tags:             newTags,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		// Assert seminar
+		seminarUpdatesFromResp, ok := updates["seminar"].(map[string]any)
+		if !ok {
+			t.Error("response does not contain 'seminar' key")
+		}
+		if name, ok := seminarUpdatesFromResp["name"].(string); !ok || name != newName {
+			t.Errorf("seminar.Name in response %v, want %s", seminarUpdatesFromResp["name"], newName)
+		}
+		if longDesc, ok := seminarUpdatesFromResp["long_description"].(string); !ok || longDesc != newLongDescription {
+			t.Errorf("seminar.LongDescription in response %v, want %s", seminarUpdatesFromResp["long_description"], newLongDescription)
+		}
+		if latePaymentDate, ok := seminarUpdatesFromResp["late_payment_date"].(time.Time); !ok || latePaymentDate != newLatePaymentDate {
+			t.Errorf("seminar.LatePaymentDate in response %v, want %v", seminarUpdatesFromResp["late_payment_date"], newLatePaymentDate)
+		}
+		if tags, ok := seminarUpdatesFromResp["tags"].([]string); !ok || !reflect.DeepEqual(tags, newTags) {
+			t.Errorf("seminar.Tags in response %v, want %v", seminarUpdatesFromResp["tags"], newTags)
+		}
+		if name, ok := seminarUpdates["name"].(string); !ok || name != newName {
+			t.Errorf("seminar.Name passed to repo %v, want %s", seminarUpdates["name"], newName)
+		}
+		if longDesc, ok := seminarUpdates["long_description"].(string); !ok || longDesc != newLongDescription {
+			t.Errorf("seminar.LongDescription passed to repo %v, want %s", seminarUpdates["long_description"], newLongDescription)
+		}
+		if latePaymentDate, ok := seminarUpdates["late_payment_date"].(time.Time); !ok || latePaymentDate != newLatePaymentDate {
+			t.Errorf("seminar.LatePaymentDate passed to repo %v, want %v", seminarUpdates["late_payment_date"], newLatePaymentDate)
+		}
+		if tags, ok := seminarUpdates["tags"].([]string); !ok || !reflect.DeepEqual(tags, newTags) {
+			t.Errorf("seminar.Tags passed to repo %v, want %v", seminarUpdates["tags"], newTags)
+		}
+
+		// Assert product
+		productUpdatesFromResp, ok := updates["reservation_product"].(map[string]any)
+		if !ok {
+			t.Error("response does not contain 'reservation_product' key")
+		}
+		if price, ok := productUpdatesFromResp["price"].(float32); !ok || price != newReservationPrice {
+			t.Errorf("product.Price in response %v, want %f", productUpdatesFromResp["price"], newReservationPrice)
+		}
+		if price, ok := productUpdates["price"].(float32); !ok || price != newReservationPrice {
+			t.Errorf("product.Price passed to repo %v, want %f", productUpdates["price"], newReservationPrice)
+		}
+	})
+
+	t.Run("success with multiple updated products", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
+		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
+
+		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(1), nil).AnyTimes()
+
+		allProductUpdates := make(map[string]any)
+		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, p *product.Product, u map[string]any) {
+				allProductUpdates[p.ID] = u
+			}).Return(int64(1), nil).AnyTimes()
+
+		newLatePrice := float32(23.55)
+		newLateSurchargePrice := float32(99.99)
+
+		// Act
+		updates, err := testService.Update(context.Background(), &seminar.UpdateRequest{
+			ID:                 seminarID,
+			ReservationPrice:   &newReservationPrice,
+			LatePrice:          &newLatePrice,
+			LateSurchargePrice: &newLateSurchargePrice,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		rproductUpdates, ok := allProductUpdates[rproductID].(map[string]any)
+		if !ok {
+			t.Error("reservation product updates was not passed to the repo")
+		}
+		if price, ok := rproductUpdates["price"].(float32); !ok || price != newReservationPrice {
+			t.Errorf("reservation_product.Price passed to repo %v, want %f", rproductUpdates["price"], newReservationPrice)
+		}
+		lproductUpdates, ok := allProductUpdates[lproductID].(map[string]any)
+		if !ok {
+			t.Error("late product updates was not passed to the repo")
+		}
+		if price, ok := lproductUpdates["price"].(float32); !ok || price != newLatePrice {
+			t.Errorf("late_product.Price passed to repo %v, want %f", lproductUpdates["price"], newLatePrice)
+		}
+		lsproductUpdates, ok := allProductUpdates[lsproductID].(map[string]any)
+		if !ok {
+			t.Error("late surcharge product updates was not passed to the repo")
+		}
+		if price, ok := lsproductUpdates["price"].(float32); !ok || price != newLateSurchargePrice {
+			t.Errorf("late_surcharge_product.Price passed to repo %v, want %f", lsproductUpdates["price"], newLateSurchargePrice)
+		}
+		rproductUpdatesFromResp, ok := updates["reservation_product"].(map[string]any)
+		if !ok {
+			t.Error("response does not have 'reservation_product' key")
+		}
+		if price, ok := rproductUpdatesFromResp["price"].(float32); !ok || price != newReservationPrice {
+			t.Errorf("reservation_product.Price from response %v, want %f", rproductUpdatesFromResp["price"], newReservationPrice)
+		}
+		lproductUpdatesFromResp, ok := updates["late_product"].(map[string]any)
+		if !ok {
+			t.Error("response does not have 'late_product' key")
+		}
+		if price, ok := lproductUpdatesFromResp["price"].(float32); !ok || price != newLatePrice {
+			t.Errorf("late_product.Price from response %v, want %f", lproductUpdatesFromResp["price"], newLatePrice)
+		}
+		lsproductUpdatesFromResp, ok := updates["late_surcharge_product"].(map[string]any)
+		if !ok {
+			t.Error("response does not have 'late_surcharge_product' key")
+		}
+		if price, ok := lsproductUpdatesFromResp["price"].(float32); !ok || price != newLateSurchargePrice {
+			t.Errorf("late_surcharge_product.Price from response %v, want %f", lsproductUpdatesFromResp["price"], newLateSurchargePrice)
+		}
+	})
+
+	t.Run("success with no updates", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
+		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
+
+		// Act
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
+			ID: seminarID, // no new fields
+		})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid request payload", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		invalidDate, _ := time.Parse(layout, "2003-Nov-01") // Date in the past
+		invalidName := "1invalidseminarname"
+
+		// Act
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
+			ID:   seminarID,
+			Date: &invalidDate,
+			Name: &invalidName,
+		})
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound).AnyTimes()
+
+		// Act
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
+			ID: seminarID,
+		})
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
+		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
+		dbErr := errors.New("database error")
+		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), dbErr).AnyTimes()
+
+		// Act
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
+			ID:   seminarID,
+			Name: &newName,
+		})
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestService_Update_Slug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	t.Cleanup(func() { _ = common.SetSlugPolicy(common.SlugKeep) })
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	// rename renames a seminar with the given slug to "Yoga Retreat" and returns the seminar updates.
+	rename := func(t *testing.T, slug string, taken []string, expectLookup bool) map[string]any {
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		s := newTestSeminar(uuid.New().String())
+		s.Name = "Old name"
+		s.Slug = slug
+		products := make([]product.Product, 0, len(s.ProductIDs()))
+		for _, id := range s.ProductIDs() {
+			products = append(products, product.Product{ID: id, DetailsID: s.ID, DetailsType: "seminar", Price: 10})
+		}
+		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), s.ID).Return(s, nil)
+		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(products, nil)
+		if expectLookup {
+			mockTxSeminarRepo.EXPECT().ListSlugsByPrefix(gomock.Any(), "yoga-retreat").Return(taken, nil)
+		}
+		var updates map[string]any
+		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), s, gomock.Any()).
+			Do(func(_ context.Context, _ *seminar.Seminar, u any) { updates = u.(map[string]any) }).
+			Return(int64(1), nil)
+
+		name := "Yoga Retreat"
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{ID: s.ID, Name: &name})
+		assert.NoError(t, err)
+		return updates
+	}
+
+	t.Run("kept by default", func(t *testing.T) {
+		// Act
+		updates := rename(t, "old-name", nil, false)
+
+		// Assert
+		assert.NotContains(t, updates, "slug")
+	})
+
+	t.Run("generated for seminar without slug", func(t *testing.T) {
+		// Act
+		updates := rename(t, "", []string{"yoga-retreat"}, true)
+
+		// Assert
+		assert.Equal(t, "yoga-retreat-2", updates["slug"])
+	})
+
+	t.Run("regenerated by policy", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, common.SetSlugPolicy(common.SlugRegenerate))
+
+		// Act
+		updates := rename(t, "old-name", []string{"yoga-retreat", "yoga-retreat-2"}, true)
+
+		// Assert
+		assert.Equal(t, "yoga-retreat-3", updates["slug"])
+	})
+
+	t.Run("own slug is not a collision", func(t *testing.T) {
+		// Arrange
+		assert.NoError(t, common.SetSlugPolicy(common.SlugRegenerate))
+
+		// Act
+		updates := rename(t, "yoga-retreat-2", []string{"yoga-retreat", "yoga-retreat-2"}, true)
+
+		// Assert
+		assert.NotContains(t, updates, "slug")
+	})
+}
+
+func TestService_Update_ZeroValues(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	// expectTx sets up transaction-bound repositories returning a tagged seminar with all of its products.
+	expectTx := func() (*seminarmock.MockRepository, *seminar.Seminar) {
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		s := newTestSeminar(uuid.New().String())
+		s.Tags = []string{"old", "tags"}
+		products := make([]product.Product, 0, len(s.ProductIDs()))
+		for _, id := range s.ProductIDs() {
+			products = append(products, product.Product{ID: id, DetailsID: s.ID, DetailsType: "seminar", Price: 10})
+		}
+		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), s.ID).Return(s, nil).AnyTimes()
+		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(products, nil).AnyTimes()
+		return mockTxSeminarRepo, s
+	}
+
+	t.Run("empty tags clear them", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo, s := expectTx()
+		var updates map[string]any
+		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), s, gomock.Any()).
+			Do(func(_ context.Context, _ *seminar.Seminar, u any) { updates = u.(map[string]any) }).
+			Return(int64(1), nil)
+
+		// Act
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{ID: s.ID, Tags: []string{}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"tags": []string{}}, updates)
+	})
+
+	t.Run("nil tags are kept", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo, s := expectTx()
+		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{ID: s.ID})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("zero date is rejected", func(t *testing.T) {
+		// Arrange
+		_, s := expectTx()
+		var zero time.Time
+
+		// Act
+		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{ID: s.ID, Date: &zero})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_Update_Tracing(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	// Arrange
+	recorder := tracingtest.Record(t)
+	seminarID := uuid.New().String()
+	name := "New seminar name"
+
+	mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockSeminarRepo.EXPECT().DB().Return(db)
+	mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+	mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(productmock.NewMockRepository(ctrl))
+	var repoSpan trace.SpanContext
+	mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).
+		DoAndReturn(func(ctx context.Context, id string) (*seminar.Seminar, error) {
+			repoSpan = trace.SpanContextFromContext(ctx)
+			return nil, gorm.ErrRecordNotFound
+		})
+
+	// Act
+	_, err = testService.Update(context.Background(), &seminar.UpdateRequest{ID: seminarID, Name: &name})
+
+	// Assert
+	assert.ErrorIs(t, err, ErrNotFound)
+	span := tracingtest.Find(recorder, "seminar.Update")
+	if assert.NotNil(t, span) {
+		assert.Contains(t, span.Attributes(), tracing.ID(seminarID))
+		// Repository calls run within the service span.
+		assert.Equal(t, span.SpanContext(), repoSpan)
+	}
+}
+
+func TestService_UpdateSurcharges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		// This prevents GORM from starting a real DB transaction,
+		// allowing the mock repositories to work as expected.
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	seminarID := uuid.New().String()
+	early := float32(20)
+	late := float32(30)
+
+	t.Run("success early only", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		esID := *testSeminar.EarlySurchargeProductID
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), []string{esID}, "id", "price").
+			Return([]product.Product{{ID: esID, Price: 10}}, nil)
+		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), map[string]any{"price": early}).
+			DoAndReturn(func(_ context.Context, p *product.Product, _ map[string]any) (int64, error) {
+				assert.Equal(t, esID, p.ID)
+				return 1, nil
+			})
+
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), seminarID, &early, nil)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("success both", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		esID := *testSeminar.EarlySurchargeProductID
+		lsID := *testSeminar.LateSurchargeProductID
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), gomock.InAnyOrder([]string{esID, lsID}), "id", "price").
+			Return([]product.Product{{ID: esID, Price: 10}, {ID: lsID, Price: 15}}, nil)
+		updated := make(map[string]any)
+		mockTxProductRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, p *product.Product, updates map[string]any) (int64, error) {
+				updated[p.ID] = updates["price"]
+				return 1, nil
+			}).Times(2)
+
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), seminarID, &early, &late)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{esID: early, lsID: late}, updated)
+	})
+
+	t.Run("neither is a no-op", func(t *testing.T) {
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), seminarID, nil, nil)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid price", func(t *testing.T) {
+		// Arrange
+		invalid := float32(0.5)
+
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), seminarID, nil, &invalid)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), "invalid-UUID", &early, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("seminar without surcharge", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID, seminar.RequiredProductRoles...)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), seminarID, nil, &late)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), seminarID, &early, &late)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("surcharge product not found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		testSeminar := newTestSeminar(seminarID)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByIDs(gomock.Any(), gomock.Any(), "id", "price").
+			Return([]product.Product{}, nil)
+
+		// Act
+		err := testService.UpdateSurcharges(context.Background(), seminarID, &early, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrProductsNotFound)
+	})
+}
+
+func TestService_PriceAsOf(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	seminarID := uuid.New().String()
+	latePaymentDate := time.Date(2025, time.November, 20, 0, 0, 0, 0, time.UTC)
+	testSeminar := newTestSeminar(seminarID)
+	testSeminar.LatePaymentDate = latePaymentDate
+	earlyID := *testSeminar.EarlyProductID
+	lateID := *testSeminar.LateProductID
+	prices := map[string]float32{earlyID: 100, lateID: 150}
+
+	tests := []struct {
+		name          string
+		at            time.Time
+		wantProductID string
+	}{
+		{name: "before late payment date", at: latePaymentDate.Add(-time.Second), wantProductID: earlyID},
+		{name: "on late payment date", at: latePaymentDate, wantProductID: lateID},
+		{name: "after late payment date", at: latePaymentDate.Add(24 * time.Hour), wantProductID: lateID},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(testSeminar, nil)
+			mockProductRepo.EXPECT().SelectWithDeletedByIDs(gomock.Any(), []string{tt.wantProductID}, "id", "price").
+				Return([]product.Product{{ID: tt.wantProductID, Price: prices[tt.wantProductID]}}, nil)
+
+			// Act
+			price, productID, err := testService.PriceAsOf(context.Background(), seminarID, tt.at)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProductID, productID)
+			assert.Equal(t, prices[tt.wantProductID], price)
+		})
+	}
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		_, _, err := testService.PriceAsOf(context.Background(), "invalid-UUID", latePaymentDate)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		_, _, err := testService.PriceAsOf(context.Background(), seminarID, latePaymentDate)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(testSeminar, nil)
+		mockProductRepo.EXPECT().SelectWithDeletedByIDs(gomock.Any(), []string{lateID}, "id", "price").
+			Return([]product.Product{}, nil)
+
+		// Act
+		_, _, err := testService.PriceAsOf(context.Background(), seminarID, latePaymentDate)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrProductsNotFound)
+	})
+}
+
+func TestService_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		// This prevents GORM from starting a real DB transaction,
+		// allowing the mock repositories to work as expected.
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	seminarID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+			Return(newTestSeminarProducts(newTestSeminar(seminarID)), nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false, gomock.Any()).Return(database.SetResult{Affected: 5}, nil)
+		// Products are deleted explicitly, the seminar delete must not cascade to them a second time.
+		mockTxSeminarRepo.EXPECT().Delete(gomock.Cond(common.CascadeSkipped), seminarID, "").Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), seminarID).Return(int64(5), nil)
+
+		// Act
+		err := testService.Delete(context.Background(), seminarID, "")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
+
+		// Act
+		err := testService.Delete(context.Background(), invalidID, "")
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+
+		// Act
+		err := testService.Delete(context.Background(), seminarID, "")
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("cannot unpublish all products", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+			Return(newTestSeminarProducts(newTestSeminar(seminarID))[:3], nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		// The guard fires before the seminar delete, so its hook can't cascade to the products either.
+		mockTxSeminarRepo.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Delete(context.Background(), seminarID, "")
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("db error", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+			Return(newTestSeminarProducts(newTestSeminar(seminarID)), nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(database.SetResult{Affected: 1}, nil)
+		dbErr := errors.New("database error")
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false, gomock.Any()).Return(database.SetResult{}, dbErr)
+
+		// Act
+		err := testService.Delete(context.Background(), seminarID, "")
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestService_DeletePermanent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	// Nothing is featured, so the dependents check always passes.
+	mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo).AnyTimes()
+	mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	testService := New(mockSeminarRepo, mockProductRepo, mockFeaturedRepo, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		// This prevents GORM from starting a real DB transaction,
+		// allowing the mock repositories to work as expected.
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	seminarID := uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(5), nil)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), seminarID, false)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Arrange
+		invalidID := "invalid-UUID"
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), invalidID, false)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(0), nil)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), seminarID, false)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
 	})
 
-	t.Run("success with no updates", func(t *testing.T) {
+	t.Run("cannot delete all products", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2092,19 +3685,38 @@ func TestService_Update(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
-		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(3), nil)
 
 		// Act
-		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
-			ID: seminarID, // no new fields
-		})
+		err := testService.DeletePermanent(context.Background(), seminarID, false)
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("force deletes inconsistent record", func(t *testing.T) {
+		// Arrange
+		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(3), nil)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), seminarID, true)
 
 		// Assert
 		assert.NoError(t, err)
 	})
 
-	t.Run("invalid request payload", func(t *testing.T) {
+	t.Run("force deletes orphaned products", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2113,22 +3725,18 @@ func TestService_Update(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		invalidDate, _ := time.Parse(layout, "2003-Nov-01") // Date in the past
-		invalidName := "1invalidseminarname"
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(0), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(2), nil)
 
 		// Act
-		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
-			ID:   seminarID,
-			Date: &invalidDate,
-			Name: &invalidName,
-		})
+		err := testService.DeletePermanent(context.Background(), seminarID, true)
 
 		// Assert
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.NoError(t, err)
 	})
 
-	t.Run("not found", func(t *testing.T) {
+	t.Run("force with nothing to delete", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2137,15 +3745,14 @@ func TestService_Update(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound).AnyTimes()
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(0), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(0), nil)
 
 		// Act
-		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
-			ID: seminarID,
-		})
+		err := testService.DeletePermanent(context.Background(), seminarID, true)
 
 		// Assert
-		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrNotFound)
 	})
 
@@ -2158,35 +3765,30 @@ func TestService_Update(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil).AnyTimes()
-		mockTxProductRepo.EXPECT().SelectByIDs(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockProducts, nil).AnyTimes()
 		dbErr := errors.New("database error")
-		mockTxSeminarRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Return(int64(0), dbErr).AnyTimes()
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(0), dbErr)
 
 		// Act
-		_, err := testService.Update(context.Background(), &seminar.UpdateRequest{
-			ID:   seminarID,
-			Name: &newName,
-		})
+		err := testService.DeletePermanent(context.Background(), seminarID, false)
 
 		// Assert
 		assert.Error(t, err)
 	})
 }
 
-func TestService_Delete(t *testing.T) {
+func TestService_DeletePermanent_Dependents(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, mockFeaturedRepo, slog.New(slog.DiscardHandler))
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
-		// This prevents GORM from starting a real DB transaction,
-		// allowing the mock repositories to work as expected.
 		SkipDefaultTransaction: true,
 	})
 	if err != nil {
@@ -2195,109 +3797,88 @@ func TestService_Delete(t *testing.T) {
 
 	seminarID := uuid.New().String()
 
-	t.Run("success", func(t *testing.T) {
-		// Arrange
-		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
-		mockTxProductRepo := productmock.NewMockRepository(ctrl)
-
-		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
-		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
-		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
-
-		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(&seminar.Seminar{}, nil)
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false).Return(int64(5), nil)
-		mockTxSeminarRepo.EXPECT().Delete(gomock.Any(), seminarID).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), seminarID).Return(int64(5), nil)
-
-		// Act
-		err := testService.Delete(context.Background(), seminarID)
-
-		// Assert
-		assert.NoError(t, err)
-	})
-
-	t.Run("invalid UUID", func(t *testing.T) {
-		// Arrange
-		invalidID := "invalid-UUID"
-
-		// Act
-		err := testService.Delete(context.Background(), invalidID)
-
-		// Assert
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, ErrInvalidArgument)
-	})
-
-	t.Run("not found", func(t *testing.T) {
+	t.Run("featured product blocks delete", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
 
 		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
 
-		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(nil, gorm.ErrRecordNotFound)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
-		err := testService.Delete(context.Background(), seminarID)
+		err := testService.DeletePermanent(context.Background(), seminarID, false)
 
 		// Assert
-		assert.Error(t, err)
-		assert.ErrorIs(t, err, ErrNotFound)
+		assert.ErrorIs(t, err, ErrHasDependents)
 	})
 
-	t.Run("cannot unpublish all products", func(t *testing.T) {
+	t.Run("cascade removes featured entries", func(t *testing.T) {
 		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
 
 		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
 
-		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(&seminar.Seminar{}, nil)
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false).Return(int64(3), nil)
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(5), nil)
 
 		// Act
-		err := testService.Delete(context.Background(), seminarID)
+		err := testService.DeletePermanent(context.Background(), seminarID, false)
 
 		// Assert
-		assert.Error(t, err)
+		assert.NoError(t, err)
 	})
 
-	t.Run("db error", func(t *testing.T) {
+	t.Run("cascade error", func(t *testing.T) {
 		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
 
 		mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
 
-		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(&seminar.Seminar{}, nil)
-		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, false).Return(int64(1), nil)
 		dbErr := errors.New("database error")
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), seminarID, false).Return(int64(0), dbErr)
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), seminarID).Return(int64(0), dbErr)
+		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
-		err := testService.Delete(context.Background(), seminarID)
+		err := testService.DeletePermanent(context.Background(), seminarID, false)
 
 		// Assert
-		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
 	})
 }
 
-func TestService_DeletePermanent(t *testing.T) {
+func TestService_Restore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -2320,11 +3901,12 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(5), nil)
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), seminarID).Return(int64(5), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), seminarID)
+		err := testService.Restore(context.Background(), seminarID)
 
 		// Assert
 		assert.NoError(t, err)
@@ -2335,7 +3917,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), invalidID)
+		err := testService.Restore(context.Background(), invalidID)
 
 		// Assert
 		assert.Error(t, err)
@@ -2351,17 +3933,18 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(0), nil)
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(0), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), seminarID)
+		err := testService.Restore(context.Background(), seminarID)
 
 		// Assert
 		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrNotFound)
 	})
 
-	t.Run("cannot delete all products", func(t *testing.T) {
+	t.Run("cannot restore all products", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2370,11 +3953,12 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), seminarID).Return(int64(3), nil)
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), seminarID).Return(int64(3), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), seminarID)
+		err := testService.Restore(context.Background(), seminarID)
 
 		// Assert
 		assert.Error(t, err)
@@ -2390,36 +3974,35 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		dbErr := errors.New("database error")
-		mockTxSeminarRepo.EXPECT().DeletePermanent(gomock.Any(), seminarID).Return(int64(0), dbErr)
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), seminarID).Return(newTestSeminar(seminarID), nil)
+		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(0), dbErr)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), seminarID)
+		err := testService.Restore(context.Background(), seminarID)
 
 		// Assert
 		assert.Error(t, err)
 	})
 }
 
-func TestService_Restore(t *testing.T) {
+func TestService_DeleteBatch(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockSeminarRepo, mockProductRepo)
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
-		// This prevents GORM from starting a real DB transaction,
-		// allowing the mock repositories to work as expected.
 		SkipDefaultTransaction: true,
 	})
 	if err != nil {
 		t.Fatalf("failed to connect database: %v", err)
 	}
 
-	seminarID := uuid.New().String()
+	id1, id2 := uuid.New().String(), uuid.New().String()
 
 	t.Run("success", func(t *testing.T) {
 		// Arrange
@@ -2430,29 +4013,34 @@ func TestService_Restore(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), seminarID).Return(int64(5), nil)
+		for _, id := range []string{id1, id2} {
+			mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), id).Return(newTestSeminar(id), nil)
+			mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{id}, "id").
+				Return(newTestSeminarProducts(newTestSeminar(id)), nil)
+			mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), id, false).Return(database.SetResult{Affected: 1}, nil)
+			mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id, false, gomock.Any()).Return(database.SetResult{Affected: 5}, nil)
+			mockTxSeminarRepo.EXPECT().Delete(gomock.Any(), id, "").Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), id).Return(int64(5), nil)
+		}
 
 		// Act
-		err := testService.Restore(context.Background(), seminarID)
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, id2, id1})
 
 		// Assert
 		assert.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
 	})
 
 	t.Run("invalid UUID", func(t *testing.T) {
-		// Arrange
-		invalidID := "invalid-UUID"
-
 		// Act
-		err := testService.Restore(context.Background(), invalidID)
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, "invalid-UUID"})
 
 		// Assert
-		assert.Error(t, err)
 		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Equal(t, int64(0), deleted)
 	})
 
-	t.Run("not found", func(t *testing.T) {
+	t.Run("guard failure rolls back batch", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2461,17 +4049,48 @@ func TestService_Restore(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(0), nil)
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), id1).Return(newTestSeminar(id1), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{id1}, "id").
+			Return(newTestSeminarProducts(newTestSeminar(id1)), nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), id1, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id1, false, gomock.Any()).Return(database.SetResult{Affected: 5}, nil)
+		mockTxSeminarRepo.EXPECT().Delete(gomock.Any(), id1, "").Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), id1).Return(int64(5), nil)
+
+		mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), id2).Return(newTestSeminar(id2), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{id2}, "id").
+			Return(newTestSeminarProducts(newTestSeminar(id2))[:1], nil)
+		mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), id2, false).Times(0)
 
 		// Act
-		err := testService.Restore(context.Background(), seminarID)
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, id2})
 
 		// Assert
 		assert.Error(t, err)
-		assert.ErrorIs(t, err, ErrNotFound)
+		assert.Equal(t, int64(0), deleted)
 	})
+}
 
-	t.Run("cannot restore all products", func(t *testing.T) {
+func TestService_RestoreBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	id1, id2 := uuid.New().String(), uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2480,17 +4099,30 @@ func TestService_Restore(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), seminarID).Return(int64(3), nil)
+		for _, id := range []string{id1, id2} {
+			mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), id).Return(newTestSeminar(id), nil)
+			mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), id).Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id).Return(int64(5), nil)
+		}
 
 		// Act
-		err := testService.Restore(context.Background(), seminarID)
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, id2, id1})
 
 		// Assert
-		assert.Error(t, err)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), restored)
 	})
 
-	t.Run("db error", func(t *testing.T) {
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, "invalid-UUID"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Equal(t, int64(0), restored)
+	})
+
+	t.Run("product count mismatch rolls back batch", func(t *testing.T) {
 		// Arrange
 		mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -2499,13 +4131,319 @@ func TestService_Restore(t *testing.T) {
 		mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		dbErr := errors.New("database error")
-		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), seminarID).Return(int64(0), dbErr)
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), id1).Return(newTestSeminar(id1), nil)
+		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), id1).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id1).Return(int64(5), nil)
+
+		mockTxSeminarRepo.EXPECT().GetWithDeleted(gomock.Any(), id2).Return(newTestSeminar(id2), nil)
+		mockTxSeminarRepo.EXPECT().Restore(gomock.Any(), id2).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id2).Return(int64(3), nil)
 
 		// Act
-		err := testService.Restore(context.Background(), seminarID)
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, id2})
 
 		// Assert
 		assert.Error(t, err)
+		assert.Equal(t, int64(0), restored)
+	})
+}
+
+// newTestSeminar returns a seminar with products for roles. If no roles are given, the seminar has
+// products for all of [seminar.AllProductRoles].
+func newTestSeminar(id string, roles ...seminar.ProductRole) *seminar.Seminar {
+	if len(roles) == 0 {
+		roles = seminar.AllProductRoles
+	}
+	s := &seminar.Seminar{ID: id}
+	for _, role := range roles {
+		productID := uuid.New().String()
+		s.SetProductID(role, &productID)
+	}
+	return s
+}
+
+// newTestSeminarProducts returns priced, unpublished products for every product ID of s.
+func newTestSeminarProducts(s *seminar.Seminar) []product.Product {
+	ids := s.ProductIDs()
+	products := make([]product.Product, len(ids))
+	for i, id := range ids {
+		products[i] = product.Product{ID: id, Price: 25}
+	}
+	return products
+}
+
+func TestService_ProductRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+	mockSeminarRepo.EXPECT().DB().Return(db).AnyTimes()
+
+	date := time.Now().Add(30 * 24 * time.Hour)
+	prices := map[seminar.ProductRole]float32{
+		seminar.RoleReservation:    11.11,
+		seminar.RoleEarly:          12.22,
+		seminar.RoleLate:           13.33,
+		seminar.RoleEarlySurcharge: 14.44,
+		seminar.RoleLateSurcharge:  15.55,
+	}
+
+	variants := []struct {
+		name  string
+		roles []seminar.ProductRole
+	}{
+		{name: "three-tier", roles: []seminar.ProductRole{seminar.RoleReservation, seminar.RoleEarly, seminar.RoleLate}},
+		{name: "five-tier", roles: seminar.AllProductRoles},
+	}
+	for _, v := range variants {
+		t.Run(v.name+" create", func(t *testing.T) {
+			// Arrange
+			mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+			mockTxProductRepo := productmock.NewMockRepository(ctrl)
+			mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+			req := &seminar.CreateRequest{
+				Name:             "Seminar name",
+				ShortDescription: "Seminar short description",
+				Date:             date,
+				EndingDate:       date.Add(48 * time.Hour),
+				LatePaymentDate:  date.Add(-7 * 24 * time.Hour),
+				Place:            "Seminar place",
+				Roles:            v.roles,
+			}
+			for _, role := range v.roles {
+				switch role {
+				case seminar.RoleReservation:
+					req.ReservationPrice = prices[role]
+				case seminar.RoleEarly:
+					req.EarlyPrice = prices[role]
+				case seminar.RoleLate:
+					req.LatePrice = prices[role]
+				case seminar.RoleEarlySurcharge:
+					req.EarlySurchargePrice = prices[role]
+				case seminar.RoleLateSurcharge:
+					req.LateSurchargePrice = prices[role]
+				}
+			}
+
+			mockTxSeminarRepo.EXPECT().ListSlugsByPrefix(gomock.Any(), "seminar-name").Return(nil, nil)
+			var createdProducts []*product.Product
+			mockTxProductRepo.EXPECT().CreateBatch(gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, products ...*product.Product) {
+					createdProducts = products
+				}).Return(nil)
+			var createdSeminar *seminar.Seminar
+			mockTxSeminarRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, s *seminar.Seminar) {
+					createdSeminar = s
+				}).Return(nil)
+
+			// Act
+			resp, err := testService.Create(context.Background(), req)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, v.roles, createdSeminar.ProductRoles())
+			if assert.Len(t, createdProducts, len(v.roles)) {
+				for i, role := range v.roles {
+					assert.Equal(t, prices[role], createdProducts[i].Price)
+					assert.Equal(t, createdProducts[i].ID, *createdSeminar.ProductID(role))
+				}
+			}
+			assert.Equal(t, len(v.roles) == 5, resp.LateSurchargeProductID != "")
+		})
+
+		t.Run(v.name+" get", func(t *testing.T) {
+			// Arrange
+			seminarID := uuid.New().String()
+			mockSeminar := newTestSeminar(seminarID, v.roles...)
+			mockSeminar.LatePaymentDate = date
+			products := make([]product.Product, 0, len(v.roles))
+			for _, role := range v.roles {
+				products = append(products, product.Product{ID: *mockSeminar.ProductID(role), Price: prices[role]})
+			}
+
+			mockSeminarRepo.EXPECT().Get(gomock.Any(), seminarID).Return(mockSeminar, nil)
+			mockProductRepo.EXPECT().SelectByIDs(gomock.Any(), mockSeminar.ProductIDs(), "id", "price", "available_from", "available_until").Return(products, nil)
+
+			// Act
+			details, err := testService.Get(context.Background(), seminarID, true)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, prices[seminar.RoleEarly], details.CurrentPrice)
+			assert.Equal(t, *mockSeminar.EarlyProductID, details.CurrentPriceProductID)
+			if len(v.roles) == 5 {
+				assert.Equal(t, prices[seminar.RoleEarlySurcharge], details.CurrentSurchargePrice)
+			} else {
+				assert.Zero(t, details.EarlySurchargePrice)
+				assert.Zero(t, details.CurrentSurchargePrice)
+				assert.Empty(t, details.CurrentSurchargePriceProductID)
+			}
+		})
+
+		t.Run(v.name+" publish", func(t *testing.T) {
+			// Arrange
+			seminarID := uuid.New().String()
+			mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+			mockTxProductRepo := productmock.NewMockRepository(ctrl)
+			mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+			testSeminar := newTestSeminar(seminarID, v.roles...)
+			mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+			mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id", "price", "is_free", "in_stock").
+				Return(newTestSeminarProducts(testSeminar), nil)
+			mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), seminarID, true).Return(database.SetResult{Affected: 1}, nil)
+			mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), seminarID, gomock.Any()).Return(int64(len(v.roles)), nil)
+
+			// Act
+			err := testService.Publish(context.Background(), seminarID)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+
+		t.Run(v.name+" delete with missing product", func(t *testing.T) {
+			// Arrange
+			seminarID := uuid.New().String()
+			mockTxSeminarRepo := seminarmock.NewMockRepository(ctrl)
+			mockTxProductRepo := productmock.NewMockRepository(ctrl)
+			mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxSeminarRepo)
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+			testSeminar := newTestSeminar(seminarID, v.roles...)
+			mockTxSeminarRepo.EXPECT().GetWithUnpublished(gomock.Any(), seminarID).Return(testSeminar, nil)
+			mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{seminarID}, "id").
+				Return(newTestSeminarProducts(testSeminar)[1:], nil)
+			mockTxSeminarRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+			// Act
+			err := testService.Delete(context.Background(), seminarID, "")
+
+			// Assert
+			assert.Error(t, err)
+			assert.NotErrorIs(t, err, ErrNotFound)
+		})
+	}
+
+	t.Run("invalid roles", func(t *testing.T) {
+		base := seminar.CreateRequest{
+			Name:             "Seminar name",
+			ShortDescription: "Seminar short description",
+			ReservationPrice: 11.11,
+			EarlyPrice:       12.22,
+			LatePrice:        13.33,
+			Date:             date,
+			EndingDate:       date.Add(48 * time.Hour),
+			LatePaymentDate:  date.Add(-7 * 24 * time.Hour),
+			Place:            "Seminar place",
+		}
+		missingRequired := base
+		missingRequired.Roles = []seminar.ProductRole{seminar.RoleReservation, seminar.RoleEarly}
+		unknown := base
+		unknown.Roles = []seminar.ProductRole{seminar.RoleReservation, seminar.RoleEarly, seminar.RoleLate, "vip"}
+		priceWithoutRole := base
+		priceWithoutRole.Roles = []seminar.ProductRole{seminar.RoleReservation, seminar.RoleEarly, seminar.RoleLate}
+		priceWithoutRole.LateSurchargePrice = 15.55
+
+		for _, req := range []seminar.CreateRequest{missingRequired, unknown, priceWithoutRole} {
+			// Arrange
+			mockSeminarRepo.EXPECT().WithTx(gomock.Any()).Return(seminarmock.NewMockRepository(ctrl))
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(productmock.NewMockRepository(ctrl))
+
+			// Act
+			_, err := testService.Create(context.Background(), &req)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+		}
+	})
+}
+
+func TestService_VerifyIntegrity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockSeminarRepo := seminarmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockSeminarRepo, mockProductRepo, nil, slog.New(slog.DiscardHandler))
+
+	var products []product.Product
+	// newSeminar returns a seminar with products for all roles, each owned by the seminar.
+	newSeminar := func() seminar.Seminar {
+		s := seminar.Seminar{ID: uuid.New().String()}
+		ids := make([]string, len(seminar.AllProductRoles))
+		for i := range ids {
+			ids[i] = uuid.New().String()
+			products = append(products, product.Product{ID: ids[i], DetailsID: s.ID, DetailsType: "seminar"})
+		}
+		s.ReservationProductID, s.EarlyProductID, s.LateProductID = &ids[0], &ids[1], &ids[2]
+		s.EarlySurchargeProductID, s.LateSurchargeProductID = &ids[3], &ids[4]
+		return s
+	}
+
+	healthy := newSeminar()
+	nilPointer := newSeminar()
+	nilPointer.EarlyProductID = nil
+	wrongType := newSeminar()
+	wrongTypeProductID := uuid.New().String()
+	wrongType.LateProductID = &wrongTypeProductID
+	products = append(products, product.Product{ID: wrongTypeProductID, DetailsID: uuid.New().String(), DetailsType: "course"})
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		seminars := []seminar.Seminar{healthy, nilPointer, wrongType}
+		mockSeminarRepo.EXPECT().ListWithUnpublished(gomock.Any(), 10, 0).Return(seminars, nil)
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), gomock.Any(), "id", "details_id", "details_type").
+			Return(products, nil)
+
+		// Act
+		issues, err := testService.VerifyIntegrity(context.Background(), 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []seminar.IntegrityIssue{
+			{SeminarID: nilPointer.ID, Role: seminar.RoleEarly, Problem: seminar.ProblemNilReference},
+			{SeminarID: wrongType.ID, Role: seminar.RoleLate, ProductID: &wrongTypeProductID, Problem: seminar.ProblemWrongType},
+		}, issues)
+	})
+
+	t.Run("healthy", func(t *testing.T) {
+		// Arrange
+		mockSeminarRepo.EXPECT().ListWithUnpublished(gomock.Any(), 10, 0).Return([]seminar.Seminar{healthy}, nil)
+		mockProductRepo.EXPECT().
+			SelectWithUnpublishedByIDs(gomock.Any(), healthy.ProductIDs(), "id", "details_id", "details_type").
+			Return(products, nil)
+
+		// Act
+		issues, err := testService.VerifyIntegrity(context.Background(), 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, issues)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		// Act
+		issues, err := testService.VerifyIntegrity(context.Background(), 0, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Nil(t, issues)
 	})
 }
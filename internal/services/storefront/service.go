@@ -0,0 +1,231 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package storefront provides service-layer business logic for the storefront feed,
+// which aggregates published items of every product type.
+package storefront
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/mikhail5545/product-service-go/internal/database"
+	storefrontmodel "github.com/mikhail5545/product-service-go/internal/models/storefront"
+	courseservice "github.com/mikhail5545/product-service-go/internal/services/course"
+	physicalgoodservice "github.com/mikhail5545/product-service-go/internal/services/physical_good"
+	seminarservice "github.com/mikhail5545/product-service-go/internal/services/seminar"
+	trainingsessionservice "github.com/mikhail5545/product-service-go/internal/services/training_session"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
+	"golang.org/x/sync/errgroup"
+)
+
+//go:generate mockgen -destination=../../test/services/storefront_mock/service_mock.go -package=storefront_mock . Service
+
+// Service provides service-layer business logic for the storefront feed.
+type Service interface {
+	// Feed fans out to every product-type service and returns a page of their published items merged
+	// into a single stable order: newest first by created_at, ties broken by descending id.
+	// At most [Config.MaxConcurrency] sub-queries run at once. Sub-queries that haven't started yet
+	// are skipped once ctx is cancelled or another sub-query fails.
+	//
+	// Returns a slice of items and the total count of published items of all types.
+	// Returns an error if the limit is not positive or the offset is negative (ErrInvalidArgument),
+	// or a database/internal error occurs.
+	Feed(ctx context.Context, limit, offset int) ([]storefrontmodel.Item, int64, error)
+}
+
+// Config configures the storefront fan-out.
+type Config struct {
+	// MaxConcurrency caps the number of product-type sub-queries running at once,
+	// and so the number of database connections a single feed request holds.
+	MaxConcurrency int
+}
+
+// DefaultConfig is used for values missing from the environment.
+var DefaultConfig = Config{MaxConcurrency: 2}
+
+// ConfigFromEnv reads the STOREFRONT_MAX_CONCURRENCY environment variable.
+// Missing or non-positive values fall back to [DefaultConfig].
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig
+	if n, err := strconv.Atoi(os.Getenv("STOREFRONT_MAX_CONCURRENCY")); err == nil && n > 0 {
+		cfg.MaxConcurrency = n
+	}
+	return cfg
+}
+
+// source lists published items of a single product type.
+type source struct {
+	detailsType string
+	list        func(ctx context.Context, limit, offset int) ([]storefrontmodel.Item, int64, error)
+}
+
+// service provides service-layer business logic for the storefront feed.
+// It holds the product-type services it fans out to.
+type service struct {
+	sources        []source
+	maxConcurrency int
+}
+
+// New creates a new service instance with provided config and product-type services.
+func New(
+	cfg Config,
+	courseService courseservice.Service,
+	seminarService seminarservice.Service,
+	tsService trainingsessionservice.Service,
+	phgService physicalgoodservice.Service,
+) Service {
+	if cfg.MaxConcurrency < 1 {
+		cfg.MaxConcurrency = DefaultConfig.MaxConcurrency
+	}
+	return &service{
+		maxConcurrency: cfg.MaxConcurrency,
+		sources: []source{
+			{detailsType: "course", list: func(ctx context.Context, limit, offset int) ([]storefrontmodel.Item, int64, error) {
+				courses, total, err := courseService.List(ctx, limit, offset)
+				items := make([]storefrontmodel.Item, 0, len(courses))
+				for _, c := range courses {
+					items = append(items, storefrontmodel.Item{ID: c.ID, Type: "course", Name: c.Name, Price: c.Price, ProductID: c.ProductID, CreatedAt: c.CreatedAt})
+				}
+				return items, total, err
+			}},
+			{detailsType: "seminar", list: func(ctx context.Context, limit, offset int) ([]storefrontmodel.Item, int64, error) {
+				seminars, total, err := seminarService.List(ctx, limit, offset)
+				items := make([]storefrontmodel.Item, 0, len(seminars))
+				for _, s := range seminars {
+					items = append(items, storefrontmodel.Item{ID: s.ID, Type: "seminar", Name: s.Name, Price: s.CurrentPrice, ProductID: s.CurrentPriceProductID, CreatedAt: s.CreatedAt})
+				}
+				return items, total, err
+			}},
+			{detailsType: "training_session", list: func(ctx context.Context, limit, offset int) ([]storefrontmodel.Item, int64, error) {
+				sessions, total, err := tsService.List(ctx, limit, offset)
+				items := make([]storefrontmodel.Item, 0, len(sessions))
+				for _, ts := range sessions {
+					items = append(items, storefrontmodel.Item{ID: ts.ID, Type: "training_session", Name: ts.Name, Price: ts.Price, ProductID: ts.ProductID, CreatedAt: ts.CreatedAt})
+				}
+				return items, total, err
+			}},
+			{detailsType: "physical_good", list: func(ctx context.Context, limit, offset int) ([]storefrontmodel.Item, int64, error) {
+				goods, total, err := phgService.List(ctx, limit, offset)
+				items := make([]storefrontmodel.Item, 0, len(goods))
+				for _, g := range goods {
+					items = append(items, storefrontmodel.Item{ID: g.ID, Type: "physical_good", Name: g.Name, Price: g.Price, ProductID: g.ProductID, CreatedAt: g.CreatedAt})
+				}
+				return items, total, err
+			}},
+		},
+	}
+}
+
+// Feed fans out to every product-type service and returns a page of their published items merged
+// into a single stable order: newest first by created_at, ties broken by descending id.
+// At most [Config.MaxConcurrency] sub-queries run at once. Sub-queries that haven't started yet
+// are skipped once ctx is cancelled or another sub-query fails.
+//
+// Returns a slice of items and the total count of published items of all types.
+// Returns an error if the limit is not positive or the offset is negative (ErrInvalidArgument),
+// or a database/internal error occurs.
+func (s *service) Feed(ctx context.Context, limit, offset int) ([]storefrontmodel.Item, int64, error) {
+	ctx, span := tracing.Start(ctx, "storefront", "Feed")
+	defer span.End()
+
+	if limit < 1 {
+		return nil, 0, fmt.Errorf("%w: limit must be positive", ErrInvalidArgument)
+	}
+	if offset < 0 {
+		return nil, 0, fmt.Errorf("%w: offset must not be negative", ErrInvalidArgument)
+	}
+	// Any item of the requested page is among the first offset+limit items of its own type,
+	// so that is all each sub-query has to fetch. The sources are asked for the merge order explicitly,
+	// DB_DEFAULT_SORT would otherwise change which items that are.
+	ctx = database.WithSort(ctx, database.DefaultSortOrder)
+	fetch := offset + limit
+	results := make([][]storefrontmodel.Item, len(s.sources))
+	totals := make([]int64, len(s.sources))
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(s.maxConcurrency)
+	for i, src := range s.sources {
+		// Go blocks while all slots are taken.
+		g.Go(func() error {
+			if err := gctx.Err(); err != nil {
+				return err
+			}
+			items, total, err := collect(gctx, src, fetch)
+			if err != nil {
+				return fmt.Errorf("failed to list %s items: %w", src.detailsType, err)
+			}
+			results[i], totals[i] = items, total
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, 0, err
+	}
+
+	var total int64
+	for _, t := range totals {
+		total += t
+	}
+	return merge(results, limit, offset), total, nil
+}
+
+// collect lists the first n items of src. A source may return fewer items than asked for while more exist,
+// seminar List drops seminars with missing products, so collect keeps listing the following rows until it has
+// n items or has gone past the source total.
+func collect(ctx context.Context, src source, n int) ([]storefrontmodel.Item, int64, error) {
+	items, total, err := src.list(ctx, n, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+	for offset := n; len(items) < n && int64(offset) < total; {
+		limit := n - len(items)
+		page, _, err := src.list(ctx, limit, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		items = append(items, page...)
+		offset += limit
+	}
+	return items, total, nil
+}
+
+// merge combines per-type item lists and returns the page [offset, offset+limit) of the union
+// ordered by created_at descending. Items created at the same moment are ordered by descending id,
+// which is unique across types, so the order is total and every item lands on exactly one page.
+//
+// Sub-queries are expected to return the newest items of their type first, Feed requests
+// [database.DefaultSortOrder] from the repositories for that. The order they return within that set doesn't matter.
+func merge(results [][]storefrontmodel.Item, limit, offset int) []storefrontmodel.Item {
+	var all []storefrontmodel.Item
+	for _, items := range results {
+		all = append(all, items...)
+	}
+	slices.SortFunc(all, func(a, b storefrontmodel.Item) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(b.ID, a.ID)
+	})
+	if offset >= len(all) {
+		return []storefrontmodel.Item{}
+	}
+	return all[offset:min(offset+limit, len(all))]
+}
@@ -0,0 +1,351 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package storefront
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mikhail5545/product-service-go/internal/database"
+	"github.com/mikhail5545/product-service-go/internal/models/course"
+	physicalgood "github.com/mikhail5545/product-service-go/internal/models/physical_good"
+	"github.com/mikhail5545/product-service-go/internal/models/seminar"
+	storefrontmodel "github.com/mikhail5545/product-service-go/internal/models/storefront"
+	trainingsession "github.com/mikhail5545/product-service-go/internal/models/training_session"
+	coursemock "github.com/mikhail5545/product-service-go/internal/test/services/course_mock"
+	physicalgoodmock "github.com/mikhail5545/product-service-go/internal/test/services/physical_good_mock"
+	seminarmock "github.com/mikhail5545/product-service-go/internal/test/services/seminar_mock"
+	trainingsessionmock "github.com/mikhail5545/product-service-go/internal/test/services/training_session_mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+// probe records how many sub-queries run at the same time.
+type probe struct {
+	inFlight atomic.Int32
+	max      atomic.Int32
+}
+
+func (p *probe) enter() {
+	n := p.inFlight.Add(1)
+	for {
+		m := p.max.Load()
+		if n <= m || p.max.CompareAndSwap(m, n) {
+			return
+		}
+	}
+}
+
+func (p *probe) leave() { p.inFlight.Add(-1) }
+
+type mocks struct {
+	course   *coursemock.MockService
+	seminar  *seminarmock.MockService
+	session  *trainingsessionmock.MockService
+	physical *physicalgoodmock.MockService
+}
+
+func newMocks(ctrl *gomock.Controller) mocks {
+	return mocks{
+		course:   coursemock.NewMockService(ctrl),
+		seminar:  seminarmock.NewMockService(ctrl),
+		session:  trainingsessionmock.NewMockService(ctrl),
+		physical: physicalgoodmock.NewMockService(ctrl),
+	}
+}
+
+// expectInstrumented makes every product-type service report one item after running wait.
+func (m mocks) expectInstrumented(p *probe, wait func()) {
+	m.course.EXPECT().List(gomock.Any(), 10, 0).DoAndReturn(func(context.Context, int, int) ([]course.CourseDetails, int64, error) {
+		p.enter()
+		defer p.leave()
+		wait()
+		return []course.CourseDetails{{Course: &course.Course{ID: "course"}}}, 1, nil
+	})
+	m.seminar.EXPECT().List(gomock.Any(), 10, 0).DoAndReturn(func(context.Context, int, int) ([]seminar.SeminarDetails, int64, error) {
+		p.enter()
+		defer p.leave()
+		wait()
+		return []seminar.SeminarDetails{{Seminar: &seminar.Seminar{ID: "seminar"}}}, 1, nil
+	})
+	m.session.EXPECT().List(gomock.Any(), 10, 0).DoAndReturn(func(context.Context, int, int) ([]trainingsession.TrainingSessionDetails, int64, error) {
+		p.enter()
+		defer p.leave()
+		wait()
+		return []trainingsession.TrainingSessionDetails{{TrainingSession: &trainingsession.TrainingSession{ID: "session"}}}, 1, nil
+	})
+	m.physical.EXPECT().List(gomock.Any(), 10, 0).DoAndReturn(func(context.Context, int, int) ([]physicalgood.PhysicalGoodDetails, int64, error) {
+		p.enter()
+		defer p.leave()
+		wait()
+		return []physicalgood.PhysicalGoodDetails{{PhysicalGood: &physicalgood.PhysicalGood{ID: "physical"}}}, 1, nil
+	})
+}
+
+func TestService_Feed(t *testing.T) {
+	t.Run("concurrency cap of 1 runs sub-queries serially", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		m := newMocks(ctrl)
+		testService := New(Config{MaxConcurrency: 1}, m.course, m.seminar, m.session, m.physical)
+
+		var p probe
+		m.expectInstrumented(&p, func() { time.Sleep(10 * time.Millisecond) })
+
+		// Act
+		items, _, err := testService.Feed(context.Background(), 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, items, 4)
+		assert.Equal(t, int32(1), p.max.Load())
+	})
+
+	t.Run("higher cap runs sub-queries in parallel", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		m := newMocks(ctrl)
+		testService := New(Config{MaxConcurrency: 4}, m.course, m.seminar, m.session, m.physical)
+
+		// Every sub-query waits for all four to have started, which only happens if they run in parallel.
+		var arrived sync.WaitGroup
+		arrived.Add(4)
+		allArrived := make(chan struct{})
+		go func() {
+			arrived.Wait()
+			close(allArrived)
+		}()
+		var p probe
+		m.expectInstrumented(&p, func() {
+			arrived.Done()
+			select {
+			case <-allArrived:
+			case <-time.After(time.Second):
+			}
+		})
+
+		// Act
+		items, _, err := testService.Feed(context.Background(), 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, items, 4)
+		assert.Equal(t, int32(4), p.max.Load())
+	})
+
+	t.Run("failed sub-query stops pending ones", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		m := newMocks(ctrl)
+		testService := New(Config{MaxConcurrency: 1}, m.course, m.seminar, m.session, m.physical)
+
+		// With a single slot the remaining services must never be called.
+		m.course.EXPECT().List(gomock.Any(), 10, 0).Return(nil, int64(0), errors.New("database error"))
+
+		// Act
+		items, _, err := testService.Feed(context.Background(), 10, 0)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Nil(t, items)
+	})
+
+	t.Run("cancelled context stops pending sub-queries", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		m := newMocks(ctrl)
+		testService := New(Config{MaxConcurrency: 1}, m.course, m.seminar, m.session, m.physical)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		m.course.EXPECT().List(gomock.Any(), 10, 0).DoAndReturn(func(context.Context, int, int) ([]course.CourseDetails, int64, error) {
+			cancel()
+			return []course.CourseDetails{}, 0, nil
+		})
+
+		// Act
+		_, _, err := testService.Feed(ctx, 10, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		// Arrange
+		ctrl := gomock.NewController(t)
+		m := newMocks(ctrl)
+		testService := New(Config{MaxConcurrency: 1}, m.course, m.seminar, m.session, m.physical)
+
+		// Act
+		_, _, err := testService.Feed(context.Background(), 0, 0)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_Feed_Order(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newMocks(ctrl)
+	testService := New(Config{MaxConcurrency: 2}, m.course, m.seminar, m.session, m.physical)
+
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return base.Add(time.Duration(minutes) * time.Minute) }
+
+	// Each type is returned newest first, like the repositories do. Types interleave by timestamp,
+	// and c-2 and s-2 share a timestamp so the id breaks the tie.
+	courses := []course.CourseDetails{
+		{Course: &course.Course{ID: "c-3", CreatedAt: at(50)}},
+		{Course: &course.Course{ID: "c-2", CreatedAt: at(30)}},
+		{Course: &course.Course{ID: "c-1", CreatedAt: at(5)}},
+	}
+	seminars := []seminar.SeminarDetails{
+		{Seminar: &seminar.Seminar{ID: "s-2"}, CreatedAt: at(30)},
+		{Seminar: &seminar.Seminar{ID: "s-1"}, CreatedAt: at(10)},
+	}
+	sessions := []trainingsession.TrainingSessionDetails{
+		{TrainingSession: &trainingsession.TrainingSession{ID: "t-1", CreatedAt: at(40)}},
+	}
+	goods := []physicalgood.PhysicalGoodDetails{
+		{PhysicalGood: &physicalgood.PhysicalGood{ID: "p-2", CreatedAt: at(60)}},
+		{PhysicalGood: &physicalgood.PhysicalGood{ID: "p-1", CreatedAt: at(20)}},
+	}
+	expected := []string{"p-2", "c-3", "t-1", "s-2", "c-2", "p-1", "s-1", "c-1"}
+
+	m.course.EXPECT().List(gomock.Any(), gomock.Any(), 0).DoAndReturn(func(_ context.Context, limit, _ int) ([]course.CourseDetails, int64, error) {
+		return courses[:min(limit, len(courses))], int64(len(courses)), nil
+	}).AnyTimes()
+	m.seminar.EXPECT().List(gomock.Any(), gomock.Any(), 0).DoAndReturn(func(_ context.Context, limit, _ int) ([]seminar.SeminarDetails, int64, error) {
+		return seminars[:min(limit, len(seminars))], int64(len(seminars)), nil
+	}).AnyTimes()
+	m.session.EXPECT().List(gomock.Any(), gomock.Any(), 0).DoAndReturn(func(_ context.Context, limit, _ int) ([]trainingsession.TrainingSessionDetails, int64, error) {
+		return sessions[:min(limit, len(sessions))], int64(len(sessions)), nil
+	}).AnyTimes()
+	m.physical.EXPECT().List(gomock.Any(), gomock.Any(), 0).DoAndReturn(func(_ context.Context, limit, _ int) ([]physicalgood.PhysicalGoodDetails, int64, error) {
+		return goods[:min(limit, len(goods))], int64(len(goods)), nil
+	}).AnyTimes()
+
+	ids := func(items []storefrontmodel.Item) []string {
+		out := make([]string, 0, len(items))
+		for _, item := range items {
+			out = append(out, item.ID)
+		}
+		return out
+	}
+
+	t.Run("merged order", func(t *testing.T) {
+		// Act
+		items, total, err := testService.Feed(context.Background(), 10, 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(len(expected)), total)
+		assert.Equal(t, expected, ids(items))
+	})
+
+	t.Run("paginating yields every item once", func(t *testing.T) {
+		// Act
+		var paged []string
+		for offset := 0; ; offset += 3 {
+			items, _, err := testService.Feed(context.Background(), 3, offset)
+			assert.NoError(t, err)
+			if len(items) == 0 {
+				break
+			}
+			paged = append(paged, ids(items)...)
+		}
+
+		// Assert
+		assert.Equal(t, expected, paged)
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		// Act
+		_, _, err := testService.Feed(context.Background(), 3, -1)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+	})
+}
+
+func TestService_Feed_Sources(t *testing.T) {
+	t.Cleanup(func() { _ = database.SetDefaultSort("") })
+	assert.NoError(t, database.SetDefaultSort("name asc"))
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	m := newMocks(ctrl)
+	testService := New(Config{MaxConcurrency: 1}, m.course, m.seminar, m.session, m.physical)
+
+	base := time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+	// Rows of the seminar table in merge order, s-3 has missing products and is dropped by List.
+	rows := []seminar.SeminarDetails{
+		{Seminar: &seminar.Seminar{ID: "s-4"}, CreatedAt: base.Add(4 * time.Minute)},
+		{Seminar: &seminar.Seminar{ID: "s-3"}, CreatedAt: base.Add(3 * time.Minute)},
+		{Seminar: &seminar.Seminar{ID: "s-2"}, CreatedAt: base.Add(2 * time.Minute)},
+		{Seminar: &seminar.Seminar{ID: "s-1"}, CreatedAt: base.Add(time.Minute)},
+	}
+	var sorts []string
+	m.seminar.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, limit, offset int) ([]seminar.SeminarDetails, int64, error) {
+		var rec []struct{ ID string }
+		db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{DryRun: true})
+		assert.NoError(t, err)
+		sorts = append(sorts, db.WithContext(ctx).Table("seminars").Scopes(database.SortDefault).Find(&rec).Statement.SQL.String())
+
+		var out []seminar.SeminarDetails
+		for _, row := range rows[min(offset, len(rows)):min(offset+limit, len(rows))] {
+			if row.ID != "s-3" {
+				out = append(out, row)
+			}
+		}
+		return out, int64(len(rows)), nil
+	}).AnyTimes()
+	m.course.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, int64(0), nil).AnyTimes()
+	m.session.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, int64(0), nil).AnyTimes()
+	m.physical.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, int64(0), nil).AnyTimes()
+
+	// Act
+	items, _, err := testService.Feed(context.Background(), 2, 1)
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, items, 2) {
+		assert.Equal(t, "s-2", items[0].ID)
+		assert.Equal(t, "s-1", items[1].ID)
+	}
+	assert.Len(t, sorts, 2, "dropped row is topped up with a second page")
+	for _, sql := range sorts {
+		assert.Contains(t, sql, "ORDER BY "+database.DefaultSortOrder)
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("STOREFRONT_MAX_CONCURRENCY", "3")
+	assert.Equal(t, 3, ConfigFromEnv().MaxConcurrency)
+
+	t.Setenv("STOREFRONT_MAX_CONCURRENCY", "0")
+	assert.Equal(t, DefaultConfig, ConfigFromEnv())
+}
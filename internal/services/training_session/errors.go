@@ -29,4 +29,8 @@ var (
 	ErrImageLimitExceeded = errors.New("maximum number of uploaded images is 5 per item")
 	// ErrImageNotFoundOnOwner can't find image on training session error
 	ErrImageNotFoundOnOwner = errors.New("image not found on training session")
+	// ErrHasDependents training session products are referenced by other records (e.g. featured list) error
+	ErrHasDependents = errors.New("training session products are referenced by other records")
+	// ErrCannotPublishFree training session product priced at zero or below and not marked free error
+	ErrCannotPublishFree = errors.New("cannot publish training session with a zero price product that is not marked free")
 )
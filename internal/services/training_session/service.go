@@ -22,12 +22,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	featuredrepo "github.com/mikhail5545/product-service-go/internal/database/featured"
 	productrepo "github.com/mikhail5545/product-service-go/internal/database/product"
 	trainingsessionrepo "github.com/mikhail5545/product-service-go/internal/database/training_session"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	productmodel "github.com/mikhail5545/product-service-go/internal/models/product"
 	trainingsessionmodel "github.com/mikhail5545/product-service-go/internal/models/training_session"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -36,12 +43,13 @@ import (
 // Service provides service-layer business logic for training session models.
 type Service interface {
 	// Get retrieves a single published and not soft-deleted training session record from the database,
-	// along with its associated product details (price and product ID).
+	// along with its associated product details (price and product ID) if includeProducts is true.
+	// Otherwise the product is not queried and the details have ProductsOmitted set.
 	//
 	// Returns a TrainingSessionDetails struct containing the combined information.
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 	// or a database/internal error occurs.
-	Get(ctx context.Context, id string) (*trainingsessionmodel.TrainingSessionDetails, error)
+	Get(ctx context.Context, id string, includeProducts bool) (*trainingsessionmodel.TrainingSessionDetails, error)
 	// GetWithDeleted retrieves a single training session record from the database, including soft-deleted ones,
 	// along with its associated product details (price and product ID).
 	//
@@ -85,13 +93,15 @@ type Service interface {
 	// making it available in the catalog.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
+	// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+	// Publishing an already published training session is a no-op.
 	Publish(ctx context.Context, id string) error
 	// Unpublish sets the `InStock` field to false for a training session and its associated product,
 	// archiving it from the catalog.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
+	// Unpublishing an already unpublished training session is a no-op.
 	Unpublish(ctx context.Context, id string) error
 	// Update performs a partial update of a training session and its related product.
 	// The request should contain the training session's ID and the fields to be updated.
@@ -104,15 +114,42 @@ type Service interface {
 	Update(ctx context.Context, req *trainingsessionmodel.UpdateRequest) (map[string]any, error)
 	// Delete performs a soft-delete of a training session and its related product record.
 	// It also unpublishes both records, meaning they must be manually published again after restoration.
+	// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 	//
-	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
+	// or a database/internal error occurs.
+	Delete(ctx context.Context, id, reason string) error
+	// DeleteBatch performs a soft-delete of multiple training sessions and their related product records
+	// in a single transaction, applying the same guards as Delete to each record.
+	// All IDs are validated before anything is deleted. Duplicate IDs are deleted once.
+	// If any record fails, the whole batch is rolled back.
+	//
+	// Returns the number of deleted training sessions.
+	// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
 	// or a database/internal error occurs.
-	Delete(ctx context.Context, id string) error
+	DeleteBatch(ctx context.Context, ids []string) (int64, error)
 	// DeletePermanent performs a complete delete of a training session and its related product record.
+	// If force is true, the related product count is not checked and whatever records exist are deleted.
+	// This is meant for cleaning up inconsistent data.
+	// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
 	//
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-	// or a database/internal error occurs.
-	DeletePermanent(ctx context.Context, id string) error
+	// the products are featured (ErrHasDependents), or a database/internal error occurs.
+	DeletePermanent(ctx context.Context, id string, force bool) error
+	// PurgeDeletedBefore permanently deletes all training sessions soft-deleted before the given time,
+	// together with their product records.
+	// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+	// is set, in which case they are removed in the same transaction.
+	//
+	// Returns the number of purged training sessions.
+	// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+	PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error)
+	// CountDeletedBefore counts training sessions soft-deleted before cutoff, that is the training sessions
+	// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+	//
+	// Returns an error if a database/internal error occurs.
+	CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error)
 	// Restore performs a restore of a training session and its related product record.
 	// Training session and its related product record are not being published. This should be
 	// done manually.
@@ -120,6 +157,15 @@ type Service interface {
 	// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 	// or a database/internal error occurs.
 	Restore(ctx context.Context, id string) error
+	// RestoreBatch restores multiple soft-deleted training sessions and their related product records
+	// in a single transaction, applying the same guards as Restore to each record.
+	// All IDs are validated before anything is restored. Duplicate IDs are restored once.
+	// If any record fails, the whole batch is rolled back.
+	//
+	// Returns the number of restored training sessions.
+	// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+	// or a database/internal error occurs.
+	RestoreBatch(ctx context.Context, ids []string) (int64, error)
 }
 
 // service provides service-layer business logic for training session models.
@@ -128,23 +174,32 @@ type Service interface {
 type service struct {
 	TrainingSessionRepo trainingsessionrepo.Repository
 	ProductRepo         productrepo.Repository
+	FeaturedRepo        featuredrepo.Repository
+	// now is the clock used to evaluate product sale windows, replaceable in tests.
+	now func() time.Time
 }
 
-// New creates a new service instance with provided training session and product repositories.
-func New(tsr trainingsessionrepo.Repository, pr productrepo.Repository) Service {
+// New creates a new service instance with provided training session, product and featured list repositories.
+func New(tsr trainingsessionrepo.Repository, pr productrepo.Repository, fr featuredrepo.Repository) Service {
 	return &service{
 		TrainingSessionRepo: tsr,
 		ProductRepo:         pr,
+		FeaturedRepo:        fr,
+		now:                 time.Now,
 	}
 }
 
 // Get retrieves a single published and not soft-deleted training session record from the database,
-// along with its associated product details (price and product ID).
+// along with its associated product details (price and product ID) if includeProducts is true.
+// Otherwise the product is not queried and the details have ProductsOmitted set.
 //
 // Returns a TrainingSessionDetails struct containing the combined information.
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
-func (s *service) Get(ctx context.Context, id string) (*trainingsessionmodel.TrainingSessionDetails, error) {
+func (s *service) Get(ctx context.Context, id string, includeProducts bool) (*trainingsessionmodel.TrainingSessionDetails, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "Get", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -155,7 +210,13 @@ func (s *service) Get(ctx context.Context, id string) (*trainingsessionmodel.Tra
 		}
 		return nil, fmt.Errorf("failed to get training session: %w", err)
 	}
-	product, err := s.ProductRepo.SelectByDetailsID(ctx, trainingSession.ID, "id", "price")
+	if !includeProducts {
+		return &trainingsessionmodel.TrainingSessionDetails{
+			TrainingSession: trainingSession,
+			ProductsOmitted: true,
+		}, nil
+	}
+	product, err := s.ProductRepo.SelectByDetailsID(ctx, trainingSession.ID, "id", "price", "available_from", "available_until")
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
@@ -164,6 +225,7 @@ func (s *service) Get(ctx context.Context, id string) (*trainingsessionmodel.Tra
 	}
 	return &trainingsessionmodel.TrainingSessionDetails{
 		TrainingSession: trainingSession,
+		Available:       product.IsAvailableAt(s.now()),
 		Price:           product.Price,
 		ProductID:       product.ID,
 	}, nil
@@ -176,6 +238,9 @@ func (s *service) Get(ctx context.Context, id string) (*trainingsessionmodel.Tra
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithDeleted(ctx context.Context, id string) (*trainingsessionmodel.TrainingSessionDetails, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "GetWithDeleted", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -186,7 +251,7 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*trainingsessi
 		}
 		return nil, fmt.Errorf("failed to get training session: %w", err)
 	}
-	product, err := s.ProductRepo.SelectWithDeletedByDetailsID(ctx, trainingSession.ID, "id", "price")
+	product, err := s.ProductRepo.SelectWithDeletedByDetailsID(ctx, trainingSession.ID, "id", "price", "available_from", "available_until")
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
@@ -194,9 +259,12 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*trainingsessi
 		return nil, fmt.Errorf("failed to get training session product: %w", err)
 	}
 	return &trainingsessionmodel.TrainingSessionDetails{
-		TrainingSession: trainingSession,
-		Price:           product.Price,
-		ProductID:       product.ID,
+		TrainingSession:  trainingSession,
+		Available:        product.IsAvailableAt(s.now()),
+		Price:            product.Price,
+		ProductID:        product.ID,
+		FirstPublishedAt: product.FirstPublishedAt,
+		LastPublishedAt:  product.LastPublishedAt,
 	}, nil
 }
 
@@ -207,6 +275,9 @@ func (s *service) GetWithDeleted(ctx context.Context, id string) (*trainingsessi
 // Returns an error if the ID is invalid (ErrInvalidArgument), the record is not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) GetWithUnpublished(ctx context.Context, id string) (*trainingsessionmodel.TrainingSessionDetails, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "GetWithUnpublished", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -217,7 +288,7 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*trainings
 		}
 		return nil, fmt.Errorf("failed to get training session: %w", err)
 	}
-	product, err := s.ProductRepo.SelectWithUnpublishedByDetailsID(ctx, trainingSession.ID, "id", "price")
+	product, err := s.ProductRepo.SelectWithUnpublishedByDetailsID(ctx, trainingSession.ID, "id", "price", "available_from", "available_until")
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, fmt.Errorf("%w: %w", ErrNotFound, err)
@@ -225,9 +296,12 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*trainings
 		return nil, fmt.Errorf("failed to get training session product: %w", err)
 	}
 	return &trainingsessionmodel.TrainingSessionDetails{
-		TrainingSession: trainingSession,
-		Price:           product.Price,
-		ProductID:       product.ID,
+		TrainingSession:  trainingSession,
+		Available:        product.IsAvailableAt(s.now()),
+		Price:            product.Price,
+		ProductID:        product.ID,
+		FirstPublishedAt: product.FirstPublishedAt,
+		LastPublishedAt:  product.LastPublishedAt,
 	}, nil
 }
 
@@ -237,6 +311,9 @@ func (s *service) GetWithUnpublished(ctx context.Context, id string) (*trainings
 // Returns a slice of TrainingSessionDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) List(ctx context.Context, limit, offset int) ([]trainingsessionmodel.TrainingSessionDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "List")
+	defer span.End()
+
 	trainingSessions, err := s.TrainingSessionRepo.List(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get training sessions: %w", err)
@@ -250,7 +327,7 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]trainingsessio
 		tsIDs = append(tsIDs, trainingSessions[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectByDetailsIDs(ctx, tsIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectByDetailsIDs(ctx, tsIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get products: %w", err)
 	}
@@ -264,6 +341,7 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]trainingsessio
 	for _, p := range products {
 		allDetails = append(allDetails, trainingsessionmodel.TrainingSessionDetails{
 			TrainingSession: sessionMap[p.DetailsID],
+			Available:       p.IsAvailableAt(s.now()),
 			Price:           p.Price,
 			ProductID:       p.ID,
 		})
@@ -277,6 +355,9 @@ func (s *service) List(ctx context.Context, limit, offset int) ([]trainingsessio
 // Returns a slice of TrainingSessionDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]trainingsessionmodel.TrainingSessionDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "ListUnpublished")
+	defer span.End()
+
 	trainingSessions, err := s.TrainingSessionRepo.ListUnpublished(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get training sessions: %w", err)
@@ -294,7 +375,7 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]tra
 		tsIDs = append(tsIDs, trainingSessions[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, tsIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, tsIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get products: %w", err)
 	}
@@ -302,6 +383,7 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]tra
 	for _, p := range products {
 		allDetails = append(allDetails, trainingsessionmodel.TrainingSessionDetails{
 			TrainingSession: sessionMap[p.DetailsID],
+			Available:       p.IsAvailableAt(s.now()),
 			Price:           p.Price,
 			ProductID:       p.ID,
 		})
@@ -315,6 +397,9 @@ func (s *service) ListUnpublished(ctx context.Context, limit, offset int) ([]tra
 // Returns a slice of TrainingSessionDetails, the total count of such records, and an error if one occurs.
 // Returns an error if a database/internal error occurs.
 func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]trainingsessionmodel.TrainingSessionDetails, int64, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "ListDeleted")
+	defer span.End()
+
 	trainingSessions, err := s.TrainingSessionRepo.ListDeleted(ctx, limit, offset)
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get training sessions: %w", err)
@@ -332,7 +417,7 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]trainin
 		tsIDs = append(tsIDs, trainingSessions[i].ID)
 	}
 
-	products, err := s.ProductRepo.SelectWithDeletedByDetailsIDs(ctx, tsIDs, "id", "price", "details_id")
+	products, err := s.ProductRepo.SelectWithDeletedByDetailsIDs(ctx, tsIDs, "id", "price", "details_id", "available_from", "available_until")
 	if err != nil {
 		return nil, 0, fmt.Errorf("failed to get products: %w", err)
 	}
@@ -340,6 +425,7 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]trainin
 	for _, p := range products {
 		allDetails = append(allDetails, trainingsessionmodel.TrainingSessionDetails{
 			TrainingSession: sessionMap[p.DetailsID],
+			Available:       p.IsAvailableAt(s.now()),
 			Price:           p.Price,
 			ProductID:       p.ID,
 		})
@@ -354,6 +440,10 @@ func (s *service) ListDeleted(ctx context.Context, limit, offset int) ([]trainin
 // Returns a CreateResponse containing the newly created TrainingSessionID and ProductID.
 // Returns an error if the request payload is invalid (ErrInvalidArgument) or a database/internal error occurs.
 func (s *service) Create(ctx context.Context, req *trainingsessionmodel.CreateRequest) (*trainingsessionmodel.CreateResponse, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "Create")
+	defer span.End()
+
+	req.Sanitize()
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("%w, %w", ErrInvalidArgument, err)
 	}
@@ -404,25 +494,44 @@ func (s *service) Create(ctx context.Context, req *trainingsessionmodel.CreateRe
 // making it available in the catalog.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
+// a product is priced at zero or below and not marked free (ErrCannotPublishFree), or a database/internal error occurs.
+// Publishing an already published training session is a no-op.
 func (s *service) Publish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "training_session", "Publish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txTrainingSessionRepo := s.TrainingSessionRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txTrainingSessionRepo.SetInStock(ctx, id, true)
+		products, err := txProductRepo.SelectWithUnpublishedByDetailsIDs(ctx, []string{id}, "id", "price", "is_free", "in_stock")
 		if err != nil {
-			return fmt.Errorf("failed to publish training session: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+			return fmt.Errorf("failed to get training session product: %w", err)
 		}
-		ra, err = txProductRepo.SetInStockByDetailsID(ctx, id, true)
+		if len(products) == 0 {
+			return fmt.Errorf("%w: training session product", ErrNotFound)
+		}
+		productsPublished := true
+		for _, product := range products {
+			if !product.IsPublishable() {
+				return fmt.Errorf("%w: product %s", ErrCannotPublishFree, product.ID)
+			}
+			productsPublished = productsPublished && product.InStock
+		}
+		res, err := txTrainingSessionRepo.SetInStock(ctx, id, true)
 		if err != nil {
+			return fmt.Errorf("failed to publish training session: %w", err)
+		}
+		if res.NotFound() {
+			return fmt.Errorf("%w: training session", ErrNotFound)
+		}
+		if res.AlreadyInState && productsPublished {
+			return nil
+		}
+		if _, err := txProductRepo.PublishByDetailsID(ctx, id, s.now()); err != nil {
 			return fmt.Errorf("failed to publich training session product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
 		return nil
 	})
@@ -433,24 +542,30 @@ func (s *service) Publish(ctx context.Context, id string) error {
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
+// Unpublishing an already unpublished training session is a no-op.
 func (s *service) Unpublish(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "training_session", "Unpublish", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txTrainingSessionRepo := s.TrainingSessionRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txTrainingSessionRepo.SetInStock(ctx, id, false)
+		res, err := txTrainingSessionRepo.SetInStock(ctx, id, false)
 		if err != nil {
 			return fmt.Errorf("failed to unpublish training session: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
-		ra, err = txProductRepo.SetInStockByDetailsID(ctx, id, false)
+		if res.NotFound() {
+			return fmt.Errorf("%w: training session", ErrNotFound)
+		}
+		productRes, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now())
 		if err != nil {
 			return fmt.Errorf("failed to unpublich training session product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		}
+		if productRes.NotFound() {
+			return fmt.Errorf("%w: training session product", ErrNotFound)
 		}
 		return nil
 	})
@@ -465,6 +580,10 @@ func (s *service) Unpublish(ctx context.Context, id string) error {
 // Returns an error if the request payload is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Update(ctx context.Context, req *trainingsessionmodel.UpdateRequest) (map[string]any, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "Update", tracing.ID(req.ID))
+	defer span.End()
+
+	req.Sanitize()
 	if err := req.Validate(); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -509,8 +628,8 @@ func (s *service) Update(ctx context.Context, req *trainingsessionmodel.UpdateRe
 		if req.Price != nil && *req.Price != product.Price {
 			productUpdates["price"] = *req.Price
 		}
-		if len(req.Tags) > 0 {
-			tsUpdates["tags"] = req.Tags
+		if tags := req.TagsUpdate(); tags != nil && !slices.Equal(tags, ts.Tags) {
+			tsUpdates["tags"] = tags
 		}
 
 		if len(productUpdates) > 0 {
@@ -536,66 +655,146 @@ func (s *service) Update(ctx context.Context, req *trainingsessionmodel.UpdateRe
 
 // Delete performs a soft-delete of a training session and its related product record.
 // It also unpublishes both records, meaning they must be manually published again after restoration.
+// An optional reason is stored on the record and returned by GetWithDeleted and ListDeleted.
 //
-// Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
+// Returns an error if the ID is invalid or the reason is longer than 255 characters (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
-func (s *service) Delete(ctx context.Context, id string) error {
+func (s *service) Delete(ctx context.Context, id, reason string) error {
+	ctx, span := tracing.Start(ctx, "training_session", "Delete")
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
+	if utf8.RuneCountInString(reason) > 255 {
+		return fmt.Errorf("%w: reason must be at most 255 characters long", ErrInvalidArgument)
+	}
 	return s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSessionRepo := s.TrainingSessionRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
+		return s.deleteInTx(ctx, txSessionRepo, txProductRepo, id, reason)
+	})
+}
 
-		// Check if training session exists
-		if _, err := txSessionRepo.GetWithUnpublished(ctx, id); err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return fmt.Errorf("%w: %w", ErrNotFound, err)
-			}
-			return fmt.Errorf("failed to get training session: %w", err)
+// deleteInTx unpublishes and soft-deletes a single training session with its products using
+// transaction-bound repositories. It is shared by Delete and DeleteBatch.
+func (s *service) deleteInTx(ctx context.Context, txSessionRepo trainingsessionrepo.Repository, txProductRepo productrepo.Repository, id, reason string) error {
+	// Check if training session exists
+	if _, err := txSessionRepo.GetWithUnpublished(ctx, id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
+		return fmt.Errorf("failed to get training session: %w", err)
+	}
 
-		// Unpublish all instances
-		if _, err := txSessionRepo.SetInStock(ctx, id, false); err != nil {
-			return fmt.Errorf("failed to unpublish training session: %w", err)
-		}
-		ra, err := txProductRepo.SetInStockByDetailsID(ctx, id, false)
-		if err != nil {
-			return fmt.Errorf("failed to unpublish training session product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+	// Unpublish all instances
+	if _, err := txSessionRepo.SetInStock(ctx, id, false); err != nil {
+		return fmt.Errorf("failed to unpublish training session: %w", err)
+	}
+	productRes, err := txProductRepo.SetInStockByDetailsID(ctx, id, false, s.now())
+	if err != nil {
+		return fmt.Errorf("failed to unpublish training session product: %w", err)
+	} else if productRes.NotFound() {
+		return fmt.Errorf("%w: training session product", ErrNotFound)
+	}
+	// The product is deleted explicitly below, the training session hook must not cascade.
+	if _, err := txSessionRepo.Delete(common.WithoutCascade(ctx), id, reason); err != nil {
+		return fmt.Errorf("failed to delete training session: %w", err)
+	}
+	if _, err := txProductRepo.DeleteByDetailsID(ctx, id); err != nil {
+		return fmt.Errorf("failed to delete training session product: %w", err)
+	}
+	return nil
+}
+
+// DeleteBatch performs a soft-delete of multiple training sessions and their related product records
+// in a single transaction, applying the same guards as Delete to each record.
+// All IDs are validated before anything is deleted. Duplicate IDs are deleted once.
+// If any record fails, the whole batch is rolled back.
+//
+// Returns the number of deleted training sessions.
+// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) DeleteBatch(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "DeleteBatch")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%w: no IDs provided", ErrInvalidArgument)
+	}
+	seen := make(map[string]struct{}, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid training session ID %q: %w", ErrInvalidArgument, id, err)
 		}
-		if _, err := txSessionRepo.Delete(ctx, id); err != nil {
-			return fmt.Errorf("failed to delete training session: %w", err)
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
 		}
-		if _, err := txProductRepo.DeleteByDetailsID(ctx, id); err != nil {
-			return fmt.Errorf("failed to delete training session product: %w", err)
+	}
+
+	var deleted int64
+	err := s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txSessionRepo := s.TrainingSessionRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		for _, id := range uniqueIDs {
+			if err := s.deleteInTx(ctx, txSessionRepo, txProductRepo, id, ""); err != nil {
+				return fmt.Errorf("failed to delete training session %s: %w", id, err)
+			}
+			deleted++
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return deleted, nil
 }
 
 // DeletePermanent performs a complete delete of a training session and its related product record.
+// If force is true, the related product count is not checked and whatever records exist are deleted.
+// This is meant for cleaning up inconsistent data.
+// Featured list entries of the products block the delete, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
 //
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
-// or a database/internal error occurs.
-func (s *service) DeletePermanent(ctx context.Context, id string) error {
+// the products are featured (ErrHasDependents), or a database/internal error occurs.
+func (s *service) DeletePermanent(ctx context.Context, id string, force bool) error {
+	ctx, span := tracing.Start(ctx, "training_session", "DeletePermanent", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSessionRepo := s.TrainingSessionRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
 
-		ra, err := txSessionRepo.DeletePermanent(ctx, id)
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsID(ctx, id); err != nil {
+				return fmt.Errorf("failed to delete training session featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsID(ctx, id); err != nil {
+			return fmt.Errorf("failed to count training session featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+
+		detailsRA, err := txSessionRepo.DeletePermanent(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to delete training session: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		} else if detailsRA == 0 && !force {
+			return ErrNotFound
 		}
-		ra, err = txProductRepo.DeletePermanentByDetailsID(ctx, id)
+		ra, err := txProductRepo.DeletePermanentByDetailsID(ctx, id)
 		if err != nil {
 			return fmt.Errorf("failed to delete training session product: %w", err)
+		} else if force {
+			if detailsRA == 0 && ra == 0 {
+				return ErrNotFound
+			}
 		} else if ra == 0 {
 			return fmt.Errorf("%w: %w", ErrNotFound, err)
 		}
@@ -603,6 +802,67 @@ func (s *service) DeletePermanent(ctx context.Context, id string) error {
 	})
 }
 
+// PurgeDeletedBefore permanently deletes all training sessions soft-deleted before the given time,
+// together with their product records.
+// Featured list entries of their products block the purge, unless [database.CascadeDependents]
+// is set, in which case they are removed in the same transaction.
+//
+// Returns the number of purged training sessions.
+// Returns an error if the products are featured (ErrHasDependents) or a database/internal error occurs.
+func (s *service) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "PurgeDeletedBefore")
+	defer span.End()
+
+	var purged int64
+	err := s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txSessionRepo := s.TrainingSessionRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		txFeaturedRepo := s.FeaturedRepo.WithTx(tx)
+
+		ids, err := txSessionRepo.ListDeletedIDsBefore(ctx, before)
+		if err != nil {
+			return fmt.Errorf("failed to list deleted training sessions: %w", err)
+		} else if len(ids) == 0 {
+			return nil
+		}
+		if database.CascadeDependents() {
+			if _, err := txFeaturedRepo.DeleteByDetailsIDs(ctx, ids); err != nil {
+				return fmt.Errorf("failed to delete training session featured entries: %w", err)
+			}
+		} else if count, err := txFeaturedRepo.CountByDetailsIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to count training session featured entries: %w", err)
+		} else if count > 0 {
+			return fmt.Errorf("%w: %d featured entries", ErrHasDependents, count)
+		}
+		if purged, err = txSessionRepo.DeletePermanentByIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete training sessions: %w", err)
+		}
+		if _, err = txProductRepo.DeletePermanentByDetailsIDs(ctx, ids); err != nil {
+			return fmt.Errorf("failed to delete training session products: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// CountDeletedBefore counts training sessions soft-deleted before cutoff, that is the training sessions
+// [Service.PurgeDeletedBefore] would remove. Nothing is deleted.
+//
+// Returns an error if a database/internal error occurs.
+func (s *service) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "CountDeletedBefore")
+	defer span.End()
+
+	count, err := s.TrainingSessionRepo.CountDeletedBefore(ctx, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count deleted training sessions: %w", err)
+	}
+	return count, nil
+}
+
 // Restore performs a restore of a training session and its related product record.
 // Training session and its related product record are not being published. This should be
 // done manually.
@@ -610,24 +870,78 @@ func (s *service) DeletePermanent(ctx context.Context, id string) error {
 // Returns an error if the ID is invalid (ErrInvalidArgument), the records are not found (ErrNotFound),
 // or a database/internal error occurs.
 func (s *service) Restore(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "training_session", "Restore", tracing.ID(id))
+	defer span.End()
+
 	if _, err := uuid.Parse(id); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
 	return s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
 		txSessionRepo := s.TrainingSessionRepo.WithTx(tx)
 		txProductRepo := s.ProductRepo.WithTx(tx)
-		ra, err := txSessionRepo.Restore(ctx, id)
-		if err != nil {
-			return fmt.Errorf("failed to restore training session: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		return s.restoreInTx(ctx, txSessionRepo, txProductRepo, id)
+	})
+}
+
+// restoreInTx restores a single training session with its product using transaction-bound repositories.
+// It is shared by Restore and RestoreBatch.
+func (s *service) restoreInTx(ctx context.Context, txSessionRepo trainingsessionrepo.Repository, txProductRepo productrepo.Repository, id string) error {
+	ra, err := txSessionRepo.Restore(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore training session: %w", err)
+	} else if ra == 0 {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	ra, err = txProductRepo.RestoreByDetailsID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to restore training session product: %w", err)
+	} else if ra == 0 {
+		return fmt.Errorf("%w: %w", ErrNotFound, err)
+	}
+	return nil
+}
+
+// RestoreBatch restores multiple soft-deleted training sessions and their related product records
+// in a single transaction, applying the same guards as Restore to each record.
+// All IDs are validated before anything is restored. Duplicate IDs are restored once.
+// If any record fails, the whole batch is rolled back.
+//
+// Returns the number of restored training sessions.
+// Returns an error if any ID is invalid or no IDs are provided (ErrInvalidArgument), any record is not found (ErrNotFound),
+// or a database/internal error occurs.
+func (s *service) RestoreBatch(ctx context.Context, ids []string) (int64, error) {
+	ctx, span := tracing.Start(ctx, "training_session", "RestoreBatch")
+	defer span.End()
+
+	if len(ids) == 0 {
+		return 0, fmt.Errorf("%w: no IDs provided", ErrInvalidArgument)
+	}
+	seen := make(map[string]struct{}, len(ids))
+	uniqueIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return 0, fmt.Errorf("%w: invalid training session ID %q: %w", ErrInvalidArgument, id, err)
 		}
-		ra, err = txProductRepo.RestoreByDetailsID(ctx, id)
-		if err != nil {
-			return fmt.Errorf("failed to restore training session product: %w", err)
-		} else if ra == 0 {
-			return fmt.Errorf("%w: %w", ErrNotFound, err)
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			uniqueIDs = append(uniqueIDs, id)
+		}
+	}
+
+	var restored int64
+	err := s.TrainingSessionRepo.DB().Transaction(func(tx *gorm.DB) error {
+		txSessionRepo := s.TrainingSessionRepo.WithTx(tx)
+		txProductRepo := s.ProductRepo.WithTx(tx)
+		for _, id := range uniqueIDs {
+			if err := s.restoreInTx(ctx, txSessionRepo, txProductRepo, id); err != nil {
+				return fmt.Errorf("failed to restore training session %s: %w", id, err)
+			}
+			restored++
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return restored, nil
 }
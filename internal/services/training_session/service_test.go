@@ -22,10 +22,14 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/mikhail5545/product-service-go/internal/database"
+	"github.com/mikhail5545/product-service-go/internal/models/common"
 	"github.com/mikhail5545/product-service-go/internal/models/product"
 	trainingsession "github.com/mikhail5545/product-service-go/internal/models/training_session"
+	featuredmock "github.com/mikhail5545/product-service-go/internal/test/database/featured_mock"
 	productmock "github.com/mikhail5545/product-service-go/internal/test/database/product_mock"
 	trainingsessionmock "github.com/mikhail5545/product-service-go/internal/test/database/training_session_mock"
 
@@ -42,7 +46,7 @@ func TestService_Get(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	tsID := uuid.New().String()
 	productID := uuid.New().String()
@@ -66,6 +70,7 @@ func TestService_Get(t *testing.T) {
 		TrainingSession: mockTrainingSession,
 		Price:           mockProduct.Price,
 		ProductID:       mockProduct.ID,
+		Available:       true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -74,7 +79,7 @@ func TestService_Get(t *testing.T) {
 		mockProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), tsID, gomock.Any()).Return(mockProduct, nil)
 
 		// Act
-		details, err := testService.Get(context.Background(), tsID)
+		details, err := testService.Get(context.Background(), tsID, true)
 
 		// Arrange
 		assert.NoError(t, err)
@@ -83,12 +88,28 @@ func TestService_Get(t *testing.T) {
 		}
 	})
 
+	t.Run("success without products", func(t *testing.T) {
+		// Arrange
+		mockTrainingSessionRepo.EXPECT().Get(gomock.Any(), tsID).Return(mockTrainingSession, nil)
+		mockProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		details, err := testService.Get(context.Background(), tsID, false)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &trainingsession.TrainingSessionDetails{
+			TrainingSession: mockTrainingSession,
+			ProductsOmitted: true,
+		}, details)
+	})
+
 	t.Run("invalid UUID", func(t *testing.T) {
 		// Arrange
 		invalidID := "invalid-UUID"
 
 		// Act
-		_, err := testService.Get(context.Background(), invalidID)
+		_, err := testService.Get(context.Background(), invalidID, true)
 
 		// Assert
 		assert.Error(t, err)
@@ -100,7 +121,7 @@ func TestService_Get(t *testing.T) {
 		mockTrainingSessionRepo.EXPECT().Get(gomock.Any(), tsID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
-		_, err := testService.Get(context.Background(), tsID)
+		_, err := testService.Get(context.Background(), tsID, true)
 
 		// Assert
 		assert.Error(t, err)
@@ -113,7 +134,7 @@ func TestService_Get(t *testing.T) {
 		mockTrainingSessionRepo.EXPECT().Get(gomock.Any(), tsID).Return(nil, dbErr)
 
 		// Act
-		_, err := testService.Get(context.Background(), tsID)
+		_, err := testService.Get(context.Background(), tsID, true)
 
 		// Assert
 		assert.Error(t, err)
@@ -127,7 +148,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	tsID := uuid.New().String()
 	productID := uuid.New().String()
@@ -151,6 +172,7 @@ func TestService_GetWithDeleted(t *testing.T) {
 		TrainingSession: mockTrainingSession,
 		Price:           mockProduct.Price,
 		ProductID:       mockProduct.ID,
+		Available:       true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -212,7 +234,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	tsID := uuid.New().String()
 	productID := uuid.New().String()
@@ -236,6 +258,7 @@ func TestService_GetWithUnpublished(t *testing.T) {
 		TrainingSession: mockTrainingSession,
 		Price:           mockProduct.Price,
 		ProductID:       mockProduct.ID,
+		Available:       true,
 	}
 
 	t.Run("success", func(t *testing.T) {
@@ -297,7 +320,7 @@ func TestService_List(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	tsID_1 := uuid.New().String()
 	tsID_2 := uuid.New().String()
@@ -341,11 +364,13 @@ func TestService_List(t *testing.T) {
 			TrainingSession: &mockTrainingSessions[0],
 			Price:           mockProducts[0].Price,
 			ProductID:       mockProducts[0].ID,
+			Available:       true,
 		},
 		{
 			TrainingSession: &mockTrainingSessions[1],
 			Price:           mockProducts[1].Price,
 			ProductID:       mockProducts[1].ID,
+			Available:       true,
 		},
 	}
 
@@ -431,7 +456,7 @@ func TestService_ListDeleted(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	tsID_1 := uuid.New().String()
 	tsID_2 := uuid.New().String()
@@ -475,11 +500,13 @@ func TestService_ListDeleted(t *testing.T) {
 			TrainingSession: &mockTrainingSessions[0],
 			Price:           mockProducts[0].Price,
 			ProductID:       mockProducts[0].ID,
+			Available:       true,
 		},
 		{
 			TrainingSession: &mockTrainingSessions[1],
 			Price:           mockProducts[1].Price,
 			ProductID:       mockProducts[1].ID,
+			Available:       true,
 		},
 	}
 
@@ -550,7 +577,7 @@ func TestService_ListUnpublished(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	tsID_1 := uuid.New().String()
 	tsID_2 := uuid.New().String()
@@ -594,11 +621,13 @@ func TestService_ListUnpublished(t *testing.T) {
 			TrainingSession: &mockTrainingSessions[0],
 			Price:           mockProducts[0].Price,
 			ProductID:       mockProducts[0].ID,
+			Available:       true,
 		},
 		{
 			TrainingSession: &mockTrainingSessions[1],
 			Price:           mockProducts[1].Price,
 			ProductID:       mockProducts[1].ID,
+			Available:       true,
 		},
 	}
 
@@ -669,7 +698,7 @@ func TestService_Create(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -761,6 +790,22 @@ func TestService_Create(t *testing.T) {
 		assert.ErrorIs(t, err, ErrInvalidArgument)
 	})
 
+	t.Run("zero or negative price", func(t *testing.T) {
+		for _, price := range []float32{0, -10} {
+			// Arrange
+			req := *createReq
+			req.Price = price
+
+			// Act
+			resp, err := testService.Create(context.Background(), &req)
+
+			// Assert
+			assert.ErrorIs(t, err, ErrInvalidArgument)
+			assert.ErrorContains(t, err, "price")
+			assert.Nil(t, resp)
+		}
+	})
+
 	t.Run("db error", func(t *testing.T) {
 		// Arrange
 		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
@@ -788,7 +833,7 @@ func TestService_Publish(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -803,6 +848,29 @@ func TestService_Publish(t *testing.T) {
 	tsID := uuid.New().String()
 
 	t.Run("success", func(t *testing.T) {
+		// Arrange
+		publishedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+		testService.(*service).now = func() time.Time { return publishedAt }
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), tsID, publishedAt).Return(int64(1), nil)
+
+		// Act
+		err := testService.Publish(context.Background(), tsID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already published", func(t *testing.T) {
 		// Arrange
 		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 		mockTxProductRepo := productmock.NewMockRepository(ctrl)
@@ -811,8 +879,31 @@ func TestService_Publish(t *testing.T) {
 		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, true).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25, InStock: true}}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), tsID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already published training session with unpublished product", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), tsID, gomock.Any()).Return(int64(1), nil)
 
 		// Act
 		err := testService.Publish(context.Background(), tsID)
@@ -842,7 +933,31 @@ func TestService_Publish(t *testing.T) {
 		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(int64(0), nil)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(database.SetResult{}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Publish(context.Background(), tsID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
 		err := testService.Publish(context.Background(), tsID)
@@ -862,13 +977,61 @@ func TestService_Publish(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		dbErr := errors.New("database error")
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(int64(0), dbErr)
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 25}}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(database.SetResult{}, dbErr)
+
+		// Act
+		err := testService.Publish(context.Background(), tsID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
+	})
+
+	t.Run("zero price not marked free", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		productID := uuid.New().String()
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: productID, Price: 0}}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
 
 		// Act
 		err := testService.Publish(context.Background(), tsID)
 
 		// Assert
 		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrCannotPublishFree)
+		assert.Contains(t, err.Error(), productID)
+	})
+
+	t.Run("zero price marked free", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxProductRepo.EXPECT().SelectWithUnpublishedByDetailsIDs(gomock.Any(), []string{tsID}, "id", "price", "is_free", "in_stock").
+			Return([]product.Product{{ID: uuid.New().String(), Price: 0, IsFree: true}}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, true).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().PublishByDetailsID(gomock.Any(), tsID, gomock.Any()).Return(int64(1), nil)
+
+		// Act
+		err := testService.Publish(context.Background(), tsID)
+
+		// Assert
+		assert.NoError(t, err)
 	})
 }
 
@@ -879,7 +1042,7 @@ func TestService_Unpublish(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -902,8 +1065,27 @@ func TestService_Unpublish(t *testing.T) {
 		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false).Return(int64(1), nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+
+		// Act
+		err := testService.Unpublish(context.Background(), tsID)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("already unpublished", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{AlreadyInState: true}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false, gomock.Any()).Return(database.SetResult{AlreadyInState: true}, nil)
 
 		// Act
 		err := testService.Unpublish(context.Background(), tsID)
@@ -933,7 +1115,28 @@ func TestService_Unpublish(t *testing.T) {
 		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(int64(0), nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.Unpublish(context.Background(), tsID)
+
+		// Assert
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("product not found", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false, gomock.Any()).Return(database.SetResult{}, nil)
 
 		// Act
 		err := testService.Unpublish(context.Background(), tsID)
@@ -953,13 +1156,14 @@ func TestService_Unpublish(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		dbErr := errors.New("database error")
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(int64(0), dbErr)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{}, dbErr)
 
 		// Act
 		err := testService.Unpublish(context.Background(), tsID)
 
 		// Assert
 		assert.Error(t, err)
+		assert.ErrorIs(t, err, dbErr)
 	})
 }
 
@@ -970,7 +1174,7 @@ func TestService_Update(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -1098,6 +1302,104 @@ func TestService_Update(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("empty tags clear them", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		tagged := *mockTrainingSession
+		tagged.Tags = []string{"old", "tags"}
+		mockTxTrainingSessionRepo.EXPECT().Select(gomock.Any(), tsID, gomock.Any()).Return(&tagged, nil)
+		mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), tsID, gomock.Any()).Return(mockProduct, nil)
+
+		var tsUpdates map[string]any
+		mockTxTrainingSessionRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+			Do(func(_ context.Context, _ *trainingsession.TrainingSession, u map[string]any) {
+				tsUpdates = u
+			}).Return(int64(1), nil)
+
+		// Act
+		updates, err := testService.Update(context.Background(), &trainingsession.UpdateRequest{
+			ID:   tsID,
+			Tags: []string{},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]any{"tags": []string{}}, tsUpdates)
+		assert.Equal(t, tsUpdates, updates["training_session"])
+	})
+
+	t.Run("unchanged tags are skipped", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		tagged := *mockTrainingSession
+		tagged.Tags = []string{"old", "tags"}
+		mockTxTrainingSessionRepo.EXPECT().Select(gomock.Any(), tsID, gomock.Any()).Return(&tagged, nil)
+		mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), tsID, gomock.Any()).Return(mockProduct, nil)
+		mockTxTrainingSessionRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		_, err := testService.Update(context.Background(), &trainingsession.UpdateRequest{
+			ID:   tsID,
+			Tags: []string{"old", "tags"},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("clear tags flag", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			req  trainingsession.UpdateRequest
+			want []string
+		}{
+			"omitted tags are kept":   {req: trainingsession.UpdateRequest{ID: tsID}},
+			"clear tags empties them": {req: trainingsession.UpdateRequest{ID: tsID, ClearTags: true}, want: []string{}},
+			"tags replace them":       {req: trainingsession.UpdateRequest{ID: tsID, Tags: newTags}, want: newTags},
+		} {
+			// Arrange
+			mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+			mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+			mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+			mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+			mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+			tagged := *mockTrainingSession
+			tagged.Tags = []string{"old", "tags"}
+			mockTxTrainingSessionRepo.EXPECT().Select(gomock.Any(), tsID, gomock.Any()).Return(&tagged, nil)
+			mockTxProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), tsID, gomock.Any()).Return(mockProduct, nil)
+
+			var tsUpdates map[string]any
+			mockTxTrainingSessionRepo.EXPECT().Update(gomock.Any(), gomock.Any(), gomock.Any()).
+				Do(func(_ context.Context, _ *trainingsession.TrainingSession, u map[string]any) {
+					tsUpdates = u
+				}).Return(int64(1), nil).MaxTimes(1)
+
+			// Act
+			_, err := testService.Update(context.Background(), &tc.req)
+
+			// Assert
+			assert.NoError(t, err, name)
+			if tc.want == nil {
+				assert.NotContains(t, tsUpdates, "tags", name)
+			} else {
+				assert.Equal(t, tc.want, tsUpdates["tags"], name)
+			}
+		}
+	})
+
 	t.Run("invalid request payload", func(t *testing.T) {
 		// Arrange
 		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
@@ -1183,7 +1485,7 @@ func TestService_Delete(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -1207,13 +1509,13 @@ func TestService_Delete(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		mockTxTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), tsID).Return(&trainingsession.TrainingSession{}, nil)
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false).Return(int64(1), nil)
-		mockTxTrainingSessionRepo.EXPECT().Delete(gomock.Any(), tsID).Return(int64(1), nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+		mockTxTrainingSessionRepo.EXPECT().Delete(gomock.Any(), tsID, "").Return(int64(1), nil)
 		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), tsID).Return(int64(1), nil)
 
 		// Act
-		err := testService.Delete(context.Background(), tsID)
+		err := testService.Delete(context.Background(), tsID, "")
 
 		// Assert
 		assert.NoError(t, err)
@@ -1224,7 +1526,7 @@ func TestService_Delete(t *testing.T) {
 		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.Delete(context.Background(), invalidID)
+		err := testService.Delete(context.Background(), invalidID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1243,7 +1545,7 @@ func TestService_Delete(t *testing.T) {
 		mockTxTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), tsID).Return(nil, gorm.ErrRecordNotFound)
 
 		// Act
-		err := testService.Delete(context.Background(), tsID)
+		err := testService.Delete(context.Background(), tsID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1260,11 +1562,11 @@ func TestService_Delete(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		mockTxTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), tsID).Return(&trainingsession.TrainingSession{}, nil)
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false).Return(int64(0), nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false, gomock.Any()).Return(database.SetResult{}, nil)
 
 		// Act
-		err := testService.Delete(context.Background(), tsID)
+		err := testService.Delete(context.Background(), tsID, "")
 
 		// Assert
 		assert.Error(t, err)
@@ -1281,10 +1583,10 @@ func TestService_Delete(t *testing.T) {
 		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
 
 		mockTxTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), tsID).Return(&trainingsession.TrainingSession{}, nil)
-		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(int64(1), nil)
-		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false).Return(int64(1), nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), tsID, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), tsID, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
 		dbErr := errors.New("database error")
-		mockTxTrainingSessionRepo.EXPECT().Delete(gomock.Any(), tsID).Return(int64(0), dbErr)
+		mockTxTrainingSessionRepo.EXPECT().Delete(gomock.Any(), tsID, "").Return(int64(0), dbErr)
 
 		// Act
 		assert.Error(t, err)
@@ -1298,7 +1600,13 @@ func TestService_DeletePermanent(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	// Nothing is featured, so the dependents check always passes.
+	mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+	mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo).AnyTimes()
+	mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Return(int64(0), nil).AnyTimes()
+
+	testService := New(mockTrainingSessionRepo, mockProductRepo, mockFeaturedRepo)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -1325,7 +1633,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), tsID).Return(int64(1), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), tsID)
+		err := testService.DeletePermanent(context.Background(), tsID, false)
 
 		// Assert
 		assert.NoError(t, err)
@@ -1336,7 +1644,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		invalidID := "invalid-UUID"
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), invalidID)
+		err := testService.DeletePermanent(context.Background(), invalidID, false)
 
 		// Assert
 		assert.Error(t, err)
@@ -1355,7 +1663,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxTrainingSessionRepo.EXPECT().DeletePermanent(gomock.Any(), tsID).Return(int64(0), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), tsID)
+		err := testService.DeletePermanent(context.Background(), tsID, false)
 
 		// Assert
 		assert.Error(t, err)
@@ -1375,7 +1683,7 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), tsID).Return(int64(0), nil)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), tsID)
+		err := testService.DeletePermanent(context.Background(), tsID, false)
 
 		// Assert
 		assert.Error(t, err)
@@ -1395,13 +1703,106 @@ func TestService_DeletePermanent(t *testing.T) {
 		mockTxTrainingSessionRepo.EXPECT().DeletePermanent(gomock.Any(), tsID).Return(int64(1), dbErr)
 
 		// Act
-		err := testService.DeletePermanent(context.Background(), tsID)
+		err := testService.DeletePermanent(context.Background(), tsID, false)
 
 		// Assert
 		assert.Error(t, err)
 	})
 }
 
+func TestService_DeletePermanent_Dependents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+	mockFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+	testService := New(mockTrainingSessionRepo, mockProductRepo, mockFeaturedRepo)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	tsID := uuid.New().String()
+
+	t.Run("featured product blocks delete", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), tsID).Return(int64(1), nil)
+		mockTxTrainingSessionRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), tsID, false)
+
+		// Assert
+		assert.ErrorIs(t, err, ErrHasDependents)
+	})
+
+	t.Run("cascade removes featured entries", func(t *testing.T) {
+		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), tsID).Return(int64(1), nil)
+		mockTxFeaturedRepo.EXPECT().CountByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+		mockTxTrainingSessionRepo.EXPECT().DeletePermanent(gomock.Any(), tsID).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), tsID).Return(int64(1), nil)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), tsID, false)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("cascade error", func(t *testing.T) {
+		// Arrange
+		database.SetCascadeDependents(true)
+		defer database.SetCascadeDependents(false)
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+		mockTxFeaturedRepo := featuredmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+		mockFeaturedRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxFeaturedRepo)
+
+		dbErr := errors.New("database error")
+		mockTxFeaturedRepo.EXPECT().DeleteByDetailsID(gomock.Any(), tsID).Return(int64(0), dbErr)
+		mockTxTrainingSessionRepo.EXPECT().DeletePermanent(gomock.Any(), gomock.Any()).Times(0)
+		mockTxProductRepo.EXPECT().DeletePermanentByDetailsID(gomock.Any(), gomock.Any()).Times(0)
+
+		// Act
+		err := testService.DeletePermanent(context.Background(), tsID, false)
+
+		// Assert
+		assert.ErrorIs(t, err, dbErr)
+	})
+}
+
 func TestService_Restore(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -1409,7 +1810,7 @@ func TestService_Restore(t *testing.T) {
 	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
 	mockProductRepo := productmock.NewMockRepository(ctrl)
 
-	testService := New(mockTrainingSessionRepo, mockProductRepo)
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
 
 	// Use an in-memory SQLite DB for testing transactions.
 	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
@@ -1512,3 +1913,256 @@ func TestService_Restore(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestService_Get_Availability(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil).(*service)
+
+	tsID := uuid.New().String()
+	from := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mockTrainingSession := &trainingsession.TrainingSession{ID: tsID, Name: "Training session name"}
+	mockProduct := &product.Product{
+		ID:             uuid.New().String(),
+		DetailsID:      tsID,
+		DetailsType:    "training_session",
+		Price:          35.55,
+		AvailableFrom:  &from,
+		AvailableUntil: &until,
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{name: "before window", now: from.Add(-time.Second), want: false},
+		{name: "window start", now: from, want: true},
+		{name: "in window", now: from.Add(72 * time.Hour), want: true},
+		{name: "window end", now: until, want: false},
+		{name: "after window", now: until.Add(time.Hour), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			testService.now = func() time.Time { return tt.now }
+			mockTrainingSessionRepo.EXPECT().Get(gomock.Any(), tsID).Return(mockTrainingSession, nil)
+			mockProductRepo.EXPECT().SelectByDetailsID(gomock.Any(), tsID, gomock.Any()).Return(mockProduct, nil)
+
+			// Act
+			details, err := testService.Get(context.Background(), tsID, true)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, details.Available)
+		})
+	}
+}
+
+func TestService_DeleteBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	id1, id2 := uuid.New().String(), uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		for _, id := range []string{id1, id2} {
+			mockTxTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), id).Return(&trainingsession.TrainingSession{ID: id}, nil)
+			mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), id, false).Return(database.SetResult{Affected: 1}, nil)
+			mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+			mockTxTrainingSessionRepo.EXPECT().Delete(gomock.Any(), id, "").Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), id).Return(int64(1), nil)
+		}
+
+		// Act
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, id2, id1})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), deleted)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, "invalid-UUID"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Equal(t, int64(0), deleted)
+	})
+
+	t.Run("guard failure rolls back batch", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), id1).Return(&trainingsession.TrainingSession{ID: id1}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), id1, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id1, false, gomock.Any()).Return(database.SetResult{Affected: 1}, nil)
+		mockTxTrainingSessionRepo.EXPECT().Delete(gomock.Any(), id1, "").Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().DeleteByDetailsID(gomock.Any(), id1).Return(int64(1), nil)
+
+		mockTxTrainingSessionRepo.EXPECT().GetWithUnpublished(gomock.Any(), id2).Return(&trainingsession.TrainingSession{ID: id2}, nil)
+		mockTxTrainingSessionRepo.EXPECT().SetInStock(gomock.Any(), id2, false).Return(database.SetResult{Affected: 1}, nil)
+		mockTxProductRepo.EXPECT().SetInStockByDetailsID(gomock.Any(), id2, false, gomock.Any()).Return(database.SetResult{}, nil)
+
+		// Act
+		deleted, err := testService.DeleteBatch(context.Background(), []string{id1, id2})
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), deleted)
+	})
+}
+
+func TestService_RestoreBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
+
+	// Use an in-memory SQLite DB for testing transactions.
+	db, err := gorm.Open(sqlite.Open("file::memory:?cache=shared"), &gorm.Config{
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("failed to connect database: %v", err)
+	}
+
+	id1, id2 := uuid.New().String(), uuid.New().String()
+
+	t.Run("success", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		for _, id := range []string{id1, id2} {
+			mockTxTrainingSessionRepo.EXPECT().Restore(gomock.Any(), id).Return(int64(1), nil)
+			mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id).Return(int64(1), nil)
+		}
+
+		// Act
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, id2, id1})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), restored)
+	})
+
+	t.Run("invalid UUID", func(t *testing.T) {
+		// Act
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, "invalid-UUID"})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.Equal(t, int64(0), restored)
+	})
+
+	t.Run("product count mismatch rolls back batch", func(t *testing.T) {
+		// Arrange
+		mockTxTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+		mockTxProductRepo := productmock.NewMockRepository(ctrl)
+
+		mockTrainingSessionRepo.EXPECT().DB().Return(db).AnyTimes()
+		mockTrainingSessionRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxTrainingSessionRepo)
+		mockProductRepo.EXPECT().WithTx(gomock.Any()).Return(mockTxProductRepo)
+
+		mockTxTrainingSessionRepo.EXPECT().Restore(gomock.Any(), id1).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id1).Return(int64(1), nil)
+
+		mockTxTrainingSessionRepo.EXPECT().Restore(gomock.Any(), id2).Return(int64(1), nil)
+		mockTxProductRepo.EXPECT().RestoreByDetailsID(gomock.Any(), id2).Return(int64(0), nil)
+
+		// Act
+		restored, err := testService.RestoreBatch(context.Background(), []string{id1, id2})
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, int64(0), restored)
+	})
+}
+
+func TestService_MaxPrice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrainingSessionRepo := trainingsessionmock.NewMockRepository(ctrl)
+	mockProductRepo := productmock.NewMockRepository(ctrl)
+
+	testService := New(mockTrainingSessionRepo, mockProductRepo, nil)
+
+	if err := common.SetMaxPrice(1000); err != nil {
+		t.Fatalf("failed to set maximum price: %v", err)
+	}
+	t.Cleanup(func() { _ = common.SetMaxPrice(0) })
+
+	t.Run("create above ceiling", func(t *testing.T) {
+		// Act
+		resp, err := testService.Create(context.Background(), &trainingsession.CreateRequest{
+			Name:             "Training session name",
+			ShortDescription: "Training session short description",
+			DurationMinutes:  30,
+			Price:            10000,
+			Format:           "online",
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.ErrorContains(t, err, "maximum price ceiling")
+		assert.Nil(t, resp)
+	})
+
+	t.Run("update above ceiling", func(t *testing.T) {
+		// Arrange
+		price := float32(1000.5)
+
+		// Act
+		updates, err := testService.Update(context.Background(), &trainingsession.UpdateRequest{
+			ID:    uuid.New().String(),
+			Price: &price,
+		})
+
+		// Assert
+		assert.ErrorIs(t, err, ErrInvalidArgument)
+		assert.ErrorContains(t, err, "maximum price ceiling")
+		assert.Nil(t, updates)
+	})
+}
@@ -29,6 +29,7 @@ import (
 	coursepart "github.com/mikhail5545/product-service-go/internal/services/course_part"
 	videomanager "github.com/mikhail5545/product-service-go/internal/services/video_manager"
 	videoowner "github.com/mikhail5545/product-service-go/internal/types/video_owner"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 )
 
 // Service provides service-layer logic for videos.
@@ -88,6 +89,9 @@ func (s *service) getOwnerRepoAdapter(ownerType string) (videoowner.OwnerRepo[vi
 // should be deassociated in the corresponding service separately. This function handles only local owner-video relations.
 // It first validates that the video exists in the media service.
 func (s *service) Add(ctx context.Context, ownerType string, req *videomodel.AddRequest) error {
+	ctx, span := tracing.Start(ctx, "video", "Add")
+	defer span.End()
+
 	adapter, err := s.getOwnerRepoAdapter(ownerType)
 	if err != nil {
 		return err
@@ -99,6 +103,9 @@ func (s *service) Add(ctx context.Context, ownerType string, req *videomodel.Add
 // This function handles only local owner-video relations.
 // Owner should be also deassociated from the video in the corresponding service.
 func (s *service) Remove(ctx context.Context, ownerType string, req *videomodel.RemoveRequest) error {
+	ctx, span := tracing.Start(ctx, "video", "Remove")
+	defer span.End()
+
 	adapter, err := s.getOwnerRepoAdapter(ownerType)
 	if err != nil {
 		return err
@@ -110,6 +117,9 @@ func (s *service) Remove(ctx context.Context, ownerType string, req *videomodel.
 // It returns generic [videomodel.Owner] struct type, featuring minimal necessary owner information.
 // It can be used if service needs to check if the owner is already associated with a video and to check it's and it's video id.
 func (s *service) GetOwner(ctx context.Context, ownerType string, ownerID string) (*videomodel.Owner, error) {
+	ctx, span := tracing.Start(ctx, "video", "GetOwner")
+	defer span.End()
+
 	adapter, err := s.getOwnerRepoAdapter(ownerType)
 	if err != nil {
 		return nil, err
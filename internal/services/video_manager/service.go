@@ -27,6 +27,7 @@ import (
 	mediaservice "github.com/mikhail5545/product-service-go/internal/clients/mediaservice"
 	videomodel "github.com/mikhail5545/product-service-go/internal/models/video"
 	videoowner "github.com/mikhail5545/product-service-go/internal/types/video_owner"
+	"github.com/mikhail5545/product-service-go/internal/util/tracing"
 	"gorm.io/gorm"
 )
 
@@ -75,6 +76,9 @@ func New(mediaClient mediaservice.Client) Service {
 // owner/video are not found (ErrOwnerNotFound/ErrVideoNotFound), the request payload is invalid (ErrInvalidArgument) or
 // a database/internal error occurres.
 func (s *service) Add(ctx context.Context, req *videomodel.AddRequest, ownerRepo videoowner.OwnerRepo[videoowner.Owner]) error {
+	ctx, span := tracing.Start(ctx, "video_manager", "Add")
+	defer span.End()
+
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -110,6 +114,9 @@ func (s *service) Add(ctx context.Context, req *videomodel.AddRequest, ownerRepo
 // It returns an error if owner/video are not found (ErrOwnerNotFound/ErrVideoNotFound),
 // the request payload is invalid (ErrInvalidArgument) or a database/internal error occurres.
 func (s *service) Remove(ctx context.Context, req *videomodel.RemoveRequest, ownerRepo videoowner.OwnerRepo[videoowner.Owner]) error {
+	ctx, span := tracing.Start(ctx, "video_manager", "Remove")
+	defer span.End()
+
 	if err := req.Validate(); err != nil {
 		return fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
@@ -138,6 +145,9 @@ func (s *service) Remove(ctx context.Context, req *videomodel.RemoveRequest, own
 // It returns an error if the ownerID is not a valid UUID (ErrInvalidArgument), owner is not found (ErrOwnerNotFound)
 // or a database/internal error occures.
 func (s *service) GetOwner(ctx context.Context, ownerID string, ownerRepo videoowner.OwnerRepo[videoowner.Owner]) (videoowner.Owner, error) {
+	ctx, span := tracing.Start(ctx, "video_manager", "GetOwner", tracing.ID(ownerID))
+	defer span.End()
+
 	if _, err := uuid.Parse(ownerID); err != nil {
 		return nil, fmt.Errorf("%w: %w", ErrInvalidArgument, err)
 	}
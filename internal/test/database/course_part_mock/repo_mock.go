@@ -12,7 +12,9 @@ package course_part_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	database "github.com/mikhail5545/product-service-go/internal/database"
 	coursepart "github.com/mikhail5545/product-service-go/internal/database/course_part"
 	coursepart0 "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	gomock "go.uber.org/mock/gomock"
@@ -58,6 +60,21 @@ func (mr *MockRepositoryMockRecorder) Count(ctx, courseID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository)(nil).Count), ctx, courseID)
 }
 
+// CountByDetailsIDs mocks base method.
+func (m *MockRepository) CountByDetailsIDs(ctx context.Context, courseIDs []string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByDetailsIDs", ctx, courseIDs)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByDetailsIDs indicates an expected call of CountByDetailsIDs.
+func (mr *MockRepositoryMockRecorder) CountByDetailsIDs(ctx, courseIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDetailsIDs", reflect.TypeOf((*MockRepository)(nil).CountByDetailsIDs), ctx, courseIDs)
+}
+
 // CountDeleted mocks base method.
 func (m *MockRepository) CountDeleted(ctx context.Context, courseID string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -73,6 +90,21 @@ func (mr *MockRepositoryMockRecorder) CountDeleted(ctx, courseID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeleted", reflect.TypeOf((*MockRepository)(nil).CountDeleted), ctx, courseID)
 }
 
+// CountDeletedBefore mocks base method.
+func (m *MockRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockRepositoryMockRecorder) CountDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockRepository)(nil).CountDeletedBefore), ctx, before)
+}
+
 // CountQuery mocks base method.
 func (m *MockRepository) CountQuery(ctx context.Context, query any, args ...any) (int64, error) {
 	m.ctrl.T.Helper()
@@ -122,6 +154,25 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, coursePart any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, coursePart)
 }
 
+// CreateBatch mocks base method.
+func (m *MockRepository) CreateBatch(ctx context.Context, courseParts ...*coursepart0.CoursePart) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range courseParts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateBatch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockRepositoryMockRecorder) CreateBatch(ctx any, courseParts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, courseParts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockRepository)(nil).CreateBatch), varargs...)
+}
+
 // DB mocks base method.
 func (m *MockRepository) DB() *gorm.DB {
 	m.ctrl.T.Helper()
@@ -137,18 +188,18 @@ func (mr *MockRepositoryMockRecorder) DB() *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, id string) (int64, error) {
+func (m *MockRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id, reason)
 }
 
 // DeleteByCourseID mocks base method.
@@ -196,6 +247,36 @@ func (mr *MockRepositoryMockRecorder) DeletePermanentByCourseID(ctx, courseID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByCourseID", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByCourseID), ctx, courseID)
 }
 
+// DeletePermanentByCourseIDs mocks base method.
+func (m *MockRepository) DeletePermanentByCourseIDs(ctx context.Context, courseIDs []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermanentByCourseIDs", ctx, courseIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePermanentByCourseIDs indicates an expected call of DeletePermanentByCourseIDs.
+func (mr *MockRepositoryMockRecorder) DeletePermanentByCourseIDs(ctx, courseIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByCourseIDs", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByCourseIDs), ctx, courseIDs)
+}
+
+// DeletePermanentByIDs mocks base method.
+func (m *MockRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermanentByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePermanentByIDs indicates an expected call of DeletePermanentByIDs.
+func (mr *MockRepositoryMockRecorder) DeletePermanentByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByIDs", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByIDs), ctx, ids)
+}
+
 // Get mocks base method.
 func (m *MockRepository) Get(ctx context.Context, id string) (*coursepart0.CoursePart, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +352,51 @@ func (mr *MockRepositoryMockRecorder) ListDeleted(ctx, courseID, limit, offset a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepository)(nil).ListDeleted), ctx, courseID, limit, offset)
 }
 
+// ListDeletedIDsBefore mocks base method.
+func (m *MockRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedIDsBefore", ctx, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedIDsBefore indicates an expected call of ListDeletedIDsBefore.
+func (mr *MockRepositoryMockRecorder) ListDeletedIDsBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedIDsBefore", reflect.TypeOf((*MockRepository)(nil).ListDeletedIDsBefore), ctx, before)
+}
+
+// ListNumbered mocks base method.
+func (m *MockRepository) ListNumbered(ctx context.Context, courseID string) ([]coursepart0.CoursePart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNumbered", ctx, courseID)
+	ret0, _ := ret[0].([]coursepart0.CoursePart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNumbered indicates an expected call of ListNumbered.
+func (mr *MockRepositoryMockRecorder) ListNumbered(ctx, courseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNumbered", reflect.TypeOf((*MockRepository)(nil).ListNumbered), ctx, courseID)
+}
+
+// ListOrdered mocks base method.
+func (m *MockRepository) ListOrdered(ctx context.Context, courseID string) ([]coursepart0.CoursePart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrdered", ctx, courseID)
+	ret0, _ := ret[0].([]coursepart0.CoursePart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrdered indicates an expected call of ListOrdered.
+func (mr *MockRepositoryMockRecorder) ListOrdered(ctx, courseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrdered", reflect.TypeOf((*MockRepository)(nil).ListOrdered), ctx, courseID)
+}
+
 // ListUnpublished mocks base method.
 func (m *MockRepository) ListUnpublished(ctx context.Context, courseID string, limit, offset int) ([]coursepart0.CoursePart, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +412,21 @@ func (mr *MockRepositoryMockRecorder) ListUnpublished(ctx, courseID, limit, offs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublished", reflect.TypeOf((*MockRepository)(nil).ListUnpublished), ctx, courseID, limit, offset)
 }
 
+// MaxNumber mocks base method.
+func (m *MockRepository) MaxNumber(ctx context.Context, courseID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxNumber", ctx, courseID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaxNumber indicates an expected call of MaxNumber.
+func (mr *MockRepositoryMockRecorder) MaxNumber(ctx, courseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxNumber", reflect.TypeOf((*MockRepository)(nil).MaxNumber), ctx, courseID)
+}
+
 // Restore mocks base method.
 func (m *MockRepository) Restore(ctx context.Context, id string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +457,21 @@ func (mr *MockRepositoryMockRecorder) RestoreByCourseID(ctx, courseID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByCourseID", reflect.TypeOf((*MockRepository)(nil).RestoreByCourseID), ctx, courseID)
 }
 
+// RestoreByCourseIDDeletedSince mocks base method.
+func (m *MockRepository) RestoreByCourseIDDeletedSince(ctx context.Context, courseID string, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreByCourseIDDeletedSince", ctx, courseID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreByCourseIDDeletedSince indicates an expected call of RestoreByCourseIDDeletedSince.
+func (mr *MockRepositoryMockRecorder) RestoreByCourseIDDeletedSince(ctx, courseID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreByCourseIDDeletedSince", reflect.TypeOf((*MockRepository)(nil).RestoreByCourseIDDeletedSince), ctx, courseID, since)
+}
+
 // Select mocks base method.
 func (m *MockRepository) Select(ctx context.Context, id string, fields ...string) (*coursepart0.CoursePart, error) {
 	m.ctrl.T.Helper()
@@ -336,11 +492,26 @@ func (mr *MockRepositoryMockRecorder) Select(ctx, id any, fields ...any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockRepository)(nil).Select), varargs...)
 }
 
+// SetNumber mocks base method.
+func (m *MockRepository) SetNumber(ctx context.Context, id string, number int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNumber", ctx, id, number)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNumber indicates an expected call of SetNumber.
+func (mr *MockRepositoryMockRecorder) SetNumber(ctx, id, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNumber", reflect.TypeOf((*MockRepository)(nil).SetNumber), ctx, id, number)
+}
+
 // SetPublished mocks base method.
-func (m *MockRepository) SetPublished(ctx context.Context, id string, published bool) (int64, error) {
+func (m *MockRepository) SetPublished(ctx context.Context, id string, published bool) (database.SetResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetPublished", ctx, id, published)
-	ret0, _ := ret[0].(int64)
+	ret0, _ := ret[0].(database.SetResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
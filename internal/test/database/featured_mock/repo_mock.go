@@ -0,0 +1,206 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mikhail5545/product-service-go/internal/database/featured (interfaces: Repository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../test/database/featured_mock/repo_mock.go -package=featured_mock github.com/mikhail5545/product-service-go/internal/database/featured Repository
+//
+
+// Package featured_mock is a generated GoMock package.
+package featured_mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	featured "github.com/mikhail5545/product-service-go/internal/database/featured"
+	featured0 "github.com/mikhail5545/product-service-go/internal/models/featured"
+	gomock "go.uber.org/mock/gomock"
+	gorm "gorm.io/gorm"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountByDetailsID mocks base method.
+func (m *MockRepository) CountByDetailsID(ctx context.Context, detailsID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByDetailsID", ctx, detailsID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByDetailsID indicates an expected call of CountByDetailsID.
+func (mr *MockRepositoryMockRecorder) CountByDetailsID(ctx, detailsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDetailsID", reflect.TypeOf((*MockRepository)(nil).CountByDetailsID), ctx, detailsID)
+}
+
+// CountByDetailsIDs mocks base method.
+func (m *MockRepository) CountByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByDetailsIDs", ctx, detailsIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByDetailsIDs indicates an expected call of CountByDetailsIDs.
+func (mr *MockRepositoryMockRecorder) CountByDetailsIDs(ctx, detailsIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDetailsIDs", reflect.TypeOf((*MockRepository)(nil).CountByDetailsIDs), ctx, detailsIDs)
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, entry *featured0.Featured) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, entry)
+}
+
+// DB mocks base method.
+func (m *MockRepository) DB() *gorm.DB {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DB")
+	ret0, _ := ret[0].(*gorm.DB)
+	return ret0
+}
+
+// DB indicates an expected call of DB.
+func (mr *MockRepositoryMockRecorder) DB() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DB", reflect.TypeOf((*MockRepository)(nil).DB))
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, productID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, productID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, productID)
+}
+
+// DeleteByDetailsID mocks base method.
+func (m *MockRepository) DeleteByDetailsID(ctx context.Context, detailsID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByDetailsID", ctx, detailsID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByDetailsID indicates an expected call of DeleteByDetailsID.
+func (mr *MockRepositoryMockRecorder) DeleteByDetailsID(ctx, detailsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByDetailsID", reflect.TypeOf((*MockRepository)(nil).DeleteByDetailsID), ctx, detailsID)
+}
+
+// DeleteByDetailsIDs mocks base method.
+func (m *MockRepository) DeleteByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByDetailsIDs", ctx, detailsIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteByDetailsIDs indicates an expected call of DeleteByDetailsIDs.
+func (mr *MockRepositoryMockRecorder) DeleteByDetailsIDs(ctx, detailsIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByDetailsIDs", reflect.TypeOf((*MockRepository)(nil).DeleteByDetailsIDs), ctx, detailsIDs)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context) ([]featured0.Featured, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]featured0.Featured)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx)
+}
+
+// MaxPosition mocks base method.
+func (m *MockRepository) MaxPosition(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MaxPosition", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MaxPosition indicates an expected call of MaxPosition.
+func (mr *MockRepositoryMockRecorder) MaxPosition(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MaxPosition", reflect.TypeOf((*MockRepository)(nil).MaxPosition), ctx)
+}
+
+// SetPosition mocks base method.
+func (m *MockRepository) SetPosition(ctx context.Context, productID string, position int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPosition", ctx, productID, position)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPosition indicates an expected call of SetPosition.
+func (mr *MockRepositoryMockRecorder) SetPosition(ctx, productID, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPosition", reflect.TypeOf((*MockRepository)(nil).SetPosition), ctx, productID, position)
+}
+
+// WithTx mocks base method.
+func (m *MockRepository) WithTx(tx *gorm.DB) featured.Repository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", tx)
+	ret0, _ := ret[0].(featured.Repository)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockRepositoryMockRecorder) WithTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockRepository)(nil).WithTx), tx)
+}
@@ -12,7 +12,9 @@ package physical_good_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	database "github.com/mikhail5545/product-service-go/internal/database"
 	physicalgood "github.com/mikhail5545/product-service-go/internal/database/physical_good"
 	image "github.com/mikhail5545/product-service-go/internal/models/image"
 	physicalgood0 "github.com/mikhail5545/product-service-go/internal/models/physical_good"
@@ -72,6 +74,21 @@ func (mr *MockRepositoryMockRecorder) AddImageBatch(ctx, goods, arg2 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageBatch", reflect.TypeOf((*MockRepository)(nil).AddImageBatch), ctx, goods, arg2)
 }
 
+// AdjustAmount mocks base method.
+func (m *MockRepository) AdjustAmount(ctx context.Context, id string, delta int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustAmount", ctx, id, delta)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustAmount indicates an expected call of AdjustAmount.
+func (mr *MockRepositoryMockRecorder) AdjustAmount(ctx, id, delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustAmount", reflect.TypeOf((*MockRepository)(nil).AdjustAmount), ctx, id, delta)
+}
+
 // BatchUpdate mocks base method.
 func (m *MockRepository) BatchUpdate(ctx context.Context, updates []physicalgood0.PhysicalGood, opt uint) (int64, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +134,21 @@ func (mr *MockRepositoryMockRecorder) CountDeleted(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeleted", reflect.TypeOf((*MockRepository)(nil).CountDeleted), ctx)
 }
 
+// CountDeletedBefore mocks base method.
+func (m *MockRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockRepositoryMockRecorder) CountDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockRepository)(nil).CountDeletedBefore), ctx, before)
+}
+
 // CountUnpublished mocks base method.
 func (m *MockRepository) CountUnpublished(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -176,18 +208,18 @@ func (mr *MockRepositoryMockRecorder) DecrementImageCount(ctx, goodIDs any) *gom
 }
 
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, id string) (int64, error) {
+func (m *MockRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id, reason)
 }
 
 // DeleteImage mocks base method.
@@ -204,6 +236,21 @@ func (mr *MockRepositoryMockRecorder) DeleteImage(ctx, good, mediaSvcID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockRepository)(nil).DeleteImage), ctx, good, mediaSvcID)
 }
 
+// DeleteImageAssociations mocks base method.
+func (m *MockRepository) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImageAssociations", ctx, mediaSvcID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageAssociations indicates an expected call of DeleteImageAssociations.
+func (mr *MockRepositoryMockRecorder) DeleteImageAssociations(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageAssociations", reflect.TypeOf((*MockRepository)(nil).DeleteImageAssociations), ctx, mediaSvcID)
+}
+
 // DeleteImageBatch mocks base method.
 func (m *MockRepository) DeleteImageBatch(ctx context.Context, goods []physicalgood0.PhysicalGood, arg2 *image.Image) error {
 	m.ctrl.T.Helper()
@@ -233,6 +280,21 @@ func (mr *MockRepositoryMockRecorder) DeletePermanent(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockRepository)(nil).DeletePermanent), ctx, id)
 }
 
+// DeletePermanentByIDs mocks base method.
+func (m *MockRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermanentByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePermanentByIDs indicates an expected call of DeletePermanentByIDs.
+func (mr *MockRepositoryMockRecorder) DeletePermanentByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByIDs", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByIDs), ctx, ids)
+}
+
 // FindOwnerIDsByImageID mocks base method.
 func (m *MockRepository) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +325,21 @@ func (mr *MockRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), ctx, id)
 }
 
+// GetBySKU mocks base method.
+func (m *MockRepository) GetBySKU(ctx context.Context, sku string) (*physicalgood0.PhysicalGood, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySKU", ctx, sku)
+	ret0, _ := ret[0].(*physicalgood0.PhysicalGood)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySKU indicates an expected call of GetBySKU.
+func (mr *MockRepositoryMockRecorder) GetBySKU(ctx, sku any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySKU", reflect.TypeOf((*MockRepository)(nil).GetBySKU), ctx, sku)
+}
+
 // GetWithDeleted mocks base method.
 func (m *MockRepository) GetWithDeleted(ctx context.Context, id string) (*physicalgood0.PhysicalGood, error) {
 	m.ctrl.T.Helper()
@@ -323,6 +400,36 @@ func (mr *MockRepositoryMockRecorder) ListDeleted(ctx, limit, offset any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepository)(nil).ListDeleted), ctx, limit, offset)
 }
 
+// ListDeletedIDsBefore mocks base method.
+func (m *MockRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedIDsBefore", ctx, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedIDsBefore indicates an expected call of ListDeletedIDsBefore.
+func (mr *MockRepositoryMockRecorder) ListDeletedIDsBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedIDsBefore", reflect.TypeOf((*MockRepository)(nil).ListDeletedIDsBefore), ctx, before)
+}
+
+// ListOwnerIDsByImageID mocks base method.
+func (m *MockRepository) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwnerIDsByImageID", ctx, mediaSvcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwnerIDsByImageID indicates an expected call of ListOwnerIDsByImageID.
+func (mr *MockRepositoryMockRecorder) ListOwnerIDsByImageID(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwnerIDsByImageID", reflect.TypeOf((*MockRepository)(nil).ListOwnerIDsByImageID), ctx, mediaSvcID)
+}
+
 // ListUnpublished mocks base method.
 func (m *MockRepository) ListUnpublished(ctx context.Context, limit, offset int) ([]physicalgood0.PhysicalGood, error) {
 	m.ctrl.T.Helper()
@@ -394,10 +501,10 @@ func (mr *MockRepositoryMockRecorder) Select(ctx, id any, fields ...any) *gomock
 }
 
 // SetInStock mocks base method.
-func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
+func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetInStock", ctx, id, inStock)
-	ret0, _ := ret[0].(int64)
+	ret0, _ := ret[0].(database.SetResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
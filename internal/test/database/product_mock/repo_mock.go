@@ -12,7 +12,9 @@ package product_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	database "github.com/mikhail5545/product-service-go/internal/database"
 	product "github.com/mikhail5545/product-service-go/internal/database/product"
 	product0 "github.com/mikhail5545/product-service-go/internal/models/product"
 	gomock "go.uber.org/mock/gomock"
@@ -43,34 +45,34 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Count mocks base method.
-func (m *MockRepository) Count(ctx context.Context) (int64, error) {
+// AddTags mocks base method.
+func (m *MockRepository) AddTags(ctx context.Context, ids, tags []string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Count", ctx)
+	ret := m.ctrl.Call(m, "AddTags", ctx, ids, tags)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Count indicates an expected call of Count.
-func (mr *MockRepositoryMockRecorder) Count(ctx any) *gomock.Call {
+// AddTags indicates an expected call of AddTags.
+func (mr *MockRepositoryMockRecorder) AddTags(ctx, ids, tags any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository)(nil).Count), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockRepository)(nil).AddTags), ctx, ids, tags)
 }
 
-// CountByDetailsType mocks base method.
-func (m *MockRepository) CountByDetailsType(ctx context.Context, detailsType string) (int64, error) {
+// Count mocks base method.
+func (m *MockRepository) Count(ctx context.Context, filter product0.ProductFilter) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountByDetailsType", ctx, detailsType)
+	ret := m.ctrl.Call(m, "Count", ctx, filter)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountByDetailsType indicates an expected call of CountByDetailsType.
-func (mr *MockRepositoryMockRecorder) CountByDetailsType(ctx, detailsType any) *gomock.Call {
+// Count indicates an expected call of Count.
+func (mr *MockRepositoryMockRecorder) Count(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByDetailsType", reflect.TypeOf((*MockRepository)(nil).CountByDetailsType), ctx, detailsType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockRepository)(nil).Count), ctx, filter)
 }
 
 // CountDeleted mocks base method.
@@ -88,19 +90,19 @@ func (mr *MockRepositoryMockRecorder) CountDeleted(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeleted", reflect.TypeOf((*MockRepository)(nil).CountDeleted), ctx)
 }
 
-// CountUnpublished mocks base method.
-func (m *MockRepository) CountUnpublished(ctx context.Context) (int64, error) {
+// CountDetailsByTag mocks base method.
+func (m *MockRepository) CountDetailsByTag(ctx context.Context, tag string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountUnpublished", ctx)
+	ret := m.ctrl.Call(m, "CountDetailsByTag", ctx, tag)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CountUnpublished indicates an expected call of CountUnpublished.
-func (mr *MockRepositoryMockRecorder) CountUnpublished(ctx any) *gomock.Call {
+// CountDetailsByTag indicates an expected call of CountDetailsByTag.
+func (mr *MockRepositoryMockRecorder) CountDetailsByTag(ctx, tag any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnpublished", reflect.TypeOf((*MockRepository)(nil).CountUnpublished), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDetailsByTag", reflect.TypeOf((*MockRepository)(nil).CountDetailsByTag), ctx, tag)
 }
 
 // Create mocks base method.
@@ -210,6 +212,21 @@ func (mr *MockRepositoryMockRecorder) DeletePermanentByDetailsID(ctx, detailsID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByDetailsID", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByDetailsID), ctx, detailsID)
 }
 
+// DeletePermanentByDetailsIDs mocks base method.
+func (m *MockRepository) DeletePermanentByDetailsIDs(ctx context.Context, detailsIDs []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermanentByDetailsIDs", ctx, detailsIDs)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePermanentByDetailsIDs indicates an expected call of DeletePermanentByDetailsIDs.
+func (mr *MockRepositoryMockRecorder) DeletePermanentByDetailsIDs(ctx, detailsIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByDetailsIDs", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByDetailsIDs), ctx, detailsIDs)
+}
+
 // Get mocks base method.
 func (m *MockRepository) Get(ctx context.Context, id string) (*product0.Product, error) {
 	m.ctrl.T.Helper()
@@ -301,33 +318,18 @@ func (mr *MockRepositoryMockRecorder) GetWithUnpublishedByDetailsID(ctx, details
 }
 
 // List mocks base method.
-func (m *MockRepository) List(ctx context.Context, limit, offset int) ([]product0.Product, error) {
+func (m *MockRepository) List(ctx context.Context, filter product0.ProductFilter, limit, offset int) ([]product0.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "List", ctx, filter, limit, offset)
 	ret0, _ := ret[0].([]product0.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, limit, offset)
-}
-
-// ListByDetailsType mocks base method.
-func (m *MockRepository) ListByDetailsType(ctx context.Context, detailsType string, limit, offset int) ([]product0.Product, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByDetailsType", ctx, detailsType, limit, offset)
-	ret0, _ := ret[0].([]product0.Product)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// ListByDetailsType indicates an expected call of ListByDetailsType.
-func (mr *MockRepositoryMockRecorder) ListByDetailsType(ctx, detailsType, limit, offset any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) List(ctx, filter, limit, offset any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDetailsType", reflect.TypeOf((*MockRepository)(nil).ListByDetailsType), ctx, detailsType, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, filter, limit, offset)
 }
 
 // ListByIDs mocks base method.
@@ -360,19 +362,94 @@ func (mr *MockRepositoryMockRecorder) ListDeleted(ctx, limit, offset any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepository)(nil).ListDeleted), ctx, limit, offset)
 }
 
-// ListUnpublished mocks base method.
-func (m *MockRepository) ListUnpublished(ctx context.Context, limit, offset int) ([]product0.Product, error) {
+// ListRelated mocks base method.
+func (m *MockRepository) ListRelated(ctx context.Context, id string, limit int, now time.Time) ([]product0.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRelated", ctx, id, limit, now)
+	ret0, _ := ret[0].([]product0.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRelated indicates an expected call of ListRelated.
+func (mr *MockRepositoryMockRecorder) ListRelated(ctx, id, limit, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRelated", reflect.TypeOf((*MockRepository)(nil).ListRelated), ctx, id, limit, now)
+}
+
+// ListUpdatedSince mocks base method.
+func (m *MockRepository) ListUpdatedSince(ctx context.Context, after product0.ChangeCursor, limit int) ([]product0.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListUnpublished", ctx, limit, offset)
+	ret := m.ctrl.Call(m, "ListUpdatedSince", ctx, after, limit)
 	ret0, _ := ret[0].([]product0.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListUnpublished indicates an expected call of ListUnpublished.
-func (mr *MockRepositoryMockRecorder) ListUnpublished(ctx, limit, offset any) *gomock.Call {
+// ListUpdatedSince indicates an expected call of ListUpdatedSince.
+func (mr *MockRepositoryMockRecorder) ListUpdatedSince(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpdatedSince", reflect.TypeOf((*MockRepository)(nil).ListUpdatedSince), ctx, after, limit)
+}
+
+// ListWithDeletedByDetailsID mocks base method.
+func (m *MockRepository) ListWithDeletedByDetailsID(ctx context.Context, detailsID string) ([]product0.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithDeletedByDetailsID", ctx, detailsID)
+	ret0, _ := ret[0].([]product0.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithDeletedByDetailsID indicates an expected call of ListWithDeletedByDetailsID.
+func (mr *MockRepositoryMockRecorder) ListWithDeletedByDetailsID(ctx, detailsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithDeletedByDetailsID", reflect.TypeOf((*MockRepository)(nil).ListWithDeletedByDetailsID), ctx, detailsID)
+}
+
+// PublishByDetailsID mocks base method.
+func (m *MockRepository) PublishByDetailsID(ctx context.Context, detailsID string, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishByDetailsID", ctx, detailsID, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishByDetailsID indicates an expected call of PublishByDetailsID.
+func (mr *MockRepositoryMockRecorder) PublishByDetailsID(ctx, detailsID, at any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublished", reflect.TypeOf((*MockRepository)(nil).ListUnpublished), ctx, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishByDetailsID", reflect.TypeOf((*MockRepository)(nil).PublishByDetailsID), ctx, detailsID, at)
+}
+
+// RemoveTag mocks base method.
+func (m *MockRepository) RemoveTag(ctx context.Context, tag string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", ctx, tag)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockRepositoryMockRecorder) RemoveTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockRepository)(nil).RemoveTag), ctx, tag)
+}
+
+// RenameTag mocks base method.
+func (m *MockRepository) RenameTag(ctx context.Context, from, to string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", ctx, from, to)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockRepositoryMockRecorder) RenameTag(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockRepository)(nil).RenameTag), ctx, from, to)
 }
 
 // Restore mocks base method.
@@ -460,6 +537,26 @@ func (mr *MockRepositoryMockRecorder) SelectByDetailsIDs(ctx, detailsIDs any, fi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByDetailsIDs", reflect.TypeOf((*MockRepository)(nil).SelectByDetailsIDs), varargs...)
 }
 
+// SelectByDetailsRefs mocks base method.
+func (m *MockRepository) SelectByDetailsRefs(ctx context.Context, refs []product0.DetailsRef, fields ...string) ([]product0.Product, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, refs}
+	for _, a := range fields {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SelectByDetailsRefs", varargs...)
+	ret0, _ := ret[0].([]product0.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SelectByDetailsRefs indicates an expected call of SelectByDetailsRefs.
+func (mr *MockRepositoryMockRecorder) SelectByDetailsRefs(ctx, refs any, fields ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, refs}, fields...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectByDetailsRefs", reflect.TypeOf((*MockRepository)(nil).SelectByDetailsRefs), varargs...)
+}
+
 // SelectByIDs mocks base method.
 func (m *MockRepository) SelectByIDs(ctx context.Context, ids []string, fields ...string) ([]product0.Product, error) {
 	m.ctrl.T.Helper()
@@ -600,34 +697,64 @@ func (mr *MockRepositoryMockRecorder) SelectWithUnpublishedByIDs(ctx, ids any, f
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectWithUnpublishedByIDs", reflect.TypeOf((*MockRepository)(nil).SelectWithUnpublishedByIDs), varargs...)
 }
 
+// SetAvailability mocks base method.
+func (m *MockRepository) SetAvailability(ctx context.Context, id string, from, until *time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", ctx, id, from, until)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockRepositoryMockRecorder) SetAvailability(ctx, id, from, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockRepository)(nil).SetAvailability), ctx, id, from, until)
+}
+
 // SetInStock mocks base method.
-func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
+func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool, at time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetInStock", ctx, id, inStock)
+	ret := m.ctrl.Call(m, "SetInStock", ctx, id, inStock, at)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetInStock indicates an expected call of SetInStock.
-func (mr *MockRepositoryMockRecorder) SetInStock(ctx, id, inStock any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SetInStock(ctx, id, inStock, at any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInStock", reflect.TypeOf((*MockRepository)(nil).SetInStock), ctx, id, inStock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInStock", reflect.TypeOf((*MockRepository)(nil).SetInStock), ctx, id, inStock, at)
 }
 
 // SetInStockByDetailsID mocks base method.
-func (m *MockRepository) SetInStockByDetailsID(ctx context.Context, detailsID string, inStock bool) (int64, error) {
+func (m *MockRepository) SetInStockByDetailsID(ctx context.Context, detailsID string, inStock bool, at time.Time) (database.SetResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetInStockByDetailsID", ctx, detailsID, inStock)
-	ret0, _ := ret[0].(int64)
+	ret := m.ctrl.Call(m, "SetInStockByDetailsID", ctx, detailsID, inStock, at)
+	ret0, _ := ret[0].(database.SetResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SetInStockByDetailsID indicates an expected call of SetInStockByDetailsID.
-func (mr *MockRepositoryMockRecorder) SetInStockByDetailsID(ctx, detailsID, inStock any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) SetInStockByDetailsID(ctx, detailsID, inStock, at any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInStockByDetailsID", reflect.TypeOf((*MockRepository)(nil).SetInStockByDetailsID), ctx, detailsID, inStock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInStockByDetailsID", reflect.TypeOf((*MockRepository)(nil).SetInStockByDetailsID), ctx, detailsID, inStock, at)
+}
+
+// SetTags mocks base method.
+func (m *MockRepository) SetTags(ctx context.Context, ids, tags []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTags", ctx, ids, tags)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTags indicates an expected call of SetTags.
+func (mr *MockRepositoryMockRecorder) SetTags(ctx, ids, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTags", reflect.TypeOf((*MockRepository)(nil).SetTags), ctx, ids, tags)
 }
 
 // Update mocks base method.
@@ -645,6 +772,39 @@ func (mr *MockRepositoryMockRecorder) Update(ctx, arg1, updates any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, arg1, updates)
 }
 
+// Upsert mocks base method.
+func (m *MockRepository) Upsert(ctx context.Context, arg1 *product0.Product) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockRepositoryMockRecorder) Upsert(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockRepository)(nil).Upsert), ctx, arg1)
+}
+
+// UpsertBatch mocks base method.
+func (m *MockRepository) UpsertBatch(ctx context.Context, products ...*product0.Product) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range products {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpsertBatch", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertBatch indicates an expected call of UpsertBatch.
+func (mr *MockRepositoryMockRecorder) UpsertBatch(ctx any, products ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, products...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBatch", reflect.TypeOf((*MockRepository)(nil).UpsertBatch), varargs...)
+}
+
 // WithTx mocks base method.
 func (m *MockRepository) WithTx(tx *gorm.DB) product.Repository {
 	m.ctrl.T.Helper()
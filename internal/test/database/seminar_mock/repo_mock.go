@@ -12,7 +12,9 @@ package seminar_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	database "github.com/mikhail5545/product-service-go/internal/database"
 	seminar "github.com/mikhail5545/product-service-go/internal/database/seminar"
 	image "github.com/mikhail5545/product-service-go/internal/models/image"
 	seminar0 "github.com/mikhail5545/product-service-go/internal/models/seminar"
@@ -117,6 +119,21 @@ func (mr *MockRepositoryMockRecorder) CountDeleted(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeleted", reflect.TypeOf((*MockRepository)(nil).CountDeleted), ctx)
 }
 
+// CountDeletedBefore mocks base method.
+func (m *MockRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockRepositoryMockRecorder) CountDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockRepository)(nil).CountDeletedBefore), ctx, before)
+}
+
 // CountUnpublished mocks base method.
 func (m *MockRepository) CountUnpublished(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -176,18 +193,18 @@ func (mr *MockRepositoryMockRecorder) DecrementImageCount(ctx, seminarIDs any) *
 }
 
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, id string) (int64, error) {
+func (m *MockRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id, reason)
 }
 
 // DeleteImage mocks base method.
@@ -204,6 +221,21 @@ func (mr *MockRepositoryMockRecorder) DeleteImage(ctx, arg1, mediaSvcID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockRepository)(nil).DeleteImage), ctx, arg1, mediaSvcID)
 }
 
+// DeleteImageAssociations mocks base method.
+func (m *MockRepository) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImageAssociations", ctx, mediaSvcID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageAssociations indicates an expected call of DeleteImageAssociations.
+func (mr *MockRepositoryMockRecorder) DeleteImageAssociations(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageAssociations", reflect.TypeOf((*MockRepository)(nil).DeleteImageAssociations), ctx, mediaSvcID)
+}
+
 // DeleteImageBatch mocks base method.
 func (m *MockRepository) DeleteImageBatch(ctx context.Context, seminars []seminar0.Seminar, arg2 *image.Image) error {
 	m.ctrl.T.Helper()
@@ -233,6 +265,21 @@ func (mr *MockRepositoryMockRecorder) DeletePermanent(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockRepository)(nil).DeletePermanent), ctx, id)
 }
 
+// DeletePermanentByIDs mocks base method.
+func (m *MockRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermanentByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePermanentByIDs indicates an expected call of DeletePermanentByIDs.
+func (mr *MockRepositoryMockRecorder) DeletePermanentByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByIDs", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByIDs), ctx, ids)
+}
+
 // FindOwnerIDsByImageID mocks base method.
 func (m *MockRepository) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +310,21 @@ func (mr *MockRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockRepository)(nil).Get), ctx, id)
 }
 
+// GetBySlug mocks base method.
+func (m *MockRepository) GetBySlug(ctx context.Context, slug string) (*seminar0.Seminar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(*seminar0.Seminar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockRepositoryMockRecorder) GetBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockRepository)(nil).GetBySlug), ctx, slug)
+}
+
 // GetWithDeleted mocks base method.
 func (m *MockRepository) GetWithDeleted(ctx context.Context, id string) (*seminar0.Seminar, error) {
 	m.ctrl.T.Helper()
@@ -278,6 +340,21 @@ func (mr *MockRepositoryMockRecorder) GetWithDeleted(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithDeleted", reflect.TypeOf((*MockRepository)(nil).GetWithDeleted), ctx, id)
 }
 
+// GetWithDeletedBySlug mocks base method.
+func (m *MockRepository) GetWithDeletedBySlug(ctx context.Context, slug string) (*seminar0.Seminar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithDeletedBySlug", ctx, slug)
+	ret0, _ := ret[0].(*seminar0.Seminar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithDeletedBySlug indicates an expected call of GetWithDeletedBySlug.
+func (mr *MockRepositoryMockRecorder) GetWithDeletedBySlug(ctx, slug any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithDeletedBySlug", reflect.TypeOf((*MockRepository)(nil).GetWithDeletedBySlug), ctx, slug)
+}
+
 // GetWithUnpublished mocks base method.
 func (m *MockRepository) GetWithUnpublished(ctx context.Context, id string) (*seminar0.Seminar, error) {
 	m.ctrl.T.Helper()
@@ -308,6 +385,46 @@ func (mr *MockRepositoryMockRecorder) List(ctx, limit, offset any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, limit, offset)
 }
 
+// ListByIDs mocks base method.
+func (m *MockRepository) ListByIDs(ctx context.Context, ids ...string) ([]seminar0.Seminar, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByIDs", varargs...)
+	ret0, _ := ret[0].([]seminar0.Seminar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockRepositoryMockRecorder) ListByIDs(ctx any, ids ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockRepository)(nil).ListByIDs), varargs...)
+}
+
+// ListByLatePaymentDateBetween mocks base method.
+func (m *MockRepository) ListByLatePaymentDateBetween(ctx context.Context, from, to time.Time, fields ...string) ([]seminar0.Seminar, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, from, to}
+	for _, a := range fields {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListByLatePaymentDateBetween", varargs...)
+	ret0, _ := ret[0].([]seminar0.Seminar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByLatePaymentDateBetween indicates an expected call of ListByLatePaymentDateBetween.
+func (mr *MockRepositoryMockRecorder) ListByLatePaymentDateBetween(ctx, from, to any, fields ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, from, to}, fields...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByLatePaymentDateBetween", reflect.TypeOf((*MockRepository)(nil).ListByLatePaymentDateBetween), varargs...)
+}
+
 // ListDeleted mocks base method.
 func (m *MockRepository) ListDeleted(ctx context.Context, limit, offset int) ([]seminar0.Seminar, error) {
 	m.ctrl.T.Helper()
@@ -323,6 +440,51 @@ func (mr *MockRepositoryMockRecorder) ListDeleted(ctx, limit, offset any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepository)(nil).ListDeleted), ctx, limit, offset)
 }
 
+// ListDeletedIDsBefore mocks base method.
+func (m *MockRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedIDsBefore", ctx, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedIDsBefore indicates an expected call of ListDeletedIDsBefore.
+func (mr *MockRepositoryMockRecorder) ListDeletedIDsBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedIDsBefore", reflect.TypeOf((*MockRepository)(nil).ListDeletedIDsBefore), ctx, before)
+}
+
+// ListOwnerIDsByImageID mocks base method.
+func (m *MockRepository) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwnerIDsByImageID", ctx, mediaSvcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwnerIDsByImageID indicates an expected call of ListOwnerIDsByImageID.
+func (mr *MockRepositoryMockRecorder) ListOwnerIDsByImageID(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwnerIDsByImageID", reflect.TypeOf((*MockRepository)(nil).ListOwnerIDsByImageID), ctx, mediaSvcID)
+}
+
+// ListSlugsByPrefix mocks base method.
+func (m *MockRepository) ListSlugsByPrefix(ctx context.Context, base string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSlugsByPrefix", ctx, base)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSlugsByPrefix indicates an expected call of ListSlugsByPrefix.
+func (mr *MockRepositoryMockRecorder) ListSlugsByPrefix(ctx, base any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSlugsByPrefix", reflect.TypeOf((*MockRepository)(nil).ListSlugsByPrefix), ctx, base)
+}
+
 // ListUnpublished mocks base method.
 func (m *MockRepository) ListUnpublished(ctx context.Context, limit, offset int) ([]seminar0.Seminar, error) {
 	m.ctrl.T.Helper()
@@ -338,6 +500,21 @@ func (mr *MockRepositoryMockRecorder) ListUnpublished(ctx, limit, offset any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublished", reflect.TypeOf((*MockRepository)(nil).ListUnpublished), ctx, limit, offset)
 }
 
+// ListWithUnpublished mocks base method.
+func (m *MockRepository) ListWithUnpublished(ctx context.Context, limit, offset int) ([]seminar0.Seminar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithUnpublished", ctx, limit, offset)
+	ret0, _ := ret[0].([]seminar0.Seminar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWithUnpublished indicates an expected call of ListWithUnpublished.
+func (mr *MockRepositoryMockRecorder) ListWithUnpublished(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithUnpublished", reflect.TypeOf((*MockRepository)(nil).ListWithUnpublished), ctx, limit, offset)
+}
+
 // ListWithUnpublishedByIDs mocks base method.
 func (m *MockRepository) ListWithUnpublishedByIDs(ctx context.Context, ids ...string) ([]seminar0.Seminar, error) {
 	m.ctrl.T.Helper()
@@ -394,10 +571,10 @@ func (mr *MockRepositoryMockRecorder) Select(ctx, id any, fields ...any) *gomock
 }
 
 // SetInStock mocks base method.
-func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
+func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetInStock", ctx, id, inStock)
-	ret0, _ := ret[0].(int64)
+	ret0, _ := ret[0].(database.SetResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
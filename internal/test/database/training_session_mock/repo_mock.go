@@ -12,7 +12,9 @@ package training_session_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	database "github.com/mikhail5545/product-service-go/internal/database"
 	trainingsession "github.com/mikhail5545/product-service-go/internal/database/training_session"
 	image "github.com/mikhail5545/product-service-go/internal/models/image"
 	trainingsession0 "github.com/mikhail5545/product-service-go/internal/models/training_session"
@@ -117,6 +119,21 @@ func (mr *MockRepositoryMockRecorder) CountDeleted(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeleted", reflect.TypeOf((*MockRepository)(nil).CountDeleted), ctx)
 }
 
+// CountDeletedBefore mocks base method.
+func (m *MockRepository) CountDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockRepositoryMockRecorder) CountDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockRepository)(nil).CountDeletedBefore), ctx, before)
+}
+
 // CountUnpublished mocks base method.
 func (m *MockRepository) CountUnpublished(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -176,18 +193,18 @@ func (mr *MockRepositoryMockRecorder) DecrementImageCount(ctx, tsIDs any) *gomoc
 }
 
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, id string) (int64, error) {
+func (m *MockRepository) Delete(ctx context.Context, id, reason string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id, reason)
 }
 
 // DeleteImage mocks base method.
@@ -204,6 +221,21 @@ func (mr *MockRepositoryMockRecorder) DeleteImage(ctx, ts, mediaSvcID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockRepository)(nil).DeleteImage), ctx, ts, mediaSvcID)
 }
 
+// DeleteImageAssociations mocks base method.
+func (m *MockRepository) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImageAssociations", ctx, mediaSvcID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageAssociations indicates an expected call of DeleteImageAssociations.
+func (mr *MockRepositoryMockRecorder) DeleteImageAssociations(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageAssociations", reflect.TypeOf((*MockRepository)(nil).DeleteImageAssociations), ctx, mediaSvcID)
+}
+
 // DeleteImageBatch mocks base method.
 func (m *MockRepository) DeleteImageBatch(ctx context.Context, ts []trainingsession0.TrainingSession, arg2 *image.Image) error {
 	m.ctrl.T.Helper()
@@ -233,6 +265,21 @@ func (mr *MockRepositoryMockRecorder) DeletePermanent(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockRepository)(nil).DeletePermanent), ctx, id)
 }
 
+// DeletePermanentByIDs mocks base method.
+func (m *MockRepository) DeletePermanentByIDs(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePermanentByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePermanentByIDs indicates an expected call of DeletePermanentByIDs.
+func (mr *MockRepositoryMockRecorder) DeletePermanentByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanentByIDs", reflect.TypeOf((*MockRepository)(nil).DeletePermanentByIDs), ctx, ids)
+}
+
 // FindOwnerIDsByImageID mocks base method.
 func (m *MockRepository) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -323,6 +370,36 @@ func (mr *MockRepositoryMockRecorder) ListDeleted(ctx, limit, offset any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepository)(nil).ListDeleted), ctx, limit, offset)
 }
 
+// ListDeletedIDsBefore mocks base method.
+func (m *MockRepository) ListDeletedIDsBefore(ctx context.Context, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeletedIDsBefore", ctx, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeletedIDsBefore indicates an expected call of ListDeletedIDsBefore.
+func (mr *MockRepositoryMockRecorder) ListDeletedIDsBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeletedIDsBefore", reflect.TypeOf((*MockRepository)(nil).ListDeletedIDsBefore), ctx, before)
+}
+
+// ListOwnerIDsByImageID mocks base method.
+func (m *MockRepository) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwnerIDsByImageID", ctx, mediaSvcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwnerIDsByImageID indicates an expected call of ListOwnerIDsByImageID.
+func (mr *MockRepositoryMockRecorder) ListOwnerIDsByImageID(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwnerIDsByImageID", reflect.TypeOf((*MockRepository)(nil).ListOwnerIDsByImageID), ctx, mediaSvcID)
+}
+
 // ListUnpublished mocks base method.
 func (m *MockRepository) ListUnpublished(ctx context.Context, limit, offset int) ([]trainingsession0.TrainingSession, error) {
 	m.ctrl.T.Helper()
@@ -394,10 +471,10 @@ func (mr *MockRepositoryMockRecorder) Select(ctx, id any, fields ...any) *gomock
 }
 
 // SetInStock mocks base method.
-func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool) (int64, error) {
+func (m *MockRepository) SetInStock(ctx context.Context, id string, inStock bool) (database.SetResult, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetInStock", ctx, id, inStock)
-	ret0, _ := ret[0].(int64)
+	ret0, _ := ret[0].(database.SetResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
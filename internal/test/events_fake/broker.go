@@ -0,0 +1,140 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package events_fake provides an in-process NATS server speaking just enough of the protocol
+// to test event publishers.
+package events_fake
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// Message is a message received by [Broker].
+type Message struct {
+	Subject string
+	Data    []byte
+}
+
+// Broker is a fake NATS server recording every published message.
+type Broker struct {
+	listener net.Listener
+
+	mu       sync.Mutex
+	messages []Message
+	conns    []net.Conn
+}
+
+// NewBroker starts a broker on a random local port. It is stopped when the test finishes.
+func NewBroker(t *testing.T) *Broker {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to start fake NATS broker: %v", err)
+	}
+	b := &Broker{listener: listener}
+	go b.serve()
+	t.Cleanup(b.Stop)
+	return b
+}
+
+// Addr returns the host:port the broker listens on.
+func (b *Broker) Addr() string {
+	return b.listener.Addr().String()
+}
+
+// URL returns the nats:// URL of the broker.
+func (b *Broker) URL() string {
+	return "nats://" + b.Addr()
+}
+
+// Messages returns the messages received so far.
+func (b *Broker) Messages() []Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]Message(nil), b.messages...)
+}
+
+// Stop closes the listener and every client connection, simulating a broker outage.
+func (b *Broker) Stop() {
+	_ = b.listener.Close()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, conn := range b.conns {
+		_ = conn.Close()
+	}
+	b.conns = nil
+}
+
+func (b *Broker) serve() {
+	for {
+		conn, err := b.listener.Accept()
+		if err != nil {
+			return
+		}
+		b.mu.Lock()
+		b.conns = append(b.conns, conn)
+		b.mu.Unlock()
+		go b.handle(conn)
+	}
+}
+
+func (b *Broker) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	if _, err := fmt.Fprint(conn, "INFO {\"server_id\":\"fake\",\"max_payload\":1048576}\r\n"); err != nil {
+		return
+	}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "PING":
+			if _, err := fmt.Fprint(conn, "PONG\r\n"); err != nil {
+				return
+			}
+		case "PUB":
+			if len(fields) != 3 {
+				fmt.Fprint(conn, "-ERR 'Unknown Protocol Operation'\r\n")
+				return
+			}
+			size, err := strconv.Atoi(fields[2])
+			if err != nil {
+				return
+			}
+			data := make([]byte, size+2)
+			if _, err := io.ReadFull(r, data); err != nil {
+				return
+			}
+			b.mu.Lock()
+			b.messages = append(b.messages, Message{Subject: fields[1], Data: data[:size]})
+			b.mu.Unlock()
+		}
+	}
+}
@@ -12,6 +12,7 @@ package course_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	course "github.com/mikhail5545/product-service-go/internal/models/course"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CountDeletedBefore mocks base method.
+func (m *MockService) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockServiceMockRecorder) CountDeletedBefore(ctx, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockService)(nil).CountDeletedBefore), ctx, cutoff)
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, req *course.CreateRequest) (*course.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -57,31 +73,32 @@ func (mr *MockServiceMockRecorder) Create(ctx, req any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockService) Delete(ctx context.Context, id string) error {
+func (m *MockService) Delete(ctx context.Context, id, reason string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id, reason)
 }
 
 // DeletePermanent mocks base method.
-func (m *MockService) DeletePermanent(ctx context.Context, id string) error {
+func (m *MockService) DeletePermanent(ctx context.Context, id string, force bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePermanent", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePermanent", ctx, id, force)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePermanent indicates an expected call of DeletePermanent.
-func (mr *MockServiceMockRecorder) DeletePermanent(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) DeletePermanent(ctx, id, force any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockService)(nil).DeletePermanent), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockService)(nil).DeletePermanent), ctx, id, force)
 }
 
 // Get mocks base method.
@@ -99,6 +116,21 @@ func (mr *MockServiceMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), ctx, id)
 }
 
+// GetFull mocks base method.
+func (m *MockService) GetFull(ctx context.Context, id string) (*course.CourseFullDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFull", ctx, id)
+	ret0, _ := ret[0].(*course.CourseFullDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFull indicates an expected call of GetFull.
+func (mr *MockServiceMockRecorder) GetFull(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFull", reflect.TypeOf((*MockService)(nil).GetFull), ctx, id)
+}
+
 // GetReduced mocks base method.
 func (m *MockService) GetReduced(ctx context.Context, id string) (*course.CourseDetails, error) {
 	m.ctrl.T.Helper()
@@ -221,12 +253,28 @@ func (mr *MockServiceMockRecorder) Publish(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockService)(nil).Publish), ctx, id)
 }
 
+// PurgeDeletedBefore mocks base method.
+func (m *MockService) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockServiceMockRecorder) PurgeDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockService)(nil).PurgeDeletedBefore), ctx, before)
+}
+
 // Restore mocks base method.
-func (m *MockService) Restore(ctx context.Context, id string) error {
+func (m *MockService) Restore(ctx context.Context, id string) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Restore", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Restore indicates an expected call of Restore.
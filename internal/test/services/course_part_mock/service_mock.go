@@ -12,6 +12,7 @@ package course_part_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	coursepart "github.com/mikhail5545/product-service-go/internal/models/course_part"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CountDeletedBefore mocks base method.
+func (m *MockService) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockServiceMockRecorder) CountDeletedBefore(ctx, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockService)(nil).CountDeletedBefore), ctx, cutoff)
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, req *coursepart.CreateRequest) (*coursepart.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,18 +72,33 @@ func (mr *MockServiceMockRecorder) Create(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, req)
 }
 
+// CreateBatch mocks base method.
+func (m *MockService) CreateBatch(ctx context.Context, courseID string, reqs []*coursepart.CreateRequest) ([]*coursepart.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, courseID, reqs)
+	ret0, _ := ret[0].([]*coursepart.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockServiceMockRecorder) CreateBatch(ctx, courseID, reqs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockService)(nil).CreateBatch), ctx, courseID, reqs)
+}
+
 // Delete mocks base method.
-func (m *MockService) Delete(ctx context.Context, id string) error {
+func (m *MockService) Delete(ctx context.Context, id, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id, reason)
 }
 
 // DeletePermanent mocks base method.
@@ -85,10 +116,10 @@ func (mr *MockServiceMockRecorder) DeletePermanent(ctx, id any) *gomock.Call {
 }
 
 // Get mocks base method.
-func (m *MockService) Get(ctx context.Context, id string) (*coursepart.CoursePart, error) {
+func (m *MockService) Get(ctx context.Context, id string) (*coursepart.CoursePartDetails, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, id)
-	ret0, _ := ret[0].(*coursepart.CoursePart)
+	ret0, _ := ret[0].(*coursepart.CoursePartDetails)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -175,10 +206,10 @@ func (mr *MockServiceMockRecorder) GetWithUnpublishedReduced(ctx, id any) *gomoc
 }
 
 // List mocks base method.
-func (m *MockService) List(ctx context.Context, courseID string, limit, offset int) ([]coursepart.CoursePart, int64, error) {
+func (m *MockService) List(ctx context.Context, courseID string, limit, offset int) ([]coursepart.CoursePartDetails, int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "List", ctx, courseID, limit, offset)
-	ret0, _ := ret[0].([]coursepart.CoursePart)
+	ret0, _ := ret[0].([]coursepart.CoursePartDetails)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -238,6 +269,21 @@ func (mr *MockServiceMockRecorder) ListUnpublished(ctx, courseID, limit, offset
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublished", reflect.TypeOf((*MockService)(nil).ListUnpublished), ctx, courseID, limit, offset)
 }
 
+// NextPosition mocks base method.
+func (m *MockService) NextPosition(ctx context.Context, courseID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextPosition", ctx, courseID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextPosition indicates an expected call of NextPosition.
+func (mr *MockServiceMockRecorder) NextPosition(ctx, courseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextPosition", reflect.TypeOf((*MockService)(nil).NextPosition), ctx, courseID)
+}
+
 // Publish mocks base method.
 func (m *MockService) Publish(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -252,6 +298,35 @@ func (mr *MockServiceMockRecorder) Publish(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockService)(nil).Publish), ctx, id)
 }
 
+// PurgeDeletedBefore mocks base method.
+func (m *MockService) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockServiceMockRecorder) PurgeDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockService)(nil).PurgeDeletedBefore), ctx, before)
+}
+
+// Resequence mocks base method.
+func (m *MockService) Resequence(ctx context.Context, courseID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resequence", ctx, courseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resequence indicates an expected call of Resequence.
+func (mr *MockServiceMockRecorder) Resequence(ctx, courseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resequence", reflect.TypeOf((*MockService)(nil).Resequence), ctx, courseID)
+}
+
 // Restore mocks base method.
 func (m *MockService) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
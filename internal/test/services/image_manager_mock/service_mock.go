@@ -71,6 +71,21 @@ func (mr *MockServiceMockRecorder) AddImageBatch(ctx, req, ownerRepo any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageBatch", reflect.TypeOf((*MockService)(nil).AddImageBatch), ctx, req, ownerRepo)
 }
 
+// AddImagesBatch mocks base method.
+func (m *MockService) AddImagesBatch(ctx context.Context, items []*image.AddRequest, ownerRepo image_owner.OwnerRepo[image_owner.Owner]) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImagesBatch", ctx, items, ownerRepo)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddImagesBatch indicates an expected call of AddImagesBatch.
+func (mr *MockServiceMockRecorder) AddImagesBatch(ctx, items, ownerRepo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImagesBatch", reflect.TypeOf((*MockService)(nil).AddImagesBatch), ctx, items, ownerRepo)
+}
+
 // DeleteImage mocks base method.
 func (m *MockService) DeleteImage(ctx context.Context, req *image.DeleteRequest, ownerRepo image_owner.OwnerRepo[image_owner.Owner]) error {
 	m.ctrl.T.Helper()
@@ -99,3 +114,23 @@ func (mr *MockServiceMockRecorder) DeleteImageBatch(ctx, req, ownerRepo any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageBatch", reflect.TypeOf((*MockService)(nil).DeleteImageBatch), ctx, req, ownerRepo)
 }
+
+// DeleteImageFromAllOwners mocks base method.
+func (m *MockService) DeleteImageFromAllOwners(ctx context.Context, mediaSvcID string, ownerRepos ...image_owner.OwnerRepo[image_owner.Owner]) (int64, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, mediaSvcID}
+	for _, a := range ownerRepos {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteImageFromAllOwners", varargs...)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageFromAllOwners indicates an expected call of DeleteImageFromAllOwners.
+func (mr *MockServiceMockRecorder) DeleteImageFromAllOwners(ctx, mediaSvcID any, ownerRepos ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, mediaSvcID}, ownerRepos...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageFromAllOwners", reflect.TypeOf((*MockService)(nil).DeleteImageFromAllOwners), varargs...)
+}
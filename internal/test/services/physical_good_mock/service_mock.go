@@ -12,6 +12,7 @@ package physical_good_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	physicalgood "github.com/mikhail5545/product-service-go/internal/models/physical_good"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,36 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AdjustStockBatch mocks base method.
+func (m *MockService) AdjustStockBatch(ctx context.Context, adjustments map[string]int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdjustStockBatch", ctx, adjustments)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdjustStockBatch indicates an expected call of AdjustStockBatch.
+func (mr *MockServiceMockRecorder) AdjustStockBatch(ctx, adjustments any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdjustStockBatch", reflect.TypeOf((*MockService)(nil).AdjustStockBatch), ctx, adjustments)
+}
+
+// CountDeletedBefore mocks base method.
+func (m *MockService) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockServiceMockRecorder) CountDeletedBefore(ctx, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockService)(nil).CountDeletedBefore), ctx, cutoff)
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, req *physicalgood.CreateRequest) (*physicalgood.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -57,31 +88,46 @@ func (mr *MockServiceMockRecorder) Create(ctx, req any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockService) Delete(ctx context.Context, id string) error {
+func (m *MockService) Delete(ctx context.Context, id, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id, reason)
+}
+
+// DeleteBatch mocks base method.
+func (m *MockService) DeleteBatch(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatch", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBatch indicates an expected call of DeleteBatch.
+func (mr *MockServiceMockRecorder) DeleteBatch(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatch", reflect.TypeOf((*MockService)(nil).DeleteBatch), ctx, ids)
 }
 
 // DeletePermanent mocks base method.
-func (m *MockService) DeletePermanent(ctx context.Context, id string) error {
+func (m *MockService) DeletePermanent(ctx context.Context, id string, force bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePermanent", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePermanent", ctx, id, force)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePermanent indicates an expected call of DeletePermanent.
-func (mr *MockServiceMockRecorder) DeletePermanent(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) DeletePermanent(ctx, id, force any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockService)(nil).DeletePermanent), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockService)(nil).DeletePermanent), ctx, id, force)
 }
 
 // Get mocks base method.
@@ -99,6 +145,21 @@ func (mr *MockServiceMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), ctx, id)
 }
 
+// GetBySKU mocks base method.
+func (m *MockService) GetBySKU(ctx context.Context, sku string) (*physicalgood.PhysicalGoodDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySKU", ctx, sku)
+	ret0, _ := ret[0].(*physicalgood.PhysicalGoodDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySKU indicates an expected call of GetBySKU.
+func (mr *MockServiceMockRecorder) GetBySKU(ctx, sku any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySKU", reflect.TypeOf((*MockService)(nil).GetBySKU), ctx, sku)
+}
+
 // GetWithDeleted mocks base method.
 func (m *MockService) GetWithDeleted(ctx context.Context, id string) (*physicalgood.PhysicalGoodDetails, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +252,21 @@ func (mr *MockServiceMockRecorder) Publish(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockService)(nil).Publish), ctx, id)
 }
 
+// PurgeDeletedBefore mocks base method.
+func (m *MockService) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockServiceMockRecorder) PurgeDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockService)(nil).PurgeDeletedBefore), ctx, before)
+}
+
 // Restore mocks base method.
 func (m *MockService) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -205,6 +281,21 @@ func (mr *MockServiceMockRecorder) Restore(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockService)(nil).Restore), ctx, id)
 }
 
+// RestoreBatch mocks base method.
+func (m *MockService) RestoreBatch(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBatch", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBatch indicates an expected call of RestoreBatch.
+func (mr *MockServiceMockRecorder) RestoreBatch(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBatch", reflect.TypeOf((*MockService)(nil).RestoreBatch), ctx, ids)
+}
+
 // Unpublish mocks base method.
 func (m *MockService) Unpublish(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package product_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	product "github.com/mikhail5545/product-service-go/internal/models/product"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,66 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AddTagsBatch mocks base method.
+func (m *MockService) AddTagsBatch(ctx context.Context, ids, tags []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTagsBatch", ctx, ids, tags)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTagsBatch indicates an expected call of AddTagsBatch.
+func (mr *MockServiceMockRecorder) AddTagsBatch(ctx, ids, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTagsBatch", reflect.TypeOf((*MockService)(nil).AddTagsBatch), ctx, ids, tags)
+}
+
+// CountByTag mocks base method.
+func (m *MockService) CountByTag(ctx context.Context, tag string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByTag", ctx, tag)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByTag indicates an expected call of CountByTag.
+func (mr *MockServiceMockRecorder) CountByTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByTag", reflect.TypeOf((*MockService)(nil).CountByTag), ctx, tag)
+}
+
+// CountFiltered mocks base method.
+func (m *MockService) CountFiltered(ctx context.Context, filter product.ProductFilter) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountFiltered", ctx, filter)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountFiltered indicates an expected call of CountFiltered.
+func (mr *MockServiceMockRecorder) CountFiltered(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountFiltered", reflect.TypeOf((*MockService)(nil).CountFiltered), ctx, filter)
+}
+
+// Create mocks base method.
+func (m *MockService) Create(ctx context.Context, req *product.AddRequest) (*product.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, req)
+	ret0, _ := ret[0].(*product.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockServiceMockRecorder) Create(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, req)
+}
+
 // Get mocks base method.
 func (m *MockService) Get(ctx context.Context, id string) (*product.Product, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +132,36 @@ func (mr *MockServiceMockRecorder) GetByDetailsID(ctx, detailsID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByDetailsID", reflect.TypeOf((*MockService)(nil).GetByDetailsID), ctx, detailsID)
 }
 
+// GetDetailsBatch mocks base method.
+func (m *MockService) GetDetailsBatch(ctx context.Context, productIDs []string) ([]product.ProductWithDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDetailsBatch", ctx, productIDs)
+	ret0, _ := ret[0].([]product.ProductWithDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDetailsBatch indicates an expected call of GetDetailsBatch.
+func (mr *MockServiceMockRecorder) GetDetailsBatch(ctx, productIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDetailsBatch", reflect.TypeOf((*MockService)(nil).GetDetailsBatch), ctx, productIDs)
+}
+
+// GetOwner mocks base method.
+func (m *MockService) GetOwner(ctx context.Context, id string) (*product.ProductOwner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwner", ctx, id)
+	ret0, _ := ret[0].(*product.ProductOwner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwner indicates an expected call of GetOwner.
+func (mr *MockServiceMockRecorder) GetOwner(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwner", reflect.TypeOf((*MockService)(nil).GetOwner), ctx, id)
+}
+
 // GetWithDeleted mocks base method.
 func (m *MockService) GetWithDeleted(ctx context.Context, id string) (*product.Product, error) {
 	m.ctrl.T.Helper()
@@ -147,20 +238,51 @@ func (mr *MockServiceMockRecorder) List(ctx, limit, offset any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockService)(nil).List), ctx, limit, offset)
 }
 
-// ListByDetailsType mocks base method.
-func (m *MockService) ListByDetailsType(ctx context.Context, detailsType string, limit, offset int) ([]product.Product, int64, error) {
+// ListAvailable mocks base method.
+func (m *MockService) ListAvailable(ctx context.Context, limit, offset int) ([]product.Product, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListByDetailsType", ctx, detailsType, limit, offset)
+	ret := m.ctrl.Call(m, "ListAvailable", ctx, limit, offset)
 	ret0, _ := ret[0].([]product.Product)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// ListByDetailsType indicates an expected call of ListByDetailsType.
-func (mr *MockServiceMockRecorder) ListByDetailsType(ctx, detailsType, limit, offset any) *gomock.Call {
+// ListAvailable indicates an expected call of ListAvailable.
+func (mr *MockServiceMockRecorder) ListAvailable(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailable", reflect.TypeOf((*MockService)(nil).ListAvailable), ctx, limit, offset)
+}
+
+// ListByOwnerAllStates mocks base method.
+func (m *MockService) ListByOwnerAllStates(ctx context.Context, detailsID string) ([]product.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByOwnerAllStates", ctx, detailsID)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByOwnerAllStates indicates an expected call of ListByOwnerAllStates.
+func (mr *MockServiceMockRecorder) ListByOwnerAllStates(ctx, detailsID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDetailsType", reflect.TypeOf((*MockService)(nil).ListByDetailsType), ctx, detailsType, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByOwnerAllStates", reflect.TypeOf((*MockService)(nil).ListByOwnerAllStates), ctx, detailsID)
+}
+
+// ListByType mocks base method.
+func (m *MockService) ListByType(ctx context.Context, detailsType string, limit, offset int) ([]product.Product, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByType", ctx, detailsType, limit, offset)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByType indicates an expected call of ListByType.
+func (mr *MockServiceMockRecorder) ListByType(ctx, detailsType, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByType", reflect.TypeOf((*MockService)(nil).ListByType), ctx, detailsType, limit, offset)
 }
 
 // ListDeleted mocks base method.
@@ -179,6 +301,54 @@ func (mr *MockServiceMockRecorder) ListDeleted(ctx, limit, offset any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockService)(nil).ListDeleted), ctx, limit, offset)
 }
 
+// ListFiltered mocks base method.
+func (m *MockService) ListFiltered(ctx context.Context, filter product.ProductFilter, limit, offset int) ([]product.Product, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFiltered", ctx, filter, limit, offset)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListFiltered indicates an expected call of ListFiltered.
+func (mr *MockServiceMockRecorder) ListFiltered(ctx, filter, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFiltered", reflect.TypeOf((*MockService)(nil).ListFiltered), ctx, filter, limit, offset)
+}
+
+// ListModifiedSince mocks base method.
+func (m *MockService) ListModifiedSince(ctx context.Context, updatedSince string, limit, offset int) ([]product.Product, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListModifiedSince", ctx, updatedSince, limit, offset)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListModifiedSince indicates an expected call of ListModifiedSince.
+func (mr *MockServiceMockRecorder) ListModifiedSince(ctx, updatedSince, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListModifiedSince", reflect.TypeOf((*MockService)(nil).ListModifiedSince), ctx, updatedSince, limit, offset)
+}
+
+// ListNeverPublished mocks base method.
+func (m *MockService) ListNeverPublished(ctx context.Context, limit, offset int) ([]product.Product, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNeverPublished", ctx, limit, offset)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListNeverPublished indicates an expected call of ListNeverPublished.
+func (mr *MockServiceMockRecorder) ListNeverPublished(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNeverPublished", reflect.TypeOf((*MockService)(nil).ListNeverPublished), ctx, limit, offset)
+}
+
 // ListUnpublished mocks base method.
 func (m *MockService) ListUnpublished(ctx context.Context, limit, offset int) ([]product.Product, int64, error) {
 	m.ctrl.T.Helper()
@@ -194,3 +364,168 @@ func (mr *MockServiceMockRecorder) ListUnpublished(ctx, limit, offset any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublished", reflect.TypeOf((*MockService)(nil).ListUnpublished), ctx, limit, offset)
 }
+
+// ListUpdatedSince mocks base method.
+func (m *MockService) ListUpdatedSince(ctx context.Context, after product.ChangeCursor, limit int) ([]product.Product, product.ChangeCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUpdatedSince", ctx, after, limit)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(product.ChangeCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUpdatedSince indicates an expected call of ListUpdatedSince.
+func (mr *MockServiceMockRecorder) ListUpdatedSince(ctx, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUpdatedSince", reflect.TypeOf((*MockService)(nil).ListUpdatedSince), ctx, after, limit)
+}
+
+// ListWithUnpublished mocks base method.
+func (m *MockService) ListWithUnpublished(ctx context.Context, limit, offset int) ([]product.Product, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWithUnpublished", ctx, limit, offset)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListWithUnpublished indicates an expected call of ListWithUnpublished.
+func (mr *MockServiceMockRecorder) ListWithUnpublished(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithUnpublished", reflect.TypeOf((*MockService)(nil).ListWithUnpublished), ctx, limit, offset)
+}
+
+// Recategorize mocks base method.
+func (m *MockService) Recategorize(ctx context.Context, productID, newDetailsType, newDetailsID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recategorize", ctx, productID, newDetailsType, newDetailsID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Recategorize indicates an expected call of Recategorize.
+func (mr *MockServiceMockRecorder) Recategorize(ctx, productID, newDetailsType, newDetailsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recategorize", reflect.TypeOf((*MockService)(nil).Recategorize), ctx, productID, newDetailsType, newDetailsID)
+}
+
+// Related mocks base method.
+func (m *MockService) Related(ctx context.Context, id string, limit int) ([]product.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Related", ctx, id, limit)
+	ret0, _ := ret[0].([]product.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Related indicates an expected call of Related.
+func (mr *MockServiceMockRecorder) Related(ctx, id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Related", reflect.TypeOf((*MockService)(nil).Related), ctx, id, limit)
+}
+
+// RemoveTagGlobally mocks base method.
+func (m *MockService) RemoveTagGlobally(ctx context.Context, tag string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagGlobally", ctx, tag)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveTagGlobally indicates an expected call of RemoveTagGlobally.
+func (mr *MockServiceMockRecorder) RemoveTagGlobally(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagGlobally", reflect.TypeOf((*MockService)(nil).RemoveTagGlobally), ctx, tag)
+}
+
+// RenameTag mocks base method.
+func (m *MockService) RenameTag(ctx context.Context, from, to string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTag", ctx, from, to)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTag indicates an expected call of RenameTag.
+func (mr *MockServiceMockRecorder) RenameTag(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTag", reflect.TypeOf((*MockService)(nil).RenameTag), ctx, from, to)
+}
+
+// ResolvePrice mocks base method.
+func (m *MockService) ResolvePrice(ctx context.Context, productID string, now time.Time) (float32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePrice", ctx, productID, now)
+	ret0, _ := ret[0].(float32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolvePrice indicates an expected call of ResolvePrice.
+func (mr *MockServiceMockRecorder) ResolvePrice(ctx, productID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePrice", reflect.TypeOf((*MockService)(nil).ResolvePrice), ctx, productID, now)
+}
+
+// ResolvePrices mocks base method.
+func (m *MockService) ResolvePrices(ctx context.Context, productIDs []string, now time.Time) (map[string]float32, []string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolvePrices", ctx, productIDs, now)
+	ret0, _ := ret[0].(map[string]float32)
+	ret1, _ := ret[1].([]string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ResolvePrices indicates an expected call of ResolvePrices.
+func (mr *MockServiceMockRecorder) ResolvePrices(ctx, productIDs, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolvePrices", reflect.TypeOf((*MockService)(nil).ResolvePrices), ctx, productIDs, now)
+}
+
+// SetAvailability mocks base method.
+func (m *MockService) SetAvailability(ctx context.Context, req *product.SetAvailabilityRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAvailability", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAvailability indicates an expected call of SetAvailability.
+func (mr *MockServiceMockRecorder) SetAvailability(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAvailability", reflect.TypeOf((*MockService)(nil).SetAvailability), ctx, req)
+}
+
+// SetTagsBatch mocks base method.
+func (m *MockService) SetTagsBatch(ctx context.Context, ids, tags []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTagsBatch", ctx, ids, tags)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTagsBatch indicates an expected call of SetTagsBatch.
+func (mr *MockServiceMockRecorder) SetTagsBatch(ctx, ids, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTagsBatch", reflect.TypeOf((*MockService)(nil).SetTagsBatch), ctx, ids, tags)
+}
+
+// SwapPrices mocks base method.
+func (m *MockService) SwapPrices(ctx context.Context, idA, idB string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SwapPrices", ctx, idA, idB)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SwapPrices indicates an expected call of SwapPrices.
+func (mr *MockServiceMockRecorder) SwapPrices(ctx, idA, idB any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SwapPrices", reflect.TypeOf((*MockService)(nil).SwapPrices), ctx, idA, idB)
+}
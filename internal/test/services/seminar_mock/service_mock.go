@@ -12,6 +12,7 @@ package seminar_mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	seminar "github.com/mikhail5545/product-service-go/internal/models/seminar"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// CountDeletedBefore mocks base method.
+func (m *MockService) CountDeletedBefore(ctx context.Context, cutoff time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDeletedBefore", ctx, cutoff)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDeletedBefore indicates an expected call of CountDeletedBefore.
+func (mr *MockServiceMockRecorder) CountDeletedBefore(ctx, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDeletedBefore", reflect.TypeOf((*MockService)(nil).CountDeletedBefore), ctx, cutoff)
+}
+
 // Create mocks base method.
 func (m *MockService) Create(ctx context.Context, req *seminar.CreateRequest) (*seminar.CreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -56,47 +72,107 @@ func (mr *MockServiceMockRecorder) Create(ctx, req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockService)(nil).Create), ctx, req)
 }
 
+// CreateIfAbsent mocks base method.
+func (m *MockService) CreateIfAbsent(ctx context.Context, req *seminar.CreateRequest) (*seminar.CreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIfAbsent", ctx, req)
+	ret0, _ := ret[0].(*seminar.CreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIfAbsent indicates an expected call of CreateIfAbsent.
+func (mr *MockServiceMockRecorder) CreateIfAbsent(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIfAbsent", reflect.TypeOf((*MockService)(nil).CreateIfAbsent), ctx, req)
+}
+
 // Delete mocks base method.
-func (m *MockService) Delete(ctx context.Context, id string) error {
+func (m *MockService) Delete(ctx context.Context, id, reason string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, reason)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockServiceMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Delete(ctx, id, reason any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockService)(nil).Delete), ctx, id, reason)
+}
+
+// DeleteBatch mocks base method.
+func (m *MockService) DeleteBatch(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBatch", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBatch indicates an expected call of DeleteBatch.
+func (mr *MockServiceMockRecorder) DeleteBatch(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBatch", reflect.TypeOf((*MockService)(nil).DeleteBatch), ctx, ids)
 }
 
 // DeletePermanent mocks base method.
-func (m *MockService) DeletePermanent(ctx context.Context, id string) error {
+func (m *MockService) DeletePermanent(ctx context.Context, id string, force bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePermanent", ctx, id)
+	ret := m.ctrl.Call(m, "DeletePermanent", ctx, id, force)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeletePermanent indicates an expected call of DeletePermanent.
-func (mr *MockServiceMockRecorder) DeletePermanent(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) DeletePermanent(ctx, id, force any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockService)(nil).DeletePermanent), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePermanent", reflect.TypeOf((*MockService)(nil).DeletePermanent), ctx, id, force)
 }
 
 // Get mocks base method.
-func (m *MockService) Get(ctx context.Context, id string) (*seminar.SeminarDetails, error) {
+func (m *MockService) Get(ctx context.Context, id string, includeProducts bool) (*seminar.SeminarDetails, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret := m.ctrl.Call(m, "Get", ctx, id, includeProducts)
 	ret0, _ := ret[0].(*seminar.SeminarDetails)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockServiceMockRecorder) Get(ctx, id any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Get(ctx, id, includeProducts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), ctx, id, includeProducts)
+}
+
+// GetByIDs mocks base method.
+func (m *MockService) GetByIDs(ctx context.Context, ids []string) (map[string]*seminar.SeminarDetails, map[string]error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByIDs", ctx, ids)
+	ret0, _ := ret[0].(map[string]*seminar.SeminarDetails)
+	ret1, _ := ret[1].(map[string]error)
+	return ret0, ret1
+}
+
+// GetByIDs indicates an expected call of GetByIDs.
+func (mr *MockServiceMockRecorder) GetByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDs", reflect.TypeOf((*MockService)(nil).GetByIDs), ctx, ids)
+}
+
+// GetBySlug mocks base method.
+func (m *MockService) GetBySlug(ctx context.Context, slug string, includeProducts bool) (*seminar.SeminarDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug, includeProducts)
+	ret0, _ := ret[0].(*seminar.SeminarDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug.
+func (mr *MockServiceMockRecorder) GetBySlug(ctx, slug, includeProducts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockService)(nil).Get), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockService)(nil).GetBySlug), ctx, slug, includeProducts)
 }
 
 // GetWithDeleted mocks base method.
@@ -177,6 +253,22 @@ func (mr *MockServiceMockRecorder) ListUnpublished(ctx, limit, offset any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnpublished", reflect.TypeOf((*MockService)(nil).ListUnpublished), ctx, limit, offset)
 }
 
+// PriceAsOf mocks base method.
+func (m *MockService) PriceAsOf(ctx context.Context, id string, at time.Time) (float32, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PriceAsOf", ctx, id, at)
+	ret0, _ := ret[0].(float32)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PriceAsOf indicates an expected call of PriceAsOf.
+func (mr *MockServiceMockRecorder) PriceAsOf(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PriceAsOf", reflect.TypeOf((*MockService)(nil).PriceAsOf), ctx, id, at)
+}
+
 // Publish mocks base method.
 func (m *MockService) Publish(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -191,6 +283,35 @@ func (mr *MockServiceMockRecorder) Publish(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockService)(nil).Publish), ctx, id)
 }
 
+// PurgeDeletedBefore mocks base method.
+func (m *MockService) PurgeDeletedBefore(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedBefore", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedBefore indicates an expected call of PurgeDeletedBefore.
+func (mr *MockServiceMockRecorder) PurgeDeletedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedBefore", reflect.TypeOf((*MockService)(nil).PurgeDeletedBefore), ctx, before)
+}
+
+// ReconcileInStock mocks base method.
+func (m *MockService) ReconcileInStock(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconcileInStock", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReconcileInStock indicates an expected call of ReconcileInStock.
+func (mr *MockServiceMockRecorder) ReconcileInStock(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconcileInStock", reflect.TypeOf((*MockService)(nil).ReconcileInStock), ctx, id)
+}
+
 // Restore mocks base method.
 func (m *MockService) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -205,6 +326,21 @@ func (mr *MockServiceMockRecorder) Restore(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockService)(nil).Restore), ctx, id)
 }
 
+// RestoreBatch mocks base method.
+func (m *MockService) RestoreBatch(ctx context.Context, ids []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBatch", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBatch indicates an expected call of RestoreBatch.
+func (mr *MockServiceMockRecorder) RestoreBatch(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBatch", reflect.TypeOf((*MockService)(nil).RestoreBatch), ctx, ids)
+}
+
 // Unpublish mocks base method.
 func (m *MockService) Unpublish(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -233,3 +369,32 @@ func (mr *MockServiceMockRecorder) Update(ctx, req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockService)(nil).Update), ctx, req)
 }
+
+// UpdateSurcharges mocks base method.
+func (m *MockService) UpdateSurcharges(ctx context.Context, id string, early, late *float32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSurcharges", ctx, id, early, late)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSurcharges indicates an expected call of UpdateSurcharges.
+func (mr *MockServiceMockRecorder) UpdateSurcharges(ctx, id, early, late any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSurcharges", reflect.TypeOf((*MockService)(nil).UpdateSurcharges), ctx, id, early, late)
+}
+
+// VerifyIntegrity mocks base method.
+func (m *MockService) VerifyIntegrity(ctx context.Context, limit, offset int) ([]seminar.IntegrityIssue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyIntegrity", ctx, limit, offset)
+	ret0, _ := ret[0].([]seminar.IntegrityIssue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyIntegrity indicates an expected call of VerifyIntegrity.
+func (mr *MockServiceMockRecorder) VerifyIntegrity(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyIntegrity", reflect.TypeOf((*MockService)(nil).VerifyIntegrity), ctx, limit, offset)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/mikhail5545/product-service-go/internal/services/storefront (interfaces: Service)
+//
+// Generated by this command:
+//
+//	mockgen -destination=../../test/services/storefront_mock/service_mock.go -package=storefront_mock . Service
+//
+
+// Package storefront_mock is a generated GoMock package.
+package storefront_mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	storefront "github.com/mikhail5545/product-service-go/internal/models/storefront"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// Feed mocks base method.
+func (m *MockService) Feed(ctx context.Context, limit, offset int) ([]storefront.Item, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, limit, offset)
+	ret0, _ := ret[0].([]storefront.Item)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockServiceMockRecorder) Feed(ctx, limit, offset any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockService)(nil).Feed), ctx, limit, offset)
+}
@@ -129,6 +129,21 @@ func (mr *MockOwnerRepoAdapterMockRecorder) DeleteImage(ctx, owner, mediaSvcID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockOwnerRepoAdapter)(nil).DeleteImage), ctx, owner, mediaSvcID)
 }
 
+// DeleteImageAssociations mocks base method.
+func (m *MockOwnerRepoAdapter) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImageAssociations", ctx, mediaSvcID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageAssociations indicates an expected call of DeleteImageAssociations.
+func (mr *MockOwnerRepoAdapterMockRecorder) DeleteImageAssociations(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageAssociations", reflect.TypeOf((*MockOwnerRepoAdapter)(nil).DeleteImageAssociations), ctx, mediaSvcID)
+}
+
 // FindOwnerIDsByImageID mocks base method.
 func (m *MockOwnerRepoAdapter) FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +159,21 @@ func (mr *MockOwnerRepoAdapterMockRecorder) FindOwnerIDsByImageID(ctx, mediaSvcI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOwnerIDsByImageID", reflect.TypeOf((*MockOwnerRepoAdapter)(nil).FindOwnerIDsByImageID), ctx, mediaSvcID, ownerIDs)
 }
 
+// GetWithDeleted mocks base method.
+func (m *MockOwnerRepoAdapter) GetWithDeleted(ctx context.Context, id string) (image_owner.Owner, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithDeleted", ctx, id)
+	ret0, _ := ret[0].(image_owner.Owner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithDeleted indicates an expected call of GetWithDeleted.
+func (mr *MockOwnerRepoAdapterMockRecorder) GetWithDeleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithDeleted", reflect.TypeOf((*MockOwnerRepoAdapter)(nil).GetWithDeleted), ctx, id)
+}
+
 // GetWithUnpublished mocks base method.
 func (m *MockOwnerRepoAdapter) GetWithUnpublished(ctx context.Context, id string) (image_owner.Owner, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +189,21 @@ func (mr *MockOwnerRepoAdapterMockRecorder) GetWithUnpublished(ctx, id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithUnpublished", reflect.TypeOf((*MockOwnerRepoAdapter)(nil).GetWithUnpublished), ctx, id)
 }
 
+// ListOwnerIDsByImageID mocks base method.
+func (m *MockOwnerRepoAdapter) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwnerIDsByImageID", ctx, mediaSvcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwnerIDsByImageID indicates an expected call of ListOwnerIDsByImageID.
+func (mr *MockOwnerRepoAdapterMockRecorder) ListOwnerIDsByImageID(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwnerIDsByImageID", reflect.TypeOf((*MockOwnerRepoAdapter)(nil).ListOwnerIDsByImageID), ctx, mediaSvcID)
+}
+
 // ListWithUnpublishedByIDs mocks base method.
 func (m *MockOwnerRepoAdapter) ListWithUnpublishedByIDs(ctx context.Context, ids ...string) ([]image_owner.Owner, error) {
 	m.ctrl.T.Helper()
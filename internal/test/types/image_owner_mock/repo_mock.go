@@ -129,6 +129,21 @@ func (mr *MockOwnerRepoMockRecorder[T]) DeleteImage(ctx, owner, mediaSvcID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImage", reflect.TypeOf((*MockOwnerRepo[T])(nil).DeleteImage), ctx, owner, mediaSvcID)
 }
 
+// DeleteImageAssociations mocks base method.
+func (m *MockOwnerRepo[T]) DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImageAssociations", ctx, mediaSvcID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageAssociations indicates an expected call of DeleteImageAssociations.
+func (mr *MockOwnerRepoMockRecorder[T]) DeleteImageAssociations(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageAssociations", reflect.TypeOf((*MockOwnerRepo[T])(nil).DeleteImageAssociations), ctx, mediaSvcID)
+}
+
 // DeleteImageBatch mocks base method.
 func (m *MockOwnerRepo[T]) DeleteImageBatch(ctx context.Context, owners []T, arg2 *image.Image) error {
 	m.ctrl.T.Helper()
@@ -158,6 +173,21 @@ func (mr *MockOwnerRepoMockRecorder[T]) FindOwnerIDsByImageID(ctx, mediaSvcID, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOwnerIDsByImageID", reflect.TypeOf((*MockOwnerRepo[T])(nil).FindOwnerIDsByImageID), ctx, mediaSvcID, ownerIDs)
 }
 
+// GetWithDeleted mocks base method.
+func (m *MockOwnerRepo[T]) GetWithDeleted(ctx context.Context, id string) (T, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithDeleted", ctx, id)
+	ret0, _ := ret[0].(T)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithDeleted indicates an expected call of GetWithDeleted.
+func (mr *MockOwnerRepoMockRecorder[T]) GetWithDeleted(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithDeleted", reflect.TypeOf((*MockOwnerRepo[T])(nil).GetWithDeleted), ctx, id)
+}
+
 // GetWithUnpublished mocks base method.
 func (m *MockOwnerRepo[T]) GetWithUnpublished(ctx context.Context, id string) (T, error) {
 	m.ctrl.T.Helper()
@@ -173,6 +203,21 @@ func (mr *MockOwnerRepoMockRecorder[T]) GetWithUnpublished(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithUnpublished", reflect.TypeOf((*MockOwnerRepo[T])(nil).GetWithUnpublished), ctx, id)
 }
 
+// ListOwnerIDsByImageID mocks base method.
+func (m *MockOwnerRepo[T]) ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOwnerIDsByImageID", ctx, mediaSvcID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOwnerIDsByImageID indicates an expected call of ListOwnerIDsByImageID.
+func (mr *MockOwnerRepoMockRecorder[T]) ListOwnerIDsByImageID(ctx, mediaSvcID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOwnerIDsByImageID", reflect.TypeOf((*MockOwnerRepo[T])(nil).ListOwnerIDsByImageID), ctx, mediaSvcID)
+}
+
 // ListWithUnpublishedByIDs mocks base method.
 func (m *MockOwnerRepo[T]) ListWithUnpublishedByIDs(ctx context.Context, ids ...string) ([]T, error) {
 	m.ctrl.T.Helper()
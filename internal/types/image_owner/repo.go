@@ -36,6 +36,7 @@ type Owner interface {
 type OwnerRepo[T Owner] interface {
 	ListWithUnpublishedByIDs(ctx context.Context, ids ...string) ([]T, error)
 	GetWithUnpublished(ctx context.Context, id string) (T, error)
+	GetWithDeleted(ctx context.Context, id string) (T, error)
 	AddImage(ctx context.Context, owner T, image *imagemodel.Image) error
 	AddImageBatch(ctx context.Context, owners []T, image *imagemodel.Image) error
 	DeleteImage(ctx context.Context, owner T, mediaSvcID string) error
@@ -43,6 +44,8 @@ type OwnerRepo[T Owner] interface {
 	BatchUpdate(ctx context.Context, owners []T, opt uint) (int64, error)
 	FindOwnerIDsByImageID(ctx context.Context, mediaSvcID string, ownerIDs []string) ([]string, error)
 	DecrementImageCount(ctx context.Context, ownerIDs []string) (int64, error)
+	ListOwnerIDsByImageID(ctx context.Context, mediaSvcID string) ([]string, error)
+	DeleteImageAssociations(ctx context.Context, mediaSvcID string) (int64, error)
 	DB() *gorm.DB
 	WithTx(tx *gorm.DB) OwnerRepo[T]
 }
@@ -33,6 +33,7 @@ import (
 	trainingsession "github.com/mikhail5545/product-service-go/internal/services/training_session"
 	videoservice "github.com/mikhail5545/product-service-go/internal/services/video"
 	videomanager "github.com/mikhail5545/product-service-go/internal/services/video_manager"
+	"github.com/mikhail5545/product-service-go/internal/util/response"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -47,27 +48,35 @@ type ServiceError interface {
 func HTTPErrorHandler(err error, c echo.Context) {
 	// Handle specific sentinel errors first
 	if errors.Is(err, seminar.ErrInvalidArgument) || errors.Is(err, course.ErrInvalidArgument) || errors.Is(err, trainingsession.ErrInvalidArgument) || errors.Is(err, physicalgood.ErrInvalidArgument) {
-		c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
 		return
 	}
 	if errors.Is(err, seminar.ErrNotFound) || errors.Is(err, course.ErrNotFound) || errors.Is(err, trainingsession.ErrNotFound) || errors.Is(err, physicalgood.ErrNotFound) {
-		c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+		response.Write(c, http.StatusNotFound, map[string]any{"error": err.Error()})
 		return
 	}
 	if errors.Is(err, seminar.ErrImageLimitExceeded) {
-		c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		response.Write(c, http.StatusBadRequest, map[string]any{"error": err.Error()})
+		return
+	}
+
+	// Errors raised by echo itself (unknown route, method not allowed) and by request helpers
+	// keep their status code.
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		response.Write(c, he.Code, map[string]any{"error": he.Message})
 		return
 	}
 
 	// Fallback for older error types
 	var se ServiceError
 	if errors.As(err, &se) {
-		c.JSON(se.GetCode(), map[string]string{"error": se.Error()})
+		response.Write(c, se.GetCode(), map[string]any{"error": se.Error()})
 		return
 	}
 
 	// Default to internal server error
-	c.JSON(http.StatusInternalServerError, map[string]string{"error": "internal server error"})
+	response.Write(c, http.StatusInternalServerError, map[string]any{"error": "internal server error"})
 }
 
 // HandleServiceError converts a service layer error into a gRPC status error.
@@ -97,6 +106,7 @@ func HandleServiceError(err error) error {
 		errors.Is(err, physicalgood.ErrNotFound) ||
 		errors.Is(err, product.ErrNotFound) ||
 		errors.Is(err, coursepart.ErrNotFound) ||
+		errors.Is(err, coursepart.ErrProductNotFound) ||
 		errors.Is(err, imagemanager.ErrOwnerNotFound) ||
 		errors.Is(err, imagemanager.ErrOwnersNotFound) ||
 		errors.Is(err, imagemanager.ErrImageNotFoundOnOwner) ||
@@ -104,6 +114,12 @@ func HandleServiceError(err error) error {
 		errors.Is(err, videomanager.ErrVideoNotFound) {
 		return status.Errorf(codes.NotFound, "Not found: %s", err.Error())
 	}
+	if errors.Is(err, seminar.ErrHasDependents) ||
+		errors.Is(err, course.ErrHasDependents) ||
+		errors.Is(err, trainingsession.ErrHasDependents) ||
+		errors.Is(err, physicalgood.ErrHasDependents) {
+		return status.Errorf(codes.FailedPrecondition, "Failed precondition: %s", err.Error())
+	}
 
 	return status.Errorf(codes.Internal, "an internal error occurred: %v", err)
 }
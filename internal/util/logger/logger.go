@@ -0,0 +1,71 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package logger builds the structured [slog.Logger] shared by the application and its services.
+package logger
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+const (
+	// FormatText is the human-readable key=value format.
+	FormatText = "text"
+	// FormatJSON is one JSON object per line, for log collectors.
+	FormatJSON = "json"
+)
+
+// Config configures the logger.
+type Config struct {
+	// Level is the minimum level of records that are written.
+	Level slog.Level
+	// Format is either [FormatText] or [FormatJSON].
+	Format string
+}
+
+// DefaultConfig is used for values missing from the environment.
+var DefaultConfig = Config{
+	Level:  slog.LevelInfo,
+	Format: FormatText,
+}
+
+// ConfigFromEnv reads LOG_LEVEL (debug, info, warn, error) and LOG_FORMAT (text, json) environment variables.
+// Missing or invalid values fall back to [DefaultConfig].
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(os.Getenv("LOG_LEVEL"))); err == nil {
+		cfg.Level = level
+	}
+	switch format := strings.ToLower(os.Getenv("LOG_FORMAT")); format {
+	case FormatText, FormatJSON:
+		cfg.Format = format
+	}
+	return cfg
+}
+
+// New creates a logger writing records of cfg.Level and above to w in cfg.Format.
+func New(w io.Writer, cfg Config) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: cfg.Level}
+	if cfg.Format == FormatJSON {
+		return slog.New(slog.NewJSONHandler(w, opts))
+	}
+	return slog.New(slog.NewTextHandler(w, opts))
+}
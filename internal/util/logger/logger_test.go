@@ -0,0 +1,108 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew_Level(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	log := New(&buf, Config{Level: slog.LevelInfo, Format: FormatText})
+
+	// Act
+	log.Debug("dropped incomplete seminar")
+	log.Info("listening")
+
+	// Assert
+	assert.NotContains(t, buf.String(), "dropped incomplete seminar")
+	assert.Contains(t, buf.String(), "msg=listening")
+}
+
+func TestNew_DebugLevel(t *testing.T) {
+	// Arrange
+	var buf bytes.Buffer
+	log := New(&buf, Config{Level: slog.LevelDebug, Format: FormatText})
+
+	// Act
+	log.Debug("dropped incomplete seminar", "seminar_id", "id")
+
+	// Assert
+	assert.Contains(t, buf.String(), "level=DEBUG")
+	assert.Contains(t, buf.String(), "seminar_id=id")
+}
+
+func TestNew_Format(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		log := New(&buf, Config{Level: slog.LevelInfo, Format: FormatJSON})
+
+		// Act
+		log.Info("listening", "port", 8080)
+
+		// Assert
+		var record map[string]any
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		assert.Equal(t, "listening", record["msg"])
+		assert.Equal(t, float64(8080), record["port"])
+	})
+
+	t.Run("text", func(t *testing.T) {
+		// Arrange
+		var buf bytes.Buffer
+		log := New(&buf, Config{Level: slog.LevelInfo, Format: FormatText})
+
+		// Act
+		log.Info("listening", "port", 8080)
+
+		// Assert
+		assert.False(t, strings.HasPrefix(buf.String(), "{"))
+		assert.Contains(t, buf.String(), "port=8080")
+	})
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("LOG_LEVEL", "")
+		t.Setenv("LOG_FORMAT", "")
+
+		assert.Equal(t, DefaultConfig, ConfigFromEnv())
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("LOG_LEVEL", "debug")
+		t.Setenv("LOG_FORMAT", "JSON")
+
+		assert.Equal(t, Config{Level: slog.LevelDebug, Format: FormatJSON}, ConfigFromEnv())
+	})
+
+	t.Run("invalid values fall back", func(t *testing.T) {
+		t.Setenv("LOG_LEVEL", "verbose")
+		t.Setenv("LOG_FORMAT", "xml")
+
+		assert.Equal(t, DefaultConfig, ConfigFromEnv())
+	})
+}
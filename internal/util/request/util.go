@@ -27,11 +27,14 @@
 package request
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/featureflags"
 )
 
 // BindAndValidateJSON binds the request body to the given struct and handles validation errors.
@@ -42,7 +45,20 @@ func BindAndValidateJSON(c echo.Context, req any) error {
 	return nil
 }
 
+// GetReason extracts the optional 'reason' field from the JSON request body.
+// A request without a body yields an empty reason.
+func GetReason(c echo.Context) (string, error) {
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := c.Bind(&body); err != nil { //nolint:wrapcheck
+		return "", echo.NewHTTPError(http.StatusBadRequest, "Invalid request JSON payload.")
+	}
+	return body.Reason, nil
+}
+
 // GetIDParam extracts a required ID from the path parameters.
+// paramName is the name the route was registered with, without the leading colon ("id" for "/:id").
 func GetIDParam(c echo.Context, paramName, errorMsg string) (string, error) {
 	id := c.Param(paramName)
 	if _, err := uuid.Parse(id); err != nil {
@@ -51,7 +67,83 @@ func GetIDParam(c echo.Context, paramName, errorMsg string) (string, error) {
 	return id, nil
 }
 
+// GetFieldsParam extracts the comma-separated 'fields' query parameter used for sparse fieldsets.
+// Empty entries are skipped. Returns nil if the parameter is absent.
+func GetFieldsParam(c echo.Context) []string {
+	raw := c.QueryParam("fields")
+	if raw == "" {
+		return nil
+	}
+	var fields []string
+	for _, f := range strings.Split(raw, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// GetIncludeParam reports whether the comma-separated 'include' query parameter lists name.
+// Returns defaultValue if the parameter is absent, an empty 'include=' lists nothing.
+func GetIncludeParam(c echo.Context, name string, defaultValue bool) bool {
+	values, ok := c.QueryParams()["include"]
+	if !ok {
+		return defaultValue
+	}
+	for _, v := range values {
+		for _, part := range strings.Split(v, ",") {
+			if strings.TrimSpace(part) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetBoolQueryParam extracts an optional boolean query parameter, returning defaultValue if it is absent.
+func GetBoolQueryParam(c echo.Context, paramName string, defaultValue bool) (bool, error) {
+	raw := c.QueryParam(paramName)
+	if raw == "" {
+		return defaultValue, nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, echo.NewHTTPError(http.StatusBadRequest, "Invalid "+paramName+" parameter.")
+	}
+	return value, nil
+}
+
+// StockPolicy is the route group default for whether lists return out-of-stock (unpublished) items.
+// It is set per route group with [SetStockPolicy] and read by handlers with [GetIncludeOutOfStock].
+type StockPolicy struct {
+	// IncludeOutOfStock is used when the request does not say otherwise.
+	IncludeOutOfStock bool
+	// AllowOverride lets the 'include_out_of_stock' query parameter replace the default.
+	AllowOverride bool
+}
+
+const stockPolicyKey = "stock_policy"
+
+// SetStockPolicy stores the stock policy of the route group the request is served by.
+func SetStockPolicy(c echo.Context, p StockPolicy) {
+	c.Set(stockPolicyKey, p)
+}
+
+// GetIncludeOutOfStock reports whether a list should return out-of-stock items under the policy stored
+// with [SetStockPolicy]. Without a stored policy out-of-stock items are left out and the parameter is ignored.
+func GetIncludeOutOfStock(c echo.Context) (bool, error) {
+	p, _ := c.Get(stockPolicyKey).(StockPolicy)
+	if !p.AllowOverride {
+		return p.IncludeOutOfStock, nil
+	}
+	return GetBoolQueryParam(c, "include_out_of_stock", p.IncludeOutOfStock)
+}
+
+// MaxStrictLimit is the largest page accepted by [GetPaginationParams] with [featureflags.StrictPagination] on.
+const MaxStrictLimit = 100
+
 // GetPaginationParams extracts 'limit' and 'offset' from query parameters with default values.
+// With [featureflags.StrictPagination] on, an explicit limit must be between 1 and [MaxStrictLimit].
 func GetPaginationParams(c echo.Context, defaultLimit, defaultOffset int) (int, int, error) {
 	limitStr := c.QueryParam("limit")
 	limit := defaultLimit
@@ -61,6 +153,9 @@ func GetPaginationParams(c echo.Context, defaultLimit, defaultOffset int) (int,
 		if err != nil || limit < -1 {
 			return 0, 0, echo.NewHTTPError(http.StatusBadRequest, "Invalid pagination parameters.")
 		}
+		if featureflags.Enabled(featureflags.StrictPagination) && (limit < 1 || limit > MaxStrictLimit) {
+			return 0, 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Limit must be between 1 and %d.", MaxStrictLimit))
+		}
 	}
 
 	offsetStr := c.QueryParam("offset")
@@ -0,0 +1,109 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package request
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/featureflags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPaginationParams_StrictPagination(t *testing.T) {
+	t.Cleanup(func() { _ = featureflags.Set(featureflags.StrictPagination, false) })
+
+	tests := []struct {
+		query     string
+		wantLimit int
+		legacyOK  bool
+		strictOK  bool
+	}{
+		{query: "", wantLimit: 10, legacyOK: true, strictOK: true},
+		{query: "limit=50&offset=5", wantLimit: 50, legacyOK: true, strictOK: true},
+		{query: "limit=100", wantLimit: 100, legacyOK: true, strictOK: true},
+		{query: "limit=-1", wantLimit: -1, legacyOK: true, strictOK: false},
+		{query: "limit=0", wantLimit: 0, legacyOK: true, strictOK: false},
+		{query: "limit=5000", wantLimit: 5000, legacyOK: true, strictOK: false},
+		{query: "limit=-2", legacyOK: false, strictOK: false},
+	}
+
+	for _, strict := range []bool{false, true} {
+		assert.NoError(t, featureflags.Set(featureflags.StrictPagination, strict))
+		for _, tt := range tests {
+			// Arrange
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil), httptest.NewRecorder())
+
+			// Act
+			limit, _, err := GetPaginationParams(c, 10, 0)
+
+			// Assert
+			wantOK := tt.legacyOK
+			if strict {
+				wantOK = tt.strictOK
+			}
+			if !wantOK {
+				var httpErr *echo.HTTPError
+				if assert.ErrorAs(t, err, &httpErr, "strict=%v %q", strict, tt.query) {
+					assert.Equal(t, http.StatusBadRequest, httpErr.Code)
+				}
+				continue
+			}
+			assert.NoError(t, err, "strict=%v %q", strict, tt.query)
+			assert.Equal(t, tt.wantLimit, limit, "strict=%v %q", strict, tt.query)
+		}
+	}
+}
+
+func TestGetIncludeOutOfStock(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *StockPolicy
+		query   string
+		want    bool
+		wantErr bool
+	}{
+		{name: "no policy ignores the parameter", query: "include_out_of_stock=true", want: false},
+		{name: "fixed policy ignores the parameter", policy: &StockPolicy{}, query: "include_out_of_stock=true", want: false},
+		{name: "default kept without the parameter", policy: &StockPolicy{IncludeOutOfStock: true, AllowOverride: true}, want: true},
+		{name: "parameter overrides the default", policy: &StockPolicy{IncludeOutOfStock: true, AllowOverride: true}, query: "include_out_of_stock=false", want: false},
+		{name: "invalid parameter", policy: &StockPolicy{AllowOverride: true}, query: "include_out_of_stock=maybe", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/?"+tt.query, nil), httptest.NewRecorder())
+			if tt.policy != nil {
+				SetStockPolicy(c, *tt.policy)
+			}
+
+			// Act
+			include, err := GetIncludeOutOfStock(c)
+
+			// Assert
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, include)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package response provides shared utility functions for HTTP handler responses.
+//
+// It works with response payloads before they are written to echo.Context:
+//
+//   - Response envelopes and content negotiation (JSON or MessagePack)
+//   - List pages
+//   - Sparse fieldsets
+//   - Request validation results
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/featureflags"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// APIVersion is the version of the HTTP API. It is stamped into every response envelope
+// under the "api_version" key, so clients can detect incompatible changes.
+const APIVersion = "v0"
+
+// MIMEApplicationMsgpack is the media type clients put into the Accept header to receive MessagePack.
+const MIMEApplicationMsgpack = "application/msgpack"
+
+// Write writes body as a response envelope with the given status code. It adds the "api_version" key to body.
+//
+// The body is encoded as MessagePack if the request's Accept header lists [MIMEApplicationMsgpack],
+// and as JSON otherwise.
+func Write(c echo.Context, code int, body map[string]any) error {
+	if body == nil {
+		body = make(map[string]any, 1)
+	}
+	body["api_version"] = APIVersion
+	if !AcceptsMsgpack(c.Request().Header.Get(echo.HeaderAccept)) {
+		return c.JSON(code, body)
+	}
+	data, err := MarshalMsgpack(body)
+	if err != nil {
+		return err
+	}
+	return c.Blob(code, MIMEApplicationMsgpack, data)
+}
+
+// WritePage writes body as a page of a list with the given status code, see [Write]. It adds the "total"
+// and "has_more" keys to body. If offset is beyond total, the page is empty and body also gets
+// "offset_out_of_range": true. With [featureflags.OffsetOutOfRange] on, such a page is written with
+// 416 Requested Range Not Satisfiable instead of code.
+//
+// limit and offset are the values the page was requested with, a negative limit means no limit.
+func WritePage(c echo.Context, code int, body map[string]any, total int64, limit, offset int) error {
+	if body == nil {
+		body = make(map[string]any, 3)
+	}
+	body["total"] = total
+	body["has_more"] = limit >= 0 && int64(offset)+int64(limit) < total
+	if int64(offset) > total {
+		body["offset_out_of_range"] = true
+		if featureflags.Enabled(featureflags.OffsetOutOfRange) {
+			code = http.StatusRequestedRangeNotSatisfiable
+		}
+	}
+	return Write(c, code, body)
+}
+
+// AcceptsMsgpack reports whether accept (the value of an Accept header) prefers [MIMEApplicationMsgpack]:
+// it is listed with a non-zero quality value not lower than the one of [echo.MIMEApplicationJSON].
+// A missing or malformed q parameter counts as 1.
+func AcceptsMsgpack(accept string) bool {
+	msgpackQ, jsonQ := 0.0, 0.0
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case MIMEApplicationMsgpack:
+			msgpackQ = max(msgpackQ, quality(params))
+		case echo.MIMEApplicationJSON:
+			jsonQ = max(jsonQ, quality(params))
+		}
+	}
+	return msgpackQ > 0 && msgpackQ >= jsonQ
+}
+
+// quality returns the q parameter of a media range, 1 if it is missing or malformed.
+func quality(params map[string]string) float64 {
+	q, err := strconv.ParseFloat(params["q"], 64)
+	if err != nil || q < 0 || q > 1 {
+		return 1
+	}
+	return q
+}
+
+// MarshalMsgpack encodes v as MessagePack. v is converted to it's JSON representation first,
+// so the MessagePack document has exactly the same keys and nesting as the JSON one.
+func MarshalMsgpack(v any) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode response: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var decoded any
+	if err := dec.Decode(&decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+	data, err := msgpack.Marshal(normalizeNumbers(decoded))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode response: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalMsgpack decodes MessagePack data produced by [MarshalMsgpack] into v
+// following the same rules as [json.Unmarshal].
+func UnmarshalMsgpack(data []byte, v any) error {
+	var decoded any
+	if err := msgpack.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(decoded)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// normalizeNumbers replaces every json.Number in v with an int64 or float64,
+// so integers stay integers in MessagePack.
+func normalizeNumbers(v any) any {
+	switch t := v.(type) {
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		f, _ := t.Float64()
+		return f
+	case map[string]any:
+		for k, el := range t {
+			t[k] = normalizeNumbers(el)
+		}
+	case []any:
+		for i, el := range t {
+			t[i] = normalizeNumbers(el)
+		}
+	}
+	return v
+}
+
+// Project trims the JSON representation of v down to the requested top-level fields.
+// If v encodes to an array, every object element is trimmed. Unknown fields are ignored,
+// so the result may be an empty object. If fields is empty, v is returned unchanged.
+func Project(v any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return v, nil
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode response: %w", err)
+	}
+	var decoded any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	keep := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		keep[f] = struct{}{}
+	}
+	switch d := decoded.(type) {
+	case map[string]any:
+		return projectObject(d, keep), nil
+	case []any:
+		for i, el := range d {
+			if obj, ok := el.(map[string]any); ok {
+				d[i] = projectObject(obj, keep)
+			}
+		}
+		return d, nil
+	}
+	return decoded, nil
+}
+
+// projectObject removes every key of obj that is not in keep.
+func projectObject(obj map[string]any, keep map[string]struct{}) map[string]any {
+	for k := range obj {
+		if _, ok := keep[k]; !ok {
+			delete(obj, k)
+		}
+	}
+	return obj
+}
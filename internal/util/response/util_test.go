@@ -0,0 +1,102 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/mikhail5545/product-service-go/internal/featureflags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWritePage(t *testing.T) {
+	t.Cleanup(func() { _ = featureflags.Set(featureflags.OffsetOutOfRange, false) })
+
+	tests := []struct {
+		name           string
+		limit, offset  int
+		wantHasMore    bool
+		wantOutOfRange bool
+	}{
+		{name: "offset within range", limit: 10, offset: 10, wantHasMore: true},
+		{name: "last page", limit: 10, offset: 20, wantHasMore: false},
+		{name: "no limit", limit: -1, offset: 0, wantHasMore: false},
+		{name: "offset at total", limit: 10, offset: 25, wantHasMore: false},
+		{name: "offset beyond total", limit: 10, offset: 40, wantHasMore: false, wantOutOfRange: true},
+	}
+
+	for _, strict := range []bool{false, true} {
+		assert.NoError(t, featureflags.Set(featureflags.OffsetOutOfRange, strict))
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s with flag %t", tt.name, strict), func(t *testing.T) {
+				// Arrange
+				rec := httptest.NewRecorder()
+				c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+
+				// Act
+				err := WritePage(c, http.StatusOK, map[string]any{"items": []string{}}, 25, tt.limit, tt.offset)
+
+				// Assert
+				assert.NoError(t, err)
+				wantCode := http.StatusOK
+				if strict && tt.wantOutOfRange {
+					wantCode = http.StatusRequestedRangeNotSatisfiable
+				}
+				assert.Equal(t, wantCode, rec.Code)
+
+				var body map[string]any
+				assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, float64(25), body["total"])
+				assert.Equal(t, tt.wantHasMore, body["has_more"])
+				if tt.wantOutOfRange {
+					assert.Equal(t, true, body["offset_out_of_range"])
+				} else {
+					assert.NotContains(t, body, "offset_out_of_range")
+				}
+			})
+		}
+	}
+}
+
+func TestAcceptsMsgpack(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "", want: false},
+		{accept: "application/json", want: false},
+		{accept: "application/msgpack", want: true},
+		{accept: "application/json, application/msgpack", want: true},
+		{accept: "application/json, application/msgpack;q=0", want: false},
+		{accept: "application/json;q=0.9, application/msgpack;q=0.5", want: false},
+		{accept: "application/json;q=0.5, application/msgpack;q=0.9", want: true},
+		{accept: "application/msgpack;q=bogus", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.accept, func(t *testing.T) {
+			// Act & Assert
+			assert.Equal(t, tt.want, AcceptsMsgpack(tt.accept))
+		})
+	}
+}
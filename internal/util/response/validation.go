@@ -0,0 +1,78 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package response
+
+import (
+	"errors"
+	"net/http"
+	"sort"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/labstack/echo/v4"
+)
+
+// FieldError is a single validation failure. Field is the JSON name of the failed field,
+// nested fields and list items are joined with dots (e.g. "tags.0"). It is empty for request-level errors.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// FieldErrors flattens an error returned by a request's Validate method into field errors sorted by field.
+// Returns nil for a nil error.
+func FieldErrors(err error) []FieldError {
+	if err == nil {
+		return nil
+	}
+	var errs validation.Errors
+	if !errors.As(err, &errs) {
+		return []FieldError{{Message: err.Error()}}
+	}
+	var out []FieldError
+	flattenFieldErrors("", errs, &out)
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
+
+func flattenFieldErrors(prefix string, errs validation.Errors, out *[]FieldError) {
+	for field, err := range errs {
+		if prefix != "" {
+			field = prefix + "." + field
+		}
+		if nested, ok := err.(validation.Errors); ok {
+			flattenFieldErrors(field, nested, out)
+			continue
+		}
+		*out = append(*out, FieldError{Field: field, Message: err.Error()})
+	}
+}
+
+// WriteValidation writes the outcome of a request's Validate method as {"valid": bool, "errors": [...]}
+// with status 200, see [FieldErrors]. A [validation.InternalError] means the rules themselves failed
+// and is returned instead, so it is served as an internal error.
+func WriteValidation(c echo.Context, err error) error {
+	var internal validation.InternalError
+	if errors.As(err, &internal) {
+		return err
+	}
+	fieldErrors := FieldErrors(err)
+	if fieldErrors == nil {
+		fieldErrors = []FieldError{}
+	}
+	return Write(c, http.StatusOK, map[string]any{"valid": err == nil, "errors": fieldErrors})
+}
@@ -0,0 +1,53 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package response
+
+import (
+	"errors"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldErrors(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, FieldErrors(nil))
+	})
+
+	t.Run("nested fields flattened and sorted", func(t *testing.T) {
+		// Arrange
+		err := validation.Errors{
+			"tags": validation.Errors{"1": errors.New("the length must be between 3 and 20")},
+			"name": errors.New("cannot be blank"),
+		}
+
+		// Act
+		got := FieldErrors(err)
+
+		// Assert
+		assert.Equal(t, []FieldError{
+			{Field: "name", Message: "cannot be blank"},
+			{Field: "tags.1", Message: "the length must be between 3 and 20"},
+		}, got)
+	})
+
+	t.Run("request-level error", func(t *testing.T) {
+		assert.Equal(t, []FieldError{{Message: "boom"}}, FieldErrors(errors.New("boom")))
+	})
+}
@@ -0,0 +1,109 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package tracing provides OpenTelemetry instrumentation shared by services, repositories and clients.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// ExporterNone keeps the global no-op tracer provider, spans are not recorded.
+	ExporterNone = "none"
+	// ExporterLog writes finished spans to the application logger.
+	ExporterLog = "log"
+)
+
+// Config configures span export.
+type Config struct {
+	// Exporter is either [ExporterNone] or [ExporterLog].
+	Exporter string
+}
+
+// DefaultConfig is used for values missing from the environment.
+var DefaultConfig = Config{Exporter: ExporterNone}
+
+// ConfigFromEnv reads the OTEL_TRACES_EXPORTER environment variable.
+// A missing value falls back to [DefaultConfig], an unknown one is rejected by [Setup].
+func ConfigFromEnv() Config {
+	cfg := DefaultConfig
+	if exporter := strings.ToLower(os.Getenv("OTEL_TRACES_EXPORTER")); exporter != "" {
+		cfg.Exporter = exporter
+	}
+	return cfg
+}
+
+// Setup installs the W3C trace context propagator and, unless cfg.Exporter is [ExporterNone],
+// a global tracer provider exporting spans with the configured exporter.
+//
+// Returns a function flushing and stopping the tracer provider, to be called on shutdown.
+// Returns an error if the exporter is unknown.
+func Setup(cfg Config, logger *slog.Logger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	switch cfg.Exporter {
+	case ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterLog:
+		exporter = &logExporter{logger: logger}
+	default:
+		return nil, fmt.Errorf("unknown traces exporter %q", cfg.Exporter)
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// logExporter writes finished spans to a logger at debug level.
+type logExporter struct {
+	logger *slog.Logger
+}
+
+// ExportSpans logs every span with its trace, parent and attributes.
+func (e *logExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	for _, span := range spans {
+		args := []any{
+			"name", span.Name(),
+			"trace_id", span.SpanContext().TraceID().String(),
+			"span_id", span.SpanContext().SpanID().String(),
+			"parent_span_id", span.Parent().SpanID().String(),
+			"duration", span.EndTime().Sub(span.StartTime()),
+			"status", span.Status().Code.String(),
+		}
+		for _, attr := range span.Attributes() {
+			args = append(args, string(attr.Key), attr.Value.Emit())
+		}
+		e.logger.DebugContext(ctx, "span", args...)
+	}
+	return nil
+}
+
+// Shutdown does nothing, the logger is owned by the application.
+func (e *logExporter) Shutdown(context.Context) error {
+	return nil
+}
@@ -0,0 +1,59 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package tracing provides OpenTelemetry instrumentation shared by services, repositories and clients.
+//
+// Spans are created through the global tracer provider, which is a no-op until [Setup]
+// installs an exporting one, so instrumented code costs next to nothing when tracing is off.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName identifies spans produced by this service.
+const InstrumentationName = "github.com/mikhail5545/product-service-go"
+
+const (
+	// EntityTypeKey is the attribute holding the entity type of an operation, e.g. "seminar".
+	EntityTypeKey = attribute.Key("entity.type")
+	// EntityIDKey is the attribute holding the ID of the entity an operation works on.
+	EntityIDKey = attribute.Key("entity.id")
+	// OperationKey is the attribute holding the name of the operation, e.g. "Update".
+	OperationKey = attribute.Key("operation")
+)
+
+// Tracer returns the tracer of this service from the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// Start opens a span named "<entityType>.<operation>" as a child of the span in ctx.
+// The caller must end the returned span.
+func Start(ctx context.Context, entityType, operation string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	attrs = append(attrs, EntityTypeKey.String(entityType), OperationKey.String(operation))
+	return Tracer().Start(ctx, entityType+"."+operation, trace.WithAttributes(attrs...))
+}
+
+// ID returns the entity ID attribute.
+func ID(id string) attribute.KeyValue {
+	return EntityIDKey.String(id)
+}
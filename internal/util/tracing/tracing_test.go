@@ -0,0 +1,116 @@
+// github.com/mikhail5545/product-service-go
+// microservice for vitianmove project family
+// Copyright (C) 2025  Mikhail Kulik
+
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published
+// by the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+package tracing
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	tracingtest "github.com/mikhail5545/product-service-go/internal/test/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestStart(t *testing.T) {
+	// Arrange
+	recorder := tracingtest.Record(t)
+
+	// Act
+	ctx, parent := Start(context.Background(), "seminar", "Update", ID("seminar-id"))
+	_, child := Start(ctx, "product", "Get")
+	child.End()
+	parent.End()
+
+	// Assert
+	updateSpan := tracingtest.Find(recorder, "seminar.Update")
+	getSpan := tracingtest.Find(recorder, "product.Get")
+	if assert.NotNil(t, updateSpan) && assert.NotNil(t, getSpan) {
+		assert.Contains(t, updateSpan.Attributes(), attribute.String("entity.type", "seminar"))
+		assert.Contains(t, updateSpan.Attributes(), attribute.String("entity.id", "seminar-id"))
+		assert.Contains(t, updateSpan.Attributes(), attribute.String("operation", "Update"))
+		assert.Equal(t, updateSpan.SpanContext().SpanID(), getSpan.Parent().SpanID())
+		assert.Equal(t, updateSpan.SpanContext().TraceID(), getSpan.SpanContext().TraceID())
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	// Arrange
+	recorder := tracingtest.Record(t)
+	previous := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTextMapPropagator(previous) })
+
+	ctx, span := Start(context.Background(), "video", "Add")
+	ctx = metadata.AppendToOutgoingContext(ctx, "x-request-id", "request-id")
+	var sent metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	// Act
+	err := UnaryClientInterceptor()(ctx, "/mux.AssetService/Get", nil, nil, nil, invoker)
+	span.End()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"request-id"}, sent.Get("x-request-id"))
+	if traceparent := sent.Get("traceparent"); assert.Len(t, traceparent, 1) {
+		addSpan := tracingtest.Find(recorder, "video.Add")
+		assert.Contains(t, traceparent[0], addSpan.SpanContext().TraceID().String())
+		assert.Contains(t, traceparent[0], addSpan.SpanContext().SpanID().String())
+	}
+}
+
+func TestSetup(t *testing.T) {
+	t.Run("none keeps the no-op provider", func(t *testing.T) {
+		previous := otel.GetTracerProvider()
+
+		shutdown, err := Setup(Config{Exporter: ExporterNone}, slog.New(slog.DiscardHandler))
+
+		assert.NoError(t, err)
+		assert.NoError(t, shutdown(context.Background()))
+		assert.Equal(t, previous, otel.GetTracerProvider())
+	})
+
+	t.Run("unknown exporter", func(t *testing.T) {
+		shutdown, err := Setup(Config{Exporter: "zipkin"}, slog.New(slog.DiscardHandler))
+
+		assert.Error(t, err)
+		assert.Nil(t, shutdown)
+	})
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("OTEL_TRACES_EXPORTER", "")
+
+		assert.Equal(t, DefaultConfig, ConfigFromEnv())
+	})
+
+	t.Run("from env", func(t *testing.T) {
+		t.Setenv("OTEL_TRACES_EXPORTER", "LOG")
+
+		assert.Equal(t, Config{Exporter: ExporterLog}, ConfigFromEnv())
+	})
+}